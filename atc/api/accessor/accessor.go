@@ -15,6 +15,8 @@ type Access interface {
 	HasToken() bool
 	IsAuthenticated() bool
 	IsAuthorized(string) bool
+	IsAuthorizedForPipeline(string, atc.PipelineRef) bool
+	IsAuthorizedForSharedPipeline([]string) bool
 	IsAdmin() bool
 	IsSystem() bool
 	TeamNames() []string
@@ -30,39 +32,66 @@ type Claims struct {
 	PreferredUsername string
 	Email             string
 	Connector         string
+	Groups            []string
+
+	// IsAPIToken is set when the request was made with an API token rather
+	// than a token issued on login.
+	IsAPIToken bool
 }
 
+// APITokenClaim is added by the verifier to the claims of requests made with
+// an API token, holding the token's "id", "role" and, for service account
+// tokens, "team".
+const APITokenClaim = "api_token"
+
 type Verification struct {
 	HasToken     bool
 	IsTokenValid bool
 	RawClaims    map[string]interface{}
 }
 
+// RequestPipeline is the pipeline a request is for, so that roles which are
+// scoped to pipelines can be taken into account.
+type RequestPipeline struct {
+	TeamName string
+	Ref      atc.PipelineRef
+}
+
 type access struct {
 	verification           Verification
+	action                 string
 	requiredRole           string
+	requestPipeline        *RequestPipeline
 	systemClaimKey         string
 	systemClaimValues      []string
 	teams                  []db.Team
+	scimGroups             []string
 	teamRoles              map[string][]string
+	teamAuth               map[string]atc.TeamAuth
 	isAdmin                bool
 	displayUserIdGenerator atc.DisplayUserIdGenerator
 }
 
 func NewAccessor(
 	verification Verification,
+	action string,
 	requiredRole string,
+	requestPipeline *RequestPipeline,
 	systemClaimKey string,
 	systemClaimValues []string,
 	teams []db.Team,
+	scimGroups []string,
 	displayUserIdGenerator atc.DisplayUserIdGenerator,
 ) *access {
 	a := &access{
 		verification:           verification,
+		action:                 action,
 		requiredRole:           requiredRole,
+		requestPipeline:        requestPipeline,
 		systemClaimKey:         systemClaimKey,
 		systemClaimValues:      systemClaimValues,
 		teams:                  teams,
+		scimGroups:             scimGroups,
 		displayUserIdGenerator: displayUserIdGenerator,
 	}
 	a.computeTeamRoles()
@@ -71,18 +100,51 @@ func NewAccessor(
 
 func (a *access) computeTeamRoles() {
 	a.teamRoles = map[string][]string{}
+	a.teamAuth = map[string]atc.TeamAuth{}
+
+	tokenRole, tokenTeam, isAPIToken := a.apiToken()
 
 	for _, team := range a.teams {
-		roles := a.rolesForTeam(team.Auth())
+		auth := team.Auth()
+
+		var roles []string
+		if tokenTeam != "" {
+			// service account tokens are granted their role on their team
+			// only, regardless of the team's auth config
+			if team.Name() != tokenTeam {
+				continue
+			}
+
+			roles = []string{tokenRole}
+			auth = atc.TeamAuth{tokenRole: {}}
+		} else {
+			roles = a.rolesForTeam(auth)
+		}
+
 		if len(roles) > 0 {
 			a.teamRoles[team.Name()] = roles
+			a.teamAuth[team.Name()] = auth
 		}
-		if team.Admin() && contains(roles, "owner") {
+		if team.Admin() && contains(roles, OwnerRole) && !auth.IsScoped(OwnerRole) && !auth.IsCustom(OwnerRole) && (!isAPIToken || tokenRole == OwnerRole) {
 			a.isAdmin = true
 		}
 	}
 }
 
+// apiToken returns the role of the API token the request was made with, and
+// its team if it belongs to a service account.
+func (a *access) apiToken() (string, string, bool) {
+	raw, ok := a.claims()[APITokenClaim].(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+
+	role, _ := raw["role"].(string)
+	team, _ := raw["team"].(string)
+
+	return role, team, true
+}
+
 func contains(arr []string, val string) bool {
 	for _, v := range arr {
 		if v == val {
@@ -101,8 +163,8 @@ func (a *access) rolesForTeam(auth atc.TeamAuth) []string {
 	userName := a.userName()
 
 	for role, auth := range auth {
-		userAuth := auth["users"]
-		groupAuth := auth["groups"]
+		userAuth := auth[atc.TeamAuthUsers]
+		groupAuth := auth[atc.TeamAuthGroups]
 
 		// backwards compatibility for allow-all-users
 		if len(userAuth) == 0 && len(groupAuth) == 0 {
@@ -130,6 +192,14 @@ func (a *access) rolesForTeam(auth atc.TeamAuth) []string {
 					}
 				}
 			}
+
+			// groups provisioned through SCIM apply whichever connector the
+			// user logged in with
+			for _, scimGroup := range a.scimGroups {
+				if strings.EqualFold(group, atc.SCIMConnector+":"+scimGroup) {
+					roleSet[role] = true
+				}
+			}
 		}
 	}
 
@@ -148,14 +218,46 @@ func (a *access) IsAuthenticated() bool {
 	return a.verification.IsTokenValid
 }
 
+// IsAuthorized returns whether the action may be performed on the team. Roles
+// scoped to pipelines only count if the request is for one of them.
 func (a *access) IsAuthorized(teamName string) bool {
-	return a.isAdmin || a.hasPermission(a.teamRoles[teamName])
+	if a.requestPipeline != nil && a.requestPipeline.TeamName == teamName {
+		return a.IsAuthorizedForPipeline(teamName, a.requestPipeline.Ref)
+	}
+
+	return a.isAdmin || a.hasPermission(teamName, nil)
+}
+
+// IsAuthorizedForPipeline returns whether the action may be performed on the
+// given pipeline of the team, either through a role granted for the whole
+// team or one scoped to the pipeline.
+func (a *access) IsAuthorizedForPipeline(teamName string, pipelineRef atc.PipelineRef) bool {
+	return a.isAdmin || a.hasPermission(teamName, &pipelineRef)
 }
 
+// IsAuthorizedForSharedPipeline returns whether the action may be performed
+// on a pipeline shared with the given teams. Sharing only ever grants read
+// access, so it only counts for actions requiring the viewer role.
+func (a *access) IsAuthorizedForSharedPipeline(sharedWithTeams []string) bool {
+	if a.requiredRole != ViewerRole {
+		return false
+	}
+
+	for _, teamName := range sharedWithTeams {
+		if a.isAdmin || a.hasPermission(teamName, nil) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TeamNames returns the teams on which the action may be performed, not
+// counting roles scoped to pipelines.
 func (a *access) TeamNames() []string {
 	teamNames := []string{}
 	for _, team := range a.teams {
-		if a.isAdmin || a.hasPermission(a.teamRoles[team.Name()]) {
+		if a.isAdmin || a.hasPermission(team.Name(), nil) {
 			teamNames = append(teamNames, team.Name())
 		}
 	}
@@ -163,19 +265,42 @@ func (a *access) TeamNames() []string {
 	return teamNames
 }
 
-func (a *access) hasPermission(roles []string) bool {
-	allow := false
-	for _, role := range roles {
-		allow = allow || a.hasRequiredRole(role)
-		if allow {
+func (a *access) hasPermission(teamName string, pipelineRef *atc.PipelineRef) bool {
+	// API tokens never grant more than their role, whatever the roles of
+	// their owner
+	if tokenRole, _, isAPIToken := a.apiToken(); isAPIToken && !satisfiesRole(tokenRole, a.requiredRole) {
+		return false
+	}
+
+	auth := a.teamAuth[teamName]
+
+	for _, role := range a.teamRoles[teamName] {
+		if auth.IsScoped(role) && (pipelineRef == nil || !auth.AppliesTo(role, *pipelineRef)) {
+			continue
+		}
+
+		if actions, custom := auth[role][atc.TeamAuthActions]; custom {
+			if contains(actions, a.action) && atc.IsGrantableAction(a.action) {
+				return true
+			}
+
+			continue
+		}
+
+		if a.hasRequiredRole(role) {
 			return true
 		}
 	}
+
 	return false
 }
 
 func (a *access) hasRequiredRole(role string) bool {
-	switch a.requiredRole {
+	return satisfiesRole(role, a.requiredRole)
+}
+
+func satisfiesRole(role string, requiredRole string) bool {
+	switch requiredRole {
 	case OwnerRole:
 		return role == OwnerRole
 	case MemberRole:
@@ -240,7 +365,7 @@ func (a *access) connectorID() string {
 }
 
 func (a *access) groups() []string {
-	groups := []string{}
+	var groups []string
 	if raw, ok := a.claims()["groups"]; ok {
 		if rawGroups, ok := raw.([]interface{}); ok {
 			for _, rawGroup := range rawGroups {
@@ -273,6 +398,8 @@ func (a *access) TeamRoles() map[string][]string {
 }
 
 func (a *access) Claims() Claims {
+	_, _, isAPIToken := a.apiToken()
+
 	return Claims{
 		Sub:               a.claim("sub"),
 		Email:             a.claim("email"),
@@ -280,6 +407,8 @@ func (a *access) Claims() Claims {
 		UserName:          a.claim("name"),
 		PreferredUsername: a.claim("preferred_username"),
 		Connector:         a.connectorID(),
+		Groups:            a.groups(),
+		IsAPIToken:        isAPIToken,
 	}
 }
 
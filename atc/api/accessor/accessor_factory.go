@@ -6,6 +6,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
 )
 
 //counterfeiter:generate . TokenVerifier
@@ -18,9 +19,16 @@ type TeamFetcher interface {
 	GetTeams() ([]db.Team, error)
 }
 
+//counterfeiter:generate . SCIMGroupFetcher
+type SCIMGroupFetcher interface {
+	SCIMUserGroups(userID string) ([]string, error)
+}
+
 func NewAccessFactory(
 	tokenVerifier TokenVerifier,
 	teamFetcher TeamFetcher,
+	scimGroupFetcher SCIMGroupFetcher,
+	scimConnector string,
 	systemClaimKey string,
 	systemClaimValues []string,
 	displayUserIdGenerator atc.DisplayUserIdGenerator,
@@ -28,6 +36,8 @@ func NewAccessFactory(
 	return &accessFactory{
 		tokenVerifier:          tokenVerifier,
 		teamFetcher:            teamFetcher,
+		scimGroupFetcher:       scimGroupFetcher,
+		scimConnector:          scimConnector,
 		systemClaimKey:         systemClaimKey,
 		systemClaimValues:      systemClaimValues,
 		displayUserIdGenerator: displayUserIdGenerator,
@@ -37,17 +47,64 @@ func NewAccessFactory(
 type accessFactory struct {
 	tokenVerifier          TokenVerifier
 	teamFetcher            TeamFetcher
+	scimGroupFetcher       SCIMGroupFetcher
+	scimConnector          string
 	systemClaimKey         string
 	systemClaimValues      []string
 	displayUserIdGenerator atc.DisplayUserIdGenerator
 }
 
-func (a *accessFactory) Create(req *http.Request, role string) (Access, error) {
+func (a *accessFactory) Create(req *http.Request, action string, role string) (Access, error) {
 	teams, err := a.teamFetcher.GetTeams()
 	if err != nil {
 		return nil, fmt.Errorf("fetch teams: %w", err)
 	}
-	return NewAccessor(a.verifyToken(req), role, a.systemClaimKey, a.systemClaimValues, teams, a.displayUserIdGenerator), nil
+
+	verification := a.verifyToken(req)
+
+	scimGroups, err := a.scimGroups(verification)
+	if err != nil {
+		return nil, fmt.Errorf("fetch scim groups: %w", err)
+	}
+
+	return NewAccessor(verification, action, role, requestPipeline(req), a.systemClaimKey, a.systemClaimValues, teams, scimGroups, a.displayUserIdGenerator), nil
+}
+
+// scimGroups returns the groups provisioned through SCIM of the user the
+// request is authenticated as. Only logins through the connector of the
+// identity provider doing the provisioning count, as the user names and
+// emails of other connectors may be chosen by the users themselves.
+func (a *accessFactory) scimGroups(verification Verification) ([]string, error) {
+	if !verification.IsTokenValid || a.scimConnector == "" {
+		return nil, nil
+	}
+
+	federatedClaims, _ := verification.RawClaims["federated_claims"].(map[string]interface{})
+	connectorID, _ := federatedClaims["connector_id"].(string)
+	userID, _ := federatedClaims["user_id"].(string)
+	if connectorID != a.scimConnector || userID == "" {
+		return nil, nil
+	}
+
+	return a.scimGroupFetcher.SCIMUserGroups(userID)
+}
+
+func requestPipeline(req *http.Request) *RequestPipeline {
+	teamName := rata.Param(req, "team_name")
+	pipelineName := rata.Param(req, "pipeline_name")
+	if teamName == "" || pipelineName == "" {
+		return nil
+	}
+
+	instanceVars, err := atc.InstanceVarsFromQueryParams(req.URL.Query())
+	if err != nil {
+		return nil
+	}
+
+	return &RequestPipeline{
+		TeamName: teamName,
+		Ref:      atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars},
+	}
 }
 
 func (a *accessFactory) verifyToken(req *http.Request) Verification {
@@ -22,7 +22,9 @@ var _ = Describe("AccessorFactory", func() {
 
 		fakeTokenVerifier *accessorfakes.FakeTokenVerifier
 		fakeTeamFetcher   *accessorfakes.FakeTeamFetcher
-		dummyRequest      *http.Request
+
+		fakeSCIMGroupFetcher *accessorfakes.FakeSCIMGroupFetcher
+		dummyRequest         *http.Request
 
 		fakeDisplayUserIdGenerator *atcfakes.FakeDisplayUserIdGenerator
 
@@ -35,6 +37,7 @@ var _ = Describe("AccessorFactory", func() {
 
 		fakeTokenVerifier = new(accessorfakes.FakeTokenVerifier)
 		fakeTeamFetcher = new(accessorfakes.FakeTeamFetcher)
+		fakeSCIMGroupFetcher = new(accessorfakes.FakeSCIMGroupFetcher)
 		dummyRequest, _ = http.NewRequest("GET", "/", nil)
 
 		fakeDisplayUserIdGenerator = new(atcfakes.FakeDisplayUserIdGenerator)
@@ -50,8 +53,8 @@ var _ = Describe("AccessorFactory", func() {
 		)
 
 		JustBeforeEach(func() {
-			factory := accessor.NewAccessFactory(fakeTokenVerifier, fakeTeamFetcher, systemClaimKey, systemClaimValues, fakeDisplayUserIdGenerator)
-			access, err = factory.Create(dummyRequest, role)
+			factory := accessor.NewAccessFactory(fakeTokenVerifier, fakeTeamFetcher, fakeSCIMGroupFetcher, "okta", systemClaimKey, systemClaimValues, fakeDisplayUserIdGenerator)
+			access, err = factory.Create(dummyRequest, atc.GetPipeline, role)
 		})
 
 		Context("when the token is valid", func() {
@@ -84,6 +87,91 @@ var _ = Describe("AccessorFactory", func() {
 			})
 		})
 
+		Context("when the request is for a pipeline", func() {
+			BeforeEach(func() {
+				dummyRequest, _ = http.NewRequest("GET", `/?:team_name=t1&:pipeline_name=some-pipeline&vars.branch="main"`, nil)
+
+				fakeTokenVerifier.VerifyReturns(map[string]interface{}{
+					"preferred_username": "user1",
+					"federated_claims": map[string]interface{}{
+						"connector_id": "github",
+					},
+				}, nil)
+
+				team := new(dbfakes.FakeTeam)
+				team.NameReturns("t1")
+				team.AuthReturns(atc.TeamAuth{"viewer": map[string][]string{
+					"users":     {"github:user1"},
+					"pipelines": {"some-pipeline/branch:main"},
+				}})
+				fakeTeamFetcher.GetTeamsReturns([]db.Team{team}, nil)
+			})
+
+			It("takes roles scoped to the pipeline into account", func() {
+				Expect(access.IsAuthorized("t1")).To(BeTrue())
+				Expect(access.IsAuthorizedForPipeline("t1", atc.PipelineRef{Name: "other-pipeline"})).To(BeFalse())
+				Expect(access.TeamNames()).To(BeEmpty())
+			})
+		})
+
+		Context("when the user is in groups provisioned through SCIM", func() {
+			BeforeEach(func() {
+				fakeTokenVerifier.VerifyReturns(map[string]interface{}{
+					"preferred_username": "user1",
+					"email":              "user1@example.com",
+					"federated_claims": map[string]interface{}{
+						"connector_id": "okta",
+						"user_id":      "00u1",
+					},
+				}, nil)
+
+				fakeSCIMGroupFetcher.SCIMUserGroupsReturns([]string{"platform"}, nil)
+
+				team := new(dbfakes.FakeTeam)
+				team.NameReturns("t1")
+				team.AuthReturns(atc.TeamAuth{"viewer": map[string][]string{
+					"groups": {"scim:platform"},
+				}})
+				fakeTeamFetcher.GetTeamsReturns([]db.Team{team}, nil)
+			})
+
+			It("grants the roles of the groups", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(access.TeamNames()).To(ConsistOf("t1"))
+
+				Expect(fakeSCIMGroupFetcher.SCIMUserGroupsArgsForCall(0)).To(Equal("00u1"))
+			})
+
+			Context("when the user logged in through another connector", func() {
+				BeforeEach(func() {
+					fakeTokenVerifier.VerifyReturns(map[string]interface{}{
+						"preferred_username": "user1",
+						"email":              "user1@example.com",
+						"federated_claims": map[string]interface{}{
+							"connector_id": "github",
+							"user_id":      "00u1",
+						},
+					}, nil)
+				})
+
+				It("does not grant the roles of the groups", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(access.TeamNames()).To(BeEmpty())
+					Expect(fakeSCIMGroupFetcher.SCIMUserGroupsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when fetching the groups fails", func() {
+				BeforeEach(func() {
+					fakeSCIMGroupFetcher.SCIMUserGroupsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+
 		Context("when the team fetcher returns an error", func() {
 			BeforeEach(func() {
 				fakeTeamFetcher.GetTeamsReturns(nil, errors.New("nope"))
@@ -103,6 +191,10 @@ var _ = Describe("AccessorFactory", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(access.HasToken()).To(BeFalse())
 			})
+
+			It("does not fetch groups provisioned through SCIM", func() {
+				Expect(fakeSCIMGroupFetcher.SCIMUserGroupsCallCount()).To(BeZero())
+			})
 		})
 
 		Context("when the verifier returns some other error", func() {
@@ -14,10 +14,13 @@ import (
 
 var _ = Describe("Accessor", func() {
 	var (
-		verification accessor.Verification
-		requiredRole string
-		teams        []db.Team
-		access       accessor.Access
+		verification    accessor.Verification
+		action          string
+		requiredRole    string
+		requestPipeline *accessor.RequestPipeline
+		teams           []db.Team
+		scimGroups      []string
+		access          accessor.Access
 
 		fakeTeam1 *dbfakes.FakeTeam
 		fakeTeam2 *dbfakes.FakeTeam
@@ -35,14 +38,17 @@ var _ = Describe("Accessor", func() {
 		fakeTeam3.NameReturns("some-team-3")
 
 		verification = accessor.Verification{}
+		action = ""
+		requestPipeline = nil
 
 		teams = []db.Team{fakeTeam1, fakeTeam2, fakeTeam3}
+		scimGroups = nil
 
 		fakeDisplayUserIdGenerator = new(atcfakes.FakeDisplayUserIdGenerator)
 	})
 
 	JustBeforeEach(func() {
-		access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+		access = accessor.NewAccessor(verification, action, requiredRole, requestPipeline, "sub", []string{"system"}, teams, scimGroups, fakeDisplayUserIdGenerator)
 	})
 
 	Describe("HasToken", func() {
@@ -195,7 +201,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, action, requiredRole, requestPipeline, "sub", []string{"system"}, teams, scimGroups, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -242,7 +248,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, action, requiredRole, requestPipeline, "sub", []string{"system"}, teams, scimGroups, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -303,7 +309,7 @@ var _ = Describe("Accessor", func() {
 				})
 			}
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, action, requiredRole, requestPipeline, "sub", []string{"system"}, teams, scimGroups, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -326,6 +332,285 @@ var _ = Describe("Accessor", func() {
 		Entry("user is viewer and group is member attempting viewer action", "viewer", "viewer", "viewer", true),
 	)
 
+	Describe("roles scoped to pipelines", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			requiredRole = accessor.OperatorRole
+
+			fakeTeam1.NameReturns("some-team")
+			fakeTeam1.AdminReturns(true)
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{
+					"users":     {"some-connector:some-user-id"},
+					"pipelines": {"some-pipeline", "other-pipeline/branch:main"},
+				},
+			})
+		})
+
+		It("does not grant access to the team as a whole", func() {
+			Expect(access.IsAuthorized("some-team")).To(BeFalse())
+			Expect(access.TeamNames()).To(BeEmpty())
+		})
+
+		It("does not make the user an admin", func() {
+			Expect(access.IsAdmin()).To(BeFalse())
+		})
+
+		It("still lists the team's roles", func() {
+			Expect(access.TeamRoles()).To(Equal(map[string][]string{"some-team": {"owner"}}))
+		})
+
+		It("grants access to the pipelines and instances it is scoped to", func() {
+			Expect(access.IsAuthorizedForPipeline("some-team", atc.PipelineRef{Name: "some-pipeline"})).To(BeTrue())
+			Expect(access.IsAuthorizedForPipeline("some-team", atc.PipelineRef{Name: "some-pipeline", InstanceVars: atc.InstanceVars{"branch": "dev"}})).To(BeTrue())
+			Expect(access.IsAuthorizedForPipeline("some-team", atc.PipelineRef{Name: "other-pipeline", InstanceVars: atc.InstanceVars{"branch": "main"}})).To(BeTrue())
+
+			Expect(access.IsAuthorizedForPipeline("some-team", atc.PipelineRef{Name: "other-pipeline", InstanceVars: atc.InstanceVars{"branch": "dev"}})).To(BeFalse())
+			Expect(access.IsAuthorizedForPipeline("some-team", atc.PipelineRef{Name: "another-pipeline"})).To(BeFalse())
+		})
+
+		Context("when the request is for one of the pipelines", func() {
+			BeforeEach(func() {
+				requestPipeline = &accessor.RequestPipeline{
+					TeamName: "some-team",
+					Ref:      atc.PipelineRef{Name: "some-pipeline"},
+				}
+			})
+
+			It("grants access to the team", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeTrue())
+			})
+		})
+
+		Context("when the request is for another pipeline", func() {
+			BeforeEach(func() {
+				requestPipeline = &accessor.RequestPipeline{
+					TeamName: "some-team",
+					Ref:      atc.PipelineRef{Name: "another-pipeline"},
+				}
+			})
+
+			It("does not grant access to the team", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("IsAuthorizedForSharedPipeline", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			requiredRole = accessor.ViewerRole
+
+			fakeTeam2.AuthReturns(atc.TeamAuth{
+				"viewer": map[string][]string{
+					"users": {"some-connector:some-user-id"},
+				},
+			})
+		})
+
+		It("grants access when the pipeline is shared with one of the user's teams", func() {
+			Expect(access.IsAuthorizedForSharedPipeline([]string{"some-team-1", "some-team-2"})).To(BeTrue())
+		})
+
+		It("does not grant access when the pipeline is shared with other teams", func() {
+			Expect(access.IsAuthorizedForSharedPipeline([]string{"some-team-1", "some-team-3"})).To(BeFalse())
+			Expect(access.IsAuthorizedForSharedPipeline(nil)).To(BeFalse())
+		})
+
+		Context("when the action requires more than the viewer role", func() {
+			BeforeEach(func() {
+				requiredRole = accessor.OperatorRole
+
+				fakeTeam2.AuthReturns(atc.TeamAuth{
+					"owner": map[string][]string{
+						"users": {"some-connector:some-user-id"},
+					},
+				})
+			})
+
+			It("does not grant access", func() {
+				Expect(access.IsAuthorizedForSharedPipeline([]string{"some-team-2"})).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("custom roles", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			fakeTeam1.NameReturns("some-team")
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"contractor": map[string][]string{
+					"users":   {"some-connector:some-user-id"},
+					"actions": {atc.GetPipeline, atc.CreateJobBuild},
+				},
+			})
+		})
+
+		Context("when the action is one of the role's", func() {
+			BeforeEach(func() {
+				action = atc.CreateJobBuild
+				requiredRole = accessor.OperatorRole
+			})
+
+			It("grants access", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeTrue())
+			})
+		})
+
+		Context("when the action is not one of the role's", func() {
+			BeforeEach(func() {
+				action = atc.ListJobs
+				requiredRole = accessor.ViewerRole
+			})
+
+			It("does not grant access, even to an action any built-in role could perform", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeFalse())
+			})
+		})
+
+		Context("when the action may not be granted by custom roles", func() {
+			BeforeEach(func() {
+				action = atc.SetTeam
+				requiredRole = accessor.OwnerRole
+
+				fakeTeam1.AuthReturns(atc.TeamAuth{
+					"contractor": map[string][]string{
+						"users":   {"some-connector:some-user-id"},
+						"actions": {atc.SetTeam},
+					},
+				})
+			})
+
+			It("does not grant access", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeFalse())
+			})
+		})
+
+		Context("when a role of the main team named owner lists actions", func() {
+			BeforeEach(func() {
+				fakeTeam1.AdminReturns(true)
+				fakeTeam1.AuthReturns(atc.TeamAuth{
+					"owner": map[string][]string{
+						"users":   {"some-connector:some-user-id"},
+						"actions": {atc.GetPipeline},
+					},
+				})
+			})
+
+			It("does not make the user an admin", func() {
+				Expect(access.IsAdmin()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("API tokens", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+
+			fakeTeam1.NameReturns("some-team")
+			fakeTeam1.AdminReturns(true)
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{
+					"users": {"some-connector:some-user-id"},
+				},
+			})
+		})
+
+		Context("when the token belongs to a user", func() {
+			BeforeEach(func() {
+				verification.RawClaims = map[string]interface{}{
+					"federated_claims": map[string]interface{}{
+						"connector_id": "some-connector",
+						"user_id":      "some-user-id",
+					},
+					accessor.APITokenClaim: map[string]interface{}{
+						"id":   1,
+						"role": accessor.ViewerRole,
+					},
+				}
+			})
+
+			It("grants the user's roles up to the token's role", func() {
+				Expect(access.TeamRoles()).To(HaveKeyWithValue("some-team", []string{"owner"}))
+				Expect(access.IsAdmin()).To(BeFalse())
+				Expect(access.Claims().IsAPIToken).To(BeTrue())
+			})
+
+			Context("when the action requires the token's role", func() {
+				BeforeEach(func() {
+					requiredRole = accessor.ViewerRole
+				})
+
+				It("grants access", func() {
+					Expect(access.IsAuthorized("some-team")).To(BeTrue())
+				})
+			})
+
+			Context("when the action requires more than the token's role", func() {
+				BeforeEach(func() {
+					requiredRole = accessor.MemberRole
+				})
+
+				It("does not grant access", func() {
+					Expect(access.IsAuthorized("some-team")).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the token belongs to a service account", func() {
+			BeforeEach(func() {
+				verification.RawClaims = map[string]interface{}{
+					"sub": "serviceaccount:some-team-2:deployer",
+					"federated_claims": map[string]interface{}{
+						"connector_id": "serviceaccount",
+						"user_id":      "deployer",
+					},
+					accessor.APITokenClaim: map[string]interface{}{
+						"id":   1,
+						"role": accessor.OperatorRole,
+						"team": "some-team-2",
+					},
+				}
+
+				requiredRole = accessor.OperatorRole
+			})
+
+			It("grants the token's role on its team only", func() {
+				Expect(access.TeamRoles()).To(Equal(map[string][]string{
+					"some-team-2": {accessor.OperatorRole},
+				}))
+				Expect(access.IsAuthorized("some-team-2")).To(BeTrue())
+				Expect(access.IsAuthorized("some-team")).To(BeFalse())
+				Expect(access.IsAdmin()).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("TeamNames", func() {
 		var result []string
 
@@ -845,6 +1130,28 @@ var _ = Describe("Accessor", func() {
 				})
 			})
 
+			Context("when the user is granted a role from a group provisioned through SCIM", func() {
+				BeforeEach(func() {
+					scimGroups = []string{"Platform"}
+
+					fakeTeam1.AuthReturns(atc.TeamAuth{
+						"member": map[string][]string{
+							"groups": {"scim:platform"},
+						},
+					})
+					fakeTeam2.AuthReturns(atc.TeamAuth{
+						"member": map[string][]string{
+							"groups": {"some-connector:platform"},
+						},
+					})
+				})
+
+				It("returns result with teams", func() {
+					Expect(result).To(HaveKeyWithValue("some-team-1", []string{"member"}))
+					Expect(result).ToNot(HaveKey("some-team-2"))
+				})
+			})
+
 			Context("when the user is granted multiple roles on the same team", func() {
 				BeforeEach(func() {
 					fakeTeam1.AuthReturns(atc.TeamAuth{
@@ -2,8 +2,12 @@ package accessor
 
 import (
 	"encoding/json"
+	"os"
 	"sync"
+	"time"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/golang/groupcache/lru"
 )
@@ -14,19 +18,30 @@ type claimsCacheEntry struct {
 }
 
 type claimsCacher struct {
+	logger             lager.Logger
+	notifications      Notifications
 	accessTokenFetcher AccessTokenFetcher
 	maxCacheSizeBytes  int
 
 	cache          *lru.Cache
 	cacheSizeBytes int
+	listening      bool
 	mu             sync.Mutex // lru.Cache is not safe for concurrent access
 }
 
+// claimsCacheMaxRetryInterval caps the backoff between attempts to listen for
+// revoked sessions.
+const claimsCacheMaxRetryInterval = time.Minute
+
 func NewClaimsCacher(
+	logger lager.Logger,
+	notifications Notifications,
 	accessTokenFetcher AccessTokenFetcher,
 	maxCacheSizeBytes int,
 ) *claimsCacher {
 	c := &claimsCacher{
+		logger:             logger,
+		notifications:      notifications,
 		accessTokenFetcher: accessTokenFetcher,
 		maxCacheSizeBytes:  maxCacheSizeBytes,
 		cache:              lru.New(0),
@@ -43,6 +58,12 @@ func (c *claimsCacher) GetAccessToken(rawToken string) (db.AccessToken, bool, er
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	// revoked sessions would go unnoticed, so nothing is cached until the
+	// cacher is listening for them
+	if !c.listening {
+		return c.accessTokenFetcher.GetAccessToken(rawToken)
+	}
+
 	claims, found := c.cache.Get(rawToken)
 	if found {
 		entry, _ := claims.(claimsCacheEntry)
@@ -70,3 +91,90 @@ func (c *claimsCacher) GetAccessToken(rawToken string) (db.AccessToken, bool, er
 
 	return token, true, nil
 }
+
+// Run drops every cached token whenever sessions are revoked on any web
+// node, as the notification does not say which ones were. Tokens are only
+// cached while it is listening for those notifications; if listening fails,
+// it is retried with a backoff. It is ready once it first tried to listen,
+// whether or not that succeeded.
+func (c *claimsCacher) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	retryInterval := time.Second
+
+	for {
+		notifier, err := c.notifications.Listen(atc.AccessTokenCacheChannel)
+		if err != nil {
+			c.logger.Error("failed-to-listen-for-access-token-cache", err)
+
+			ready = c.signalReady(ready)
+
+			select {
+			case <-signals:
+				return nil
+			case <-time.After(retryInterval):
+			}
+
+			retryInterval *= 2
+			if retryInterval > claimsCacheMaxRetryInterval {
+				retryInterval = claimsCacheMaxRetryInterval
+			}
+
+			continue
+		}
+
+		retryInterval = time.Second
+
+		c.setListening(true)
+
+		ready = c.signalReady(ready)
+
+		stopped := c.waitForNotifications(signals, notifier)
+
+		c.setListening(false)
+
+		err = c.notifications.Unlisten(atc.AccessTokenCacheChannel, notifier)
+		if err != nil {
+			c.logger.Error("failed-to-unlisten-for-access-token-cache", err)
+		}
+
+		if stopped {
+			return nil
+		}
+	}
+}
+
+// waitForNotifications clears the cache on every notification, returning
+// whether it was signalled to stop rather than the notifier being closed.
+func (c *claimsCacher) waitForNotifications(signals <-chan os.Signal, notifier chan bool) bool {
+	for {
+		select {
+		case <-signals:
+			return true
+		case _, ok := <-notifier:
+			if !ok {
+				return false
+			}
+
+			c.mu.Lock()
+			c.cache.Clear()
+			c.mu.Unlock()
+		}
+	}
+}
+
+func (c *claimsCacher) signalReady(ready chan<- struct{}) chan<- struct{} {
+	if ready != nil {
+		close(ready)
+	}
+
+	return nil
+}
+
+// setListening clears the cache either way, as sessions may have been revoked
+// while not listening.
+func (c *claimsCacher) setListening(listening bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache.Clear()
+	c.listening = listening
+}
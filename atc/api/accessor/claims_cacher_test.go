@@ -2,10 +2,15 @@ package accessor_test
 
 import (
 	"errors"
+	"os"
+	"time"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/ifrit"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -14,18 +19,34 @@ import (
 var _ = Describe("ClaimsCacher", func() {
 	var (
 		fakeAccessTokenFetcher *accessorfakes.FakeAccessTokenFetcher
+		fakeNotifications      *accessorfakes.FakeNotifications
+		notifier               chan bool
 		maxCacheSizeBytes      int
 
 		claimsCacher accessor.AccessTokenFetcher
+		process      ifrit.Process
 	)
 
 	BeforeEach(func() {
 		fakeAccessTokenFetcher = new(accessorfakes.FakeAccessTokenFetcher)
 		maxCacheSizeBytes = 1000
+
+		notifier = make(chan bool, 1)
+		fakeNotifications = new(accessorfakes.FakeNotifications)
+		fakeNotifications.ListenReturns(notifier, nil)
 	})
 
 	JustBeforeEach(func() {
-		claimsCacher = accessor.NewClaimsCacher(fakeAccessTokenFetcher, maxCacheSizeBytes)
+		cacher := accessor.NewClaimsCacher(lager.NewLogger("test"), fakeNotifications, fakeAccessTokenFetcher, maxCacheSizeBytes)
+		claimsCacher = cacher
+
+		process = ifrit.Invoke(cacher)
+		Expect(fakeNotifications.ListenCallCount()).To(Equal(1))
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
 	})
 
 	It("fetches claims from the DB", func() {
@@ -86,6 +107,63 @@ var _ = Describe("ClaimsCacher", func() {
 		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(4), "evicted the latest token")
 	})
 
+	It("fetches claims from the DB again once sessions are revoked", func() {
+		fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{}, true, nil)
+		claimsCacher.GetAccessToken("token")
+		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(1))
+
+		Expect(fakeNotifications.ListenArgsForCall(0)).To(Equal(atc.AccessTokenCacheChannel))
+
+		notifier <- true
+
+		Eventually(func() int {
+			claimsCacher.GetAccessToken("token")
+			return fakeAccessTokenFetcher.GetAccessTokenCallCount()
+		}).Should(BeNumerically(">", 1), "did not drop cached claims")
+	})
+
+	It("stops listening when signalled", func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+
+		Expect(fakeNotifications.UnlistenCallCount()).To(Equal(1))
+		channel, unlistened := fakeNotifications.UnlistenArgsForCall(0)
+		Expect(channel).To(Equal(atc.AccessTokenCacheChannel))
+		Expect(unlistened).To(Equal(notifier))
+	})
+
+	Context("when the notifier is closed", func() {
+		It("listens again", func() {
+			fakeNotifications.ListenReturnsOnCall(1, make(chan bool), nil)
+			close(notifier)
+
+			Eventually(fakeNotifications.ListenCallCount).Should(Equal(2))
+			Expect(fakeNotifications.UnlistenCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when listening fails", func() {
+		BeforeEach(func() {
+			fakeNotifications.ListenReturnsOnCall(0, nil, errors.New("nope"))
+		})
+
+		It("does not cache claims until listening succeeds", func() {
+			fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{}, true, nil)
+			claimsCacher.GetAccessToken("token")
+			claimsCacher.GetAccessToken("token")
+			Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(2), "cached claims while not listening")
+
+			Eventually(fakeNotifications.ListenCallCount, 2*time.Second).Should(Equal(2))
+
+			// listening was retried, but may not have succeeded yet
+			Eventually(func() int {
+				claimsCacher.GetAccessToken("token")
+				claimsCacher.GetAccessToken("token")
+				return fakeAccessTokenFetcher.GetAccessTokenCallCount() % 2
+			}).Should(Equal(1), "did not cache claims once listening")
+		})
+	})
+
 	It("errors when the DB fails", func() {
 		fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{}, false, errors.New("error"))
 		_, _, err := claimsCacher.GetAccessToken("token")
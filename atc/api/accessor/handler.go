@@ -15,7 +15,7 @@ const accessorContextKey atc.ContextKey = "accessor"
 
 //counterfeiter:generate . AccessFactory
 type AccessFactory interface {
-	Create(req *http.Request, role string) (Access, error)
+	Create(req *http.Request, action string, role string) (Access, error)
 }
 
 func NewHandler(
@@ -51,7 +51,7 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		requiredRole = DefaultRoles[h.action]
 	}
 
-	acc, err := h.accessFactory.Create(r, requiredRole)
+	acc, err := h.accessFactory.Create(r, h.action, requiredRole)
 	if err != nil {
 		h.logger.Error("failed-to-construct-accessor", err)
 		w.WriteHeader(http.StatusInternalServerError)
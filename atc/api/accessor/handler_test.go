@@ -77,7 +77,8 @@ var _ = Describe("Handler", func() {
 
 				It("finds the role", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, actualAction, role := fakeAccessorFactory.CreateArgsForCall(0)
+					Expect(actualAction).To(Equal(atc.SaveConfig))
 					Expect(role).To(Equal(accessor.MemberRole))
 				})
 			})
@@ -91,7 +92,7 @@ var _ = Describe("Handler", func() {
 
 				It("finds the role", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, role := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(Equal(accessor.ViewerRole))
 				})
 			})
@@ -109,7 +110,7 @@ var _ = Describe("Handler", func() {
 
 				It("sends a blank role (admin roles don't have defaults)", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, role := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(BeEmpty())
 				})
 			})
@@ -14,6 +14,8 @@ const (
 var DefaultRoles = map[string]string{
 	atc.SaveConfig:                    MemberRole,
 	atc.GetConfig:                     ViewerRole,
+	atc.ListPipelineConfigVersions:    ViewerRole,
+	atc.GetPipelineConfigVersion:      ViewerRole,
 	atc.GetCC:                         ViewerRole,
 	atc.GetBuild:                      ViewerRole,
 	atc.GetBuildPlan:                  ViewerRole,
@@ -46,6 +48,7 @@ var DefaultRoles = map[string]string{
 	atc.SetPinCommentOnResource:       OperatorRole,
 	atc.CheckResource:                 OperatorRole,
 	atc.CheckResourceWebHook:          OperatorRole,
+	atc.CheckTeamWebHook:              OperatorRole,
 	atc.CheckResourceType:             OperatorRole,
 	atc.CheckPrototype:                OperatorRole,
 	atc.ListResourceVersions:          ViewerRole,
@@ -68,13 +71,16 @@ var DefaultRoles = map[string]string{
 	atc.UnpausePipeline:               OperatorRole,
 	atc.ExposePipeline:                MemberRole,
 	atc.HidePipeline:                  MemberRole,
+	atc.SharePipeline:                 MemberRole,
 	atc.RenamePipeline:                MemberRole,
 	atc.ListPipelineBuilds:            ViewerRole,
 	atc.CreatePipelineBuild:           MemberRole,
 	atc.PipelineBadge:                 ViewerRole,
 	atc.RegisterWorker:                MemberRole,
 	atc.LandWorker:                    MemberRole,
+	atc.DrainWorker:                   MemberRole,
 	atc.RetireWorker:                  MemberRole,
+	atc.ScaleDownWorker:               MemberRole,
 	atc.PruneWorker:                   MemberRole,
 	atc.HeartbeatWorker:               MemberRole,
 	atc.ListWorkers:                   ViewerRole,
@@ -84,6 +90,7 @@ var DefaultRoles = map[string]string{
 	atc.DownloadCLI:                   ViewerRole,
 	atc.GetInfo:                       ViewerRole,
 	atc.GetInfoCreds:                  ViewerRole,
+	atc.StatusEvents:                  ViewerRole,
 	atc.ListContainers:                ViewerRole,
 	atc.GetContainer:                  ViewerRole,
 	atc.HijackContainer:               MemberRole,
@@ -98,8 +105,24 @@ var DefaultRoles = map[string]string{
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
+	atc.GetTeamGitOps:                 ViewerRole,
+	atc.SetTeamGitOps:                 OwnerRole,
+	atc.DeleteTeamGitOps:              OwnerRole,
 	atc.CreateArtifact:                MemberRole,
 	atc.GetArtifact:                   MemberRole,
 	atc.ListBuildArtifacts:            ViewerRole,
 	atc.GetWall:                       ViewerRole,
+
+	atc.GetTeamNotifications:           ViewerRole,
+	atc.SetTeamNotifications:           MemberRole,
+	atc.ListTeamNotificationDeliveries: ViewerRole,
+
+	atc.GetTeamResources: ViewerRole,
+	atc.SetTeamResources: MemberRole,
+
+	atc.ListTeamMembers: OwnerRole,
+
+	atc.ListTeamTokens:  OwnerRole,
+	atc.CreateTeamToken: OwnerRole,
+	atc.RevokeTeamToken: OwnerRole,
 }
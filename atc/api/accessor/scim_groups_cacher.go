@@ -0,0 +1,41 @@
+package accessor
+
+import (
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+type scimGroupsCacher struct {
+	scimGroupFetcher SCIMGroupFetcher
+	cache            *cache.Cache
+}
+
+// NewSCIMGroupsCacher caches the SCIM groups of each user for the given
+// expiration, so that changes pushed by the identity provider apply within
+// it without looking them up on every request.
+func NewSCIMGroupsCacher(
+	scimGroupFetcher SCIMGroupFetcher,
+	expiration time.Duration,
+	cleanupInterval time.Duration,
+) *scimGroupsCacher {
+	return &scimGroupsCacher{
+		scimGroupFetcher: scimGroupFetcher,
+		cache:            cache.New(expiration, cleanupInterval),
+	}
+}
+
+func (c *scimGroupsCacher) SCIMUserGroups(userID string) ([]string, error) {
+	if groups, found := c.cache.Get(userID); found {
+		return groups.([]string), nil
+	}
+
+	groups, err := c.scimGroupFetcher.SCIMUserGroups(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Set(userID, groups, cache.DefaultExpiration)
+
+	return groups, nil
+}
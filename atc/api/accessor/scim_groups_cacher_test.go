@@ -0,0 +1,51 @@
+package accessor_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCIMGroupsCacher", func() {
+	var (
+		fakeSCIMGroupFetcher *accessorfakes.FakeSCIMGroupFetcher
+		scimGroupFetcher     accessor.SCIMGroupFetcher
+	)
+
+	BeforeEach(func() {
+		fakeSCIMGroupFetcher = new(accessorfakes.FakeSCIMGroupFetcher)
+		fakeSCIMGroupFetcher.SCIMUserGroupsReturns([]string{"platform"}, nil)
+
+		scimGroupFetcher = accessor.NewSCIMGroupsCacher(fakeSCIMGroupFetcher, time.Minute, time.Minute)
+	})
+
+	It("fetches the groups of each user once", func() {
+		groups, err := scimGroupFetcher.SCIMUserGroups("00u1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groups).To(Equal([]string{"platform"}))
+
+		groups, err = scimGroupFetcher.SCIMUserGroups("00u1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groups).To(Equal([]string{"platform"}))
+		Expect(fakeSCIMGroupFetcher.SCIMUserGroupsCallCount()).To(Equal(1))
+
+		_, err = scimGroupFetcher.SCIMUserGroups("00u2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeSCIMGroupFetcher.SCIMUserGroupsCallCount()).To(Equal(2))
+	})
+
+	It("does not cache errors", func() {
+		fakeSCIMGroupFetcher.SCIMUserGroupsReturnsOnCall(0, nil, errors.New("nope"))
+
+		_, err := scimGroupFetcher.SCIMUserGroups("00u1")
+		Expect(err).To(HaveOccurred())
+
+		groups, err := scimGroupFetcher.SCIMUserGroups("00u1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groups).To(Equal([]string{"platform"}))
+	})
+})
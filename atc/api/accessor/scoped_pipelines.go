@@ -0,0 +1,45 @@
+package accessor
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ScopedPipelines returns the private pipelines of the teams which the user
+// can only see through roles scoped to those pipelines.
+func ScopedPipelines(acc Access, teamFactory db.TeamFactory) ([]db.Pipeline, error) {
+	teamNames := map[string]bool{}
+	for _, teamName := range acc.TeamNames() {
+		teamNames[teamName] = true
+	}
+
+	var scoped []db.Pipeline
+	for teamName := range acc.TeamRoles() {
+		if teamNames[teamName] {
+			continue
+		}
+
+		team, found, err := teamFactory.FindTeam(teamName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			continue
+		}
+
+		pipelines, err := team.Pipelines()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pipeline := range pipelines {
+			pipelineRef := atc.PipelineRef{Name: pipeline.Name(), InstanceVars: pipeline.InstanceVars()}
+			if !pipeline.Public() && acc.IsAuthorizedForPipeline(teamName, pipelineRef) {
+				scoped = append(scoped, pipeline)
+			}
+		}
+	}
+
+	return scoped, nil
+}
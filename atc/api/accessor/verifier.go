@@ -23,9 +23,15 @@ type AccessTokenFetcher interface {
 	GetAccessToken(rawToken string) (db.AccessToken, bool, error)
 }
 
-func NewVerifier(accessTokenFetcher AccessTokenFetcher, audience []string) *verifier {
+//counterfeiter:generate . APITokenFetcher
+type APITokenFetcher interface {
+	GetAPIToken(rawToken string) (db.APIToken, bool, error)
+}
+
+func NewVerifier(accessTokenFetcher AccessTokenFetcher, apiTokenFetcher APITokenFetcher, audience []string) *verifier {
 	return &verifier{
 		accessTokenFetcher: accessTokenFetcher,
+		apiTokenFetcher:    apiTokenFetcher,
 		audience:           audience,
 	}
 }
@@ -33,6 +39,7 @@ func NewVerifier(accessTokenFetcher AccessTokenFetcher, audience []string) *veri
 type verifier struct {
 	sync.Mutex
 	accessTokenFetcher AccessTokenFetcher
+	apiTokenFetcher    APITokenFetcher
 	audience           []string
 }
 
@@ -52,6 +59,10 @@ func (v *verifier) Verify(r *http.Request) (map[string]interface{}, error) {
 }
 
 func (v *verifier) verify(rawToken string) (map[string]interface{}, error) {
+	if strings.HasPrefix(rawToken, db.APITokenPrefix) {
+		return v.verifyAPIToken(rawToken)
+	}
+
 	token, found, err := v.accessTokenFetcher.GetAccessToken(rawToken)
 	if err != nil {
 		return nil, err
@@ -74,3 +85,33 @@ func (v *verifier) verify(rawToken string) (map[string]interface{}, error) {
 
 	return nil, ErrVerificationInvalidAudience
 }
+
+// verifyAPIToken looks up API tokens every time rather than caching them, so
+// that revoking a token takes effect immediately. The claims identify the
+// token so that its role can be enforced.
+func (v *verifier) verifyAPIToken(rawToken string) (map[string]interface{}, error) {
+	token, found, err := v.apiTokenFetcher.GetAPIToken(rawToken)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrVerificationInvalidToken
+	}
+
+	if token.IsExpired(time.Now()) {
+		return nil, ErrVerificationTokenExpired
+	}
+
+	claims := map[string]interface{}{}
+	for k, v := range token.Claims.RawClaims {
+		claims[k] = v
+	}
+
+	claims[APITokenClaim] = map[string]interface{}{
+		"id":   token.ID,
+		"role": token.Role,
+		"team": token.TeamName,
+	}
+
+	return claims, nil
+}
@@ -18,12 +18,14 @@ var _ = Describe("Verifier", func() {
 	var (
 		accessTokenFetcher *accessorfakes.FakeAccessTokenFetcher
 		accessToken        db.AccessToken
+		apiTokenFetcher    *accessorfakes.FakeAPITokenFetcher
 
 		req *http.Request
 
 		verifier accessor.TokenVerifier
 
-		err error
+		claims map[string]interface{}
+		err    error
 	)
 
 	BeforeEach(func() {
@@ -35,13 +37,15 @@ var _ = Describe("Verifier", func() {
 		req, _ = http.NewRequest("GET", "localhost:8080", nil)
 		req.Header.Set("Authorization", "bearer 1234567890")
 
-		verifier = accessor.NewVerifier(accessTokenFetcher, []string{"some-aud"})
+		apiTokenFetcher = new(accessorfakes.FakeAPITokenFetcher)
+
+		verifier = accessor.NewVerifier(accessTokenFetcher, apiTokenFetcher, []string{"some-aud"})
 	})
 
 	Describe("Verify", func() {
 
 		JustBeforeEach(func() {
-			_, err = verifier.Verify(req)
+			claims, err = verifier.Verify(req)
 		})
 
 		Context("when request has no token", func() {
@@ -140,5 +144,65 @@ var _ = Describe("Verifier", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
+
+		Context("when the token is an API token", func() {
+			BeforeEach(func() {
+				req.Header.Set("Authorization", "bearer "+db.APITokenPrefix+"1234567890")
+
+				apiTokenFetcher.GetAPITokenReturns(db.APIToken{
+					ID:       42,
+					Role:     "viewer",
+					TeamName: "some-team",
+					Claims: db.Claims{
+						RawClaims: map[string]interface{}{"sub": "some-sub"},
+					},
+				}, true, nil)
+			})
+
+			It("identifies the token in the claims without consulting access tokens", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apiTokenFetcher.GetAPITokenArgsForCall(0)).To(Equal(db.APITokenPrefix + "1234567890"))
+				Expect(accessTokenFetcher.GetAccessTokenCallCount()).To(BeZero())
+
+				Expect(claims).To(HaveKeyWithValue("sub", "some-sub"))
+				Expect(claims).To(HaveKeyWithValue(accessor.APITokenClaim, map[string]interface{}{
+					"id":   42,
+					"role": "viewer",
+					"team": "some-team",
+				}))
+			})
+
+			Context("when the token is not found", func() {
+				BeforeEach(func() {
+					apiTokenFetcher.GetAPITokenReturns(db.APIToken{}, false, nil)
+				})
+
+				It("fails verification", func() {
+					Expect(err).To(Equal(accessor.ErrVerificationInvalidToken))
+				})
+			})
+
+			Context("when the token has expired", func() {
+				BeforeEach(func() {
+					apiTokenFetcher.GetAPITokenReturns(db.APIToken{
+						ExpiresAt: time.Now().Add(-time.Minute),
+					}, true, nil)
+				})
+
+				It("fails verification", func() {
+					Expect(err).To(Equal(accessor.ErrVerificationTokenExpired))
+				})
+			})
+
+			Context("when getting the token errors", func() {
+				BeforeEach(func() {
+					apiTokenFetcher.GetAPITokenReturns(db.APIToken{}, false, errors.New("db error"))
+				})
+
+				It("errors", func() {
+					Expect(err).To(MatchError("db error"))
+				})
+			})
+		})
 	})
 })
@@ -22,6 +22,7 @@ import (
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
+	"github.com/concourse/concourse/atc/autoscaler/autoscalerfakes"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
@@ -42,6 +43,7 @@ var (
 	clusterName = "Test Cluster"
 
 	fakeWorkerPool          *workerfakes.FakePool
+	fakeWorkerCapacity      *autoscalerfakes.FakeCalculator
 	fakeVolumeRepository    *dbfakes.FakeVolumeRepository
 	fakeContainerRepository *dbfakes.FakeContainerRepository
 	fakeDestroyer           *gcfakes.FakeDestroyer
@@ -59,6 +61,11 @@ var (
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbUserFactory           *dbfakes.FakeUserFactory
+	dbAccessTokenFactory    *dbfakes.FakeAccessTokenFactory
+	dbStatusEventFactory    *dbfakes.FakeStatusEventFactory
+	dbAPITokenFactory       *dbfakes.FakeAPITokenFactory
+	dbAuditEventRepository  *dbfakes.FakeAuditEventRepository
+	dbSCIMRepository        *dbfakes.FakeSCIMRepository
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
@@ -108,6 +115,11 @@ var _ = BeforeEach(func() {
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 	dbUserFactory = new(dbfakes.FakeUserFactory)
+	dbAccessTokenFactory = new(dbfakes.FakeAccessTokenFactory)
+	dbStatusEventFactory = new(dbfakes.FakeStatusEventFactory)
+	dbAPITokenFactory = new(dbfakes.FakeAPITokenFactory)
+	dbAuditEventRepository = new(dbfakes.FakeAuditEventRepository)
+	dbSCIMRepository = new(dbfakes.FakeSCIMRepository)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
 
@@ -133,6 +145,7 @@ var _ = BeforeEach(func() {
 	dbWorkerLifecycle = new(dbfakes.FakeWorkerLifecycle)
 
 	fakeWorkerPool = new(workerfakes.FakePool)
+	fakeWorkerCapacity = new(autoscalerfakes.FakeCalculator)
 
 	fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
 	fakeContainerRepository = new(dbfakes.FakeContainerRepository)
@@ -200,10 +213,16 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		dbAccessTokenFactory,
+		dbStatusEventFactory,
+		dbAPITokenFactory,
+		dbAuditEventRepository,
+		dbSCIMRepository,
 
 		constructedEventHandler.Construct,
 
 		fakeWorkerPool,
+		fakeWorkerCapacity,
 
 		sink,
 
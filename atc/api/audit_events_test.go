@@ -0,0 +1,171 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Events API", func() {
+	var (
+		response *http.Response
+		query    url.Values
+	)
+
+	BeforeEach(func() {
+		query = url.Values{}
+	})
+
+	Context("GET /api/v1/audit-events", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/audit-events", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.URL.RawQuery = query.Encode()
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("not an admin", func() {
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("being an admin", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAdminReturns(true)
+
+					dbAuditEventRepository.AuditEventsReturns([]atc.AuditEvent{
+						{
+							ID:         2,
+							Time:       100,
+							Actor:      "alice",
+							TeamName:   "main",
+							Action:     atc.SaveConfig,
+							Target:     "pipeline_name=deploy",
+							Method:     "PUT",
+							Path:       "/api/v1/teams/main/pipelines/deploy/config",
+							RemoteAddr: "10.0.0.1",
+							UserAgent:  "fly/7.0.0",
+						},
+					}, nil)
+				})
+
+				It("returns the events", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[{
+						"id": 2,
+						"time": 100,
+						"actor": "alice",
+						"team_name": "main",
+						"action": "SaveConfig",
+						"target": "pipeline_name=deploy",
+						"method": "PUT",
+						"path": "/api/v1/teams/main/pipelines/deploy/config",
+						"remote_addr": "10.0.0.1",
+						"user_agent": "fly/7.0.0"
+					}]`))
+				})
+
+				It("returns the most recent events by default", func() {
+					Expect(dbAuditEventRepository.AuditEventsCallCount()).To(Equal(1))
+					Expect(dbAuditEventRepository.AuditEventsArgsForCall(0)).To(Equal(db.AuditEventFilter{Limit: 100}))
+				})
+
+				Context("with filters", func() {
+					BeforeEach(func() {
+						query.Set("since", "10")
+						query.Set("until", "20")
+						query.Set("actor", "alice")
+						query.Set("action", atc.SaveConfig)
+						query.Set("team", "main")
+						query.Set("limit", "5")
+					})
+
+					It("filters the events", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(dbAuditEventRepository.AuditEventsArgsForCall(0)).To(Equal(db.AuditEventFilter{
+							Since:    time.Unix(10, 0),
+							Until:    time.Unix(20, 0),
+							Actor:    "alice",
+							Action:   atc.SaveConfig,
+							TeamName: "main",
+							Limit:    5,
+						}))
+					})
+				})
+
+				Context("with a limit over the maximum", func() {
+					BeforeEach(func() {
+						query.Set("limit", "5000")
+					})
+
+					It("caps the limit", func() {
+						Expect(dbAuditEventRepository.AuditEventsArgsForCall(0).Limit).To(Equal(1000))
+					})
+				})
+
+				Context("with an invalid timestamp", func() {
+					BeforeEach(func() {
+						query.Set("since", "yesterday")
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(dbAuditEventRepository.AuditEventsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("with an invalid limit", func() {
+					BeforeEach(func() {
+						query.Set("limit", "-1")
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when getting the events fails", func() {
+					BeforeEach(func() {
+						dbAuditEventRepository.AuditEventsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+})
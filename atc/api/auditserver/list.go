@@ -0,0 +1,93 @@
+package auditserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// ListAuditEvents lists the persisted audit events, most recent first,
+// filtered by the 'since' and 'until' unix timestamps and by 'actor',
+// 'action' and 'team'.
+func (s *Server) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-audit-events")
+
+	filter, err := parseFilter(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
+	events, err := s.repository.AuditEvents(filter)
+	if err != nil {
+		logger.Error("failed-to-get-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(events)
+	if err != nil {
+		logger.Error("failed-to-encode-audit-events", err)
+	}
+}
+
+func parseFilter(r *http.Request) (db.AuditEventFilter, error) {
+	filter := db.AuditEventFilter{
+		Actor:    r.FormValue("actor"),
+		Action:   r.FormValue("action"),
+		TeamName: r.FormValue("team"),
+		Limit:    defaultLimit,
+	}
+
+	var err error
+
+	filter.Since, err = parseTime(r, "since")
+	if err != nil {
+		return db.AuditEventFilter{}, err
+	}
+
+	filter.Until, err = parseTime(r, "until")
+	if err != nil {
+		return db.AuditEventFilter{}, err
+	}
+
+	if limit := r.FormValue("limit"); limit != "" {
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit <= 0 {
+			return db.AuditEventFilter{}, fmt.Errorf("invalid limit: %s", limit)
+		}
+
+		if filter.Limit > maxLimit {
+			filter.Limit = maxLimit
+		}
+	}
+
+	return filter, nil
+}
+
+func parseTime(r *http.Request, param string) (time.Time, error) {
+	value := r.FormValue(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s: %s", param, value)
+	}
+
+	return time.Unix(unix, 0), nil
+}
@@ -0,0 +1,18 @@
+package auditserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger     lager.Logger
+	repository db.AuditEventRepository
+}
+
+func NewServer(logger lager.Logger, repository db.AuditEventRepository) *Server {
+	return &Server{
+		logger:     logger,
+		repository: repository,
+	}
+}
@@ -67,7 +67,7 @@ func (h checkBuildWriteAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if !acc.IsAuthorized(build.TeamName()) {
+	if !isAuthorizedForBuild(acc, build) {
 		h.rejector.Forbidden(w, r)
 		return
 	}
@@ -75,3 +75,13 @@ func (h checkBuildWriteAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	ctx := context.WithValue(r.Context(), BuildContextKey, build)
 	h.delegateHandler.ServeHTTP(w, r.WithContext(ctx))
 }
+
+// isAuthorizedForBuild also takes into account roles scoped to the pipeline
+// of the build, as the build's route does not name it.
+func isAuthorizedForBuild(acc accessor.Access, build db.Build) bool {
+	if acc.IsAuthorized(build.TeamName()) {
+		return true
+	}
+
+	return build.PipelineID() != 0 && acc.IsAuthorizedForPipeline(build.TeamName(), build.PipelineRef())
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
@@ -122,6 +123,22 @@ var _ = Describe("CheckBuildWriteAccessHandler", func() {
 		It("returns 403", func() {
 			Expect(response.StatusCode).To(Equal(http.StatusForbidden))
 		})
+
+		Context("when authorized for the build's pipeline", func() {
+			BeforeEach(func() {
+				build.PipelineIDReturns(42)
+				build.PipelineRefReturns(atc.PipelineRef{Name: "some-pipeline"})
+				fakeaccess.IsAuthorizedForPipelineReturns(true)
+			})
+
+			It("returns 200 ok", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				teamName, pipelineRef := fakeaccess.IsAuthorizedForPipelineArgsForCall(0)
+				Expect(teamName).To(Equal("some-team"))
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+			})
+		})
 	})
 
 	Context("when not authenticated", func() {
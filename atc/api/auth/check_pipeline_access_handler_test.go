@@ -144,6 +144,22 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 					It("returns 403 Forbidden", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
 					})
+
+					Context("when the pipeline is shared with one of the user's teams", func() {
+						BeforeEach(func() {
+							pipeline.SharedWithTeamsReturns([]string{"some-other-team"})
+							fakeaccess.IsAuthorizedForSharedPipelineReturns(true)
+						})
+
+						It("checks access against the teams the pipeline is shared with", func() {
+							Expect(fakeaccess.IsAuthorizedForSharedPipelineArgsForCall(0)).To(Equal([]string{"some-other-team"}))
+						})
+
+						It("calls pipelineScopedHandler with pipelineDB in context", func() {
+							Expect(delegate.IsCalled).To(BeTrue())
+							Expect(delegate.ContextPipelineDB).To(BeIdenticalTo(pipeline))
+						})
+					})
 				})
 
 				Context("and not authenticated", func() {
@@ -0,0 +1,177 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config Versions API", func() {
+	var (
+		fakeTeam     *dbfakes.FakeTeam
+		fakePipeline *dbfakes.FakePipeline
+		response     *http.Response
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		fakeTeam.PipelineReturns(fakePipeline, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/config/versions")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the pipeline has versions", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigVersionsReturns([]atc.PipelineConfigVersion{
+						{
+							Version:   5,
+							CreatedBy: "some-user",
+							CreatedAt: 100,
+							Changes: &atc.ConfigChanges{
+								Jobs: []atc.ObjectChange{{Name: "some-job", Action: atc.DiffAdded}},
+							},
+						},
+						{
+							Version:   2,
+							BuildID:   42,
+							CreatedAt: 50,
+						},
+					}, nil)
+				})
+
+				It("returns them", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"version": 5,
+							"created_by": "some-user",
+							"created_at": 100,
+							"changes": {"jobs": [{"name": "some-job", "action": "added"}]}
+						},
+						{
+							"version": 2,
+							"build_id": 42,
+							"created_at": 50
+						}
+					]`))
+				})
+
+				It("looks up the pipeline by name", func() {
+					Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+				})
+			})
+
+			Context("when the pipeline does not exist", func() {
+				BeforeEach(func() {
+					fakeTeam.PipelineReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the versions fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigVersionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", func() {
+		var version string
+
+		BeforeEach(func() {
+			version = "5"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/config/versions/" + version)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the version exists", func() {
+				BeforeEach(func() {
+					fakePipeline.FindConfigVersionReturns(atc.PipelineConfigVersion{
+						Version:   5,
+						CreatedBy: "some-user",
+						CreatedAt: 100,
+						Config: &atc.Config{
+							Jobs: atc.JobConfigs{{Name: "some-job"}},
+						},
+					}, true, nil)
+				})
+
+				It("returns it with its config", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakePipeline.FindConfigVersionArgsForCall(0)).To(Equal(5))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"version": 5,
+						"created_by": "some-user",
+						"created_at": 100,
+						"config": {
+							"jobs": [{"name": "some-job", "plan": null}]
+						}
+					}`))
+				})
+			})
+
+			Context("when the version does not exist", func() {
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the version is not a number", func() {
+				BeforeEach(func() {
+					version = "latest"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeTeam.PipelineCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+})
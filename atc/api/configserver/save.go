@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -61,7 +62,31 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	warnings, errorMessages := configvalidate.Validate(config)
+	teamName := rata.Param(r, "team_name")
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		session.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		session.Debug("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	shared, err := team.TeamResources()
+	if err != nil {
+		session.Error("failed-to-get-team-resources", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// the pipeline may use the team's shared resources without declaring them
+	applied, _ := shared.ApplyTo(config)
+
+	warnings, errorMessages := configvalidate.Validate(applied)
 	if len(errorMessages) > 0 {
 		session.Info("ignoring-invalid-config", lager.Data{"errors": errorMessages})
 		s.handleBadRequest(w, errorMessages...)
@@ -79,7 +104,6 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		warnings = append(warnings, *warning)
 	}
 
-	teamName := rata.Param(r, "team_name")
 	warning, err = atc.ValidateIdentifier(teamName, "team")
 	if err != nil {
 		session.Info("ignoring-team-name", lager.Data{"error": err.Error()})
@@ -115,20 +139,8 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 
 	session.Info("saving")
 
-	team, found, err := s.teamFactory.FindTeam(teamName)
-	if err != nil {
-		session.Error("failed-to-find-team", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	if !found {
-		session.Debug("team-not-found")
-		w.WriteHeader(http.StatusNotFound)
-		return
-	}
-
-	_, created, err := team.SavePipeline(pipelineRef, config, version, true)
+	acc := accessor.GetAccessor(r)
+	_, created, err := team.SavePipeline(pipelineRef, config, version, true, acc.UserInfo().DisplayUserId)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
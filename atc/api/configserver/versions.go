@@ -0,0 +1,115 @@
+package configserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) ListConfigVersions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-config-versions")
+
+	pipeline, ok := s.findPipeline(logger, w, r)
+	if !ok {
+		return
+	}
+
+	versions, err := pipeline.ConfigVersions()
+	if err != nil {
+		logger.Error("failed-to-get-config-versions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(versions)
+	if err != nil {
+		logger.Error("failed-to-encode-config-versions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) GetConfigVersion(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-config-version")
+
+	version, err := strconv.Atoi(rata.Param(r, "config_version"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "config version is malformed: %s", err)
+		return
+	}
+
+	pipeline, ok := s.findPipeline(logger, w, r)
+	if !ok {
+		return
+	}
+
+	configVersion, found, err := pipeline.FindConfigVersion(version)
+	if err != nil {
+		logger.Error("failed-to-get-config-version", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(configVersion)
+	if err != nil {
+		logger.Error("failed-to-encode-config-version", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) findPipeline(logger lager.Logger, w http.ResponseWriter, r *http.Request) (db.Pipeline, bool) {
+	teamName := rata.Param(r, "team_name")
+	pipelineRef := atc.PipelineRef{Name: rata.Param(r, "pipeline_name")}
+
+	var err error
+	pipelineRef.InstanceVars, err = atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		logger.Error("malformed-instance-vars", err)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "instance vars are malformed: %v", err)
+		return nil, false
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Debug("team-not-found", lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	pipeline, found, err := team.Pipeline(pipelineRef)
+	if err != nil {
+		logger.Error("failed-to-find-pipeline", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineRef.String()})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	return pipeline, true
+}
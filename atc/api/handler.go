@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/artifactserver"
+	"github.com/concourse/concourse/atc/api/auditserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
@@ -20,11 +21,14 @@ import (
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
+	"github.com/concourse/concourse/atc/api/statusserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
+	"github.com/concourse/concourse/atc/api/tokenserver"
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/wallserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
+	"github.com/concourse/concourse/atc/autoscaler"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/gc"
@@ -55,10 +59,16 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbAccessTokenFactory db.AccessTokenFactory,
+	dbStatusEventFactory db.StatusEventFactory,
+	dbAPITokenFactory db.APITokenFactory,
+	dbAuditEventRepository db.AuditEventRepository,
+	dbSCIMRepository db.SCIMRepository,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 
 	workerPool worker.Pool,
+	workerCapacity autoscaler.Calculator,
 
 	sink *lager.ReconfigurableSink,
 
@@ -93,21 +103,27 @@ func NewHandler(
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL)
 	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager)
 	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
-	workerServer := workerserver.NewServer(logger, workerTeamFactory, dbWorkerFactory)
+	workerServer := workerserver.NewServer(logger, workerTeamFactory, dbWorkerFactory, workerCapacity)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
 	containerServer := containerserver.NewServer(logger, workerPool, secretManager, varSourcePool, interceptTimeoutFactory, interceptUpdateInterval, containerRepository, destroyer, clock)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
-	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
+	teamServer := teamserver.NewServer(logger, dbTeamFactory, dbSCIMRepository, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
 	artifactServer := artifactserver.NewServer(logger, workerPool)
-	usersServer := usersserver.NewServer(logger, dbUserFactory)
+	usersServer := usersserver.NewServer(logger, dbUserFactory, dbAccessTokenFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	statusServer := statusserver.NewServer(logger, dbTeamFactory, dbStatusEventFactory)
+	tokenServer := tokenserver.NewServer(logger, dbAPITokenFactory)
+	auditServer := auditserver.NewServer(logger, dbAuditEventRepository)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
 		atc.SaveConfig: http.HandlerFunc(configServer.SaveConfig),
 
+		atc.ListPipelineConfigVersions: http.HandlerFunc(configServer.ListConfigVersions),
+		atc.GetPipelineConfigVersion:   http.HandlerFunc(configServer.GetConfigVersion),
+
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
 		atc.ListBuilds:          http.HandlerFunc(buildServer.ListBuilds),
@@ -150,6 +166,7 @@ func NewHandler(
 		atc.UnpausePipeline:           pipelineHandlerFactory.HandlerFor(pipelineServer.UnpausePipeline),
 		atc.ExposePipeline:            pipelineHandlerFactory.HandlerFor(pipelineServer.ExposePipeline),
 		atc.HidePipeline:              pipelineHandlerFactory.HandlerFor(pipelineServer.HidePipeline),
+		atc.SharePipeline:             pipelineHandlerFactory.HandlerFor(pipelineServer.SharePipeline),
 		atc.GetVersionsDB:             pipelineHandlerFactory.HandlerFor(pipelineServer.GetVersionsDB),
 		atc.RenamePipeline:            teamHandlerFactory.HandlerFor(pipelineServer.RenamePipeline),
 		atc.ListPipelineBuilds:        pipelineHandlerFactory.HandlerFor(pipelineServer.ListPipelineBuilds),
@@ -164,6 +181,7 @@ func NewHandler(
 		atc.SetPinCommentOnResource: pipelineHandlerFactory.HandlerFor(resourceServer.SetPinCommentOnResource),
 		atc.CheckResource:           pipelineHandlerFactory.HandlerFor(resourceServer.CheckResource),
 		atc.CheckResourceWebHook:    pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
+		atc.CheckTeamWebHook:        teamHandlerFactory.HandlerFor(resourceServer.CheckTeamWebHook),
 		atc.CheckResourceType:       pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
 		atc.CheckPrototype:          pipelineHandlerFactory.HandlerFor(resourceServer.CheckPrototype),
 		atc.ClearResourceCache:      pipelineHandlerFactory.HandlerFor(resourceServer.ClearResourceCache),
@@ -180,10 +198,14 @@ func NewHandler(
 		atc.ListWorkers:     http.HandlerFunc(workerServer.ListWorkers),
 		atc.RegisterWorker:  http.HandlerFunc(workerServer.RegisterWorker),
 		atc.LandWorker:      http.HandlerFunc(workerServer.LandWorker),
+		atc.DrainWorker:     http.HandlerFunc(workerServer.DrainWorker),
 		atc.RetireWorker:    http.HandlerFunc(workerServer.RetireWorker),
 		atc.PruneWorker:     http.HandlerFunc(workerServer.PruneWorker),
 		atc.HeartbeatWorker: http.HandlerFunc(workerServer.HeartbeatWorker),
 		atc.DeleteWorker:    http.HandlerFunc(workerServer.DeleteWorker),
+		atc.ScaleDownWorker: http.HandlerFunc(workerServer.ScaleDownWorker),
+
+		atc.GetWorkerCapacity: http.HandlerFunc(workerServer.GetWorkerCapacity),
 
 		atc.SetLogLevel: http.HandlerFunc(logLevelServer.SetMinLevel),
 		atc.GetLogLevel: http.HandlerFunc(logLevelServer.GetMinLevel),
@@ -192,9 +214,24 @@ func NewHandler(
 		atc.GetInfo:      http.HandlerFunc(infoServer.Info),
 		atc.GetInfoCreds: http.HandlerFunc(infoServer.Creds),
 
+		atc.StatusEvents: http.HandlerFunc(statusServer.StatusEvents),
+
 		atc.GetUser:              http.HandlerFunc(usersServer.GetUser),
 		atc.ListActiveUsersSince: http.HandlerFunc(usersServer.GetUsersSince),
 
+		atc.ListSessions:       http.HandlerFunc(usersServer.ListSessions),
+		atc.RevokeSession:      http.HandlerFunc(usersServer.RevokeSession),
+		atc.RevokeUserSessions: http.HandlerFunc(usersServer.RevokeUserSessions),
+
+		atc.ListUserTokens:  http.HandlerFunc(tokenServer.ListUserTokens),
+		atc.CreateUserToken: http.HandlerFunc(tokenServer.CreateUserToken),
+		atc.RevokeUserToken: http.HandlerFunc(tokenServer.RevokeUserToken),
+		atc.ListTeamTokens:  teamHandlerFactory.HandlerFor(tokenServer.ListTeamTokens),
+		atc.CreateTeamToken: teamHandlerFactory.HandlerFor(tokenServer.CreateTeamToken),
+		atc.RevokeTeamToken: teamHandlerFactory.HandlerFor(tokenServer.RevokeTeamToken),
+
+		atc.ListAuditEvents: http.HandlerFunc(auditServer.ListAuditEvents),
+
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
@@ -212,6 +249,19 @@ func NewHandler(
 		atc.DestroyTeam:    teamHandlerFactory.HandlerFor(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: teamHandlerFactory.HandlerFor(teamServer.ListTeamBuilds),
 
+		atc.GetTeamGitOps:    teamHandlerFactory.HandlerFor(teamServer.GetGitOps),
+		atc.SetTeamGitOps:    teamHandlerFactory.HandlerFor(teamServer.SetGitOps),
+		atc.DeleteTeamGitOps: teamHandlerFactory.HandlerFor(teamServer.DeleteGitOps),
+
+		atc.GetTeamNotifications:           teamHandlerFactory.HandlerFor(teamServer.GetNotifications),
+		atc.SetTeamNotifications:           teamHandlerFactory.HandlerFor(teamServer.SetNotifications),
+		atc.ListTeamNotificationDeliveries: teamHandlerFactory.HandlerFor(teamServer.ListNotificationDeliveries),
+
+		atc.GetTeamResources: teamHandlerFactory.HandlerFor(teamServer.GetTeamResources),
+		atc.SetTeamResources: teamHandlerFactory.HandlerFor(teamServer.SetTeamResources),
+
+		atc.ListTeamMembers: teamHandlerFactory.HandlerFor(teamServer.ListMembers),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 
@@ -235,6 +235,63 @@ var _ = Describe("Pipelines API", func() {
 				})
 			})
 		})
+
+		Context("when the user has a role scoped to a private pipeline of another team", func() {
+			BeforeEach(func() {
+				fakeAccess.TeamNamesReturns([]string{})
+				fakeAccess.TeamRolesReturns(map[string][]string{"main": {"member"}})
+				fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, ref atc.PipelineRef) bool {
+					return teamName == "main" && ref.Name == "private-pipeline"
+				}
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns the public pipelines along with the scoped pipeline", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("main"))
+
+				var pipelines []map[string]interface{}
+				err = json.Unmarshal(body, &pipelines)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pipelines).To(ConsistOf(
+					HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+					HaveKeyWithValue("id", BeNumerically("==", anotherPublicPipeline.ID())),
+					HaveKeyWithValue("id", BeNumerically("==", privatePipeline.ID())),
+				))
+			})
+
+			Context("when the scoped pipeline is also visible", func() {
+				BeforeEach(func() {
+					dbPipelineFactory.VisiblePipelinesReturns([]db.Pipeline{publicPipeline, anotherPublicPipeline, privatePipeline}, nil)
+				})
+
+				It("returns it only once", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					var pipelines []map[string]interface{}
+					err = json.Unmarshal(body, &pipelines)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pipelines).To(ConsistOf(
+						HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+						HaveKeyWithValue("id", BeNumerically("==", anotherPublicPipeline.ID())),
+						HaveKeyWithValue("id", BeNumerically("==", privatePipeline.ID())),
+					))
+				})
+			})
+
+			Context("when finding the team fails", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, errors.New("disaster"))
+				})
+
+				It("returns 500 internal server error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
 	})
 
 	Describe("GET /api/v1/teams/:team_name/pipelines", func() {
@@ -337,6 +394,69 @@ var _ = Describe("Pipelines API", func() {
 			})
 		})
 
+		Context("when authorized only for some of the team's pipelines", func() {
+			BeforeEach(func() {
+				fakeAccess.TeamRolesReturns(map[string][]string{"main": {"member"}})
+				fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, ref atc.PipelineRef) bool {
+					return teamName == "main" && ref.Name == "private-pipeline"
+				}
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns the public pipelines along with the scoped pipelines", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				var pipelines []map[string]interface{}
+				json.Unmarshal(body, &pipelines)
+
+				Expect(pipelines).To(ConsistOf(
+					HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+					HaveKeyWithValue("id", BeNumerically("==", privatePipeline.ID())),
+				))
+			})
+
+			Context("when no private pipeline is in scope", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedForPipelineReturns(false)
+					fakeAccess.IsAuthorizedForPipelineStub = nil
+				})
+
+				It("returns only team's public pipelines", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					var pipelines []map[string]interface{}
+					json.Unmarshal(body, &pipelines)
+
+					Expect(pipelines).To(ConsistOf(
+						HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+					))
+				})
+			})
+		})
+
+		Context("when authenticated as a team the private pipeline is shared with", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				privatePipeline.SharedWithTeamsReturns([]string{"other-team"})
+				fakeAccess.IsAuthorizedForSharedPipelineStub = func(teamNames []string) bool {
+					return len(teamNames) == 1 && teamNames[0] == "other-team"
+				}
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns the public pipelines along with the shared pipelines", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				var pipelines []map[string]interface{}
+				json.Unmarshal(body, &pipelines)
+
+				Expect(pipelines).To(ConsistOf(
+					HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+					HaveKeyWithValue("id", BeNumerically("==", privatePipeline.ID())),
+				))
+			})
+		})
+
 		Context("when authenticated as another team", func() {
 			BeforeEach(func() {
 				fakeAccess.IsAuthenticatedReturns(false)
@@ -1168,6 +1288,106 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/shares", func() {
+		var response *http.Response
+		var requestBody string
+
+		BeforeEach(func() {
+			requestBody = `{"teams":["team-a","team-b"]}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/shares", bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(true)
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+				})
+
+				It("shares the pipeline with the teams", func() {
+					Expect(dbPipeline.ShareCallCount()).To(Equal(1))
+					Expect(dbPipeline.ShareArgsForCall(0)).To(Equal([]string{"team-a", "team-b"}))
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				Context("when the request body is invalid", func() {
+					BeforeEach(func() {
+						requestBody = `{`
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+
+					It("does not share the pipeline", func() {
+						Expect(dbPipeline.ShareCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when a team does not exist", func() {
+					BeforeEach(func() {
+						dbPipeline.ShareReturns(db.ErrTeamsNotFound{Names: []string{"team-b"}})
+					})
+
+					It("returns 400 with the missing teams", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("teams not found: team-b\n"))
+					})
+				})
+
+				Context("when sharing the pipeline fails", func() {
+					BeforeEach(func() {
+						dbPipeline.ShareReturns(errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/hide", func() {
 		var response *http.Response
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
@@ -30,6 +31,8 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 
 	if acc.IsAuthorized(requestTeamName) {
 		pipelines, err = team.Pipelines()
+	} else if len(acc.TeamRoles()[requestTeamName]) != 0 || acc.IsAuthenticated() {
+		pipelines, err = visiblePipelines(team, acc)
 	} else {
 		pipelines, err = team.PublicPipelines()
 	}
@@ -48,3 +51,28 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// visiblePipelines returns the team's public pipelines along with the private
+// ones the user has been granted a role scoped to, or which are shared with
+// one of the user's teams.
+func visiblePipelines(team db.Team, acc accessor.Access) ([]db.Pipeline, error) {
+	pipelines, err := team.Pipelines()
+	if err != nil {
+		return nil, err
+	}
+
+	var visible []db.Pipeline
+	for _, pipeline := range pipelines {
+		if pipeline.Public() ||
+			acc.IsAuthorizedForPipeline(pipeline.TeamName(), pipelineRef(pipeline)) ||
+			acc.IsAuthorizedForSharedPipeline(pipeline.SharedWithTeams()) {
+			visible = append(visible, pipeline)
+		}
+	}
+
+	return visible, nil
+}
+
+func pipelineRef(pipeline db.Pipeline) atc.PipelineRef {
+	return atc.PipelineRef{Name: pipeline.Name(), InstanceVars: pipeline.InstanceVars()}
+}
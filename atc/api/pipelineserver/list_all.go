@@ -30,6 +30,28 @@ func (s *Server) ListAllPipelines(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !acc.IsAdmin() {
+		scopedPipelines, err := accessor.ScopedPipelines(acc, s.teamFactory)
+		if err != nil {
+			logger.Error("failed-to-get-scoped-pipelines", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// a pipeline may also be visible, e.g. if it was exposed since
+		// fetching the visible ones
+		listed := map[int]bool{}
+		for _, pipeline := range pipelines {
+			listed[pipeline.ID()] = true
+		}
+
+		for _, pipeline := range scopedPipelines {
+			if !listed[pipeline.ID()] {
+				pipelines = append(pipelines, pipeline)
+			}
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(present.Pipelines(pipelines))
 	if err != nil {
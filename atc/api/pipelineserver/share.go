@@ -0,0 +1,41 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SharePipeline(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("share-pipeline")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var shares atc.PipelineShares
+		if err := json.NewDecoder(r.Body).Decode(&shares); err != nil {
+			logger.Error("invalid-json", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err := pipeline.Share(shares.Teams)
+		if err != nil {
+			logger.Error("failed-to-share-pipeline", err, lager.Data{
+				"teams": shares.Teams,
+			})
+			var errNotFound db.ErrTeamsNotFound
+			if errors.As(err, &errNotFound) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintln(w, err.Error())
+			} else {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
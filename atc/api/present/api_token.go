@@ -0,0 +1,23 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func APIToken(token db.APIToken) atc.APIToken {
+	presented := atc.APIToken{
+		ID:             token.ID,
+		Name:           token.Name,
+		Role:           token.Role,
+		TeamName:       token.TeamName,
+		ServiceAccount: token.ServiceAccount,
+		CreatedAt:      token.CreatedAt.Unix(),
+	}
+
+	if !token.ExpiresAt.IsZero() {
+		presented.ExpiresAt = token.ExpiresAt.Unix()
+	}
+
+	return presented
+}
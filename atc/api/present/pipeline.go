@@ -13,6 +13,7 @@ func Pipeline(savedPipeline db.Pipeline) atc.Pipeline {
 		TeamName:      savedPipeline.TeamName(),
 		Paused:        savedPipeline.Paused(),
 		Public:        savedPipeline.Public(),
+		SharedWith:    savedPipeline.SharedWithTeams(),
 		Archived:      savedPipeline.Archived(),
 		Groups:        savedPipeline.Groups(),
 		Display:       savedPipeline.Display(),
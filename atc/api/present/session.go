@@ -0,0 +1,17 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func Session(session db.Session) atc.Session {
+	return atc.Session{
+		ID:        session.ID,
+		Sub:       session.Sub,
+		Username:  session.Username,
+		Connector: session.Connector,
+		CreatedAt: session.CreatedAt.Unix(),
+		ExpiresAt: session.ExpiresAt.Unix(),
+	}
+}
@@ -40,6 +40,9 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		State:            string(workerInfo.State()),
 		Version:          version,
 		Ephemeral:        workerInfo.Ephemeral(),
+
+		ScaleDownCandidate: workerInfo.ScaleDownCandidate(),
+		Draining:           workerInfo.Draining(),
 	}
 
 	if !workerInfo.StartTime().IsZero() {
@@ -0,0 +1,214 @@
+package resourceserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/webhooks"
+	"github.com/concourse/concourse/vars"
+	"golang.org/x/time/rate"
+)
+
+// maxWebhookPayloadSize is well above the push and merge request payloads
+// providers send, which are only large for pushes of very many commits.
+const maxWebhookPayloadSize = 5 * 1024 * 1024
+
+// The team webhook is unauthenticated and resolves the webhook_token of
+// every resource of the team, so requests are limited to keep anonymous
+// callers from hammering the credential manager. Signatures can only be
+// verified using those tokens, so the limit applies before verifying them;
+// it is kept per team and client address, so that a caller exhausting it
+// does not block the team's webhooks sent from elsewhere.
+const (
+	teamWebhookRate  = rate.Limit(5)
+	teamWebhookBurst = 20
+
+	maxTeamWebhookSources = 10000
+)
+
+type teamWebhookSource struct {
+	teamID int
+	host   string
+}
+
+// CheckTeamWebHook checks every resource of the team affected by a payload
+// sent by GitHub, GitLab, Bitbucket or any other sender. Only resources with
+// a webhook_token are considered, and the token is the secret the payload
+// must be signed with. The payload is only matched against the sources of
+// the resources whose token it was signed with, and the response does not
+// depend on whether any matched, so that it reveals nothing about sources.
+func (s *Server) CheckTeamWebHook(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("check-team-webhook", lager.Data{
+			"team": team.Name(),
+		})
+
+		if !s.teamWebhookLimiter(team.ID(), r).Allow() {
+			logger.Info("rate-limited", lager.Data{"remote-addr": r.RemoteAddr})
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize+1))
+		if err != nil {
+			logger.Error("failed-to-read-body", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if len(body) > maxWebhookPayloadSize {
+			logger.Info("payload-too-large")
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		event, err := webhooks.Parse(r, body)
+		if err != nil {
+			logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		logger = logger.WithData(lager.Data{"provider": event.Provider, "event": event.Type})
+
+		pipelines, err := team.Pipelines()
+		if err != nil {
+			logger.Error("failed-to-get-pipelines", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := lagerctx.NewContext(context.Background(), logger)
+
+		verified := false
+		checks := []atc.WebhookCheck{}
+
+		for _, pipeline := range pipelines {
+			if pipeline.Archived() {
+				continue
+			}
+
+			resources, err := pipeline.Resources()
+			if err != nil {
+				logger.Error("failed-to-get-resources", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			var variables vars.Variables
+			var resourceTypes db.ResourceTypes
+
+			for _, resource := range resources {
+				if resource.WebhookToken() == "" {
+					continue
+				}
+
+				resourceLogger := logger.Session("resource", lager.Data{
+					"pipeline": pipeline.Name(),
+					"resource": resource.Name(),
+				})
+
+				if variables == nil {
+					variables, err = pipeline.Variables(logger, s.secretManager, s.varSourcePool)
+					if err != nil {
+						logger.Error("failed-to-create-var-sources", err)
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+				}
+
+				token, err := creds.NewString(variables, resource.WebhookToken()).Evaluate()
+				if err != nil {
+					resourceLogger.Error("failed-to-evaluate-webhook-token", err)
+					continue
+				}
+
+				if !event.Verify(token) {
+					continue
+				}
+
+				verified = true
+
+				source, err := creds.NewSource(variables, resource.Source()).Evaluate()
+				if err != nil {
+					resourceLogger.Error("failed-to-evaluate-source", err)
+					continue
+				}
+
+				if !event.Affects(source) {
+					continue
+				}
+
+				if resourceTypes == nil {
+					resourceTypes, err = pipeline.ResourceTypes()
+					if err != nil {
+						logger.Error("failed-to-get-resource-types", err)
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+				}
+
+				check := atc.WebhookCheck{
+					PipelineName:         pipeline.Name(),
+					PipelineInstanceVars: pipeline.InstanceVars(),
+					ResourceName:         resource.Name(),
+				}
+
+				build, created, err := s.checkFactory.TryCreateCheck(ctx, resource, resourceTypes, nil, true)
+				if err != nil {
+					resourceLogger.Error("failed-to-create-check", err)
+					check.Error = err.Error()
+				} else if created {
+					check.BuildID = build.ID()
+				}
+
+				checks = append(checks, check)
+			}
+		}
+
+		if !verified {
+			logger.Info("no-resource-accepted-the-signature")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(checks)
+		if err != nil {
+			logger.Error("failed-to-encode-checks", err)
+		}
+	})
+}
+
+func (s *Server) teamWebhookLimiter(teamID int, r *http.Request) *rate.Limiter {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	source := teamWebhookSource{teamID: teamID, host: host}
+
+	s.teamWebhookLimitersMu.Lock()
+	defer s.teamWebhookLimitersMu.Unlock()
+
+	if limiter, found := s.teamWebhookLimiters.Get(source); found {
+		return limiter.(*rate.Limiter)
+	}
+
+	limiter := rate.NewLimiter(teamWebhookRate, teamWebhookBurst)
+	s.teamWebhookLimiters.Add(source, limiter)
+
+	return limiter
+}
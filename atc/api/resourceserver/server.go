@@ -1,9 +1,12 @@
 package resourceserver
 
 import (
+	"sync"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/golang/groupcache/lru"
 )
 
 type Server struct {
@@ -13,6 +16,11 @@ type Server struct {
 	checkFactory          db.CheckFactory
 	resourceFactory       db.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
+
+	// teamWebhookLimiters holds a *rate.Limiter per teamWebhookSource, up to
+	// maxTeamWebhookSources of them.
+	teamWebhookLimiters   *lru.Cache
+	teamWebhookLimitersMu sync.Mutex // lru.Cache is not safe for concurrent access
 }
 
 func NewServer(
@@ -30,5 +38,6 @@ func NewServer(
 		checkFactory:          checkFactory,
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
+		teamWebhookLimiters:   lru.New(maxTeamWebhookSources),
 	}
 }
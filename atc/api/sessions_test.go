@@ -0,0 +1,251 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sessions API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/sessions", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/sessions"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbAccessTokenFactory.GetSessionsReturns([]db.Session{
+					{
+						ID:        2,
+						Sub:       "bob-sub",
+						Username:  "bob",
+						Connector: "github",
+						CreatedAt: time.Unix(100, 0),
+						ExpiresAt: time.Unix(200, 0),
+					},
+				}, nil)
+			})
+
+			It("returns the sessions", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response).To(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`[{
+					"id": 2,
+					"sub": "bob-sub",
+					"username": "bob",
+					"connector": "github",
+					"created_at": 100,
+					"expires_at": 200
+				}]`))
+
+				Expect(dbAccessTokenFactory.GetSessionsArgsForCall(0)).To(Equal(db.SessionFilter{}))
+			})
+
+			Context("when filtering by user", func() {
+				BeforeEach(func() {
+					query = "?username=bob&connector=github"
+				})
+
+				It("filters the sessions", func() {
+					Expect(dbAccessTokenFactory.GetSessionsArgsForCall(0)).To(Equal(db.SessionFilter{
+						Username:  "bob",
+						Connector: "github",
+					}))
+				})
+			})
+
+			Context("when getting the sessions fails", func() {
+				BeforeEach(func() {
+					dbAccessTokenFactory.GetSessionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/sessions/:session_id", func() {
+		var sessionID string
+
+		BeforeEach(func() {
+			sessionID = "2"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/sessions/"+sessionID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when the session exists", func() {
+				BeforeEach(func() {
+					dbAccessTokenFactory.RevokeSessionReturns(true, nil)
+				})
+
+				It("revokes the session", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(dbAccessTokenFactory.RevokeSessionArgsForCall(0)).To(Equal(2))
+				})
+			})
+
+			Context("when the session does not exist", func() {
+				BeforeEach(func() {
+					dbAccessTokenFactory.RevokeSessionReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the id is invalid", func() {
+				BeforeEach(func() {
+					sessionID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbAccessTokenFactory.RevokeSessionCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAccessTokenFactory.RevokeSessionCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/sessions", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = "?username=bob"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/sessions"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbAccessTokenFactory.RevokeSessionsReturns(atc.RevokedSessions{Revoked: 3, APITokens: 1}, nil)
+			})
+
+			It("revokes the user's sessions and API tokens", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"revoked": 3, "api_tokens": 1}`))
+
+				Expect(dbAccessTokenFactory.RevokeSessionsArgsForCall(0)).To(Equal(db.SessionFilter{Username: "bob"}))
+			})
+
+			Context("when no username is given", func() {
+				BeforeEach(func() {
+					query = "?connector=github"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbAccessTokenFactory.RevokeSessionsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when revoking the sessions fails", func() {
+				BeforeEach(func() {
+					dbAccessTokenFactory.RevokeSessionsReturns(atc.RevokedSessions{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+})
@@ -0,0 +1,183 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Status Events API", func() {
+	var (
+		fakeSource  *dbfakes.FakeStatusEventSource
+		lastEventID string
+		response    *http.Response
+	)
+
+	BeforeEach(func() {
+		lastEventID = ""
+
+		fakeSource = new(dbfakes.FakeStatusEventSource)
+		fakeSource.NextReturnsOnCall(0, atc.StatusEvent{
+			ID:       42,
+			Type:     atc.StatusEventBuild,
+			Time:     100,
+			TeamName: "some-team",
+			BuildID:  7,
+			Status:   atc.StatusStarted,
+		}, nil)
+		fakeSource.NextReturns(atc.StatusEvent{}, db.ErrStatusEventStreamClosed)
+
+		dbStatusEventFactory.EventsReturns(fakeSource, nil)
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", server.URL+"/api/v1/status/events", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		if lastEventID != "" {
+			request.Header.Set("Last-Event-ID", lastEventID)
+		}
+
+		response, err = client.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when not authenticated", func() {
+		It("returns 401", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(dbStatusEventFactory.EventsCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when authenticated", func() {
+		BeforeEach(func() {
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.TeamNamesReturns([]string{"some-team", "other-team"})
+		})
+
+		It("streams the events as server-sent events", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("id: 42\nevent: event\ndata: " +
+				`{"id":42,"type":"build","time":100,"team_name":"some-team","build_id":7,"status":"started"}` +
+				"\n\n"))
+		})
+
+		It("only streams the events of the pipelines visible to the user's teams, starting with new events", func() {
+			Expect(dbStatusEventFactory.EventsCallCount()).To(Equal(1))
+
+			filter, after := dbStatusEventFactory.EventsArgsForCall(0)
+			Expect(filter).To(Equal(db.StatusEventFilter{TeamNames: []string{"some-team", "other-team"}}))
+			Expect(after).To(BeZero())
+		})
+
+		It("closes the source", func() {
+			Eventually(fakeSource.CloseCallCount).Should(Equal(1))
+		})
+
+		Context("when the user is on no teams", func() {
+			BeforeEach(func() {
+				fakeAccess.TeamNamesReturns(nil)
+			})
+
+			It("streams no team's events rather than every team's", func() {
+				filter, _ := dbStatusEventFactory.EventsArgsForCall(0)
+				Expect(filter.TeamNames).To(BeEmpty())
+				Expect(filter.TeamNames).NotTo(BeNil())
+			})
+		})
+
+		Context("when the user has a role scoped to a private pipeline of another team", func() {
+			BeforeEach(func() {
+				fakeTeam := new(dbfakes.FakeTeam)
+
+				privatePipeline := new(dbfakes.FakePipeline)
+				privatePipeline.IDReturns(1)
+				privatePipeline.NameReturns("private-pipeline")
+
+				otherPipeline := new(dbfakes.FakePipeline)
+				otherPipeline.IDReturns(2)
+				otherPipeline.NameReturns("other-pipeline")
+
+				fakeTeam.PipelinesReturns([]db.Pipeline{privatePipeline, otherPipeline}, nil)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+				fakeAccess.TeamRolesReturns(map[string][]string{"main": {"member"}})
+				fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, ref atc.PipelineRef) bool {
+					return teamName == "main" && ref.Name == "private-pipeline"
+				}
+			})
+
+			It("streams the events of the scoped pipeline too", func() {
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("main"))
+
+				filter, _ := dbStatusEventFactory.EventsArgsForCall(0)
+				Expect(filter.PipelineIDs).To(Equal([]int{1}))
+			})
+
+			Context("when finding the team fails", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					Expect(dbStatusEventFactory.EventsCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the user is an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("streams the events of every team", func() {
+				filter, _ := dbStatusEventFactory.EventsArgsForCall(0)
+				Expect(filter.TeamNames).To(BeNil())
+				Expect(dbTeamFactory.FindTeamCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the client is resuming", func() {
+			BeforeEach(func() {
+				lastEventID = "41"
+			})
+
+			It("streams the events after the last one it saw", func() {
+				_, after := dbStatusEventFactory.EventsArgsForCall(0)
+				Expect(after).To(Equal(41))
+			})
+		})
+
+		Context("when the last event ID is malformed", func() {
+			BeforeEach(func() {
+				lastEventID = "nope"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbStatusEventFactory.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the events cannot be streamed", func() {
+			BeforeEach(func() {
+				dbStatusEventFactory.EventsReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package statusserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/vito/go-sse/sse"
+)
+
+// StatusEvents streams the status changes of builds and jobs of every pipeline
+// the user can see. A client reconnecting with a Last-Event-ID header picks up
+// where it left off.
+func (s *Server) StatusEvents(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("status-events")
+
+	var after int
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		var err error
+		after, err = strconv.Atoi(lastEventID)
+		if err != nil || after < 0 {
+			logger.Info("failed-to-parse-last-event-id", lager.Data{"last-event-id": lastEventID})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	// admins see the events of every pipeline
+	var filter db.StatusEventFilter
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsAdmin() {
+		filter.TeamNames = append([]string{}, acc.TeamNames()...)
+
+		scopedPipelines, err := accessor.ScopedPipelines(acc, s.teamFactory)
+		if err != nil {
+			logger.Error("failed-to-get-scoped-pipelines", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		for _, pipeline := range scopedPipelines {
+			filter.PipelineIDs = append(filter.PipelineIDs, pipeline.ID())
+		}
+	}
+
+	events, err := s.statusEventFactory.Events(filter, after)
+	if err != nil {
+		logger.Error("failed-to-get-status-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// closing the source ends the loop below when the client goes away, even
+	// if nothing is happening
+	go func() {
+		<-r.Context().Done()
+		db.Close(events)
+	}()
+
+	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Add("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	flusher := w.(http.Flusher)
+	flusher.Flush()
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err != db.ErrStatusEventStreamClosed {
+				logger.Error("failed-to-get-next-status-event", err)
+			}
+
+			return
+		}
+
+		payload, err := json.Marshal(ev)
+		if err != nil {
+			logger.Error("failed-to-marshal-status-event", err)
+			return
+		}
+
+		err = sse.Event{
+			ID:   strconv.Itoa(ev.ID),
+			Name: "event",
+			Data: payload,
+		}.Write(w)
+		if err != nil {
+			logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+			return
+		}
+
+		flusher.Flush()
+	}
+}
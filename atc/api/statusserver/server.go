@@ -0,0 +1,20 @@
+package statusserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger             lager.Logger
+	teamFactory        db.TeamFactory
+	statusEventFactory db.StatusEventFactory
+}
+
+func NewServer(logger lager.Logger, teamFactory db.TeamFactory, statusEventFactory db.StatusEventFactory) *Server {
+	return &Server{
+		logger:             logger,
+		teamFactory:        teamFactory,
+		statusEventFactory: statusEventFactory,
+	}
+}
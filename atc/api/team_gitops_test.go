@@ -0,0 +1,184 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Team GitOps API", func() {
+	var (
+		fakeTeam *dbfakes.FakeTeam
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("a-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/gitops", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/gitops")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when gitops is not enabled", func() {
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when gitops is enabled", func() {
+				BeforeEach(func() {
+					fakeTeam.GitOpsReturns(atc.GitOpsConfig{
+						Type:   "git",
+						Source: atc.Source{"private_key": "secret"},
+						Path:   "pipelines.yml",
+					}, true, nil)
+
+					fakeTeam.GitOpsStatusReturns(atc.GitOpsStatus{
+						BuildID:  42,
+						Result:   atc.GitOpsSucceeded,
+						Version:  atc.Version{"ref": "abc"},
+						Created:  []string{"some-pipeline"},
+						Archived: []string{"old-pipeline"},
+					}, true, nil)
+				})
+
+				It("returns the config without its source and the last sync status", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"config": {
+							"type": "git",
+							"source": null,
+							"path": "pipelines.yml"
+						},
+						"status": {
+							"build_id": 42,
+							"result": "succeeded",
+							"version": {"ref": "abc"},
+							"created": ["some-pipeline"],
+							"archived": ["old-pipeline"]
+						}
+					}`))
+				})
+			})
+
+			Context("when getting the config fails", func() {
+				BeforeEach(func() {
+					fakeTeam.GitOpsReturns(atc.GitOpsConfig{}, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/gitops", func() {
+		var requestBody string
+
+		BeforeEach(func() {
+			requestBody = `{"type":"git","source":{"uri":"https://example.com/ci.git"},"path":"pipelines.yml","interval":"5m"}`
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/gitops", bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.SetGitOpsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("saves the config", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				Expect(fakeTeam.SetGitOpsCallCount()).To(Equal(1))
+				Expect(fakeTeam.SetGitOpsArgsForCall(0)).To(Equal(&atc.GitOpsConfig{
+					Type:     "git",
+					Source:   atc.Source{"uri": "https://example.com/ci.git"},
+					Path:     "pipelines.yml",
+					Interval: "5m",
+				}))
+			})
+
+			Context("when the config is invalid", func() {
+				BeforeEach(func() {
+					requestBody = `{"type":"git","source":{}}`
+				})
+
+				It("returns 400 with the error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("gitops config must specify the path of the manifest")))
+					Expect(fakeTeam.SetGitOpsCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/gitops", func() {
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/gitops", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("disables gitops", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				Expect(fakeTeam.SetGitOpsCallCount()).To(Equal(1))
+				Expect(fakeTeam.SetGitOpsArgsForCall(0)).To(BeNil())
+			})
+		})
+	})
+})
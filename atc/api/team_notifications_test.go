@@ -0,0 +1,242 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Team Notifications API", func() {
+	var (
+		fakeTeam *dbfakes.FakeTeam
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("a-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/notifications", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/notifications")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team has no notifications", func() {
+				It("returns an empty list", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[]`))
+				})
+			})
+
+			Context("when the team has notifications", func() {
+				BeforeEach(func() {
+					fakeTeam.NotificationsReturns(atc.NotificationConfigs{
+						{
+							Name:      "slack",
+							URL:       "https://hooks.slack.com/services/T000/B000/XXXX",
+							Secret:    "shh",
+							Pipelines: []string{"some-pipeline"},
+							Statuses:  []atc.BuildStatus{atc.StatusFailed},
+						},
+					}, nil)
+				})
+
+				It("returns them without their secrets", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"name": "slack",
+							"url": "https://hooks.slack.com",
+							"pipelines": ["some-pipeline"],
+							"statuses": ["failed"]
+						}
+					]`))
+				})
+			})
+
+			Context("when getting the notifications fails", func() {
+				BeforeEach(func() {
+					fakeTeam.NotificationsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/notifications", func() {
+		var body string
+
+		BeforeEach(func() {
+			body = `[{"name": "some-hook", "url": "https://example.com/hook", "secret": "shh"}]`
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/notifications", bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeTeam.SetNotificationsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("saves the notifications", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(fakeTeam.SetNotificationsCallCount()).To(Equal(1))
+				Expect(fakeTeam.SetNotificationsArgsForCall(0)).To(Equal(atc.NotificationConfigs{
+					{Name: "some-hook", URL: "https://example.com/hook", Secret: "shh"},
+				}))
+			})
+
+			Context("when the notifications are invalid", func() {
+				BeforeEach(func() {
+					body = `[{"name": "some-hook", "url": "ftp://example.com"}]`
+				})
+
+				It("returns 400 with the error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("url must be http or https"))
+					Expect(fakeTeam.SetNotificationsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the request is malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SetNotificationsReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/notifications/deliveries", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/notifications/deliveries" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakeTeam.NotificationDeliveriesReturns([]atc.NotificationDelivery{
+					{
+						ID:             3,
+						Notification:   "some-hook",
+						State:          atc.NotificationDeliveryPending,
+						Attempts:       2,
+						PipelineName:   "some-pipeline",
+						JobName:        "some-job",
+						BuildID:        7,
+						BuildName:      "3",
+						BuildStatus:    atc.StatusFailed,
+						ResponseStatus: 502,
+						Error:          "unexpected response: 502 Bad Gateway",
+						CreatedAt:      100,
+						LastAttemptAt:  200,
+					},
+				}, nil)
+			})
+
+			It("returns the deliveries", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(fakeTeam.NotificationDeliveriesArgsForCall(0)).To(Equal(50))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"id": 3,
+						"notification": "some-hook",
+						"state": "pending",
+						"attempts": 2,
+						"pipeline_name": "some-pipeline",
+						"job_name": "some-job",
+						"build_id": 7,
+						"build_name": "3",
+						"build_status": "failed",
+						"response_status": 502,
+						"error": "unexpected response: 502 Bad Gateway",
+						"created_at": 100,
+						"last_attempt_at": 200
+					}
+				]`))
+			})
+
+			Context("with a limit", func() {
+				BeforeEach(func() {
+					query = "?limit=5"
+				})
+
+				It("returns that many", func() {
+					Expect(fakeTeam.NotificationDeliveriesArgsForCall(0)).To(Equal(5))
+				})
+			})
+
+			Context("with a malformed limit", func() {
+				BeforeEach(func() {
+					query = "?limit=lots"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,177 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Team Resources API", func() {
+	var (
+		fakeTeam *dbfakes.FakeTeam
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("a-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/shared-resources", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/shared-resources")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team has shared resources", func() {
+				BeforeEach(func() {
+					fakeTeam.TeamResourcesReturns(atc.TeamResources{
+						Resources: atc.ResourceConfigs{
+							{Name: "repo", Type: "git", Source: atc.Source{"uri": "https://example.com/repo.git"}},
+						},
+						ResourceTypes: atc.ResourceTypes{
+							{Name: "slack", Type: "registry-image", Source: atc.Source{"repository": "slack"}},
+						},
+					}, nil)
+				})
+
+				It("returns them", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"resources": [
+							{"name": "repo", "type": "git", "source": {"uri": "https://example.com/repo.git"}}
+						],
+						"resource_types": [
+							{"name": "slack", "type": "registry-image", "source": {"repository": "slack"}}
+						]
+					}`))
+				})
+			})
+
+			Context("when getting the shared resources fails", func() {
+				BeforeEach(func() {
+					fakeTeam.TeamResourcesReturns(atc.TeamResources{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/shared-resources", func() {
+		var body string
+
+		BeforeEach(func() {
+			body = `{"resources": [{"name": "repo", "type": "git", "source": {"uri": "https://example.com/repo.git"}}]}`
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/shared-resources", bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeTeam.SetTeamResourcesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("saves the shared resources", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(fakeTeam.SetTeamResourcesCallCount()).To(Equal(1))
+				Expect(fakeTeam.SetTeamResourcesArgsForCall(0)).To(Equal(atc.TeamResources{
+					Resources: atc.ResourceConfigs{
+						{Name: "repo", Type: "git", Source: atc.Source{"uri": "https://example.com/repo.git"}},
+					},
+				}))
+			})
+
+			It("notifies the resource scanner", func() {
+				Expect(dbTeamFactory.NotifyResourceScannerCallCount()).To(Equal(1))
+			})
+
+			Context("when the shared resources are invalid", func() {
+				BeforeEach(func() {
+					body = `{"resources": [{"name": "repo"}]}`
+				})
+
+				It("returns 400 with the errors", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("resources.repo has no type"))
+					Expect(fakeTeam.SetTeamResourcesCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the request is malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when a removed shared resource is still used", func() {
+				BeforeEach(func() {
+					fakeTeam.SetTeamResourcesReturns(db.ErrTeamResourceInUse{
+						Kind:     "resource",
+						Name:     "chat",
+						Pipeline: atc.PipelineRef{Name: "some-pipeline"},
+					})
+				})
+
+				It("returns 409 with the error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"errors": ["shared resource 'chat' is still used by pipeline 'some-pipeline'"]
+					}`))
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SetTeamResourcesReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
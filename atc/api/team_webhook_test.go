@@ -0,0 +1,248 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/webhooks"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Team WebHook API", func() {
+	Describe("POST /api/v1/teams/:team_name/webhook", func() {
+		var (
+			body      []byte
+			signature string
+			response  *http.Response
+
+			fakeResource      *dbfakes.FakeResource
+			otherResource     *dbfakes.FakeResource
+			unhookedResource  *dbfakes.FakeResource
+			fakeResourceTypes db.ResourceTypes
+		)
+
+		BeforeEach(func() {
+			body = []byte(`{
+				"ref": "refs/heads/main",
+				"repository": {
+					"full_name": "owner/repo",
+					"clone_url": "https://github.com/owner/repo.git",
+					"default_branch": "main"
+				}
+			}`)
+			signature = webhooks.Sign("some-secret", body)
+
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.NameReturns("some-repo")
+			fakeResource.SourceReturns(atc.Source{"uri": "((uri))", "branch": "main"})
+			fakeResource.WebhookTokenReturns("((webhook-token))")
+
+			otherResource = new(dbfakes.FakeResource)
+			otherResource.NameReturns("other-repo")
+			otherResource.SourceReturns(atc.Source{"uri": "https://github.com/owner/other.git"})
+			otherResource.WebhookTokenReturns("((webhook-token))")
+
+			unhookedResource = new(dbfakes.FakeResource)
+			unhookedResource.NameReturns("unhooked-repo")
+			unhookedResource.SourceReturns(atc.Source{"uri": "https://github.com/owner/repo.git"})
+
+			fakePipeline.NameReturns("some-pipeline")
+			fakePipeline.InstanceVarsReturns(atc.InstanceVars{"branch": "main"})
+			fakePipeline.ResourcesReturns(db.Resources{fakeResource, otherResource, unhookedResource}, nil)
+			fakePipeline.VariablesReturns(vars.StaticVariables{
+				"uri":           "git@github.com:owner/repo.git",
+				"webhook-token": "some-secret",
+			}, nil)
+
+			fakeResourceTypes = db.ResourceTypes{}
+			fakePipeline.ResourceTypesReturns(fakeResourceTypes, nil)
+
+			archivedPipeline := new(dbfakes.FakePipeline)
+			archivedPipeline.ArchivedReturns(true)
+
+			dbTeam.NameReturns("a-team")
+			dbTeam.PipelinesReturns([]db.Pipeline{fakePipeline, archivedPipeline}, nil)
+
+			fakeBuild := new(dbfakes.FakeBuild)
+			fakeBuild.IDReturns(42)
+			dbCheckFactory.TryCreateCheckReturns(fakeBuild, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/webhook", bytes.NewBuffer(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set(webhooks.GitHubEventHeader, "push")
+			request.Header.Set(webhooks.GitHubSignatureHeader, signature)
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("checks the resources affected by the payload", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+				{
+					"pipeline_name": "some-pipeline",
+					"pipeline_instance_vars": {"branch": "main"},
+					"resource_name": "some-repo",
+					"build_id": 42
+				}
+			]`))
+
+			Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+			_, actualResource, actualResourceTypes, actualFromVersion, manuallyTriggered := dbCheckFactory.TryCreateCheckArgsForCall(0)
+			Expect(actualResource).To(Equal(fakeResource))
+			Expect(actualResourceTypes).To(Equal(fakeResourceTypes))
+			Expect(actualFromVersion).To(BeNil())
+			Expect(manuallyTriggered).To(BeTrue())
+		})
+
+		Context("when a check is already running", func() {
+			BeforeEach(func() {
+				dbCheckFactory.TryCreateCheckReturns(nil, false, nil)
+			})
+
+			It("reports the resource without a build", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"pipeline_name": "some-pipeline",
+						"pipeline_instance_vars": {"branch": "main"},
+						"resource_name": "some-repo"
+					}
+				]`))
+			})
+		})
+
+		Context("when creating the check fails", func() {
+			BeforeEach(func() {
+				dbCheckFactory.TryCreateCheckReturns(nil, false, errors.New("nope"))
+			})
+
+			It("reports the error for the resource", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"pipeline_name": "some-pipeline",
+						"pipeline_instance_vars": {"branch": "main"},
+						"resource_name": "some-repo",
+						"error": "nope"
+					}
+				]`))
+			})
+		})
+
+		Context("when the signature does not match", func() {
+			BeforeEach(func() {
+				signature = webhooks.Sign("wrong-secret", body)
+			})
+
+			It("returns 401 without checking anything", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload affects no resources", func() {
+			BeforeEach(func() {
+				body = []byte(`{"ref": "refs/heads/main", "repository": {"full_name": "someone/else"}}`)
+				signature = webhooks.Sign("some-secret", body)
+			})
+
+			It("returns an empty list", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[]`))
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+			})
+
+			Context("and is not signed", func() {
+				BeforeEach(func() {
+					signature = ""
+				})
+
+				It("returns 401, as when it affects some", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+		})
+
+		Context("when the signature matches no resource", func() {
+			BeforeEach(func() {
+				signature = webhooks.Sign("wrong-secret", body)
+			})
+
+			It("does not evaluate any source", func() {
+				Expect(fakeResource.SourceCallCount()).To(BeZero())
+				Expect(otherResource.SourceCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the team receives too many payloads", func() {
+			BeforeEach(func() {
+				for i := 0; i < 20; i++ {
+					request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/webhook", bytes.NewBufferString(`{}`))
+					Expect(err).NotTo(HaveOccurred())
+
+					response, err := client.Do(request)
+					Expect(err).NotTo(HaveOccurred())
+					response.Body.Close()
+				}
+			})
+
+			It("returns 429", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusTooManyRequests))
+			})
+		})
+
+		Context("when the payload is too large", func() {
+			BeforeEach(func() {
+				body = bytes.Repeat([]byte(" "), 5*1024*1024+1)
+				signature = webhooks.Sign("some-secret", body)
+			})
+
+			It("returns 413 without looking at the resources", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusRequestEntityTooLarge))
+				Expect(fakePipeline.ResourcesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is malformed", func() {
+			BeforeEach(func() {
+				body = []byte(`{`)
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when getting the pipelines fails", func() {
+			BeforeEach(func() {
+				dbTeam.PipelinesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when getting the resource types fails", func() {
+			BeforeEach(func() {
+				fakePipeline.ResourceTypesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
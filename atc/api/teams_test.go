@@ -212,6 +212,123 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/members", func() {
+		var response *http.Response
+		var fakeTeam *dbfakes.FakeTeam
+
+		BeforeEach(func() {
+			fakeTeam = new(dbfakes.FakeTeam)
+			fakeTeam.IDReturns(1)
+			fakeTeam.NameReturns("a-team")
+			fakeTeam.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{
+					"groups": {"scim:admins", "github:some-org"}, "users": {"local:username"},
+				},
+				"member": map[string][]string{
+					"groups": {"SCIM:developers"}, "users": {},
+				},
+			})
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/teams/a-team/members", server.URL), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				dbSCIMRepository.SCIMGroupMembersStub = func(group string) ([]db.SCIMUser, error) {
+					switch group {
+					case "admins":
+						return []db.SCIMUser{
+							{ID: "1", UserName: "bob", DisplayName: "Bob", Email: "bob@example.com"},
+						}, nil
+					case "developers":
+						return []db.SCIMUser{
+							{ID: "2", UserName: "alice", Email: "alice@example.com"},
+							{ID: "1", UserName: "bob", DisplayName: "Bob", Email: "bob@example.com"},
+						}, nil
+					default:
+						return nil, nil
+					}
+				}
+			})
+
+			It("returns 200 ok", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response).Should(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+			})
+
+			It("only looks up members of scim groups", func() {
+				Expect(dbSCIMRepository.SCIMGroupMembersCallCount()).To(Equal(2))
+			})
+
+			It("returns the members with their roles and groups", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"user_name": "alice",
+						"email": "alice@example.com",
+						"roles": ["member"],
+						"groups": ["developers"]
+					},
+					{
+						"user_name": "bob",
+						"display_name": "Bob",
+						"email": "bob@example.com",
+						"roles": ["member", "owner"],
+						"groups": ["admins", "developers"]
+					}
+				]`))
+			})
+
+			Context("when looking up group members fails", func() {
+				BeforeEach(func() {
+					dbSCIMRepository.SCIMGroupMembersStub = nil
+					dbSCIMRepository.SCIMGroupMembersReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated but not authorized", func() {
+			BeforeEach(func() {
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name", func() {
 		var (
 			response *http.Response
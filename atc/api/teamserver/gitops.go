@@ -0,0 +1,96 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetGitOps(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("get-gitops")
+
+		config, found, err := team.GitOps()
+		if err != nil {
+			logger.Error("failed-to-get-gitops-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// the source may contain credentials, so it is never shown
+		config.Source = nil
+
+		gitOps := atc.GitOps{Config: &config}
+
+		status, found, err := team.GitOpsStatus()
+		if err != nil {
+			logger.Error("failed-to-get-gitops-status", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if found {
+			gitOps.Status = &status
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(gitOps)
+		if err != nil {
+			logger.Error("failed-to-encode-gitops", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) SetGitOps(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-gitops")
+
+		var config atc.GitOpsConfig
+		err := json.NewDecoder(r.Body).Decode(&config)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = config.Validate()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		err = team.SetGitOps(&config)
+		if err != nil {
+			logger.Error("failed-to-set-gitops", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (s *Server) DeleteGitOps(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("delete-gitops")
+
+		err := team.SetGitOps(nil)
+		if err != nil {
+			logger.Error("failed-to-disable-gitops", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
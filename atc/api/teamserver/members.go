@@ -0,0 +1,90 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListMembers lists the users provisioned through SCIM who are granted roles
+// on the team by the 'scim:' groups in its auth config. Users granted roles
+// by other connectors are only known once they log in, so are not listed.
+func (s *Server) ListMembers(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-members")
+
+		members := map[string]*atc.TeamMember{}
+		for role, config := range team.Auth() {
+			for _, group := range config[atc.TeamAuthGroups] {
+				connector, groupName, found := splitGroup(group)
+				if !found || !strings.EqualFold(connector, atc.SCIMConnector) {
+					continue
+				}
+
+				users, err := s.scimRepository.SCIMGroupMembers(groupName)
+				if err != nil {
+					logger.Error("failed-to-get-group-members", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				for _, user := range users {
+					member, found := members[user.ID]
+					if !found {
+						member = &atc.TeamMember{
+							UserName:    user.UserName,
+							DisplayName: user.DisplayName,
+							Email:       user.Email,
+						}
+
+						members[user.ID] = member
+					}
+
+					member.Roles = appendUnique(member.Roles, role)
+					member.Groups = appendUnique(member.Groups, groupName)
+				}
+			}
+		}
+
+		presented := []atc.TeamMember{}
+		for _, member := range members {
+			sort.Strings(member.Roles)
+			sort.Strings(member.Groups)
+			presented = append(presented, *member)
+		}
+
+		sort.Slice(presented, func(i, j int) bool {
+			return presented[i].UserName < presented[j].UserName
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-members", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func splitGroup(group string) (string, string, bool) {
+	i := strings.Index(group, ":")
+	if i == -1 {
+		return "", "", false
+	}
+
+	return group[:i], group[i+1:], true
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
@@ -0,0 +1,109 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const defaultNotificationDeliveriesLimit = 50
+
+func (s *Server) GetNotifications(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("get-notifications")
+
+		configs, err := team.Notifications()
+		if err != nil {
+			logger.Error("failed-to-get-notifications", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// webhook URLs often embed a token (e.g. Slack's), so like the secret
+		// only the host is shown
+		presented := atc.NotificationConfigs{}
+		for _, config := range configs {
+			config.Secret = ""
+
+			u, err := url.Parse(config.URL)
+			if err == nil {
+				config.URL = (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+			}
+
+			presented = append(presented, config)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-notifications", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) SetNotifications(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-notifications")
+
+		var configs atc.NotificationConfigs
+		err := json.NewDecoder(r.Body).Decode(&configs)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = configs.Validate()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		err = team.SetNotifications(configs)
+		if err != nil {
+			logger.Error("failed-to-set-notifications", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (s *Server) ListNotificationDeliveries(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-notification-deliveries")
+
+		limit := defaultNotificationDeliveriesLimit
+		if limitParam := r.FormValue("limit"); limitParam != "" {
+			var err error
+			limit, err = strconv.Atoi(limitParam)
+			if err != nil || limit <= 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "malformed limit: %s", limitParam)
+				return
+			}
+		}
+
+		deliveries, err := team.NotificationDeliveries(limit)
+		if err != nil {
+			logger.Error("failed-to-get-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(deliveries)
+		if err != nil {
+			logger.Error("failed-to-encode-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetTeamResources(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("get-team-resources")
+
+		shared, err := team.TeamResources()
+		if err != nil {
+			logger.Error("failed-to-get-team-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(shared)
+		if err != nil {
+			logger.Error("failed-to-encode-team-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) SetTeamResources(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-team-resources")
+
+		var shared atc.TeamResources
+		err := json.NewDecoder(r.Body).Decode(&shared)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		warnings, errorMessages := configvalidate.ValidateTeamResources(shared)
+		if len(errorMessages) > 0 {
+			writeSaveConfigResponse(w, http.StatusBadRequest, atc.SaveConfigResponse{Errors: errorMessages})
+			return
+		}
+
+		err = team.SetTeamResources(shared)
+		if err != nil {
+			var inUse db.ErrTeamResourceInUse
+			if errors.As(err, &inUse) {
+				writeSaveConfigResponse(w, http.StatusConflict, atc.SaveConfigResponse{Errors: []string{inUse.Error()}})
+				return
+			}
+
+			logger.Error("failed-to-set-team-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		err = s.teamFactory.NotifyResourceScanner()
+		if err != nil {
+			logger.Error("failed-to-notify-resource-scanner", err)
+		}
+
+		writeSaveConfigResponse(w, http.StatusOK, atc.SaveConfigResponse{Warnings: warnings})
+	})
+}
+
+func writeSaveConfigResponse(w http.ResponseWriter, status int, response atc.SaveConfigResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,384 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Tokens API", func() {
+	var (
+		response *http.Response
+		body     string
+	)
+
+	BeforeEach(func() {
+		dbAPITokenFactory.CreateAPITokenStub = func(token db.APIToken) (db.APIToken, string, error) {
+			token.ID = 42
+			token.CreatedAt = time.Unix(100, 0)
+			return token, "cpat_some-token", nil
+		}
+	})
+
+	Describe("GET /api/v1/user/tokens", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/user/tokens")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{Sub: "some-sub"})
+
+				dbAPITokenFactory.ListUserAPITokensReturns([]db.APIToken{
+					{
+						ID:        1,
+						Name:      "ci",
+						Role:      "member",
+						CreatedAt: time.Unix(100, 0),
+						ExpiresAt: time.Unix(200, 0),
+					},
+				}, nil)
+			})
+
+			It("returns the user's tokens", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbAPITokenFactory.ListUserAPITokensArgsForCall(0)).To(Equal("some-sub"))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{
+						"id": 1,
+						"name": "ci",
+						"role": "member",
+						"created_at": 100,
+						"expires_at": 200
+					}
+				]`))
+			})
+
+			Context("when listing the tokens fails", func() {
+				BeforeEach(func() {
+					dbAPITokenFactory.ListUserAPITokensReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/user/tokens", func() {
+		BeforeEach(func() {
+			body = `{"name": "ci", "role": "viewer"}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Post(server.URL+"/api/v1/user/tokens", "application/json", bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{
+					Sub:       "some-sub",
+					UserID:    "some-user-id",
+					UserName:  "some-name",
+					Connector: "github",
+					Groups:    []string{"some-org"},
+				})
+			})
+
+			It("creates a token authenticating as the user", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"id": 42,
+					"name": "ci",
+					"role": "viewer",
+					"created_at": 100,
+					"token": "cpat_some-token"
+				}`))
+
+				Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(1))
+				token := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+				Expect(token.Owner).To(Equal("some-sub"))
+				Expect(token.TeamID).To(BeZero())
+				Expect(token.Claims.RawClaims).To(HaveKeyWithValue("sub", "some-sub"))
+				Expect(token.Claims.RawClaims).To(HaveKeyWithValue("groups", []interface{}{"some-org"}))
+				Expect(token.Claims.RawClaims).To(HaveKeyWithValue("federated_claims", map[string]interface{}{
+					"user_id":      "some-user-id",
+					"connector_id": "github",
+				}))
+			})
+
+			Context("when the token expires", func() {
+				BeforeEach(func() {
+					body = `{"name": "ci", "role": "viewer", "expires_at": 4102444800}`
+				})
+
+				It("stores the expiry", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					Expect(dbAPITokenFactory.CreateAPITokenArgsForCall(0).ExpiresAt).To(Equal(time.Unix(4102444800, 0)))
+				})
+			})
+
+			Context("when the token would already have expired", func() {
+				BeforeEach(func() {
+					body = `{"name": "ci", "role": "viewer", "expires_at": 100}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the role is unknown", func() {
+				BeforeEach(func() {
+					body = `{"name": "ci", "role": "superuser"}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("unknown role: superuser")))
+				})
+			})
+
+			Context("when a service account is given", func() {
+				BeforeEach(func() {
+					body = `{"name": "ci", "role": "viewer", "service_account": "deployer"}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when authenticated with an API token", func() {
+				BeforeEach(func() {
+					fakeAccess.ClaimsReturns(accessor.Claims{Sub: "some-sub", IsAPIToken: true})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/user/tokens/:token_id", func() {
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/user/tokens/42", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{Sub: "some-sub"})
+				dbAPITokenFactory.RevokeUserAPITokenReturns(true, nil)
+			})
+
+			It("revokes the user's token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				owner, id := dbAPITokenFactory.RevokeUserAPITokenArgsForCall(0)
+				Expect(owner).To(Equal("some-sub"))
+				Expect(id).To(Equal(42))
+			})
+
+			Context("when the token is not the user's", func() {
+				BeforeEach(func() {
+					dbAPITokenFactory.RevokeUserAPITokenReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("team service account tokens", func() {
+		var fakeTeam *dbfakes.FakeTeam
+
+		BeforeEach(func() {
+			fakeTeam = new(dbfakes.FakeTeam)
+			fakeTeam.IDReturns(7)
+			fakeTeam.NameReturns("a-team")
+			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		})
+
+		Describe("POST /api/v1/teams/:team_name/tokens", func() {
+			BeforeEach(func() {
+				body = `{"name": "deploy", "role": "pipeline-operator", "service_account": "deployer"}`
+			})
+
+			JustBeforeEach(func() {
+				var err error
+				response, err = client.Post(server.URL+"/api/v1/teams/a-team/tokens", "application/json", bytes.NewBufferString(body))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				It("creates a token for the service account", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"id": 42,
+						"name": "deploy",
+						"role": "pipeline-operator",
+						"team_name": "a-team",
+						"service_account": "deployer",
+						"created_at": 100,
+						"token": "cpat_some-token"
+					}`))
+
+					token := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+					Expect(token.TeamID).To(Equal(7))
+					Expect(token.Owner).To(BeEmpty())
+					Expect(token.Claims.RawClaims).To(HaveKeyWithValue("sub", "serviceaccount:a-team:deployer"))
+				})
+
+				Context("when no service account is given", func() {
+					BeforeEach(func() {
+						body = `{"name": "deploy", "role": "pipeline-operator"}`
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when the service account is not a valid identifier", func() {
+					BeforeEach(func() {
+						body = `{"name": "deploy", "role": "pipeline-operator", "service_account": "Deployer!"}`
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+
+				Context("when creating the token fails", func() {
+					BeforeEach(func() {
+						dbAPITokenFactory.CreateAPITokenStub = nil
+						dbAPITokenFactory.CreateAPITokenReturns(db.APIToken{}, "", errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Describe("GET /api/v1/teams/:team_name/tokens", func() {
+			JustBeforeEach(func() {
+				var err error
+				response, err = client.Get(server.URL + "/api/v1/teams/a-team/tokens")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+
+					dbAPITokenFactory.ListTeamAPITokensReturns([]db.APIToken{
+						{
+							ID:             1,
+							Name:           "deploy",
+							Role:           "member",
+							TeamName:       "a-team",
+							ServiceAccount: "deployer",
+							CreatedAt:      time.Unix(100, 0),
+						},
+					}, nil)
+				})
+
+				It("returns the team's tokens", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(dbAPITokenFactory.ListTeamAPITokensArgsForCall(0)).To(Equal(7))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"id": 1,
+							"name": "deploy",
+							"role": "member",
+							"team_name": "a-team",
+							"service_account": "deployer",
+							"created_at": 100
+						}
+					]`))
+				})
+			})
+		})
+
+		Describe("DELETE /api/v1/teams/:team_name/tokens/:token_id", func() {
+			JustBeforeEach(func() {
+				request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/tokens/42", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err = client.Do(request)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+					dbAPITokenFactory.RevokeTeamAPITokenReturns(true, nil)
+				})
+
+				It("revokes the team's token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					teamID, id := dbAPITokenFactory.RevokeTeamAPITokenArgsForCall(0)
+					Expect(teamID).To(Equal(7))
+					Expect(id).To(Equal(42))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package tokenserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger          lager.Logger
+	apiTokenFactory db.APITokenFactory
+}
+
+func NewServer(
+	logger lager.Logger,
+	apiTokenFactory db.APITokenFactory,
+) *Server {
+	return &Server{
+		logger:          logger,
+		apiTokenFactory: apiTokenFactory,
+	}
+}
+
+func (s *Server) createToken(logger lager.Logger, w http.ResponseWriter, token db.APIToken) {
+	if token.IsExpired(time.Now()) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("token must expire in the future"))
+		return
+	}
+
+	created, rawToken, err := s.apiTokenFactory.CreateAPIToken(token)
+	if err != nil {
+		logger.Error("failed-to-create-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := present.APIToken(created)
+	presented.Token = rawToken
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-token", err)
+	}
+}
+
+func (s *Server) respondWithTokens(logger lager.Logger, w http.ResponseWriter, tokens []db.APIToken) {
+	presented := []atc.APIToken{}
+	for _, token := range tokens {
+		presented = append(presented, present.APIToken(token))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err := json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func expiresAt(request atc.APITokenRequest) time.Time {
+	if request.ExpiresAt == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(request.ExpiresAt, 0)
+}
@@ -0,0 +1,95 @@
+package tokenserver
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ServiceAccountConnector is the connector service account tokens
+// authenticate with.
+const ServiceAccountConnector = "serviceaccount"
+
+// ListTeamTokens lists the API tokens of the team's service accounts.
+func (s *Server) ListTeamTokens(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-team-tokens")
+
+		tokens, err := s.apiTokenFactory.ListTeamAPITokens(team.ID())
+		if err != nil {
+			logger.Error("failed-to-list-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		s.respondWithTokens(logger, w, tokens)
+	})
+}
+
+// CreateTeamToken creates an API token for a service account of the team,
+// granted the requested role on the team only.
+func (s *Server) CreateTeamToken(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("create-team-token")
+
+		request, ok := decodeRequest(w, r)
+		if !ok {
+			return
+		}
+
+		if request.ServiceAccount == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("team tokens must be for a service account"))
+			return
+		}
+
+		s.createToken(logger, w, db.APIToken{
+			Name:           request.Name,
+			Role:           request.Role,
+			TeamID:         team.ID(),
+			TeamName:       team.Name(),
+			ServiceAccount: request.ServiceAccount,
+			Claims: db.Claims{
+				RawClaims: map[string]interface{}{
+					"sub":                ServiceAccountConnector + ":" + team.Name() + ":" + request.ServiceAccount,
+					"name":               request.ServiceAccount,
+					"preferred_username": request.ServiceAccount,
+					"federated_claims": map[string]interface{}{
+						"user_id":      request.ServiceAccount,
+						"connector_id": ServiceAccountConnector,
+					},
+				},
+			},
+			ExpiresAt: expiresAt(request),
+		})
+	})
+}
+
+// RevokeTeamToken revokes one of the API tokens of the team's service
+// accounts.
+func (s *Server) RevokeTeamToken(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("revoke-team-token")
+
+		id, err := strconv.Atoi(r.FormValue(":token_id"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		revoked, err := s.apiTokenFactory.RevokeTeamAPIToken(team.ID(), id)
+		if err != nil {
+			logger.Error("failed-to-revoke-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !revoked {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
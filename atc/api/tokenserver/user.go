@@ -0,0 +1,141 @@
+package tokenserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListUserTokens lists the API tokens owned by the user.
+func (s *Server) ListUserTokens(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-user-tokens")
+
+	acc := accessor.GetAccessor(r)
+
+	tokens, err := s.apiTokenFactory.ListUserAPITokens(acc.Claims().Sub)
+	if err != nil {
+		logger.Error("failed-to-list-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	s.respondWithTokens(logger, w, tokens)
+}
+
+// CreateUserToken creates an API token which authenticates as the user, but
+// is never granted more than the requested role.
+func (s *Server) CreateUserToken(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-user-token")
+
+	acc := accessor.GetAccessor(r)
+	claims := acc.Claims()
+
+	// otherwise a token could be used to create one with a greater role
+	if claims.IsAPIToken {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("API tokens cannot be created using an API token"))
+		return
+	}
+
+	request, ok := decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if request.ServiceAccount != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("service account tokens must be created for a team"))
+		return
+	}
+
+	// the groups are replaced with the user's groups as of their last login
+	// when the token is used, so these only apply until then
+	groups := []interface{}{}
+	for _, group := range claims.Groups {
+		groups = append(groups, group)
+	}
+
+	s.createToken(logger, w, db.APIToken{
+		Name:  request.Name,
+		Role:  request.Role,
+		Owner: claims.Sub,
+		Claims: db.Claims{
+			RawClaims: map[string]interface{}{
+				"sub":                claims.Sub,
+				"name":               claims.UserName,
+				"preferred_username": claims.PreferredUsername,
+				"email":              claims.Email,
+				"groups":             groups,
+				"federated_claims": map[string]interface{}{
+					"user_id":      claims.UserID,
+					"connector_id": claims.Connector,
+				},
+			},
+		},
+		ExpiresAt: expiresAt(request),
+	})
+}
+
+// RevokeUserToken revokes one of the user's API tokens.
+func (s *Server) RevokeUserToken(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-user-token")
+
+	id, err := strconv.Atoi(r.FormValue(":token_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	acc := accessor.GetAccessor(r)
+
+	revoked, err := s.apiTokenFactory.RevokeUserAPIToken(acc.Claims().Sub, id)
+	if err != nil {
+		logger.Error("failed-to-revoke-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !revoked {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func decodeRequest(w http.ResponseWriter, r *http.Request) (atc.APITokenRequest, bool) {
+	var request atc.APITokenRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("malformed request: " + err.Error()))
+		return atc.APITokenRequest{}, false
+	}
+
+	err = request.Validate()
+	if err == nil {
+		err = validateRole(request.Role)
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return atc.APITokenRequest{}, false
+	}
+
+	return request, true
+}
+
+func validateRole(role string) error {
+	switch role {
+	case accessor.OwnerRole, accessor.MemberRole, accessor.OperatorRole, accessor.ViewerRole:
+		return nil
+	default:
+		return errors.New("unknown role: " + role)
+	}
+}
@@ -6,16 +6,19 @@ import (
 )
 
 type Server struct {
-	logger      lager.Logger
-	userFactory db.UserFactory
+	logger             lager.Logger
+	userFactory        db.UserFactory
+	accessTokenFactory db.AccessTokenFactory
 }
 
 func NewServer(
 	logger lager.Logger,
 	userFactory db.UserFactory,
+	accessTokenFactory db.AccessTokenFactory,
 ) *Server {
 	return &Server{
-		logger:      logger,
-		userFactory: userFactory,
+		logger:             logger,
+		userFactory:        userFactory,
+		accessTokenFactory: accessTokenFactory,
 	}
 }
@@ -0,0 +1,100 @@
+package usersserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListSessions lists the unexpired sessions, optionally only those of the
+// user given by the 'username' and 'connector' query params.
+func (s *Server) ListSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-sessions")
+
+	sessions, err := s.accessTokenFactory.GetSessions(sessionFilter(r))
+	if err != nil {
+		logger.Error("failed-to-get-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := []atc.Session{}
+	for _, session := range sessions {
+		presented = append(presented, present.Session(session))
+	}
+
+	s.respond(logger, w, http.StatusOK, presented)
+}
+
+// RevokeSession revokes a single session, logging its user out.
+func (s *Server) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-session")
+
+	id, err := strconv.Atoi(r.FormValue(":session_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	revoked, err := s.accessTokenFactory.RevokeSession(id)
+	if err != nil {
+		logger.Error("failed-to-revoke-session", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !revoked {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	logger.Info("revoked", lager.Data{"session": id})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RevokeUserSessions revokes every session of the user given by the
+// 'username' and, optionally, 'connector' query params.
+func (s *Server) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-user-sessions")
+
+	filter := sessionFilter(r)
+	if filter.Username == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("username must be specified"))
+		return
+	}
+
+	revoked, err := s.accessTokenFactory.RevokeSessions(filter)
+	if err != nil {
+		logger.Error("failed-to-revoke-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("revoked", lager.Data{"username": filter.Username, "connector": filter.Connector, "sessions": revoked.Revoked, "api-tokens": revoked.APITokens})
+
+	s.respond(logger, w, http.StatusOK, revoked)
+}
+
+func sessionFilter(r *http.Request) db.SessionFilter {
+	return db.SessionFilter{
+		Username:  r.URL.Query().Get("username"),
+		Connector: r.URL.Query().Get("connector"),
+	}
+}
+
+func (s *Server) respond(logger lager.Logger, w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		logger.Error("failed-to-encode-response", err)
+	}
+}
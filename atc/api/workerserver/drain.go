@@ -0,0 +1,30 @@
+package workerserver
+
+import "net/http"
+
+func (s *Server) DrainWorker(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("draining-worker")
+	workerName := r.FormValue(":worker_name")
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-finding-worker-to-drain", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.Drain()
+	if err != nil {
+		logger.Error("failed-to-drain-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
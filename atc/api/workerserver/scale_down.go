@@ -0,0 +1,30 @@
+package workerserver
+
+import "net/http"
+
+func (s *Server) ScaleDownWorker(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("scaling-down-worker")
+	workerName := r.FormValue(":worker_name")
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-finding-worker-to-scale-down", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.MarkForScaleDown()
+	if err != nil {
+		logger.Error("failed-to-mark-worker-for-scale-down", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -2,6 +2,7 @@ package workerserver
 
 import (
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/autoscaler"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -10,17 +11,19 @@ type Server struct {
 
 	teamFactory     db.TeamFactory
 	dbWorkerFactory db.WorkerFactory
+	workerCapacity  autoscaler.Calculator
 }
 
 func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
 	dbWorkerFactory db.WorkerFactory,
-
+	workerCapacity autoscaler.Calculator,
 ) *Server {
 	return &Server{
 		logger:          logger,
 		teamFactory:     teamFactory,
 		dbWorkerFactory: dbWorkerFactory,
+		workerCapacity:  workerCapacity,
 	}
 }
@@ -0,0 +1,60 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// APIToken is a long-lived token for automation, owned either by a user or by
+// a service account of a team.
+type APIToken struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+
+	TeamName       string `json:"team_name,omitempty"`
+	ServiceAccount string `json:"service_account,omitempty"`
+
+	CreatedAt int64 `json:"created_at"`
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+
+	// Token is only returned when the token is created, as it is stored
+	// hashed.
+	Token string `json:"token,omitempty"`
+}
+
+// APITokenRequest is the request to create an APIToken. ServiceAccount is
+// only allowed when creating a token for a team.
+type APITokenRequest struct {
+	Name           string `json:"name"`
+	Role           string `json:"role"`
+	ServiceAccount string `json:"service_account,omitempty"`
+	ExpiresAt      int64  `json:"expires_at,omitempty"`
+}
+
+func (request APITokenRequest) Validate() error {
+	if request.Name == "" {
+		return errors.New("token must have a name")
+	}
+
+	if request.Role == "" {
+		return errors.New("token must have a role")
+	}
+
+	if request.ServiceAccount != "" {
+		warning, err := ValidateIdentifier(request.ServiceAccount, "service account")
+		if err != nil {
+			return err
+		}
+
+		if warning != nil {
+			return errors.New(warning.Message)
+		}
+	}
+
+	if request.ExpiresAt < 0 {
+		return fmt.Errorf("invalid expiry: %d", request.ExpiresAt)
+	}
+
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/autoscaler"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/component"
 	"github.com/concourse/concourse/atc/compression"
@@ -39,16 +40,21 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/gitops"
 	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/notifications"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
+	"github.com/concourse/concourse/atc/scim"
 	"github.com/concourse/concourse/atc/syslog"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
+	"github.com/concourse/concourse/atc/worker/provisioner"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/concourse/concourse/skymarshal/dexserver"
 	"github.com/concourse/concourse/skymarshal/legacyserver"
@@ -144,6 +150,11 @@ type RunCommand struct {
 
 	InterceptIdleTimeout time.Duration `long:"intercept-idle-timeout" default:"0m" description:"Length of time for a intercepted session to be idle before terminating."`
 
+	OIDCTokenDuration time.Duration `long:"oidc-token-duration" default:"1h" description:"Length of time for which the OIDC tokens issued to tasks are valid."`
+
+	SCIMToken     string `long:"scim-token"     description:"Bearer token with which identity providers provision users and groups through the SCIM 2.0 endpoint at /scim/v2. The endpoint is disabled if not set."`
+	SCIMConnector string `long:"scim-connector" description:"ID of the connector (e.g. 'oidc' or 'saml') of the identity provider provisioning through SCIM. Provisioned groups only apply to users logging in through it, matched by its user ID against their externalId, or userName if they have none."`
+
 	ComponentRunnerInterval time.Duration `long:"component-runner-interval" default:"10s" description:"Interval on which runners are kicked off for builds, locks, scans, and checks"`
 
 	LidarScannerInterval time.Duration `long:"lidar-scanner-interval" default:"10s" description:"Interval on which the resource scanner will run to see if new checks need to be scheduled"`
@@ -196,6 +207,8 @@ type RunCommand struct {
 		FailedGracePeriod      time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
 		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
 		VarSourceRecyclePeriod time.Duration `long:"var-source-recycle-period" default:"5m" description:"Period after which to reap var_sources that are not used."`
+		StatusEventRetention   time.Duration `long:"status-event-retention" default:"1h" description:"Period for which build and job status events are kept, so that clients of the status event stream can catch up after reconnecting."`
+		AuditEventRetention    time.Duration `long:"audit-event-retention" default:"2160h" description:"Period for which audit events are kept."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
@@ -223,6 +236,9 @@ type RunCommand struct {
 		EnableTeamAuditLog      bool `long:"enable-team-auditing" description:"Enable auditing for all api requests connected to teams."`
 		EnableWorkerAuditLog    bool `long:"enable-worker-auditing" description:"Enable auditing for all api requests connected to workers."`
 		EnableVolumeAuditLog    bool `long:"enable-volume-auditing" description:"Enable auditing for all api requests connected to volumes."`
+
+		WebhookURL    string `long:"audit-webhook-url" description:"URL to which audit events are forwarded as they happen, as a JSON array of events in a POST request."`
+		WebhookSecret string `long:"audit-webhook-secret" description:"Secret with which requests to the audit webhook are signed."`
 	}
 
 	Syslog struct {
@@ -233,6 +249,26 @@ type RunCommand struct {
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	WorkerAutoscaler struct {
+		Interval   time.Duration `long:"interval" default:"30s" description:"Interval on which to compute the desired number of workers."`
+		WebhookURL string        `long:"webhook-url" description:"URL to POST the desired worker capacity to whenever it changes."`
+		MinWorkers int           `long:"min-workers" default:"0" description:"Minimum number of workers to ask for."`
+		MaxWorkers int           `long:"max-workers" default:"0" description:"Maximum number of workers to ask for. 0 means no limit."`
+	} `group:"Worker Autoscaling" namespace:"worker-autoscaler"`
+
+	WorkerProvisioner struct {
+		Tags         []string      `long:"tag" description:"Provision a worker of its own for each step requiring this tag. It is torn down after the step, or once the build has finished for build steps. Can be specified multiple times."`
+		LocalCommand string        `long:"local-command" description:"Command which starts a worker process on this host, accepting the same flags as 'concourse worker'. It is passed the worker's name, tags and --ephemeral."`
+		LocalArgs    []string      `long:"local-arg" description:"Argument to pass to the local worker command before the worker's name and tags. Can be specified multiple times."`
+		Timeout      time.Duration `long:"timeout" default:"5m" description:"How long a step waits for the worker provisioned for it to register before failing."`
+	} `group:"Worker Provisioning" namespace:"worker-provisioner"`
+
+	WarmContainers struct {
+		Interval time.Duration `long:"interval" default:"30s" description:"Interval on which to create warm containers."`
+
+		worker.WarmPoolConfig
+	} `group:"Warm Containers" namespace:"warm-containers"`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
@@ -684,12 +720,18 @@ func (cmd *RunCommand) constructMembers(
 		return nil, err
 	}
 
-	backendComponents, err := cmd.backendComponents(logger, backendConn, lockFactory, secretManager, policyChecker)
+	// shared so that the collector stops the workers the pool provisioned
+	workerProvisioner, err := cmd.workerProvisioner()
+	if err != nil {
+		return nil, err
+	}
+
+	backendComponents, err := cmd.backendComponents(logger, backendConn, lockFactory, secretManager, policyChecker, workerProvisioner)
 	if err != nil {
 		return nil, err
 	}
 
-	gcComponents, err := cmd.gcComponents(logger, gcConn, lockFactory)
+	gcComponents, err := cmd.gcComponents(logger, gcConn, lockFactory, workerProvisioner)
 	if err != nil {
 		return nil, err
 	}
@@ -802,7 +844,8 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.GardenRequestTimeout,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	dbWaitingStepRepository := db.NewWaitingStepRepository(dbConn)
+	pool := worker.NewPool(workerProvider, dbWaitingStepRepository, worker.Provisioning{}, nil)
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -819,8 +862,12 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbAccessTokenFactory := db.NewAccessTokenFactory(dbConn)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbStatusEventFactory := db.NewStatusEventFactory(dbConn)
+	dbAPITokenFactory := db.NewAPITokenFactory(dbConn)
+	dbAuditEventRepository := db.NewAuditEventRepository(dbConn)
+	dbSCIMRepository := db.NewSCIMRepository(dbConn)
 
-	tokenVerifier := cmd.constructTokenVerifier(dbAccessTokenFactory)
+	tokenVerifier, claimsCacher := cmd.constructTokenVerifier(logger, dbConn.Bus(), dbAccessTokenFactory, dbAPITokenFactory)
 
 	teamsCacher := accessor.NewTeamsCacher(
 		logger,
@@ -838,6 +885,8 @@ func (cmd *RunCommand) constructAPIMembers(
 	accessFactory := accessor.NewAccessFactory(
 		tokenVerifier,
 		teamsCacher,
+		accessor.NewSCIMGroupsCacher(dbSCIMRepository, time.Minute, time.Minute),
+		cmd.SCIMConnector,
 		cmd.SystemClaimKey,
 		cmd.SystemClaimValues,
 		displayUserIdGenerator,
@@ -861,7 +910,13 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		dbAccessTokenFactory,
+		dbStatusEventFactory,
+		dbAPITokenFactory,
+		dbAuditEventRepository,
+		dbSCIMRepository,
 		pool,
+		cmd.workerCapacityCalculator(dbWorkerFactory, dbWaitingStepRepository),
 		secretManager,
 		credsManagers,
 		accessFactory,
@@ -904,6 +959,13 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
+	oidcIssuer, err := cmd.constructOIDCIssuer()
+	if err != nil {
+		return nil, err
+	}
+
+	scimServer := scim.NewServer(logger.Session("scim"), dbSCIMRepository, cmd.SCIMToken, cmd.ExternalURL.String())
+
 	var httpHandler, httpsHandler http.Handler
 	if cmd.isTLSEnabled() {
 		httpHandler = cmd.constructHTTPHandler(
@@ -939,6 +1001,14 @@ func (cmd *RunCommand) constructAPIMembers(
 				externalHost:  cmd.ExternalURL.URL.Host,
 				baseHandler:   legacyHandler,
 			},
+			tlsRedirectHandler{
+				matchHostname: cmd.ExternalURL.URL.Hostname(),
+				externalHost:  cmd.ExternalURL.URL.Host,
+				baseHandler:   oidcIssuer,
+			},
+
+			// like the API, identity providers are not redirected
+			scimServer,
 			middleware,
 		)
 
@@ -949,6 +1019,8 @@ func (cmd *RunCommand) constructAPIMembers(
 			authHandler,
 			loginHandler,
 			legacyHandler,
+			oidcIssuer,
+			scimServer,
 			middleware,
 		)
 	} else {
@@ -959,6 +1031,8 @@ func (cmd *RunCommand) constructAPIMembers(
 			authHandler,
 			loginHandler,
 			legacyHandler,
+			oidcIssuer,
+			scimServer,
 			middleware,
 		)
 	}
@@ -972,6 +1046,7 @@ func (cmd *RunCommand) constructAPIMembers(
 			cmd.nonTLSBindAddr(),
 			httpHandler,
 		)},
+		{Name: "claims-cacher", Runner: claimsCacher},
 	}
 
 	if httpsHandler != nil {
@@ -995,6 +1070,7 @@ func (cmd *RunCommand) backendComponents(
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
 	policyChecker policy.Checker,
+	workerProvisioner worker.Provisioner,
 ) ([]RunnableComponent, error) {
 
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
@@ -1013,6 +1089,7 @@ func (cmd *RunCommand) backendComponents(
 	fetchSourceFactory := worker.NewFetchSourceFactory(dbResourceCacheFactory)
 	resourceFetcher := worker.NewFetcher(clock.NewClock(), lockFactory, fetchSourceFactory)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(dbConn, lockFactory)
+	dbContainerRepository := db.NewContainerRepository(dbConn)
 
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, secretManager, cmd.varSourcePool, db.CheckDurations{
@@ -1060,7 +1137,25 @@ func (cmd *RunCommand) backendComponents(
 		cmd.GardenRequestTimeout,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	dbWaitingStepRepository := db.NewWaitingStepRepository(dbConn)
+
+	var warmPool *worker.WarmPool
+	var warmContainers worker.WarmContainers
+	if cmd.WarmContainers.Size > 0 {
+		warmPool = worker.NewWarmPool(workerProvider, dbWorkerFactory, cmd.WarmContainers.WarmPoolConfig)
+		warmContainers = warmPool
+	}
+
+	pool := worker.NewPool(
+		workerProvider,
+		dbWaitingStepRepository,
+		worker.Provisioning{
+			Provisioner:  workerProvisioner,
+			Reservations: db.NewProvisionedWorkerRepository(dbConn),
+			Timeout:      cmd.WorkerProvisioner.Timeout,
+		},
+		warmContainers,
+	)
 	artifactStreamer := worker.NewArtifactStreamer(pool, compressionLib)
 	artifactSourcer := worker.NewArtifactSourcer(compressionLib, pool, cmd.FeatureFlags.EnableP2PVolumeStreaming, cmd.P2pVolumeStreamingTimeout, dbResourceCacheFactory)
 
@@ -1082,6 +1177,11 @@ func (cmd *RunCommand) backendComponents(
 		clock.NewClock(),
 	)
 
+	oidcIssuer, err := cmd.constructOIDCIssuer()
+	if err != nil {
+		return nil, err
+	}
+
 	engine := cmd.constructEngine(
 		pool,
 		artifactStreamer,
@@ -1092,12 +1192,15 @@ func (cmd *RunCommand) backendComponents(
 		dbBuildFactory,
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
+		dbContainerRepository,
+		dbConn.Bus(),
 		secretManager,
 		defaultLimits,
 		buildContainerStrategy,
 		lockFactory,
 		rateLimiter,
 		policyChecker,
+		oidcIssuer,
 	)
 
 	// In case that a user configures resource-checking-interval, but forgets to
@@ -1166,6 +1269,67 @@ func (cmd *RunCommand) backendComponents(
 				syslogDrainConfigured,
 			),
 		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentWorkerAutoscaler,
+				Interval: cmd.WorkerAutoscaler.Interval,
+			},
+			Runnable: autoscaler.NewPublisher(
+				cmd.workerCapacityCalculator(dbWorkerFactory, dbWaitingStepRepository),
+				dbWaitingStepRepository,
+				cmd.WorkerAutoscaler.WebhookURL,
+				&http.Client{Timeout: time.Minute},
+			),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentGitOps,
+				Interval: 10 * time.Second,
+			},
+			Runnable: gitops.NewReconciler(
+				teamFactory,
+				dbBuildFactory,
+				clock.NewClock(),
+			),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentNotifier,
+				Interval: 10 * time.Second,
+			},
+			Runnable: notifications.NewNotifier(
+				teamFactory,
+				db.NewNotificationRepository(dbConn),
+				&http.Client{Timeout: 10 * time.Second},
+				cmd.ExternalURL.String(),
+				clock.NewClock(),
+			),
+		},
+	}
+
+	if warmPool != nil {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentWarmContainers,
+				Interval: cmd.WarmContainers.Interval,
+			},
+			Runnable: warmPool,
+		})
+	}
+
+	if cmd.Auditor.WebhookURL != "" {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentAuditForwarder,
+				Interval: 10 * time.Second,
+			},
+			Runnable: auditor.NewForwarder(
+				db.NewAuditEventRepository(dbConn),
+				&http.Client{Timeout: 10 * time.Second},
+				cmd.Auditor.WebhookURL,
+				cmd.Auditor.WebhookSecret,
+			),
+		})
 	}
 
 	if syslogDrainConfigured {
@@ -1191,6 +1355,7 @@ func (cmd *RunCommand) gcComponents(
 	logger lager.Logger,
 	gcConn db.Conn,
 	lockFactory lock.LockFactory,
+	workerProvisioner worker.Provisioner,
 ) ([]RunnableComponent, error) {
 	dbWorkerLifecycle := db.NewWorkerLifecycle(gcConn)
 	dbResourceCacheLifecycle := db.NewResourceCacheLifecycle(gcConn)
@@ -1202,6 +1367,8 @@ func (cmd *RunCommand) gcComponents(
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
 	dbPipelineLifecycle := db.NewPipelineLifecycle(gcConn, lockFactory)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
+	dbStatusEventLifecycle := db.NewStatusEventLifecycle(gcConn)
+	dbAuditEventLifecycle := db.NewAuditEventLifecycle(gcConn)
 
 	dbVolumeRepository := db.NewVolumeRepository(gcConn)
 
@@ -1222,11 +1389,17 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorResourceCacheUses: gc.NewResourceCacheUseCollector(dbResourceCacheLifecycle),
 		atc.ComponentCollectorArtifacts:         gc.NewArtifactCollector(dbArtifactLifecycle),
 		atc.ComponentCollectorVolumes:           gc.NewVolumeCollector(dbVolumeRepository, cmd.GC.MissingGracePeriod),
-		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, cmd.GC.MissingGracePeriod, cmd.GC.HijackGracePeriod),
+		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, cmd.GC.MissingGracePeriod, cmd.GC.HijackGracePeriod, cmd.WarmContainers.IdleTimeout),
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
 		atc.ComponentCollectorPipelines:         gc.NewPipelineCollector(dbPipelineLifecycle),
 		atc.ComponentCollectorAccessTokens:      gc.NewAccessTokensCollector(dbAccessTokenLifecycle, jwt.DefaultLeeway),
 		atc.ComponentCollectorChecks:            gc.NewChecksCollector(dbCheckLifecycle),
+		atc.ComponentCollectorStatusEvents:      gc.NewStatusEventsCollector(dbStatusEventLifecycle, cmd.GC.StatusEventRetention),
+		atc.ComponentCollectorAuditEvents:       gc.NewAuditEventsCollector(dbAuditEventLifecycle, cmd.GC.AuditEventRetention),
+	}
+
+	if workerProvisioner != nil {
+		collectors[atc.ComponentCollectorProvisionedWorkers] = gc.NewProvisionedWorkerCollector(db.NewProvisionedWorkerRepository(gcConn), workerProvisioner)
 	}
 
 	var components []RunnableComponent
@@ -1561,6 +1734,13 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	if cmd.SCIMToken != "" && cmd.SCIMConnector == "" {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify --scim-connector to use --scim-token"),
+		)
+	}
+
 	if err := cmd.validateCustomRoles(); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -1635,6 +1815,42 @@ func constructLockConn(driverName, connectionString string) (*sql.DB, error) {
 	return dbConn, nil
 }
 
+func (cmd *RunCommand) workerProvisioner() (worker.Provisioner, error) {
+	if len(cmd.WorkerProvisioner.Tags) == 0 {
+		return nil, nil
+	}
+
+	if cmd.WorkerProvisioner.LocalCommand == "" {
+		return nil, fmt.Errorf("worker provisioner is misconfigured, cannot provision workers without a command")
+	}
+
+	return provisioner.NewLocal(
+		cmd.WorkerProvisioner.Tags,
+		cmd.WorkerProvisioner.LocalCommand,
+		cmd.WorkerProvisioner.LocalArgs,
+	), nil
+}
+
+func (cmd *RunCommand) workerCapacityCalculator(
+	dbWorkerFactory db.WorkerFactory,
+	dbWaitingStepRepository db.WaitingStepRepository,
+) autoscaler.Calculator {
+	limits := autoscaler.Limits{
+		MinWorkers: cmd.WorkerAutoscaler.MinWorkers,
+		MaxWorkers: cmd.WorkerAutoscaler.MaxWorkers,
+	}
+
+	// the active tasks limit only holds back steps when the strategy enforcing
+	// it is in use
+	for _, strategy := range cmd.ContainerPlacementStrategyOptions.ContainerPlacementStrategy {
+		if strategy == "limit-active-tasks" {
+			limits.MaxActiveTasksPerWorker = cmd.ContainerPlacementStrategyOptions.MaxActiveTasksPerWorker
+		}
+	}
+
+	return autoscaler.NewCalculator(dbWorkerFactory, dbWaitingStepRepository, limits)
+}
+
 func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacementStrategy, error) {
 	return worker.NewChainPlacementStrategy(cmd.ContainerPlacementStrategyOptions)
 }
@@ -1672,12 +1888,15 @@ func (cmd *RunCommand) constructEngine(
 	buildFactory db.BuildFactory,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	containerRepository db.ContainerRepository,
+	notifications exec.Notifications,
 	secretManager creds.Secrets,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
 	rateLimiter engine.RateLimiter,
 	policyChecker policy.Checker,
+	oidcTokenIssuer exec.OIDCTokenIssuer,
 ) engine.Engine {
 	return engine.NewEngine(
 		engine.NewStepperFactory(
@@ -1690,9 +1909,12 @@ func (cmd *RunCommand) constructEngine(
 				buildFactory,
 				resourceCacheFactory,
 				resourceConfigFactory,
+				containerRepository,
+				notifications,
 				defaultLimits,
 				strategy,
 				cmd.GlobalResourceCheckTimeout,
+				oidcTokenIssuer,
 			),
 			cmd.ExternalURL.String(),
 			rateLimiter,
@@ -1706,6 +1928,14 @@ func (cmd *RunCommand) constructEngine(
 	)
 }
 
+func (cmd *RunCommand) constructOIDCIssuer() (*token.OIDCIssuer, error) {
+	return token.NewOIDCIssuer(
+		cmd.ExternalURL.String(),
+		cmd.Auth.AuthFlags.SigningKey.PrivateKey,
+		cmd.OIDCTokenDuration,
+	)
+}
+
 func (cmd *RunCommand) constructHTTPHandler(
 	logger lager.Logger,
 	webHandler http.Handler,
@@ -1713,6 +1943,8 @@ func (cmd *RunCommand) constructHTTPHandler(
 	authHandler http.Handler,
 	loginHandler http.Handler,
 	legacyHandler http.Handler,
+	oidcHandler http.Handler,
+	scimHandler http.Handler,
 	middleware token.Middleware,
 ) http.Handler {
 
@@ -1728,6 +1960,8 @@ func (cmd *RunCommand) constructHTTPHandler(
 	webMux.Handle("/auth/", legacyHandler)
 	webMux.Handle("/login", legacyHandler)
 	webMux.Handle("/logout", legacyHandler)
+	webMux.Handle(token.OIDCIssuerPath+"/", oidcHandler)
+	webMux.Handle(scim.Path+"/", scimHandler)
 	webMux.Handle("/", webHandler)
 
 	httpHandler := wrappa.LoggerHandler{
@@ -1842,7 +2076,12 @@ func (cmd *RunCommand) constructLoginHandler(
 	return skyserver.NewSkyHandler(skyServer), nil
 }
 
-func (cmd *RunCommand) constructTokenVerifier(accessTokenFactory db.AccessTokenFactory) accessor.TokenVerifier {
+func (cmd *RunCommand) constructTokenVerifier(
+	logger lager.Logger,
+	notifications accessor.Notifications,
+	accessTokenFactory db.AccessTokenFactory,
+	apiTokenFactory db.APITokenFactory,
+) (accessor.TokenVerifier, ifrit.Runner) {
 
 	validClients := []string{flyClientID}
 	for clientId := range cmd.Auth.AuthFlags.Clients {
@@ -1850,9 +2089,9 @@ func (cmd *RunCommand) constructTokenVerifier(accessTokenFactory db.AccessTokenF
 	}
 
 	MiB := 1024 * 1024
-	claimsCacher := accessor.NewClaimsCacher(accessTokenFactory, 1*MiB)
+	claimsCacher := accessor.NewClaimsCacher(logger, notifications, accessTokenFactory, 1*MiB)
 
-	return accessor.NewVerifier(claimsCacher, validClients)
+	return accessor.NewVerifier(claimsCacher, apiTokenFactory, validClients), claimsCacher
 }
 
 func (cmd *RunCommand) constructAPIHandler(
@@ -1871,7 +2110,13 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbAccessTokenFactory db.AccessTokenFactory,
+	dbStatusEventFactory db.StatusEventFactory,
+	dbAPITokenFactory db.APITokenFactory,
+	dbAuditEventRepository db.AuditEventRepository,
+	dbSCIMRepository db.SCIMRepository,
 	workerPool worker.Pool,
+	workerCapacity autoscaler.Calculator,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
@@ -1896,6 +2141,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		cmd.Auditor.EnableTeamAuditLog,
 		cmd.Auditor.EnableWorkerAuditLog,
 		cmd.Auditor.EnableVolumeAuditLog,
+		dbAuditEventRepository,
 		logger,
 	)
 
@@ -1947,10 +2193,16 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		dbAccessTokenFactory,
+		dbStatusEventFactory,
+		dbAPITokenFactory,
+		dbAuditEventRepository,
+		dbSCIMRepository,
 
 		buildserver.NewEventHandler,
 
 		workerPool,
+		workerCapacity,
 
 		reconfigurableSink,
 
@@ -0,0 +1,22 @@
+package atc
+
+// AuditEvent is an audited API request, as persisted and served to admins
+// from the audit events endpoint.
+type AuditEvent struct {
+	ID   int   `json:"id"`
+	Time int64 `json:"time"`
+
+	Actor    string `json:"actor"`
+	TeamName string `json:"team_name,omitempty"`
+	Action   string `json:"action"`
+
+	// Target identifies what the request acted on by the params of its
+	// route other than the team, e.g. "pipeline_name=deploy,job_name=prod".
+	Target string `json:"target,omitempty"`
+
+	Method     string              `json:"method"`
+	Path       string              `json:"path"`
+	RemoteAddr string              `json:"remote_addr,omitempty"`
+	UserAgent  string              `json:"user_agent,omitempty"`
+	Parameters map[string][]string `json:"parameters,omitempty"`
+}
@@ -2,10 +2,14 @@ package auditor
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -21,6 +25,7 @@ func NewAuditor(
 	EnableTeamAuditLog bool,
 	EnableWorkerAuditLog bool,
 	EnableVolumeAuditLog bool,
+	repository db.AuditEventRepository,
 	logger lager.Logger,
 ) *auditor {
 	return &auditor{
@@ -33,6 +38,7 @@ func NewAuditor(
 		EnableTeamAuditLog:      EnableTeamAuditLog,
 		EnableWorkerAuditLog:    EnableWorkerAuditLog,
 		EnableVolumeAuditLog:    EnableVolumeAuditLog,
+		repository:              repository,
 		logger:                  logger,
 	}
 }
@@ -51,6 +57,7 @@ type auditor struct {
 	EnableTeamAuditLog      bool
 	EnableWorkerAuditLog    bool
 	EnableVolumeAuditLog    bool
+	repository              db.AuditEventRepository
 	logger                  lager.Logger
 }
 
@@ -62,6 +69,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RerunJobBuild,
 		atc.ListBuilds,
 		atc.BuildEvents,
+		atc.StatusEvents,
 		atc.BuildResources,
 		atc.AbortBuild,
 		atc.GetBuildPreparation,
@@ -101,6 +109,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.UnpausePipeline,
 		atc.ExposePipeline,
 		atc.HidePipeline,
+		atc.SharePipeline,
 		atc.RenamePipeline,
 		atc.ListPipelineBuilds,
 		atc.CreatePipelineBuild,
@@ -114,6 +123,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.SetPinCommentOnResource,
 		atc.CheckResource,
 		atc.CheckResourceWebHook,
+		atc.CheckTeamWebHook,
 		atc.CheckResourceType,
 		atc.CheckPrototype,
 		atc.ListResourceVersions,
@@ -127,6 +137,8 @@ func (a *auditor) ValidateAction(action string) bool {
 	case
 		atc.SaveConfig,
 		atc.GetConfig,
+		atc.ListPipelineConfigVersions,
+		atc.GetPipelineConfigVersion,
 		atc.GetCC,
 		atc.GetVersionsDB,
 		atc.ClearTaskCache,
@@ -136,7 +148,14 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetInfo,
 		atc.GetInfoCreds,
 		atc.ListActiveUsersSince,
+		atc.ListSessions,
+		atc.RevokeSession,
+		atc.RevokeUserSessions,
+		atc.ListAuditEvents,
 		atc.GetUser,
+		atc.ListUserTokens,
+		atc.CreateUserToken,
+		atc.RevokeUserToken,
 		atc.GetWall,
 		atc.SetWall,
 		atc.ClearWall:
@@ -146,11 +165,26 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
-		atc.GetTeam:
+		atc.GetTeam,
+		atc.GetTeamGitOps,
+		atc.SetTeamGitOps,
+		atc.DeleteTeamGitOps,
+		atc.GetTeamNotifications,
+		atc.SetTeamNotifications,
+		atc.ListTeamNotificationDeliveries,
+		atc.GetTeamResources,
+		atc.SetTeamResources,
+		atc.ListTeamMembers,
+		atc.ListTeamTokens,
+		atc.CreateTeamToken,
+		atc.RevokeTeamToken:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
 		atc.LandWorker,
+		atc.DrainWorker,
 		atc.RetireWorker,
+		atc.ScaleDownWorker,
+		atc.GetWorkerCapacity,
 		atc.PruneWorker,
 		atc.HeartbeatWorker,
 		atc.ListWorkers,
@@ -165,9 +199,64 @@ func (a *auditor) ValidateAction(action string) bool {
 	}
 }
 
+// Audit logs the request if its action is being audited, and persists it
+// if a repository is configured.
 func (a *auditor) Audit(action string, userName string, r *http.Request) {
 	err := r.ParseForm()
-	if err == nil && a.ValidateAction(action) {
-		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+	if err != nil || !a.ValidateAction(action) {
+		return
 	}
+
+	a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+
+	if a.repository == nil {
+		return
+	}
+
+	err = a.repository.CreateAuditEvent(Event(action, userName, r))
+	if err != nil {
+		a.logger.Error("failed-to-persist-audit-event", err, lager.Data{"action": action})
+	}
+}
+
+// Event describes the request as an audit event. The params of its route
+// are told apart from the other parameters by their ':' prefix.
+func Event(action string, userName string, r *http.Request) atc.AuditEvent {
+	event := atc.AuditEvent{
+		Actor:     userName,
+		Action:    action,
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		UserAgent: r.UserAgent(),
+	}
+
+	event.RemoteAddr, _, _ = net.SplitHostPort(r.RemoteAddr)
+	if event.RemoteAddr == "" {
+		event.RemoteAddr = r.RemoteAddr
+	}
+
+	var target []string
+	for key, values := range r.Form {
+		if !strings.HasPrefix(key, ":") {
+			if event.Parameters == nil {
+				event.Parameters = map[string][]string{}
+			}
+
+			event.Parameters[key] = values
+			continue
+		}
+
+		param := strings.TrimPrefix(key, ":")
+		if param == "team_name" {
+			event.TeamName = r.Form.Get(key)
+			continue
+		}
+
+		target = append(target, param+"="+r.Form.Get(key))
+	}
+
+	sort.Strings(target)
+	event.Target = strings.Join(target, ",")
+
+	return event
 }
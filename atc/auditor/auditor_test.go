@@ -1,12 +1,15 @@
 package auditor_test
 
 import (
+	"errors"
 	"net/http"
 
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -28,6 +31,7 @@ var _ = Describe("Audit", func() {
 		EnableTeamAuditLog      bool
 		EnableWorkerAuditLog    bool
 		EnableVolumeAuditLog    bool
+		repository              db.AuditEventRepository
 	)
 
 	BeforeEach(func() {
@@ -51,6 +55,7 @@ var _ = Describe("Audit", func() {
 			EnableTeamAuditLog,
 			EnableWorkerAuditLog,
 			EnableVolumeAuditLog,
+			repository,
 			logger,
 		)
 	})
@@ -65,6 +70,7 @@ var _ = Describe("Audit", func() {
 		EnableTeamAuditLog = false
 		EnableWorkerAuditLog = false
 		EnableVolumeAuditLog = false
+		repository = nil
 	})
 	Context("when audit is called", func() {
 		BeforeEach(func() {
@@ -87,6 +93,64 @@ var _ = Describe("Audit", func() {
 		})
 	})
 
+	Describe("persisting events", func() {
+		var fakeRepository *dbfakes.FakeAuditEventRepository
+
+		BeforeEach(func() {
+			fakeRepository = new(dbfakes.FakeAuditEventRepository)
+			repository = fakeRepository
+
+			var err error
+			req, err = http.NewRequest("PUT", "http://localhost:8080/api/v1/teams/main/pipelines/deploy/jobs/prod/pause?instance_vars=%7B%7D", http.NoBody)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.RemoteAddr = "10.0.0.1:51234"
+			req.Header.Set("User-Agent", "fly/7.0.0")
+			req.URL.RawQuery += "&:team_name=main&:pipeline_name=deploy&:job_name=prod"
+		})
+
+		Context("when the action is audited", func() {
+			BeforeEach(func() {
+				EnableJobAuditLog = true
+			})
+
+			It("persists the event", func() {
+				aud.Audit(atc.PauseJob, userName, req)
+
+				Expect(fakeRepository.CreateAuditEventCallCount()).To(Equal(1))
+				Expect(fakeRepository.CreateAuditEventArgsForCall(0)).To(Equal(atc.AuditEvent{
+					Actor:      "test",
+					TeamName:   "main",
+					Action:     atc.PauseJob,
+					Target:     "job_name=prod,pipeline_name=deploy",
+					Method:     "PUT",
+					Path:       "/api/v1/teams/main/pipelines/deploy/jobs/prod/pause",
+					RemoteAddr: "10.0.0.1",
+					UserAgent:  "fly/7.0.0",
+					Parameters: map[string][]string{"instance_vars": {"{}"}},
+				}))
+			})
+
+			Context("when persisting fails", func() {
+				BeforeEach(func() {
+					fakeRepository.CreateAuditEventReturns(errors.New("nope"))
+				})
+
+				It("logs the error", func() {
+					aud.Audit(atc.PauseJob, userName, req)
+					Expect(logger.LogMessages()).To(ContainElement("access_handler.failed-to-persist-audit-event"))
+				})
+			})
+		})
+
+		Context("when the action is not audited", func() {
+			It("does not persist the event", func() {
+				aud.Audit(atc.PauseJob, userName, req)
+				Expect(fakeRepository.CreateAuditEventCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("EnableBuildAuditLog", func() {
 
 		Context("When EnableBuildAudit is false with a Build action", func() {
@@ -0,0 +1,103 @@
+package auditor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/webhooks"
+)
+
+const forwardBatchSize = 100
+
+func NewForwarder(
+	repository db.AuditEventRepository,
+	httpClient *http.Client,
+	webhookURL string,
+	secret string,
+) *forwarder {
+	return &forwarder{
+		repository: repository,
+		httpClient: httpClient,
+		webhookURL: webhookURL,
+		secret:     secret,
+	}
+}
+
+// forwarder sends persisted audit events to a webhook in batches, in the
+// order they happened. A batch which fails to send is retried on the next
+// run, so events are sent at least once.
+type forwarder struct {
+	repository db.AuditEventRepository
+	httpClient *http.Client
+	webhookURL string
+	secret     string
+}
+
+func (f *forwarder) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("audit-forwarder")
+
+	for {
+		events, err := f.repository.UnforwardedAuditEvents(forwardBatchSize)
+		if err != nil {
+			logger.Error("failed-to-get-unforwarded-audit-events", err)
+			return err
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		payload, err := json.Marshal(events)
+		if err != nil {
+			return err
+		}
+
+		err = f.send(ctx, payload)
+		if err != nil {
+			logger.Error("failed-to-forward-audit-events", err, lager.Data{"count": len(events)})
+			return err
+		}
+
+		err = f.repository.MarkAuditEventsForwarded(events[len(events)-1].ID)
+		if err != nil {
+			logger.Error("failed-to-mark-audit-events-forwarded", err)
+			return err
+		}
+
+		if len(events) < forwardBatchSize {
+			return nil
+		}
+	}
+}
+
+func (f *forwarder) send(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", f.webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if f.secret != "" {
+		req.Header.Set(webhooks.GenericSignatureHeader, webhooks.Sign(f.secret, payload))
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	return nil
+}
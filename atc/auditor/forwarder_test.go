@@ -0,0 +1,107 @@
+package auditor_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/webhooks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Forwarder", func() {
+	var (
+		fakeRepository *dbfakes.FakeAuditEventRepository
+		hookServer     *ghttp.Server
+		events         []atc.AuditEvent
+		payload        []byte
+
+		err error
+	)
+
+	BeforeEach(func() {
+		hookServer = ghttp.NewServer()
+
+		events = []atc.AuditEvent{
+			{ID: 3, Actor: "alice", Action: atc.SaveConfig, TeamName: "main"},
+			{ID: 5, Actor: "bob", Action: atc.PauseJob, TeamName: "main"},
+		}
+
+		payload, err = json.Marshal(events)
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeRepository = new(dbfakes.FakeAuditEventRepository)
+		fakeRepository.UnforwardedAuditEventsReturnsOnCall(0, events, nil)
+	})
+
+	AfterEach(func() {
+		hookServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		forwarder := auditor.NewForwarder(fakeRepository, http.DefaultClient, hookServer.URL()+"/audit", "shh")
+
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		err = forwarder.Run(ctx)
+	})
+
+	Context("when the webhook accepts the events", func() {
+		BeforeEach(func() {
+			hookServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/audit"),
+				ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+				ghttp.VerifyHeaderKV(webhooks.GenericSignatureHeader, webhooks.Sign("shh", payload)),
+				ghttp.VerifyBody(payload),
+				ghttp.RespondWith(http.StatusOK, ""),
+			))
+		})
+
+		It("marks them as forwarded", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hookServer.ReceivedRequests()).To(HaveLen(1))
+
+			Expect(fakeRepository.MarkAuditEventsForwardedCallCount()).To(Equal(1))
+			Expect(fakeRepository.MarkAuditEventsForwardedArgsForCall(0)).To(Equal(5))
+		})
+	})
+
+	Context("when the webhook rejects the events", func() {
+		BeforeEach(func() {
+			hookServer.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, ""))
+		})
+
+		It("leaves them to be retried", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(fakeRepository.MarkAuditEventsForwardedCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when there are no events to forward", func() {
+		BeforeEach(func() {
+			fakeRepository.UnforwardedAuditEventsReturnsOnCall(0, []atc.AuditEvent{}, nil)
+		})
+
+		It("does not call the webhook", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hookServer.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("when getting the events fails", func() {
+		BeforeEach(func() {
+			fakeRepository.UnforwardedAuditEventsReturnsOnCall(0, nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("nope"))
+		})
+	})
+})
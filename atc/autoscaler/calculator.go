@@ -0,0 +1,161 @@
+package autoscaler
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+// WaitingStepFreshness is how long a waiting step is counted after it was last
+// refreshed. Waiting steps are refreshed on every worker polling interval, so
+// anything older belongs to a web node that went away.
+const WaitingStepFreshness = 3 * worker.WorkerPollingInterval
+
+type Limits struct {
+	MinWorkers              int
+	MaxWorkers              int
+	MaxActiveTasksPerWorker int
+}
+
+//counterfeiter:generate . Calculator
+type Calculator interface {
+	WorkerCapacity(lager.Logger) (atc.WorkerCapacity, error)
+}
+
+type calculator struct {
+	workerFactory db.WorkerFactory
+	waitingSteps  db.WaitingStepRepository
+	limits        Limits
+}
+
+func NewCalculator(
+	workerFactory db.WorkerFactory,
+	waitingSteps db.WaitingStepRepository,
+	limits Limits,
+) Calculator {
+	return &calculator{
+		workerFactory: workerFactory,
+		waitingSteps:  waitingSteps,
+		limits:        limits,
+	}
+}
+
+func (c *calculator) WorkerCapacity(logger lager.Logger) (atc.WorkerCapacity, error) {
+	workers, err := c.workerFactory.Workers()
+	if err != nil {
+		logger.Error("failed-to-get-workers", err)
+		return atc.WorkerCapacity{}, err
+	}
+
+	buildContainers, err := c.workerFactory.BuildContainersCountPerWorker()
+	if err != nil {
+		logger.Error("failed-to-get-build-containers-per-worker", err)
+		return atc.WorkerCapacity{}, err
+	}
+
+	waitingSteps, err := c.waitingSteps.WaitingSteps(WaitingStepFreshness)
+	if err != nil {
+		logger.Error("failed-to-get-waiting-steps", err)
+		return atc.WorkerCapacity{}, err
+	}
+
+	capacity := atc.WorkerCapacity{
+		MaxActiveTasksPerWorker: c.limits.MaxActiveTasksPerWorker,
+	}
+
+	var busyWorkers int
+	var idleWorkers []db.Worker
+	for _, w := range workers {
+		if w.State() != db.WorkerStateRunning || w.ScaleDownCandidate() {
+			continue
+		}
+
+		capacity.RunningWorkers++
+
+		activeTasks, err := w.ActiveTasks()
+		if err != nil {
+			logger.Error("failed-to-get-active-tasks", err, lager.Data{"worker": w.Name()})
+			return atc.WorkerCapacity{}, err
+		}
+
+		capacity.ActiveTasks += activeTasks
+
+		if buildContainers[w.Name()] > 0 {
+			busyWorkers++
+		} else {
+			idleWorkers = append(idleWorkers, w)
+		}
+	}
+
+	// every worker running a build is needed, and when active tasks are
+	// limited the running tasks need at least enough workers to fit them
+	desired := busyWorkers
+	if c.limits.MaxActiveTasksPerWorker > 0 {
+		desired = max(desired, divideRoundingUp(capacity.ActiveTasks, c.limits.MaxActiveTasksPerWorker))
+	}
+
+	for _, summary := range waitingSteps {
+		// without a limit on active tasks, a step only waits when no
+		// compatible worker exists, so one more worker will do
+		additional := 1
+		if c.limits.MaxActiveTasksPerWorker > 0 {
+			additional = divideRoundingUp(summary.Count, c.limits.MaxActiveTasksPerWorker)
+		}
+
+		capacity.WaitingSteps += summary.Count
+		capacity.Demand = append(capacity.Demand, atc.WorkerDemand{
+			Platform:          summary.Platform,
+			Tags:              summary.Tags,
+			Team:              summary.TeamName,
+			WaitingSteps:      summary.Count,
+			AdditionalWorkers: additional,
+		})
+
+		desired += additional
+	}
+
+	desired = max(desired, c.limits.MinWorkers)
+	if c.limits.MaxWorkers > 0 {
+		desired = min(desired, c.limits.MaxWorkers)
+	}
+
+	capacity.DesiredWorkers = desired
+
+	surplus := capacity.RunningWorkers - desired
+	if surplus > 0 && capacity.WaitingSteps == 0 {
+		// retire the most recently started idle workers first, keeping the
+		// ones that are more likely to have warm caches
+		sort.SliceStable(idleWorkers, func(i, j int) bool {
+			return idleWorkers[i].StartTime().After(idleWorkers[j].StartTime())
+		})
+
+		for i := 0; i < surplus && i < len(idleWorkers); i++ {
+			capacity.ScaleDownCandidates = append(capacity.ScaleDownCandidates, idleWorkers[i].Name())
+		}
+	}
+
+	return capacity, nil
+}
+
+func divideRoundingUp(n, d int) int {
+	return (n + d - 1) / d
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,165 @@
+package autoscaler_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/autoscaler"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Calculator", func() {
+	var (
+		fakeWorkerFactory *dbfakes.FakeWorkerFactory
+		fakeWaitingSteps  *dbfakes.FakeWaitingStepRepository
+		limits            autoscaler.Limits
+
+		capacity atc.WorkerCapacity
+		err      error
+	)
+
+	newWorker := func(name string, state db.WorkerState, activeTasks int, started time.Time) *dbfakes.FakeWorker {
+		worker := new(dbfakes.FakeWorker)
+		worker.NameReturns(name)
+		worker.StateReturns(state)
+		worker.ActiveTasksReturns(activeTasks, nil)
+		worker.StartTimeReturns(started)
+		return worker
+	}
+
+	BeforeEach(func() {
+		fakeWorkerFactory = new(dbfakes.FakeWorkerFactory)
+		fakeWaitingSteps = new(dbfakes.FakeWaitingStepRepository)
+		limits = autoscaler.Limits{}
+
+		now := time.Now()
+		fakeWorkerFactory.WorkersReturns([]db.Worker{
+			newWorker("busy-worker", db.WorkerStateRunning, 2, now.Add(-3*time.Hour)),
+			newWorker("old-idle-worker", db.WorkerStateRunning, 0, now.Add(-2*time.Hour)),
+			newWorker("new-idle-worker", db.WorkerStateRunning, 0, now.Add(-time.Hour)),
+			newWorker("landing-worker", db.WorkerStateLanding, 1, now),
+		}, nil)
+
+		fakeWorkerFactory.BuildContainersCountPerWorkerReturns(map[string]int{
+			"busy-worker":    3,
+			"landing-worker": 1,
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		calculator := autoscaler.NewCalculator(fakeWorkerFactory, fakeWaitingSteps, limits)
+		capacity, err = calculator.WorkerCapacity(lagertest.NewTestLogger("test"))
+	})
+
+	It("only fetches waiting steps which are fresh", func() {
+		Expect(fakeWaitingSteps.WaitingStepsCallCount()).To(Equal(1))
+		Expect(fakeWaitingSteps.WaitingStepsArgsForCall(0)).To(Equal(autoscaler.WaitingStepFreshness))
+	})
+
+	Context("when no steps are waiting", func() {
+		It("only wants the busy workers", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(capacity).To(Equal(atc.WorkerCapacity{
+				RunningWorkers:      3,
+				DesiredWorkers:      1,
+				ActiveTasks:         2,
+				ScaleDownCandidates: []string{"new-idle-worker", "old-idle-worker"},
+			}))
+		})
+
+		Context("when a minimum number of workers is configured", func() {
+			BeforeEach(func() {
+				limits.MinWorkers = 2
+			})
+
+			It("keeps the minimum", func() {
+				Expect(capacity.DesiredWorkers).To(Equal(2))
+				Expect(capacity.ScaleDownCandidates).To(Equal([]string{"new-idle-worker"}))
+			})
+		})
+
+		Context("when active tasks are limited", func() {
+			BeforeEach(func() {
+				limits.MaxActiveTasksPerWorker = 1
+			})
+
+			It("wants enough workers to fit the active tasks", func() {
+				Expect(capacity.DesiredWorkers).To(Equal(2))
+				Expect(capacity.MaxActiveTasksPerWorker).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when a worker is already marked for scale down", func() {
+		BeforeEach(func() {
+			marked := newWorker("marked-worker", db.WorkerStateRunning, 0, time.Now())
+			marked.ScaleDownCandidateReturns(true)
+
+			fakeWorkerFactory.WorkersReturns([]db.Worker{marked}, nil)
+		})
+
+		It("does not count it as running", func() {
+			Expect(capacity.RunningWorkers).To(Equal(0))
+			Expect(capacity.ScaleDownCandidates).To(BeEmpty())
+		})
+	})
+
+	Context("when steps are waiting", func() {
+		BeforeEach(func() {
+			fakeWaitingSteps.WaitingStepsReturns([]db.WaitingStepSummary{
+				{Platform: "linux", Count: 3},
+				{Platform: "windows", Tags: []string{"gpu"}, TeamName: "some-team", Count: 1},
+			}, nil)
+		})
+
+		It("wants one more worker for each kind of worker being waited on", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(capacity.WaitingSteps).To(Equal(4))
+			Expect(capacity.DesiredWorkers).To(Equal(3))
+			Expect(capacity.Demand).To(Equal([]atc.WorkerDemand{
+				{Platform: "linux", WaitingSteps: 3, AdditionalWorkers: 1},
+				{Platform: "windows", Tags: []string{"gpu"}, Team: "some-team", WaitingSteps: 1, AdditionalWorkers: 1},
+			}))
+		})
+
+		It("does not suggest scaling down", func() {
+			Expect(capacity.ScaleDownCandidates).To(BeEmpty())
+		})
+
+		Context("when active tasks are limited", func() {
+			BeforeEach(func() {
+				limits.MaxActiveTasksPerWorker = 2
+			})
+
+			It("wants enough workers to fit the waiting steps", func() {
+				Expect(capacity.DesiredWorkers).To(Equal(4))
+				Expect(capacity.Demand[0].AdditionalWorkers).To(Equal(2))
+			})
+		})
+
+		Context("when a maximum number of workers is configured", func() {
+			BeforeEach(func() {
+				limits.MaxWorkers = 2
+			})
+
+			It("does not want more than the maximum", func() {
+				Expect(capacity.DesiredWorkers).To(Equal(2))
+			})
+		})
+	})
+
+	Context("when getting the waiting steps fails", func() {
+		BeforeEach(func() {
+			fakeWaitingSteps.WaitingStepsReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("nope"))
+		})
+	})
+})
@@ -0,0 +1,110 @@
+package autoscaler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type Publisher struct {
+	calculator   Calculator
+	waitingSteps db.WaitingStepRepository
+
+	webhookURL string
+	httpClient *http.Client
+
+	lastPublished *int
+}
+
+// NewPublisher returns a component which periodically computes the desired
+// worker capacity and emits it as a metric. If a webhook URL is configured,
+// the capacity is also POSTed to it whenever the desired number of workers
+// changes.
+func NewPublisher(
+	calculator Calculator,
+	waitingSteps db.WaitingStepRepository,
+	webhookURL string,
+	httpClient *http.Client,
+) *Publisher {
+	return &Publisher{
+		calculator:   calculator,
+		waitingSteps: waitingSteps,
+		webhookURL:   webhookURL,
+		httpClient:   httpClient,
+	}
+}
+
+func (p *Publisher) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("worker-autoscaler")
+
+	removed, err := p.waitingSteps.RemoveStaleWaitingSteps(WaitingStepFreshness)
+	if err != nil {
+		logger.Error("failed-to-remove-stale-waiting-steps", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-stale-waiting-steps", lager.Data{"count": removed})
+	}
+
+	capacity, err := p.calculator.WorkerCapacity(logger)
+	if err != nil {
+		return err
+	}
+
+	metric.WorkerCapacity{
+		DesiredWorkers: capacity.DesiredWorkers,
+		WaitingSteps:   capacity.WaitingSteps,
+	}.Emit(logger)
+
+	if p.webhookURL == "" {
+		return nil
+	}
+
+	if p.lastPublished != nil && *p.lastPublished == capacity.DesiredWorkers {
+		return nil
+	}
+
+	payload, err := json.Marshal(capacity)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		logger.Error("failed-to-publish-worker-capacity", err)
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		err = fmt.Errorf("webhook responded with %s", response.Status)
+		logger.Error("failed-to-publish-worker-capacity", err)
+		return err
+	}
+
+	logger.Info("published-worker-capacity", lager.Data{
+		"running": capacity.RunningWorkers,
+		"desired": capacity.DesiredWorkers,
+	})
+
+	desired := capacity.DesiredWorkers
+	p.lastPublished = &desired
+
+	return nil
+}
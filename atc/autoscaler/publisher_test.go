@@ -0,0 +1,131 @@
+package autoscaler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/autoscaler"
+	"github.com/concourse/concourse/atc/autoscaler/autoscalerfakes"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Publisher", func() {
+	var (
+		fakeCalculator   *autoscalerfakes.FakeCalculator
+		fakeWaitingSteps *dbfakes.FakeWaitingStepRepository
+		webhookServer    *ghttp.Server
+		webhookURL       string
+
+		publisher *autoscaler.Publisher
+		ctx       context.Context
+		err       error
+	)
+
+	capacity := atc.WorkerCapacity{
+		RunningWorkers: 1,
+		DesiredWorkers: 3,
+		WaitingSteps:   2,
+	}
+
+	BeforeEach(func() {
+		fakeCalculator = new(autoscalerfakes.FakeCalculator)
+		fakeCalculator.WorkerCapacityReturns(capacity, nil)
+
+		fakeWaitingSteps = new(dbfakes.FakeWaitingStepRepository)
+
+		webhookServer = ghttp.NewServer()
+		webhookURL = ""
+
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+	})
+
+	AfterEach(func() {
+		webhookServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		publisher = autoscaler.NewPublisher(fakeCalculator, fakeWaitingSteps, webhookURL, http.DefaultClient)
+		err = publisher.Run(ctx)
+	})
+
+	It("removes stale waiting steps", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeWaitingSteps.RemoveStaleWaitingStepsCallCount()).To(Equal(1))
+		Expect(fakeWaitingSteps.RemoveStaleWaitingStepsArgsForCall(0)).To(Equal(autoscaler.WaitingStepFreshness))
+	})
+
+	It("computes the capacity", func() {
+		Expect(fakeCalculator.WorkerCapacityCallCount()).To(Equal(1))
+	})
+
+	Context("when computing the capacity fails", func() {
+		BeforeEach(func() {
+			fakeCalculator.WorkerCapacityReturns(atc.WorkerCapacity{}, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("nope"))
+		})
+	})
+
+	Context("when a webhook is configured", func() {
+		BeforeEach(func() {
+			webhookURL = webhookServer.URL() + "/scale"
+
+			webhookServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/scale"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+					ghttp.VerifyJSONRepresenting(capacity),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("publishes the capacity", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webhookServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("does not publish again until the desired workers change", func() {
+			Expect(publisher.Run(ctx)).To(Succeed())
+			Expect(webhookServer.ReceivedRequests()).To(HaveLen(1))
+
+			changed := capacity
+			changed.DesiredWorkers = 1
+			fakeCalculator.WorkerCapacityReturns(changed, nil)
+
+			webhookServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyJSONRepresenting(changed),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+
+			Expect(publisher.Run(ctx)).To(Succeed())
+			Expect(webhookServer.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		Context("when the webhook fails", func() {
+			BeforeEach(func() {
+				webhookServer.SetHandler(0, ghttp.RespondWith(http.StatusInternalServerError, nil))
+			})
+
+			It("returns an error and retries on the next run", func() {
+				Expect(err).To(HaveOccurred())
+
+				webhookServer.AppendHandlers(ghttp.RespondWith(http.StatusOK, nil))
+
+				Expect(publisher.Run(ctx)).To(Succeed())
+				Expect(webhookServer.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+	})
+})
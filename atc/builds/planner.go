@@ -64,6 +64,8 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		OutputMapping:     step.OutputMapping,
 		ImageArtifactName: step.ImageArtifactName,
 		Timeout:           step.Timeout,
+		Retryable:         step.Retryable,
+		OIDCToken:         step.OIDCToken,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -120,13 +122,14 @@ func (visitor *planVisitor) VisitGet(step *atc.GetStep) error {
 	visitor.plan = visitor.planFactory.NewPlan(atc.GetPlan{
 		Name: step.Name,
 
-		Type:     resource.Type,
-		Resource: resourceName,
-		Source:   resource.Source,
-		Params:   step.Params,
-		Version:  &version,
-		Tags:     step.Tags,
-		Timeout:  step.Timeout,
+		Type:      resource.Type,
+		Resource:  resourceName,
+		Source:    resource.Source,
+		Params:    step.Params,
+		Version:   &version,
+		Tags:      step.Tags,
+		Timeout:   step.Timeout,
+		Retryable: step.Retryable,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -159,8 +162,9 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 
 		Inputs: step.Inputs,
 
-		Tags:    step.Tags,
-		Timeout: step.Timeout,
+		Tags:      step.Tags,
+		Timeout:   step.Timeout,
+		Retryable: step.Retryable,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	}
@@ -175,8 +179,9 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 		Params:      step.GetParams,
 		VersionFrom: &putPlan.ID,
 
-		Tags:    step.Tags,
-		Timeout: step.Timeout,
+		Tags:      step.Tags,
+		Timeout:   step.Timeout,
+		Retryable: step.Retryable,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -292,6 +297,17 @@ func (visitor *planVisitor) VisitLoadVar(step *atc.LoadVarStep) error {
 	return nil
 }
 
+func (visitor *planVisitor) VisitPipelineOutput(step *atc.PipelineOutputStep) error {
+	visitor.plan = visitor.planFactory.NewPlan(atc.PipelineOutputPlan{
+		Name:     step.Name,
+		Team:     step.Team,
+		Pipeline: step.Pipeline,
+		Job:      step.Job,
+	})
+
+	return nil
+}
+
 func (visitor *planVisitor) VisitTry(step *atc.TryStep) error {
 	err := step.Step.Config.Visit(visitor)
 	if err != nil {
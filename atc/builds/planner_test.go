@@ -257,6 +257,7 @@ var factoryTests = []PlannerTest{
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
 			Timeout:           "1h",
+			Retryable:         true,
 		},
 
 		PlanJSON: `{
@@ -276,6 +277,7 @@ var factoryTests = []PlannerTest{
 				"output_mapping": {"specific": "generic"},
 				"image": "some-image",
 				"timeout": "1h",
+				"retryable": true,
 				"resource_types": [
 					{
 						"name": "some-resource-type",
@@ -420,6 +422,27 @@ var factoryTests = []PlannerTest{
 			}
 		}`,
 	},
+	{
+		Title: "pipeline_output step",
+
+		Config: &atc.PipelineOutputStep{
+			Name:     "some-output",
+			Team:     "some-team",
+			Pipeline: "some-pipeline",
+			Job:      "some-job",
+			Trigger:  true,
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"pipeline_output": {
+				"name": "some-output",
+				"team": "some-team",
+				"pipeline": "some-pipeline",
+				"job": "some-job"
+			}
+		}`,
+	},
 	{
 		Title: "try step",
 
@@ -3,4 +3,8 @@ package atc
 const (
 	TeamCacheName    = "teams"
 	TeamCacheChannel = "team_cache"
+
+	// AccessTokenCacheChannel is notified when sessions are revoked, so that
+	// every web node drops the access tokens it has cached.
+	AccessTokenCacheChannel = "access_token_cache"
 )
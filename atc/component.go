@@ -3,23 +3,31 @@ package atc
 import "time"
 
 const (
-	ComponentScheduler                  = "scheduler"
-	ComponentBuildTracker               = "tracker"
-	ComponentLidarScanner               = "scanner"
-	ComponentBuildReaper                = "reaper"
-	ComponentSyslogDrainer              = "drainer"
-	ComponentCollectorAccessTokens      = "collector_access_tokens"
-	ComponentCollectorArtifacts         = "collector_artifacts"
-	ComponentCollectorBuilds            = "collector_builds"
-	ComponentCollectorCheckSessions     = "collector_check_sessions"
-	ComponentCollectorChecks            = "collector_checks"
-	ComponentCollectorContainers        = "collector_containers"
-	ComponentCollectorResourceCacheUses = "collector_resource_cache_uses"
-	ComponentCollectorResourceCaches    = "collector_resource_caches"
-	ComponentCollectorResourceConfigs   = "collector_resource_configs"
-	ComponentCollectorVolumes           = "collector_volumes"
-	ComponentCollectorWorkers           = "collector_workers"
-	ComponentCollectorPipelines         = "collector_pipelines"
+	ComponentScheduler                   = "scheduler"
+	ComponentBuildTracker                = "tracker"
+	ComponentLidarScanner                = "scanner"
+	ComponentBuildReaper                 = "reaper"
+	ComponentSyslogDrainer               = "drainer"
+	ComponentWorkerAutoscaler            = "worker_autoscaler"
+	ComponentWarmContainers              = "warm_containers"
+	ComponentGitOps                      = "gitops"
+	ComponentNotifier                    = "notifier"
+	ComponentAuditForwarder              = "audit_forwarder"
+	ComponentCollectorAccessTokens       = "collector_access_tokens"
+	ComponentCollectorArtifacts          = "collector_artifacts"
+	ComponentCollectorAuditEvents        = "collector_audit_events"
+	ComponentCollectorBuilds             = "collector_builds"
+	ComponentCollectorCheckSessions      = "collector_check_sessions"
+	ComponentCollectorChecks             = "collector_checks"
+	ComponentCollectorContainers         = "collector_containers"
+	ComponentCollectorResourceCacheUses  = "collector_resource_cache_uses"
+	ComponentCollectorResourceCaches     = "collector_resource_caches"
+	ComponentCollectorResourceConfigs    = "collector_resource_configs"
+	ComponentCollectorVolumes            = "collector_volumes"
+	ComponentCollectorWorkers            = "collector_workers"
+	ComponentCollectorPipelines          = "collector_pipelines"
+	ComponentCollectorStatusEvents       = "collector_status_events"
+	ComponentCollectorProvisionedWorkers = "collector_provisioned_workers"
 )
 
 type Component struct {
@@ -20,19 +20,21 @@ const DefaultTeamName = "main"
 type Tags []string
 
 type Config struct {
-	Groups        GroupConfigs     `json:"groups,omitempty"`
-	VarSources    VarSourceConfigs `json:"var_sources,omitempty"`
-	Resources     ResourceConfigs  `json:"resources,omitempty"`
-	ResourceTypes ResourceTypes    `json:"resource_types,omitempty"`
-	Prototypes    Prototypes       `json:"prototypes,omitempty"`
-	Jobs          JobConfigs       `json:"jobs,omitempty"`
-	Display       *DisplayConfig   `json:"display,omitempty"`
+	Groups        GroupConfigs         `json:"groups,omitempty"`
+	Vars          vars.VarDeclarations `json:"vars,omitempty"`
+	VarSources    VarSourceConfigs     `json:"var_sources,omitempty"`
+	Resources     ResourceConfigs      `json:"resources,omitempty"`
+	ResourceTypes ResourceTypes        `json:"resource_types,omitempty"`
+	Prototypes    Prototypes           `json:"prototypes,omitempty"`
+	Jobs          JobConfigs           `json:"jobs,omitempty"`
+	Display       *DisplayConfig       `json:"display,omitempty"`
 }
 
 func UnmarshalConfig(payload []byte, config interface{}) error {
 	// a 'skeleton' of Config, specifying only the toplevel fields
 	type skeletonConfig struct {
 		Groups        interface{} `json:"groups,omitempty"`
+		Vars          interface{} `json:"vars,omitempty"`
 		VarSources    interface{} `json:"var_sources,omitempty"`
 		Resources     interface{} `json:"resources,omitempty"`
 		ResourceTypes interface{} `json:"resource_types,omitempty"`
@@ -2,12 +2,16 @@ package atc
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/aryann/difflib"
+	"github.com/concourse/concourse/vars"
 	"github.com/mgutz/ansi"
 	"github.com/onsi/gomega/gexec"
 	"sigs.k8s.io/yaml"
@@ -106,6 +110,21 @@ func (index VarSourceIndex) FindEquivalent(obj interface{}) (interface{}, bool)
 	return VarSourceConfigs(index).Lookup(name(obj))
 }
 
+type VarIndex vars.VarDeclarations
+
+func (index VarIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index VarIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return vars.VarDeclarations(index).Lookup(name(obj))
+}
+
 type JobIndex JobConfigs
 
 func (index JobIndex) Slice() []interface{} {
@@ -289,6 +308,16 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 		}
 	}
 
+	varDiffs := diffIndices(VarIndex(c.Vars), VarIndex(newConfig.Vars))
+	if len(varDiffs) > 0 {
+		diffExists = true
+		fmt.Fprintln(out, "vars:")
+
+		for _, diff := range varDiffs {
+			diff.Render(indent, "var")
+		}
+	}
+
 	varSourceDiffs := diffIndices(VarSourceIndex(c.VarSources), VarSourceIndex(newConfig.VarSources))
 	if len(varSourceDiffs) > 0 {
 		diffExists = true
@@ -337,3 +366,294 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 
 	return diffExists
 }
+
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+	DiffRenamed = "renamed"
+)
+
+// ConfigChanges is a structured form of the diff rendered by Config.Diff,
+// along with its consequences for the pipeline.
+type ConfigChanges struct {
+	Groups        []ObjectChange `json:"groups,omitempty"`
+	Vars          []ObjectChange `json:"vars,omitempty"`
+	VarSources    []ObjectChange `json:"var_sources,omitempty"`
+	Resources     []ObjectChange `json:"resources,omitempty"`
+	ResourceTypes []ObjectChange `json:"resource_types,omitempty"`
+	Jobs          []ObjectChange `json:"jobs,omitempty"`
+	Display       *ObjectChange  `json:"display,omitempty"`
+
+	// NewCheckConfigs lists the resources whose type or source changes, so
+	// they will be checked from scratch under a new config.
+	NewCheckConfigs []string `json:"new_check_configs,omitempty"`
+
+	// OrphanedJobs lists the removed jobs which are not renamed through
+	// old_name, so their build history will no longer be reachable.
+	OrphanedJobs []string `json:"orphaned_jobs,omitempty"`
+}
+
+// ObjectChange is a change to a named object of the config.
+type ObjectChange struct {
+	Name    string        `json:"name"`
+	OldName string        `json:"old_name,omitempty"`
+	Action  string        `json:"action"`
+	Fields  []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is a change to the value at a JSON path within an object.
+type FieldChange struct {
+	Path   string      `json:"path"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+func (changes ConfigChanges) HasChanges() bool {
+	return len(changes.Groups) > 0 ||
+		len(changes.Vars) > 0 ||
+		len(changes.VarSources) > 0 ||
+		len(changes.Resources) > 0 ||
+		len(changes.ResourceTypes) > 0 ||
+		len(changes.Jobs) > 0 ||
+		changes.Display != nil
+}
+
+// Changes compares the config with a new config, like Diff, but returns the
+// differences as data rather than rendering them.
+func (c Config) Changes(newConfig Config) ConfigChanges {
+	changes := ConfigChanges{
+		Vars:          objectChanges(diffIndices(VarIndex(c.Vars), VarIndex(newConfig.Vars))),
+		VarSources:    objectChanges(diffIndices(VarSourceIndex(c.VarSources), VarSourceIndex(newConfig.VarSources))),
+		ResourceTypes: objectChanges(diffIndices(ResourceTypeIndex(c.ResourceTypes), ResourceTypeIndex(newConfig.ResourceTypes))),
+		Resources:     objectChanges(renames(diffIndices(ResourceIndex(c.Resources), ResourceIndex(newConfig.Resources)))),
+		Jobs:          objectChanges(renames(diffIndices(JobIndex(c.Jobs), JobIndex(newConfig.Jobs)))),
+	}
+
+	// a reordered group appears twice, so only keep the first
+	seenGroups := map[string]bool{}
+	for _, change := range objectChanges(groupDiffIndices(GroupIndex(c.Groups), GroupIndex(newConfig.Groups))) {
+		if !seenGroups[change.Name] {
+			seenGroups[change.Name] = true
+			changes.Groups = append(changes.Groups, change)
+		}
+	}
+
+	displayDiff, different := diffDisplay(c.Display, newConfig.Display)
+	if different {
+		// avoid typed nils, which objectChange would consider present
+		var diff Diff
+		if displayDiff.Before != nil {
+			diff.Before = displayDiff.Before
+		}
+		if displayDiff.After != nil {
+			diff.After = displayDiff.After
+		}
+
+		change := objectChange(diff)
+		change.Name = "display"
+		changes.Display = &change
+	}
+
+	changedTypes := map[string]bool{}
+	for _, change := range changes.ResourceTypes {
+		if change.Action != DiffAdded {
+			changedTypes[change.Name] = true
+		}
+	}
+
+	for _, resource := range newConfig.Resources {
+		oldName := resource.Name
+		if resource.OldName != "" {
+			if _, found := c.Resources.Lookup(resource.Name); !found {
+				oldName = resource.OldName
+			}
+		}
+
+		oldResource, found := c.Resources.Lookup(oldName)
+		if !found {
+			continue
+		}
+
+		if oldResource.Type != resource.Type ||
+			practicallyDifferent(oldResource.Source, resource.Source) ||
+			changedTypes[resource.Type] {
+			changes.NewCheckConfigs = append(changes.NewCheckConfigs, resource.Name)
+		}
+	}
+
+	for _, change := range changes.Jobs {
+		if change.Action == DiffRemoved {
+			changes.OrphanedJobs = append(changes.OrphanedJobs, change.Name)
+		}
+	}
+
+	return changes
+}
+
+// renames pairs up the removal and addition of an object which is renamed
+// through old_name.
+func renames(diffs Diffs) Diffs {
+	removed := map[string]int{}
+	for i, diff := range diffs {
+		if diff.After == nil {
+			removed[name(diff.Before)] = i
+		}
+	}
+
+	consumed := map[int]bool{}
+	for i, diff := range diffs {
+		if diff.Before != nil {
+			continue
+		}
+
+		oldName := reflect.ValueOf(diff.After).FieldByName("OldName").String()
+		if j, found := removed[oldName]; found {
+			diffs[i].Before = diffs[j].Before
+			consumed[j] = true
+		}
+	}
+
+	var result Diffs
+	for i, diff := range diffs {
+		if !consumed[i] {
+			result = append(result, diff)
+		}
+	}
+
+	return result
+}
+
+func objectChanges(diffs Diffs) []ObjectChange {
+	var changes []ObjectChange
+	for _, diff := range diffs {
+		changes = append(changes, objectChange(diff))
+	}
+
+	return changes
+}
+
+func objectChange(diff Diff) ObjectChange {
+	switch {
+	case diff.Before == nil:
+		return ObjectChange{Name: nameOf(diff.After), Action: DiffAdded}
+
+	case diff.After == nil:
+		return ObjectChange{Name: nameOf(diff.Before), Action: DiffRemoved}
+
+	default:
+		change := ObjectChange{
+			Name:   nameOf(diff.After),
+			Action: DiffChanged,
+			Fields: fieldChanges("$", toGeneric(diff.Before), toGeneric(diff.After)),
+		}
+
+		if oldName := nameOf(diff.Before); oldName != change.Name {
+			change.OldName = oldName
+			change.Action = DiffRenamed
+		}
+
+		return change
+	}
+}
+
+// nameOf is like name, but also works for pointers such as *DisplayConfig.
+func nameOf(v interface{}) string {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+
+	field := value.FieldByName("Name")
+	if !field.IsValid() {
+		return ""
+	}
+
+	return field.String()
+}
+
+// toGeneric converts a value to its JSON representation so that it can be
+// compared field by field.
+func toGeneric(v interface{}) interface{} {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var generic interface{}
+	err = json.Unmarshal(payload, &generic)
+	if err != nil {
+		return nil
+	}
+
+	return generic
+}
+
+func fieldChanges(path string, before interface{}, after interface{}) []FieldChange {
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+
+	switch b := before.(type) {
+	case map[string]interface{}:
+		a, ok := after.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := map[string]bool{}
+		for k := range b {
+			keys[k] = true
+		}
+		for k := range a {
+			keys[k] = true
+		}
+
+		var sorted []string
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		var changes []FieldChange
+		for _, k := range sorted {
+			changes = append(changes, fieldChanges(childPath(path, k), b[k], a[k])...)
+		}
+
+		return changes
+
+	case []interface{}:
+		a, ok := after.([]interface{})
+		if !ok {
+			break
+		}
+
+		var changes []FieldChange
+		for i := 0; i < len(b) || i < len(a); i++ {
+			var beforeElem, afterElem interface{}
+			if i < len(b) {
+				beforeElem = b[i]
+			}
+			if i < len(a) {
+				afterElem = a[i]
+			}
+
+			changes = append(changes, fieldChanges(fmt.Sprintf("%s[%d]", path, i), beforeElem, afterElem)...)
+		}
+
+		return changes
+	}
+
+	return []FieldChange{{Path: path, Before: before, After: after}}
+}
+
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func childPath(path string, key string) string {
+	if identifierRegex.MatchString(key) {
+		return path + "." + key
+	}
+
+	quoted, _ := json.Marshal(key)
+	return fmt.Sprintf("%s[%s]", path, quoted)
+}
@@ -1,7 +1,10 @@
 package atc_test
 
 import (
+	"encoding/json"
+
 	. "github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
@@ -216,4 +219,114 @@ var _ = Describe("Config diff", func() {
 			})
 		})
 	})
+
+	Describe("Changes", func() {
+		var oldConfig Config
+
+		BeforeEach(func() {
+			oldConfig = Config{
+				ResourceTypes: ResourceTypes{
+					{Name: "custom", Type: "registry-image", Source: Source{"repository": "custom"}},
+				},
+				Resources: ResourceConfigs{
+					{Name: "repo", Type: "git", Source: Source{"uri": "some-uri"}},
+					{Name: "other", Type: "custom", Source: Source{"some": "source"}},
+					{Name: "unchanged", Type: "git", Source: Source{"uri": "unchanged-uri"}},
+				},
+				Jobs: JobConfigs{
+					{Name: "unit", PlanSequence: []Step{{Config: &GetStep{Name: "repo"}}}},
+					{Name: "old-integration"},
+					{Name: "deploy"},
+				},
+			}
+		})
+
+		It("reports no changes for the same config", func() {
+			changes := oldConfig.Changes(oldConfig)
+			Expect(changes.HasChanges()).To(BeFalse())
+			Expect(changes).To(Equal(ConfigChanges{}))
+		})
+
+		It("reports changed fields by JSON path along with the consequences", func() {
+			newConfig := Config{
+				ResourceTypes: ResourceTypes{
+					{Name: "custom", Type: "registry-image", Source: Source{"repository": "custom", "tag": "2"}},
+				},
+				Resources: ResourceConfigs{
+					{Name: "repo", Type: "git", Source: Source{"uri": "other-uri"}},
+					{Name: "other", Type: "custom", Source: Source{"some": "source"}},
+					{Name: "unchanged", Type: "git", Source: Source{"uri": "unchanged-uri"}},
+				},
+				Jobs: JobConfigs{
+					{Name: "unit", PlanSequence: []Step{{Config: &GetStep{Name: "repo", Trigger: true}}}},
+					{Name: "integration", OldName: "old-integration"},
+					{Name: "smoke"},
+				},
+			}
+
+			changes := oldConfig.Changes(newConfig)
+			Expect(changes.HasChanges()).To(BeTrue())
+
+			Expect(changes.ResourceTypes).To(Equal([]ObjectChange{
+				{
+					Name:   "custom",
+					Action: DiffChanged,
+					Fields: []FieldChange{{Path: "$.source.tag", After: "2"}},
+				},
+			}))
+
+			Expect(changes.Resources).To(Equal([]ObjectChange{
+				{
+					Name:   "repo",
+					Action: DiffChanged,
+					Fields: []FieldChange{{Path: "$.source.uri", Before: "some-uri", After: "other-uri"}},
+				},
+			}))
+
+			Expect(changes.Jobs).To(Equal([]ObjectChange{
+				{
+					Name:   "unit",
+					Action: DiffChanged,
+					Fields: []FieldChange{{Path: "$.plan[0].trigger", After: true}},
+				},
+				{Name: "deploy", Action: DiffRemoved},
+				{
+					Name:    "integration",
+					OldName: "old-integration",
+					Action:  DiffRenamed,
+					Fields: []FieldChange{
+						{Path: "$.name", Before: "old-integration", After: "integration"},
+						{Path: "$.old_name", After: "old-integration"},
+					},
+				},
+				{Name: "smoke", Action: DiffAdded},
+			}))
+
+			Expect(changes.NewCheckConfigs).To(Equal([]string{"repo", "other"}))
+			Expect(changes.OrphanedJobs).To(Equal([]string{"deploy"}))
+		})
+
+		It("encodes fields which were added or removed with a null value", func() {
+			payload, err := json.Marshal([]FieldChange{
+				{Path: "$.source.tag", After: "2"},
+				{Path: "$.source.branch", Before: "main"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(payload).To(MatchJSON(`[
+				{"path": "$.source.tag", "before": null, "after": "2"},
+				{"path": "$.source.branch", "before": "main", "after": null}
+			]`))
+		})
+
+		It("reports display changes", func() {
+			changes := Config{}.Changes(Config{Display: &DisplayConfig{BackgroundImage: "bg.jpg"}})
+			Expect(changes.Display).To(Equal(&ObjectChange{Name: "display", Action: DiffAdded}))
+		})
+
+		It("reports var declaration changes", func() {
+			changes := Config{}.Changes(Config{Vars: vars.VarDeclarations{{Name: "env", Required: true}}})
+			Expect(changes.HasChanges()).To(BeTrue())
+			Expect(changes.Vars).To(Equal([]ObjectChange{{Name: "env", Action: DiffAdded}}))
+		})
+	})
 })
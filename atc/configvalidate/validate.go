@@ -8,6 +8,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/vars"
 	"github.com/gobwas/glob"
 )
 
@@ -68,6 +69,12 @@ func Validate(c atc.Config) ([]atc.ConfigWarning, []string) {
 	}
 	warnings = append(warnings, prototypesWarnings...)
 
+	varsWarnings, varsErr := validateVars(c)
+	if varsErr != nil {
+		errorMessages = append(errorMessages, formatErr("vars", varsErr))
+	}
+	warnings = append(warnings, varsWarnings...)
+
 	varSourcesWarnings, varSourcesErr := validateVarSources(c)
 	if varSourcesErr != nil {
 		errorMessages = append(errorMessages, formatErr("variable sources", varSourcesErr))
@@ -89,6 +96,27 @@ func Validate(c atc.Config) ([]atc.ConfigWarning, []string) {
 	return warnings, errorMessages
 }
 
+// ValidateTeamResources validates a team's shared resources and resource
+// types. Unlike in a pipeline, a shared resource need not be used.
+func ValidateTeamResources(shared atc.TeamResources) ([]atc.ConfigWarning, []string) {
+	warnings := []atc.ConfigWarning{}
+	errorMessages := []string{}
+
+	resourcesWarnings, resourcesErrs := validateResourceDeclarations(shared.Resources)
+	if len(resourcesErrs) > 0 {
+		errorMessages = append(errorMessages, formatErr("resources", compositeErr(resourcesErrs)))
+	}
+	warnings = append(warnings, resourcesWarnings...)
+
+	resourceTypesWarnings, resourceTypesErr := validateResourceTypes(atc.Config{ResourceTypes: shared.ResourceTypes}, map[string]location{})
+	if resourceTypesErr != nil {
+		errorMessages = append(errorMessages, formatErr("resource types", resourceTypesErr))
+	}
+	warnings = append(warnings, resourceTypesWarnings...)
+
+	return warnings, errorMessages
+}
+
 func validateGroups(c atc.Config) ([]atc.ConfigWarning, error) {
 	var warnings []atc.ConfigWarning
 	var errorMessages []string
@@ -167,12 +195,20 @@ func validateGroups(c atc.Config) ([]atc.ConfigWarning, error) {
 }
 
 func validateResources(c atc.Config) ([]atc.ConfigWarning, error) {
+	warnings, errorMessages := validateResourceDeclarations(c.Resources)
+
+	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
+
+	return warnings, compositeErr(errorMessages)
+}
+
+func validateResourceDeclarations(resources atc.ResourceConfigs) ([]atc.ConfigWarning, []string) {
 	var warnings []atc.ConfigWarning
 	var errorMessages []string
 
 	names := map[string]location{}
 
-	for i, resource := range c.Resources {
+	for i, resource := range resources {
 		location := location{section: "resources", index: i}
 		identifier := location.Identifier(resource.Name)
 
@@ -202,9 +238,7 @@ func validateResources(c atc.Config) ([]atc.ConfigWarning, error) {
 		}
 	}
 
-	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
-
-	return warnings, compositeErr(errorMessages)
+	return warnings, errorMessages
 }
 
 func validateResourceTypes(c atc.Config, seenTypes map[string]location) ([]atc.ConfigWarning, error) {
@@ -411,6 +445,71 @@ func compositeErr(errorMessages []string) error {
 	return errors.New(strings.Join(errorMessages, "\n"))
 }
 
+func validateVars(c atc.Config) ([]atc.ConfigWarning, error) {
+	var warnings []atc.ConfigWarning
+	var errorMessages []string
+
+	names := map[string]location{}
+
+	for i, declaration := range c.Vars {
+		location := location{section: "vars", index: i}
+		identifier := location.Identifier(declaration.Name)
+
+		warning, err := atc.ValidateIdentifier(declaration.Name, identifier)
+		if err != nil {
+			errorMessages = append(errorMessages, err.Error())
+		}
+		if warning != nil {
+			warnings = append(warnings, *warning)
+		}
+
+		if other, exists := names[declaration.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"%s and %s have the same name ('%s')",
+					other, location, declaration.Name))
+		} else if declaration.Name != "" {
+			names[declaration.Name] = location
+		}
+
+		if declaration.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		}
+
+		if declaration.Type != "" && !isVarType(declaration.Type) {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"%s has unknown type '%s' (must be one of: %s)",
+					identifier, declaration.Type, strings.Join(vars.VarTypes, ", ")))
+		}
+
+		if declaration.Default != nil {
+			if declaration.Required {
+				errorMessages = append(errorMessages, identifier+" is required but has a default")
+			}
+
+			if isVarType(declaration.Type) && !declaration.HasType(declaration.Default) {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf(
+						"%s has a default which is not of type %s",
+						identifier, declaration.Type))
+			}
+		}
+	}
+
+	return warnings, compositeErr(errorMessages)
+}
+
+func isVarType(varType string) bool {
+	for _, t := range vars.VarTypes {
+		if t == varType {
+			return true
+		}
+	}
+
+	return false
+}
+
 func validateVarSources(c atc.Config) ([]atc.ConfigWarning, error) {
 	var warnings []atc.ConfigWarning
 	var errorMessages []string
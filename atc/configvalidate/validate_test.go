@@ -6,6 +6,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/vars"
 
 	// load dummy credential manager
 	_ "github.com/concourse/concourse/atc/creds/dummy"
@@ -385,6 +386,75 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("invalid vars", func() {
+		Context("when the vars are valid", func() {
+			BeforeEach(func() {
+				config.Vars = vars.VarDeclarations{
+					{Name: "branch", Type: "string", Default: "main"},
+					{Name: "replicas", Type: "number", Default: float64(1)},
+					{Name: "env", Required: true},
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when vars have the same name", func() {
+			BeforeEach(func() {
+				config.Vars = vars.VarDeclarations{
+					{Name: "branch"},
+					{Name: "branch"},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("vars[0] and vars[1] have the same name ('branch')"))
+			})
+		})
+
+		Context("when a var has an unknown type", func() {
+			BeforeEach(func() {
+				config.Vars = vars.VarDeclarations{
+					{Name: "branch", Type: "text"},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("vars.branch has unknown type 'text' (must be one of: string, number, boolean, list, map)"))
+			})
+		})
+
+		Context("when a var has a default of another type", func() {
+			BeforeEach(func() {
+				config.Vars = vars.VarDeclarations{
+					{Name: "replicas", Type: "number", Default: "one"},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("vars.replicas has a default which is not of type number"))
+			})
+		})
+
+		Context("when a required var has a default", func() {
+			BeforeEach(func() {
+				config.Vars = vars.VarDeclarations{
+					{Name: "branch", Required: true, Default: "main"},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("vars.branch is required but has a default"))
+			})
+		})
+	})
+
 	Describe("invalid var sources", func() {
 		Context("when a var source type is invalid", func() {
 			BeforeEach(func() {
@@ -1095,6 +1165,29 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a task plan requests an invalid OIDC token", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.TaskStep{
+							Name:       "lol",
+							ConfigPath: "task.yml",
+							OIDCToken: &atc.OIDCTokenConfig{
+								Env:  "NOT-AN-ENV-VAR",
+								File: "../token",
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(lol).oidc_token: invalid env var name: NOT-AN-ENV-VAR"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(lol).oidc_token: file must be a path within the task's working directory: ../token"))
+				})
+			})
+
 			Context("when a task plan is invalid", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1887,6 +1980,78 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a job's input references a job of another pipeline", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:           "some-resource",
+							PassedPipeline: "some-team/some-pipeline/some-job",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a job's input references a job of another pipeline without a team", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:           "some-resource",
+							PassedPipeline: "some-pipeline/some-job",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).passed_pipeline: invalid passed pipeline job 'some-pipeline/some-job': expected team/pipeline/job"))
+				})
+			})
+
+			Context("when a job's input references a job of another pipeline along with passed constraints", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:           "some-resource",
+							Passed:         []string{"some-job"},
+							PassedPipeline: "some-team/some-pipeline/some-job",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).passed_pipeline: cannot be combined with passed"))
+				})
+			})
+
+			Context("when a job's input references a job of another pipeline with every version", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:           "some-resource",
+							PassedPipeline: "some-team/some-pipeline/some-job",
+							Version:        &atc.VersionConfig{Every: true},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
 			Context("when a load_var has no name or file defined", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1903,6 +2068,23 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a pipeline_output has no name, pipeline or job defined", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.PipelineOutputStep{},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].pipeline_output(): identifier cannot be an empty string"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].pipeline_output(): no pipeline specified"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].pipeline_output(): no job specified"))
+				})
+			})
+
 			Context("when two load_var steps have same name", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -2259,3 +2441,53 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 })
+
+var _ = Describe("ValidateTeamResources", func() {
+	var (
+		shared        atc.TeamResources
+		errorMessages []string
+	)
+
+	BeforeEach(func() {
+		shared = atc.TeamResources{
+			Resources: atc.ResourceConfigs{
+				{Name: "repo", Type: "git"},
+			},
+			ResourceTypes: atc.ResourceTypes{
+				{Name: "slack", Type: "registry-image"},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		_, errorMessages = configvalidate.ValidateTeamResources(shared)
+	})
+
+	It("does not require the resources to be used", func() {
+		Expect(errorMessages).To(BeEmpty())
+	})
+
+	Context("when two resources have the same name", func() {
+		BeforeEach(func() {
+			shared.Resources = append(shared.Resources, shared.Resources[0])
+		})
+
+		It("returns an error", func() {
+			Expect(errorMessages).To(HaveLen(1))
+			Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+			Expect(errorMessages[0]).To(ContainSubstring("resources[0] and resources[1] have the same name ('repo')"))
+		})
+	})
+
+	Context("when a resource type has no type", func() {
+		BeforeEach(func() {
+			shared.ResourceTypes[0].Type = ""
+		})
+
+		It("returns an error", func() {
+			Expect(errorMessages).To(HaveLen(1))
+			Expect(errorMessages[0]).To(ContainSubstring("invalid resource types:"))
+			Expect(errorMessages[0]).To(ContainSubstring("resource_types.slack has no type"))
+		})
+	})
+})
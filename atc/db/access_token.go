@@ -49,6 +49,20 @@ func (c *Claims) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Groups returns the groups the user is a member of.
+func (c Claims) Groups() []string {
+	var groups []string
+	if rawGroups, ok := c.RawClaims["groups"].([]interface{}); ok {
+		for _, rawGroup := range rawGroups {
+			if group, ok := rawGroup.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	return groups
+}
+
 func (c Claims) Value() (driver.Value, error) {
 	return json.Marshal(c)
 }
@@ -0,0 +1,276 @@
+package db
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// APITokenPrefix is the prefix of every raw API token, distinguishing them
+// from the access tokens issued on login.
+const APITokenPrefix = "cpat_"
+
+// APIToken is a long-lived token granting Role, owned either by a user
+// (Owner) or by a service account of a team (TeamID and ServiceAccount).
+type APIToken struct {
+	ID   int
+	Name string
+	Role string
+
+	Owner string
+
+	TeamID         int
+	TeamName       string
+	ServiceAccount string
+
+	// Claims are the claims requests made with the token are authenticated
+	// with. The groups of tokens owned by a user are those the user was a
+	// member of as of their last login, rather than when the token was
+	// created, so that leaving a group also takes effect for their tokens.
+	Claims Claims
+
+	CreatedAt time.Time
+
+	// ExpiresAt is zero for tokens which never expire.
+	ExpiresAt time.Time
+}
+
+// IsExpired returns whether the token has expired as of the given time.
+func (t APIToken) IsExpired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)
+}
+
+//counterfeiter:generate . APITokenFactory
+type APITokenFactory interface {
+	// CreateAPIToken stores the token, returning it along with the raw token
+	// to hand to its owner. Only a hash of the raw token is stored.
+	CreateAPIToken(token APIToken) (APIToken, string, error)
+
+	GetAPIToken(rawToken string) (APIToken, bool, error)
+
+	ListUserAPITokens(owner string) ([]APIToken, error)
+	ListTeamAPITokens(teamID int) ([]APIToken, error)
+
+	RevokeUserAPIToken(owner string, id int) (bool, error)
+	RevokeTeamAPIToken(teamID int, id int) (bool, error)
+}
+
+func NewAPITokenFactory(conn Conn) APITokenFactory {
+	return &apiTokenFactory{conn}
+}
+
+type apiTokenFactory struct {
+	conn Conn
+}
+
+var apiTokensQuery = psql.Select(
+	"t.id",
+	"t.name",
+	"t.role",
+	"t.owner",
+	"t.team_id",
+	"tm.name",
+	"t.service_account",
+	"t.claims",
+	"t.created_at",
+	"t.expires_at",
+	"u.groups",
+).
+	From("api_tokens t").
+	LeftJoin("teams tm ON tm.id = t.team_id").
+	LeftJoin("users u ON u.sub = t.owner")
+
+func (f *apiTokenFactory) CreateAPIToken(token APIToken) (APIToken, string, error) {
+	rawToken, err := generateAPIToken()
+	if err != nil {
+		return APIToken{}, "", err
+	}
+
+	var expiresAt interface{}
+	if !token.ExpiresAt.IsZero() {
+		expiresAt = token.ExpiresAt
+	}
+
+	err = psql.Insert("api_tokens").
+		Columns("name", "token_hash", "role", "owner", "team_id", "service_account", "claims", "expires_at").
+		Values(
+			token.Name,
+			hashAPIToken(rawToken),
+			token.Role,
+			sql.NullString{String: token.Owner, Valid: token.Owner != ""},
+			sql.NullInt64{Int64: int64(token.TeamID), Valid: token.TeamID != 0},
+			sql.NullString{String: token.ServiceAccount, Valid: token.ServiceAccount != ""},
+			token.Claims,
+			expiresAt,
+		).
+		Suffix("RETURNING id, created_at").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		return APIToken{}, "", err
+	}
+
+	return token, rawToken, nil
+}
+
+func (f *apiTokenFactory) GetAPIToken(rawToken string) (APIToken, bool, error) {
+	if !strings.HasPrefix(rawToken, APITokenPrefix) {
+		return APIToken{}, false, nil
+	}
+
+	row := apiTokensQuery.
+		Where(sq.Eq{"t.token_hash": hashAPIToken(rawToken)}).
+		RunWith(f.conn).
+		QueryRow()
+
+	var token APIToken
+	err := scanAPIToken(&token, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return APIToken{}, false, nil
+		}
+
+		return APIToken{}, false, err
+	}
+
+	return token, true, nil
+}
+
+func (f *apiTokenFactory) ListUserAPITokens(owner string) ([]APIToken, error) {
+	return f.listAPITokens(sq.Eq{"t.owner": owner})
+}
+
+func (f *apiTokenFactory) ListTeamAPITokens(teamID int) ([]APIToken, error) {
+	return f.listAPITokens(sq.Eq{"t.team_id": teamID})
+}
+
+func (f *apiTokenFactory) listAPITokens(where sq.Eq) ([]APIToken, error) {
+	rows, err := apiTokensQuery.
+		Where(where).
+		OrderBy("t.id").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	tokens := []APIToken{}
+	for rows.Next() {
+		var token APIToken
+		err = scanAPIToken(&token, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, rows.Err()
+}
+
+func (f *apiTokenFactory) RevokeUserAPIToken(owner string, id int) (bool, error) {
+	return f.revokeAPIToken(sq.Eq{"owner": owner, "id": id})
+}
+
+func (f *apiTokenFactory) RevokeTeamAPIToken(teamID int, id int) (bool, error) {
+	return f.revokeAPIToken(sq.Eq{"team_id": teamID, "id": id})
+}
+
+func (f *apiTokenFactory) revokeAPIToken(where sq.Eq) (bool, error) {
+	result, err := psql.Delete("api_tokens").
+		Where(where).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+func scanAPIToken(token *APIToken, row scannable) error {
+	var (
+		owner, teamName, serviceAccount, groups sql.NullString
+		teamID                                  sql.NullInt64
+		expiresAt                               sql.NullTime
+	)
+
+	err := row.Scan(
+		&token.ID,
+		&token.Name,
+		&token.Role,
+		&owner,
+		&teamID,
+		&teamName,
+		&serviceAccount,
+		&token.Claims,
+		&token.CreatedAt,
+		&expiresAt,
+		&groups,
+	)
+	if err != nil {
+		return err
+	}
+
+	// users who have not logged in since groups were recorded keep the groups
+	// the token was created with
+	if groups.Valid {
+		var ownerGroups []string
+		err = json.Unmarshal([]byte(groups.String), &ownerGroups)
+		if err != nil {
+			return err
+		}
+
+		rawGroups := []interface{}{}
+		for _, group := range ownerGroups {
+			rawGroups = append(rawGroups, group)
+		}
+
+		if token.Claims.RawClaims == nil {
+			token.Claims.RawClaims = map[string]interface{}{}
+		}
+
+		token.Claims.RawClaims["groups"] = rawGroups
+	}
+
+	token.Owner = owner.String
+	token.TeamID = int(teamID.Int64)
+	token.TeamName = teamName.String
+	token.ServiceAccount = serviceAccount.String
+	token.ExpiresAt = expiresAt.Time
+
+	return nil
+}
+
+// generateAPIToken returns a random token, prefixed so that it can be
+// recognized (e.g. by secret scanners).
+func generateAPIToken() (string, error) {
+	buf := make([]byte, 32)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+
+	return APITokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashAPIToken does not need to be slow, as the tokens are random rather
+// than chosen by users.
+func hashAPIToken(rawToken string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(rawToken)))
+}
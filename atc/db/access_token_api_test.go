@@ -0,0 +1,181 @@
+package db_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Token Factory", func() {
+	var (
+		factory db.APITokenFactory
+		claims  db.Claims
+	)
+
+	BeforeEach(func() {
+		factory = db.NewAPITokenFactory(dbConn)
+
+		claims = db.Claims{
+			RawClaims: map[string]interface{}{
+				"sub": "some-sub",
+				"federated_claims": map[string]interface{}{
+					"user_id":      "some-user",
+					"connector_id": "local",
+				},
+			},
+		}
+	})
+
+	It("stores tokens hashed and finds them by the raw token", func() {
+		created, rawToken, err := factory.CreateAPIToken(db.APIToken{
+			Name:   "ci",
+			Role:   "member",
+			Owner:  "some-sub",
+			Claims: claims,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rawToken).To(HavePrefix(db.APITokenPrefix))
+		Expect(created.ID).ToNot(BeZero())
+		Expect(created.CreatedAt).ToNot(BeZero())
+
+		var hash string
+		err = dbConn.QueryRow("SELECT token_hash FROM api_tokens WHERE id = $1", created.ID).Scan(&hash)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hash).ToNot(ContainSubstring(strings.TrimPrefix(rawToken, db.APITokenPrefix)))
+
+		token, found, err := factory.GetAPIToken(rawToken)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(token.ID).To(Equal(created.ID))
+		Expect(token.Name).To(Equal("ci"))
+		Expect(token.Role).To(Equal("member"))
+		Expect(token.Owner).To(Equal("some-sub"))
+		Expect(token.ExpiresAt).To(BeZero())
+		Expect(token.Claims.RawClaims).To(HaveKeyWithValue("sub", "some-sub"))
+
+		_, found, err = factory.GetAPIToken(rawToken + "x")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("authenticates tokens with the groups of the owner's last login", func() {
+		claims.RawClaims["groups"] = []interface{}{"org:old-team"}
+
+		_, rawToken, err := factory.CreateAPIToken(db.APIToken{
+			Name:   "ci",
+			Role:   "member",
+			Owner:  "some-sub",
+			Claims: claims,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		token, found, err := factory.GetAPIToken(rawToken)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(token.Claims.Groups()).To(Equal([]string{"org:old-team"}))
+
+		userFactory := db.NewUserFactory(dbConn)
+		err = userFactory.CreateOrUpdateUser("some-user", "local", "some-sub", []string{"org:new-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		token, found, err = factory.GetAPIToken(rawToken)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(token.Claims.Groups()).To(Equal([]string{"org:new-team"}))
+
+		err = userFactory.CreateOrUpdateUser("some-user", "local", "some-sub", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		token, found, err = factory.GetAPIToken(rawToken)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(token.Claims.Groups()).To(BeEmpty())
+	})
+
+	It("lists and revokes the tokens of a user", func() {
+		created, rawToken, err := factory.CreateAPIToken(db.APIToken{
+			Name:      "ci",
+			Role:      "viewer",
+			Owner:     "some-sub",
+			Claims:    claims,
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		tokens, err := factory.ListUserAPITokens("some-sub")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].ExpiresAt).ToNot(BeZero())
+
+		Expect(factory.ListUserAPITokens("other-sub")).To(BeEmpty())
+
+		revoked, err := factory.RevokeUserAPIToken("other-sub", created.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+
+		revoked, err = factory.RevokeUserAPIToken("some-sub", created.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+
+		_, found, err := factory.GetAPIToken(rawToken)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("lists and revokes the service account tokens of a team", func() {
+		created, _, err := factory.CreateAPIToken(db.APIToken{
+			Name:           "deploy",
+			Role:           "pipeline-operator",
+			TeamID:         defaultTeam.ID(),
+			ServiceAccount: "deployer",
+			Claims:         claims,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		tokens, err := factory.ListTeamAPITokens(defaultTeam.ID())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].TeamName).To(Equal(defaultTeam.Name()))
+		Expect(tokens[0].ServiceAccount).To(Equal("deployer"))
+
+		revoked, err := factory.RevokeTeamAPIToken(defaultTeam.ID(), created.ID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+
+		Expect(factory.ListTeamAPITokens(defaultTeam.ID())).To(BeEmpty())
+	})
+
+	It("removes expired tokens", func() {
+		lifecycle := db.NewAccessTokenLifecycle(dbConn)
+
+		_, _, err := factory.CreateAPIToken(db.APIToken{
+			Name:      "expired",
+			Role:      "viewer",
+			Owner:     "some-sub",
+			Claims:    claims,
+			ExpiresAt: now().Add(-time.Hour),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, _, err = factory.CreateAPIToken(db.APIToken{
+			Name:   "forever",
+			Role:   "viewer",
+			Owner:  "some-sub",
+			Claims: claims,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		n, err := lifecycle.RemoveExpiredAPITokens(0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(1))
+
+		tokens, err := factory.ListUserAPITokens("some-sub")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tokens).To(HaveLen(1))
+		Expect(tokens[0].Name).To(Equal("forever"))
+	})
+})
@@ -2,9 +2,11 @@ package db
 
 import (
 	"database/sql"
+	"errors"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -13,8 +15,32 @@ import (
 type AccessTokenFactory interface {
 	CreateAccessToken(token string, claims Claims) error
 	GetAccessToken(token string) (AccessToken, bool, error)
+
+	GetSessions(filter SessionFilter) ([]Session, error)
+	RevokeSession(id int) (bool, error)
+	RevokeSessions(filter SessionFilter) (atc.RevokedSessions, error)
+}
+
+// Session is an unexpired access token issued to a user on login. The token
+// itself is never exposed; sessions are identified by their ID.
+type Session struct {
+	ID        int
+	Sub       string
+	Username  string
+	Connector string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// SessionFilter narrows sessions down to those of a user, as named in the
+// users table. Connector is optional, as usernames are unique per connector.
+type SessionFilter struct {
+	Username  string
+	Connector string
 }
 
+var ErrSessionFilterMissingUsername = errors.New("username must be specified when revoking sessions")
+
 func NewAccessTokenFactory(conn Conn) AccessTokenFactory {
 	return &accessTokenFactory{conn}
 }
@@ -56,3 +82,151 @@ func (a *accessTokenFactory) GetAccessToken(token string) (AccessToken, bool, er
 	}
 	return accessToken, true, nil
 }
+
+func (a *accessTokenFactory) GetSessions(filter SessionFilter) ([]Session, error) {
+	rows, err := psql.Select(
+		"a.id",
+		"a.sub",
+		"COALESCE(u.username, '')",
+		"COALESCE(u.connector, '')",
+		"a.created_at",
+		"a.expires_at",
+	).
+		From("access_tokens a").
+		LeftJoin("users u ON u.sub = a.sub").
+		Where(sq.Expr("a.expires_at > now()")).
+		Where(filter.where()).
+		OrderBy("a.created_at DESC", "a.id DESC").
+		RunWith(a.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	sessions := []Session{}
+	for rows.Next() {
+		var session Session
+		err = rows.Scan(
+			&session.ID,
+			&session.Sub,
+			&session.Username,
+			&session.Connector,
+			&session.CreatedAt,
+			&session.ExpiresAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession deletes the session's access token and notifies every web
+// node so that it is no longer accepted from their caches.
+func (a *accessTokenFactory) RevokeSession(id int) (bool, error) {
+	result, err := psql.Delete("access_tokens").
+		Where(sq.Eq{"id": id}).
+		RunWith(a.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if affected == 0 {
+		return false, nil
+	}
+
+	return true, a.conn.Bus().Notify(atc.AccessTokenCacheChannel)
+}
+
+// RevokeSessions deletes the access tokens of every session of the user, along
+// with the user's personal API tokens, and notifies every web node so that
+// the sessions are no longer accepted from their caches.
+func (a *accessTokenFactory) RevokeSessions(filter SessionFilter) (atc.RevokedSessions, error) {
+	if filter.Username == "" {
+		return atc.RevokedSessions{}, ErrSessionFilterMissingUsername
+	}
+
+	// built with '?' placeholders, which are numbered within the delete
+	subs := sq.Select("u.sub").
+		From("users u").
+		Where(filter.where())
+
+	query, args, err := subs.ToSql()
+	if err != nil {
+		return atc.RevokedSessions{}, err
+	}
+
+	tx, err := a.conn.Begin()
+	if err != nil {
+		return atc.RevokedSessions{}, err
+	}
+
+	defer Rollback(tx)
+
+	sessions, err := deleteBySub(tx, "access_tokens", "sub", query, args)
+	if err != nil {
+		return atc.RevokedSessions{}, err
+	}
+
+	apiTokens, err := deleteBySub(tx, "api_tokens", "owner", query, args)
+	if err != nil {
+		return atc.RevokedSessions{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return atc.RevokedSessions{}, err
+	}
+
+	revoked := atc.RevokedSessions{
+		Revoked:   sessions,
+		APITokens: apiTokens,
+	}
+
+	if sessions == 0 {
+		return revoked, nil
+	}
+
+	return revoked, a.conn.Bus().Notify(atc.AccessTokenCacheChannel)
+}
+
+func deleteBySub(tx Tx, table string, column string, subsQuery string, args []interface{}) (int, error) {
+	result, err := psql.Delete(table).
+		Where(sq.Expr(column+" IN ("+subsQuery+")", args...)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+func (filter SessionFilter) where() sq.Sqlizer {
+	where := sq.And{}
+	if filter.Username != "" {
+		where = append(where, sq.Eq{"u.username": filter.Username})
+	}
+
+	if filter.Connector != "" {
+		where = append(where, sq.Eq{"u.connector": filter.Connector})
+	}
+
+	return where
+}
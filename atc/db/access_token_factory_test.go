@@ -1,6 +1,9 @@
 package db_test
 
 import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"gopkg.in/square/go-jose.v2/jwt"
 
@@ -76,4 +79,116 @@ var _ = Describe("Access Token Factory", func() {
 			},
 		}))
 	})
+
+	Describe("sessions", func() {
+		createToken := func(token string, sub string, expiresIn time.Duration) {
+			expiry := jwt.NewNumericDate(time.Now().Add(expiresIn))
+			err := factory.CreateAccessToken(token, db.Claims{
+				Claims: jwt.Claims{Subject: sub, Expiry: expiry},
+				RawClaims: map[string]interface{}{
+					"sub": sub,
+					"exp": expiry,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			userFactory := db.NewUserFactory(dbConn)
+			Expect(userFactory.CreateOrUpdateUser("bob", "github", "bob-sub", nil)).To(Succeed())
+			Expect(userFactory.CreateOrUpdateUser("bob", "ldap", "bob-ldap-sub", nil)).To(Succeed())
+			Expect(userFactory.CreateOrUpdateUser("alice", "github", "alice-sub", nil)).To(Succeed())
+
+			createToken("bob-token-1", "bob-sub", time.Hour)
+			createToken("bob-token-2", "bob-ldap-sub", time.Hour)
+			createToken("bob-expired-token", "bob-sub", -time.Hour)
+			createToken("alice-token", "alice-sub", time.Hour)
+		})
+
+		It("lists the unexpired sessions with their users", func() {
+			sessions, err := factory.GetSessions(db.SessionFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(3))
+
+			var users []string
+			for _, session := range sessions {
+				users = append(users, session.Connector+":"+session.Username)
+			}
+
+			Expect(users).To(ConsistOf("github:bob", "ldap:bob", "github:alice"))
+		})
+
+		It("filters the sessions by user", func() {
+			sessions, err := factory.GetSessions(db.SessionFilter{Username: "bob", Connector: "ldap"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].Sub).To(Equal("bob-ldap-sub"))
+		})
+
+		It("revokes a session", func() {
+			sessions, err := factory.GetSessions(db.SessionFilter{Username: "alice"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+
+			revoked, err := factory.RevokeSession(sessions[0].ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			_, found, err := factory.GetAccessToken("alice-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			revoked, err = factory.RevokeSession(sessions[0].ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+		})
+
+		It("revokes all of a user's sessions", func() {
+			revoked, err := factory.RevokeSessions(db.SessionFilter{Username: "bob"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(Equal(atc.RevokedSessions{Revoked: 3}))
+
+			sessions, err := factory.GetSessions(db.SessionFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].Username).To(Equal("alice"))
+		})
+
+		It("revokes the user's API tokens along with their sessions", func() {
+			apiTokenFactory := db.NewAPITokenFactory(dbConn)
+
+			_, bobToken, err := apiTokenFactory.CreateAPIToken(db.APIToken{
+				Name:   "ci",
+				Role:   "member",
+				Owner:  "bob-sub",
+				Claims: db.Claims{RawClaims: map[string]interface{}{"sub": "bob-sub"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, aliceToken, err := apiTokenFactory.CreateAPIToken(db.APIToken{
+				Name:   "ci",
+				Role:   "member",
+				Owner:  "alice-sub",
+				Claims: db.Claims{RawClaims: map[string]interface{}{"sub": "alice-sub"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			revoked, err := factory.RevokeSessions(db.SessionFilter{Username: "bob"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(Equal(atc.RevokedSessions{Revoked: 3, APITokens: 1}))
+
+			_, found, err := apiTokenFactory.GetAPIToken(bobToken)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = apiTokenFactory.GetAPIToken(aliceToken)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("requires a username to revoke sessions", func() {
+			_, err := factory.RevokeSessions(db.SessionFilter{Connector: "github"})
+			Expect(err).To(Equal(db.ErrSessionFilterMissingUsername))
+		})
+	})
 })
@@ -10,6 +10,7 @@ import (
 //counterfeiter:generate . AccessTokenLifecycle
 type AccessTokenLifecycle interface {
 	RemoveExpiredAccessTokens(leeway time.Duration) (int, error)
+	RemoveExpiredAPITokens(leeway time.Duration) (int, error)
 }
 
 type accessTokenLifecycle struct {
@@ -21,7 +22,15 @@ func NewAccessTokenLifecycle(conn Conn) AccessTokenLifecycle {
 }
 
 func (a accessTokenLifecycle) RemoveExpiredAccessTokens(leeway time.Duration) (int, error) {
-	res, err := sq.Delete("access_tokens").
+	return a.removeExpired("access_tokens", leeway)
+}
+
+func (a accessTokenLifecycle) RemoveExpiredAPITokens(leeway time.Duration) (int, error) {
+	return a.removeExpired("api_tokens", leeway)
+}
+
+func (a accessTokenLifecycle) removeExpired(table string, leeway time.Duration) (int, error) {
+	res, err := sq.Delete(table).
 		Where(
 			sq.Expr(fmt.Sprintf("expires_at < now() - '%d seconds'::interval", int(leeway.Seconds()))),
 		).
@@ -0,0 +1,218 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// AuditEventFilter narrows down the audit events returned. Zero values match
+// every event.
+type AuditEventFilter struct {
+	Since time.Time
+	Until time.Time
+
+	Actor    string
+	Action   string
+	TeamName string
+
+	Limit int
+}
+
+//counterfeiter:generate . AuditEventRepository
+type AuditEventRepository interface {
+	CreateAuditEvent(event atc.AuditEvent) error
+
+	// AuditEvents returns the events matching the filter, most recent first.
+	AuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error)
+
+	// UnforwardedAuditEvents returns the oldest events which have not been
+	// sent to the audit webhook yet.
+	UnforwardedAuditEvents(limit int) ([]atc.AuditEvent, error)
+	MarkAuditEventsForwarded(throughID int) error
+}
+
+//counterfeiter:generate . AuditEventLifecycle
+type AuditEventLifecycle interface {
+	RemoveAuditEventsOlderThan(age time.Duration) (int, error)
+}
+
+type auditEventRepository struct {
+	conn Conn
+}
+
+func NewAuditEventRepository(conn Conn) AuditEventRepository {
+	return &auditEventRepository{conn}
+}
+
+var auditEventsQuery = psql.Select(
+	"id",
+	"created_at",
+	"actor",
+	"team_name",
+	"action",
+	"target",
+	"method",
+	"path",
+	"remote_addr",
+	"user_agent",
+	"parameters",
+).From("audit_events")
+
+func (r *auditEventRepository) CreateAuditEvent(event atc.AuditEvent) error {
+	var parameters interface{}
+	if len(event.Parameters) != 0 {
+		payload, err := json.Marshal(event.Parameters)
+		if err != nil {
+			return err
+		}
+
+		parameters = payload
+	}
+
+	_, err := psql.Insert("audit_events").
+		Columns("actor", "team_name", "action", "target", "method", "path", "remote_addr", "user_agent", "parameters").
+		Values(
+			event.Actor,
+			sql.NullString{String: event.TeamName, Valid: event.TeamName != ""},
+			event.Action,
+			sql.NullString{String: event.Target, Valid: event.Target != ""},
+			event.Method,
+			event.Path,
+			sql.NullString{String: event.RemoteAddr, Valid: event.RemoteAddr != ""},
+			sql.NullString{String: event.UserAgent, Valid: event.UserAgent != ""},
+			parameters,
+		).
+		RunWith(r.conn).
+		Exec()
+	return err
+}
+
+func (r *auditEventRepository) AuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error) {
+	query := auditEventsQuery.OrderBy("id DESC")
+
+	if !filter.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"created_at": filter.Since})
+	}
+
+	if !filter.Until.IsZero() {
+		query = query.Where(sq.Lt{"created_at": filter.Until})
+	}
+
+	if filter.Actor != "" {
+		query = query.Where(sq.Eq{"actor": filter.Actor})
+	}
+
+	if filter.Action != "" {
+		query = query.Where(sq.Eq{"action": filter.Action})
+	}
+
+	if filter.TeamName != "" {
+		query = query.Where(sq.Eq{"team_name": filter.TeamName})
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+
+	return r.queryAuditEvents(query)
+}
+
+func (r *auditEventRepository) UnforwardedAuditEvents(limit int) ([]atc.AuditEvent, error) {
+	return r.queryAuditEvents(auditEventsQuery.
+		Where(sq.Eq{"forwarded_at": nil}).
+		OrderBy("id ASC").
+		Limit(uint64(limit)))
+}
+
+func (r *auditEventRepository) MarkAuditEventsForwarded(throughID int) error {
+	_, err := psql.Update("audit_events").
+		Set("forwarded_at", sq.Expr("now()")).
+		Where(sq.Eq{"forwarded_at": nil}).
+		Where(sq.LtOrEq{"id": throughID}).
+		RunWith(r.conn).
+		Exec()
+	return err
+}
+
+func (r *auditEventRepository) queryAuditEvents(query sq.SelectBuilder) ([]atc.AuditEvent, error) {
+	rows, err := query.RunWith(r.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	events := []atc.AuditEvent{}
+	for rows.Next() {
+		var (
+			event                                   atc.AuditEvent
+			createdAt                               time.Time
+			teamName, target, remoteAddr, userAgent sql.NullString
+			parameters                              []byte
+		)
+
+		err = rows.Scan(
+			&event.ID,
+			&createdAt,
+			&event.Actor,
+			&teamName,
+			&event.Action,
+			&target,
+			&event.Method,
+			&event.Path,
+			&remoteAddr,
+			&userAgent,
+			&parameters,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if parameters != nil {
+			err = json.Unmarshal(parameters, &event.Parameters)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		event.Time = createdAt.Unix()
+		event.TeamName = teamName.String
+		event.Target = target.String
+		event.RemoteAddr = remoteAddr.String
+		event.UserAgent = userAgent.String
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+type auditEventLifecycle struct {
+	conn Conn
+}
+
+func NewAuditEventLifecycle(conn Conn) AuditEventLifecycle {
+	return &auditEventLifecycle{conn}
+}
+
+func (l *auditEventLifecycle) RemoveAuditEventsOlderThan(age time.Duration) (int, error) {
+	res, err := psql.Delete("audit_events").
+		Where(sq.Expr(fmt.Sprintf("created_at < now() - '%d seconds'::interval", int(age.Seconds())))).
+		RunWith(l.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
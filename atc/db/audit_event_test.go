@@ -0,0 +1,93 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Events", func() {
+	var (
+		repository db.AuditEventRepository
+		lifecycle  db.AuditEventLifecycle
+	)
+
+	BeforeEach(func() {
+		repository = db.NewAuditEventRepository(dbConn)
+		lifecycle = db.NewAuditEventLifecycle(dbConn)
+
+		for _, event := range []atc.AuditEvent{
+			{Actor: "alice", TeamName: "main", Action: atc.SaveConfig, Target: "pipeline_name=deploy", Method: "PUT", Path: "/api/v1/teams/main/pipelines/deploy/config"},
+			{Actor: "bob", TeamName: "other", Action: atc.PausePipeline, Target: "pipeline_name=build", Method: "PUT", Path: "/api/v1/teams/other/pipelines/build/pause"},
+			{Actor: "alice", Action: atc.SetLogLevel, Method: "PUT", Path: "/api/v1/log-level", Parameters: map[string][]string{"level": {"debug"}}},
+		} {
+			Expect(repository.CreateAuditEvent(event)).To(Succeed())
+		}
+	})
+
+	It("returns the events most recent first", func() {
+		events, err := repository.AuditEvents(db.AuditEventFilter{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(3))
+		Expect(events[0].Action).To(Equal(atc.SetLogLevel))
+		Expect(events[0].Parameters).To(Equal(map[string][]string{"level": {"debug"}}))
+		Expect(events[0].Time).ToNot(BeZero())
+		Expect(events[2].TeamName).To(Equal("main"))
+		Expect(events[2].Target).To(Equal("pipeline_name=deploy"))
+	})
+
+	It("filters the events", func() {
+		events, err := repository.AuditEvents(db.AuditEventFilter{Actor: "alice"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(2))
+
+		events, err = repository.AuditEvents(db.AuditEventFilter{Action: atc.PausePipeline})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Actor).To(Equal("bob"))
+
+		events, err = repository.AuditEvents(db.AuditEventFilter{TeamName: "main", Limit: 1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+
+		events, err = repository.AuditEvents(db.AuditEventFilter{Until: time.Now().Add(-time.Hour)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(BeEmpty())
+
+		events, err = repository.AuditEvents(db.AuditEventFilter{Since: time.Now().Add(-time.Hour)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(3))
+	})
+
+	It("tracks which events have been forwarded", func() {
+		events, err := repository.UnforwardedAuditEvents(2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Actor).To(Equal("alice"))
+		Expect(events[1].Actor).To(Equal("bob"))
+
+		Expect(repository.MarkAuditEventsForwarded(events[1].ID)).To(Succeed())
+
+		events, err = repository.UnforwardedAuditEvents(2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Action).To(Equal(atc.SetLogLevel))
+	})
+
+	It("removes old events", func() {
+		_, err := dbConn.Exec("UPDATE audit_events SET created_at = now() - '2 hours'::interval WHERE actor = 'bob'")
+		Expect(err).ToNot(HaveOccurred())
+
+		removed, err := lifecycle.RemoveAuditEventsOlderThan(time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(removed).To(Equal(1))
+
+		events, err := repository.AuditEvents(db.AuditEventFilter{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(2))
+	})
+})
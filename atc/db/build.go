@@ -562,6 +562,11 @@ func (b *build) start(tx Tx, plan atc.Plan) (bool, error) {
 		return false, err
 	}
 
+	err = saveBuildStatusEvent(tx, b, BuildStatusStarted)
+	if err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -598,6 +603,11 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	err = saveBuildStatusEvent(tx, b, status)
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(fmt.Sprintf(`
 		DROP SEQUENCE %s
 	`, buildEventSeq(b.id)))
@@ -712,6 +722,11 @@ func (b *build) Finish(status BuildStatus) error {
 			return err
 		}
 
+		err = requestScheduleOnPipelineOutputConsumers(tx, b.jobID)
+		if err != nil {
+			return err
+		}
+
 		// recursively archive any child pipelines. This is likely the most common case for
 		// automatic archiving so it's worth it to make the feedback more instantenous rather
 		// than relying on GC
@@ -1741,7 +1756,7 @@ func (b *build) SavePipeline(
 
 	jobID := newNullInt64(b.jobID)
 	buildID := newNullInt64(b.id)
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID)
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID, "")
 	if err != nil {
 		return nil, false, err
 	}
@@ -1967,6 +1982,11 @@ func createBuild(tx Tx, build *build, vals map[string]interface{}) error {
 		return err
 	}
 
+	err = saveBuildStatusEvent(tx, build, build.status)
+	if err != nil {
+		return err
+	}
+
 	return createBuildEventSeq(tx, buildID)
 }
 
@@ -61,6 +61,7 @@ func (f *buildFactory) VisibleBuilds(teamNames []string, page Page) ([]Build, Pa
 		Where(sq.Or{
 			sq.Eq{"p.public": true},
 			sq.Eq{"t.name": teamNames},
+			sharedWithTeams(teamNames),
 		})
 
 	if page.UseDate {
@@ -226,3 +226,43 @@ func (c resourceConfigCheckSessionContainerOwner) Create(tx Tx, workerName strin
 		"resource_config_check_session_id": rccsID,
 	}, nil
 }
+
+// NewWarmContainerOwner references a slot in the pool of warm containers kept
+// for a task image on a worker. Warm containers are handed over to a build
+// step when claimed, and are garbage collected once they have been idle for
+// too long.
+func NewWarmContainerOwner(
+	key string,
+	slot int,
+	teamID int,
+) ContainerOwner {
+	return warmContainerOwner{
+		Key:    key,
+		Slot:   slot,
+		TeamID: teamID,
+	}
+}
+
+type warmContainerOwner struct {
+	Key    string
+	Slot   int
+	TeamID int
+}
+
+func (c warmContainerOwner) Find(Conn) (sq.Eq, bool, error) {
+	return sq.Eq(c.sqlMap()), true, nil
+}
+
+func (c warmContainerOwner) Create(Tx, string) (map[string]interface{}, error) {
+	cols := c.sqlMap()
+	cols["warm_since"] = sq.Expr("now()")
+	return cols, nil
+}
+
+func (c warmContainerOwner) sqlMap() map[string]interface{} {
+	return map[string]interface{}{
+		"warm_key":  c.Key,
+		"warm_slot": c.Slot,
+		"team_id":   c.TeamID,
+	}
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -13,6 +14,9 @@ import (
 type ContainerRepository interface {
 	FindOrphanedContainers() ([]CreatingContainer, []CreatedContainer, []DestroyingContainer, error)
 	DestroyFailedContainers() (int, error)
+	DestroyIdleWarmContainers(time.Duration) (int, error)
+	FindContainerOnDrainingWorker(buildID int, planID atc.PlanID, retryable bool) (string, bool, error)
+	DestroyBuildContainersOnDrainingWorkers(buildID int) (int, error)
 	FindDestroyingContainers(workerName string) ([]string, error)
 	RemoveDestroyingContainers(workerName string, currentHandles []string) (int, error)
 	UpdateContainersMissingSince(workerName string, handles []string) error
@@ -231,6 +235,7 @@ func (repository *containerRepository) FindOrphanedContainers() ([]CreatingConta
 				"c.image_check_container_id":         nil,
 				"c.image_get_container_id":           nil,
 				"c.resource_config_check_session_id": nil,
+				"c.warm_key":                         nil,
 			},
 			sq.And{
 				sq.NotEq{"c.build_id": nil},
@@ -388,6 +393,92 @@ func (repository *containerRepository) DestroyFailedContainers() (int, error) {
 	return int(affected), nil
 }
 
+// DestroyIdleWarmContainers marks warm containers which have not been claimed
+// within the idle timeout as destroying.
+func (repository *containerRepository) DestroyIdleWarmContainers(idleTimeout time.Duration) (int, error) {
+	result, err := psql.Update("containers").
+		Set("state", atc.ContainerStateDestroying).
+		Where(sq.And{
+			sq.NotEq{"warm_key": nil},
+			sq.Eq{"state": []string{atc.ContainerStateCreating, atc.ContainerStateCreated}},
+			sq.Expr(fmt.Sprintf("warm_since < now() - '%d seconds'::interval", int(idleTimeout.Seconds()))),
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+// FindContainerOnDrainingWorker returns the name of the draining worker which
+// the build step's container is on, if the step can be moved off of it. This
+// is the case for steps of interruptible jobs, and for retryable steps.
+func (repository *containerRepository) FindContainerOnDrainingWorker(buildID int, planID atc.PlanID, retryable bool) (string, bool, error) {
+	query := psql.Select("c.worker_name").
+		From("containers c").
+		Join("workers w ON w.name = c.worker_name").
+		Join("builds b ON b.id = c.build_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		Where(sq.Eq{
+			"c.build_id": buildID,
+			"c.plan_id":  string(planID),
+			"c.state":    []string{atc.ContainerStateCreating, atc.ContainerStateCreated},
+			"w.draining": true,
+		})
+
+	if !retryable {
+		query = query.Where(sq.Eq{"j.interruptible": true})
+	}
+
+	var workerName string
+	err := query.
+		Limit(1).
+		RunWith(repository.conn).
+		QueryRow().
+		Scan(&workerName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+
+		return "", false, err
+	}
+
+	return workerName, true, nil
+}
+
+// DestroyBuildContainersOnDrainingWorkers marks the build's containers on
+// draining workers as destroying once the build has been moved off of them,
+// so that they no longer hold back the workers from landing.
+func (repository *containerRepository) DestroyBuildContainersOnDrainingWorkers(buildID int) (int, error) {
+	result, err := psql.Update("containers").
+		Set("state", atc.ContainerStateDestroying).
+		Where(sq.Eq{
+			"build_id": buildID,
+			"state":    atc.ContainerStateCreated,
+		}).
+		Where(sq.Expr("worker_name IN (SELECT name FROM workers WHERE draining)")).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
 func (repository *containerRepository) DestroyUnknownContainers(workerName string, reportedHandles []string) (int, error) {
 	tx, err := repository.conn.Begin()
 	if err != nil {
@@ -4,6 +4,7 @@ package dbfakes
 import (
 	"sync"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -35,6 +36,45 @@ type FakeAccessTokenFactory struct {
 		result2 bool
 		result3 error
 	}
+	GetSessionsStub        func(db.SessionFilter) ([]db.Session, error)
+	getSessionsMutex       sync.RWMutex
+	getSessionsArgsForCall []struct {
+		arg1 db.SessionFilter
+	}
+	getSessionsReturns struct {
+		result1 []db.Session
+		result2 error
+	}
+	getSessionsReturnsOnCall map[int]struct {
+		result1 []db.Session
+		result2 error
+	}
+	RevokeSessionStub        func(int) (bool, error)
+	revokeSessionMutex       sync.RWMutex
+	revokeSessionArgsForCall []struct {
+		arg1 int
+	}
+	revokeSessionReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeSessionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeSessionsStub        func(db.SessionFilter) (atc.RevokedSessions, error)
+	revokeSessionsMutex       sync.RWMutex
+	revokeSessionsArgsForCall []struct {
+		arg1 db.SessionFilter
+	}
+	revokeSessionsReturns struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}
+	revokeSessionsReturnsOnCall map[int]struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -168,6 +208,198 @@ func (fake *FakeAccessTokenFactory) GetAccessTokenReturnsOnCall(i int, result1 d
 	}{result1, result2, result3}
 }
 
+func (fake *FakeAccessTokenFactory) GetSessions(arg1 db.SessionFilter) ([]db.Session, error) {
+	fake.getSessionsMutex.Lock()
+	ret, specificReturn := fake.getSessionsReturnsOnCall[len(fake.getSessionsArgsForCall)]
+	fake.getSessionsArgsForCall = append(fake.getSessionsArgsForCall, struct {
+		arg1 db.SessionFilter
+	}{arg1})
+	stub := fake.GetSessionsStub
+	fakeReturns := fake.getSessionsReturns
+	fake.recordInvocation("GetSessions", []interface{}{arg1})
+	fake.getSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAccessTokenFactory) GetSessionsCallCount() int {
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	return len(fake.getSessionsArgsForCall)
+}
+
+func (fake *FakeAccessTokenFactory) GetSessionsCalls(stub func(db.SessionFilter) ([]db.Session, error)) {
+	fake.getSessionsMutex.Lock()
+	defer fake.getSessionsMutex.Unlock()
+	fake.GetSessionsStub = stub
+}
+
+func (fake *FakeAccessTokenFactory) GetSessionsArgsForCall(i int) db.SessionFilter {
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	argsForCall := fake.getSessionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccessTokenFactory) GetSessionsReturns(result1 []db.Session, result2 error) {
+	fake.getSessionsMutex.Lock()
+	defer fake.getSessionsMutex.Unlock()
+	fake.GetSessionsStub = nil
+	fake.getSessionsReturns = struct {
+		result1 []db.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenFactory) GetSessionsReturnsOnCall(i int, result1 []db.Session, result2 error) {
+	fake.getSessionsMutex.Lock()
+	defer fake.getSessionsMutex.Unlock()
+	fake.GetSessionsStub = nil
+	if fake.getSessionsReturnsOnCall == nil {
+		fake.getSessionsReturnsOnCall = make(map[int]struct {
+			result1 []db.Session
+			result2 error
+		})
+	}
+	fake.getSessionsReturnsOnCall[i] = struct {
+		result1 []db.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSession(arg1 int) (bool, error) {
+	fake.revokeSessionMutex.Lock()
+	ret, specificReturn := fake.revokeSessionReturnsOnCall[len(fake.revokeSessionArgsForCall)]
+	fake.revokeSessionArgsForCall = append(fake.revokeSessionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.RevokeSessionStub
+	fakeReturns := fake.revokeSessionReturns
+	fake.recordInvocation("RevokeSession", []interface{}{arg1})
+	fake.revokeSessionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionCallCount() int {
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	return len(fake.revokeSessionArgsForCall)
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionCalls(stub func(int) (bool, error)) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = stub
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionArgsForCall(i int) int {
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	argsForCall := fake.revokeSessionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionReturns(result1 bool, result2 error) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = nil
+	fake.revokeSessionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = nil
+	if fake.revokeSessionReturnsOnCall == nil {
+		fake.revokeSessionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeSessionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessions(arg1 db.SessionFilter) (atc.RevokedSessions, error) {
+	fake.revokeSessionsMutex.Lock()
+	ret, specificReturn := fake.revokeSessionsReturnsOnCall[len(fake.revokeSessionsArgsForCall)]
+	fake.revokeSessionsArgsForCall = append(fake.revokeSessionsArgsForCall, struct {
+		arg1 db.SessionFilter
+	}{arg1})
+	stub := fake.RevokeSessionsStub
+	fakeReturns := fake.revokeSessionsReturns
+	fake.recordInvocation("RevokeSessions", []interface{}{arg1})
+	fake.revokeSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionsCallCount() int {
+	fake.revokeSessionsMutex.RLock()
+	defer fake.revokeSessionsMutex.RUnlock()
+	return len(fake.revokeSessionsArgsForCall)
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionsCalls(stub func(db.SessionFilter) (atc.RevokedSessions, error)) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = stub
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionsArgsForCall(i int) db.SessionFilter {
+	fake.revokeSessionsMutex.RLock()
+	defer fake.revokeSessionsMutex.RUnlock()
+	argsForCall := fake.revokeSessionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionsReturns(result1 atc.RevokedSessions, result2 error) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = nil
+	fake.revokeSessionsReturns = struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenFactory) RevokeSessionsReturnsOnCall(i int, result1 atc.RevokedSessions, result2 error) {
+	fake.revokeSessionsMutex.Lock()
+	defer fake.revokeSessionsMutex.Unlock()
+	fake.RevokeSessionsStub = nil
+	if fake.revokeSessionsReturnsOnCall == nil {
+		fake.revokeSessionsReturnsOnCall = make(map[int]struct {
+			result1 atc.RevokedSessions
+			result2 error
+		})
+	}
+	fake.revokeSessionsReturnsOnCall[i] = struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAccessTokenFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -175,6 +407,12 @@ func (fake *FakeAccessTokenFactory) Invocations() map[string][][]interface{} {
 	defer fake.createAccessTokenMutex.RUnlock()
 	fake.getAccessTokenMutex.RLock()
 	defer fake.getAccessTokenMutex.RUnlock()
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	fake.revokeSessionsMutex.RLock()
+	defer fake.revokeSessionsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,6 +9,19 @@ import (
 )
 
 type FakeAccessTokenLifecycle struct {
+	RemoveExpiredAPITokensStub        func(time.Duration) (int, error)
+	removeExpiredAPITokensMutex       sync.RWMutex
+	removeExpiredAPITokensArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeExpiredAPITokensReturns struct {
+		result1 int
+		result2 error
+	}
+	removeExpiredAPITokensReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	RemoveExpiredAccessTokensStub        func(time.Duration) (int, error)
 	removeExpiredAccessTokensMutex       sync.RWMutex
 	removeExpiredAccessTokensArgsForCall []struct {
@@ -26,6 +39,70 @@ type FakeAccessTokenLifecycle struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeAccessTokenLifecycle) RemoveExpiredAPITokens(arg1 time.Duration) (int, error) {
+	fake.removeExpiredAPITokensMutex.Lock()
+	ret, specificReturn := fake.removeExpiredAPITokensReturnsOnCall[len(fake.removeExpiredAPITokensArgsForCall)]
+	fake.removeExpiredAPITokensArgsForCall = append(fake.removeExpiredAPITokensArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	stub := fake.RemoveExpiredAPITokensStub
+	fakeReturns := fake.removeExpiredAPITokensReturns
+	fake.recordInvocation("RemoveExpiredAPITokens", []interface{}{arg1})
+	fake.removeExpiredAPITokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAccessTokenLifecycle) RemoveExpiredAPITokensCallCount() int {
+	fake.removeExpiredAPITokensMutex.RLock()
+	defer fake.removeExpiredAPITokensMutex.RUnlock()
+	return len(fake.removeExpiredAPITokensArgsForCall)
+}
+
+func (fake *FakeAccessTokenLifecycle) RemoveExpiredAPITokensCalls(stub func(time.Duration) (int, error)) {
+	fake.removeExpiredAPITokensMutex.Lock()
+	defer fake.removeExpiredAPITokensMutex.Unlock()
+	fake.RemoveExpiredAPITokensStub = stub
+}
+
+func (fake *FakeAccessTokenLifecycle) RemoveExpiredAPITokensArgsForCall(i int) time.Duration {
+	fake.removeExpiredAPITokensMutex.RLock()
+	defer fake.removeExpiredAPITokensMutex.RUnlock()
+	argsForCall := fake.removeExpiredAPITokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccessTokenLifecycle) RemoveExpiredAPITokensReturns(result1 int, result2 error) {
+	fake.removeExpiredAPITokensMutex.Lock()
+	defer fake.removeExpiredAPITokensMutex.Unlock()
+	fake.RemoveExpiredAPITokensStub = nil
+	fake.removeExpiredAPITokensReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAccessTokenLifecycle) RemoveExpiredAPITokensReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeExpiredAPITokensMutex.Lock()
+	defer fake.removeExpiredAPITokensMutex.Unlock()
+	fake.RemoveExpiredAPITokensStub = nil
+	if fake.removeExpiredAPITokensReturnsOnCall == nil {
+		fake.removeExpiredAPITokensReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeExpiredAPITokensReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAccessTokenLifecycle) RemoveExpiredAccessTokens(arg1 time.Duration) (int, error) {
 	fake.removeExpiredAccessTokensMutex.Lock()
 	ret, specificReturn := fake.removeExpiredAccessTokensReturnsOnCall[len(fake.removeExpiredAccessTokensArgsForCall)]
@@ -93,6 +170,8 @@ func (fake *FakeAccessTokenLifecycle) RemoveExpiredAccessTokensReturnsOnCall(i i
 func (fake *FakeAccessTokenLifecycle) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.removeExpiredAPITokensMutex.RLock()
+	defer fake.removeExpiredAPITokensMutex.RUnlock()
 	fake.removeExpiredAccessTokensMutex.RLock()
 	defer fake.removeExpiredAccessTokensMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
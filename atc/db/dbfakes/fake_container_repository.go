@@ -5,10 +5,24 @@ import (
 	"sync"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
 type FakeContainerRepository struct {
+	DestroyBuildContainersOnDrainingWorkersStub        func(int) (int, error)
+	destroyBuildContainersOnDrainingWorkersMutex       sync.RWMutex
+	destroyBuildContainersOnDrainingWorkersArgsForCall []struct {
+		arg1 int
+	}
+	destroyBuildContainersOnDrainingWorkersReturns struct {
+		result1 int
+		result2 error
+	}
+	destroyBuildContainersOnDrainingWorkersReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	DestroyFailedContainersStub        func() (int, error)
 	destroyFailedContainersMutex       sync.RWMutex
 	destroyFailedContainersArgsForCall []struct {
@@ -21,6 +35,19 @@ type FakeContainerRepository struct {
 		result1 int
 		result2 error
 	}
+	DestroyIdleWarmContainersStub        func(time.Duration) (int, error)
+	destroyIdleWarmContainersMutex       sync.RWMutex
+	destroyIdleWarmContainersArgsForCall []struct {
+		arg1 time.Duration
+	}
+	destroyIdleWarmContainersReturns struct {
+		result1 int
+		result2 error
+	}
+	destroyIdleWarmContainersReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	DestroyUnknownContainersStub        func(string, []string) (int, error)
 	destroyUnknownContainersMutex       sync.RWMutex
 	destroyUnknownContainersArgsForCall []struct {
@@ -35,6 +62,23 @@ type FakeContainerRepository struct {
 		result1 int
 		result2 error
 	}
+	FindContainerOnDrainingWorkerStub        func(int, atc.PlanID, bool) (string, bool, error)
+	findContainerOnDrainingWorkerMutex       sync.RWMutex
+	findContainerOnDrainingWorkerArgsForCall []struct {
+		arg1 int
+		arg2 atc.PlanID
+		arg3 bool
+	}
+	findContainerOnDrainingWorkerReturns struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
+	findContainerOnDrainingWorkerReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
 	FindDestroyingContainersStub        func(string) ([]string, error)
 	findDestroyingContainersMutex       sync.RWMutex
 	findDestroyingContainersArgsForCall []struct {
@@ -107,6 +151,70 @@ type FakeContainerRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeContainerRepository) DestroyBuildContainersOnDrainingWorkers(arg1 int) (int, error) {
+	fake.destroyBuildContainersOnDrainingWorkersMutex.Lock()
+	ret, specificReturn := fake.destroyBuildContainersOnDrainingWorkersReturnsOnCall[len(fake.destroyBuildContainersOnDrainingWorkersArgsForCall)]
+	fake.destroyBuildContainersOnDrainingWorkersArgsForCall = append(fake.destroyBuildContainersOnDrainingWorkersArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.DestroyBuildContainersOnDrainingWorkersStub
+	fakeReturns := fake.destroyBuildContainersOnDrainingWorkersReturns
+	fake.recordInvocation("DestroyBuildContainersOnDrainingWorkers", []interface{}{arg1})
+	fake.destroyBuildContainersOnDrainingWorkersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerRepository) DestroyBuildContainersOnDrainingWorkersCallCount() int {
+	fake.destroyBuildContainersOnDrainingWorkersMutex.RLock()
+	defer fake.destroyBuildContainersOnDrainingWorkersMutex.RUnlock()
+	return len(fake.destroyBuildContainersOnDrainingWorkersArgsForCall)
+}
+
+func (fake *FakeContainerRepository) DestroyBuildContainersOnDrainingWorkersCalls(stub func(int) (int, error)) {
+	fake.destroyBuildContainersOnDrainingWorkersMutex.Lock()
+	defer fake.destroyBuildContainersOnDrainingWorkersMutex.Unlock()
+	fake.DestroyBuildContainersOnDrainingWorkersStub = stub
+}
+
+func (fake *FakeContainerRepository) DestroyBuildContainersOnDrainingWorkersArgsForCall(i int) int {
+	fake.destroyBuildContainersOnDrainingWorkersMutex.RLock()
+	defer fake.destroyBuildContainersOnDrainingWorkersMutex.RUnlock()
+	argsForCall := fake.destroyBuildContainersOnDrainingWorkersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeContainerRepository) DestroyBuildContainersOnDrainingWorkersReturns(result1 int, result2 error) {
+	fake.destroyBuildContainersOnDrainingWorkersMutex.Lock()
+	defer fake.destroyBuildContainersOnDrainingWorkersMutex.Unlock()
+	fake.DestroyBuildContainersOnDrainingWorkersStub = nil
+	fake.destroyBuildContainersOnDrainingWorkersReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRepository) DestroyBuildContainersOnDrainingWorkersReturnsOnCall(i int, result1 int, result2 error) {
+	fake.destroyBuildContainersOnDrainingWorkersMutex.Lock()
+	defer fake.destroyBuildContainersOnDrainingWorkersMutex.Unlock()
+	fake.DestroyBuildContainersOnDrainingWorkersStub = nil
+	if fake.destroyBuildContainersOnDrainingWorkersReturnsOnCall == nil {
+		fake.destroyBuildContainersOnDrainingWorkersReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.destroyBuildContainersOnDrainingWorkersReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeContainerRepository) DestroyFailedContainers() (int, error) {
 	fake.destroyFailedContainersMutex.Lock()
 	ret, specificReturn := fake.destroyFailedContainersReturnsOnCall[len(fake.destroyFailedContainersArgsForCall)]
@@ -163,6 +271,70 @@ func (fake *FakeContainerRepository) DestroyFailedContainersReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeContainerRepository) DestroyIdleWarmContainers(arg1 time.Duration) (int, error) {
+	fake.destroyIdleWarmContainersMutex.Lock()
+	ret, specificReturn := fake.destroyIdleWarmContainersReturnsOnCall[len(fake.destroyIdleWarmContainersArgsForCall)]
+	fake.destroyIdleWarmContainersArgsForCall = append(fake.destroyIdleWarmContainersArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	stub := fake.DestroyIdleWarmContainersStub
+	fakeReturns := fake.destroyIdleWarmContainersReturns
+	fake.recordInvocation("DestroyIdleWarmContainers", []interface{}{arg1})
+	fake.destroyIdleWarmContainersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerRepository) DestroyIdleWarmContainersCallCount() int {
+	fake.destroyIdleWarmContainersMutex.RLock()
+	defer fake.destroyIdleWarmContainersMutex.RUnlock()
+	return len(fake.destroyIdleWarmContainersArgsForCall)
+}
+
+func (fake *FakeContainerRepository) DestroyIdleWarmContainersCalls(stub func(time.Duration) (int, error)) {
+	fake.destroyIdleWarmContainersMutex.Lock()
+	defer fake.destroyIdleWarmContainersMutex.Unlock()
+	fake.DestroyIdleWarmContainersStub = stub
+}
+
+func (fake *FakeContainerRepository) DestroyIdleWarmContainersArgsForCall(i int) time.Duration {
+	fake.destroyIdleWarmContainersMutex.RLock()
+	defer fake.destroyIdleWarmContainersMutex.RUnlock()
+	argsForCall := fake.destroyIdleWarmContainersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeContainerRepository) DestroyIdleWarmContainersReturns(result1 int, result2 error) {
+	fake.destroyIdleWarmContainersMutex.Lock()
+	defer fake.destroyIdleWarmContainersMutex.Unlock()
+	fake.DestroyIdleWarmContainersStub = nil
+	fake.destroyIdleWarmContainersReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRepository) DestroyIdleWarmContainersReturnsOnCall(i int, result1 int, result2 error) {
+	fake.destroyIdleWarmContainersMutex.Lock()
+	defer fake.destroyIdleWarmContainersMutex.Unlock()
+	fake.DestroyIdleWarmContainersStub = nil
+	if fake.destroyIdleWarmContainersReturnsOnCall == nil {
+		fake.destroyIdleWarmContainersReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.destroyIdleWarmContainersReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeContainerRepository) DestroyUnknownContainers(arg1 string, arg2 []string) (int, error) {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -233,6 +405,75 @@ func (fake *FakeContainerRepository) DestroyUnknownContainersReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeContainerRepository) FindContainerOnDrainingWorker(arg1 int, arg2 atc.PlanID, arg3 bool) (string, bool, error) {
+	fake.findContainerOnDrainingWorkerMutex.Lock()
+	ret, specificReturn := fake.findContainerOnDrainingWorkerReturnsOnCall[len(fake.findContainerOnDrainingWorkerArgsForCall)]
+	fake.findContainerOnDrainingWorkerArgsForCall = append(fake.findContainerOnDrainingWorkerArgsForCall, struct {
+		arg1 int
+		arg2 atc.PlanID
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.FindContainerOnDrainingWorkerStub
+	fakeReturns := fake.findContainerOnDrainingWorkerReturns
+	fake.recordInvocation("FindContainerOnDrainingWorker", []interface{}{arg1, arg2, arg3})
+	fake.findContainerOnDrainingWorkerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContainerRepository) FindContainerOnDrainingWorkerCallCount() int {
+	fake.findContainerOnDrainingWorkerMutex.RLock()
+	defer fake.findContainerOnDrainingWorkerMutex.RUnlock()
+	return len(fake.findContainerOnDrainingWorkerArgsForCall)
+}
+
+func (fake *FakeContainerRepository) FindContainerOnDrainingWorkerCalls(stub func(int, atc.PlanID, bool) (string, bool, error)) {
+	fake.findContainerOnDrainingWorkerMutex.Lock()
+	defer fake.findContainerOnDrainingWorkerMutex.Unlock()
+	fake.FindContainerOnDrainingWorkerStub = stub
+}
+
+func (fake *FakeContainerRepository) FindContainerOnDrainingWorkerArgsForCall(i int) (int, atc.PlanID, bool) {
+	fake.findContainerOnDrainingWorkerMutex.RLock()
+	defer fake.findContainerOnDrainingWorkerMutex.RUnlock()
+	argsForCall := fake.findContainerOnDrainingWorkerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContainerRepository) FindContainerOnDrainingWorkerReturns(result1 string, result2 bool, result3 error) {
+	fake.findContainerOnDrainingWorkerMutex.Lock()
+	defer fake.findContainerOnDrainingWorkerMutex.Unlock()
+	fake.FindContainerOnDrainingWorkerStub = nil
+	fake.findContainerOnDrainingWorkerReturns = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContainerRepository) FindContainerOnDrainingWorkerReturnsOnCall(i int, result1 string, result2 bool, result3 error) {
+	fake.findContainerOnDrainingWorkerMutex.Lock()
+	defer fake.findContainerOnDrainingWorkerMutex.Unlock()
+	fake.FindContainerOnDrainingWorkerStub = nil
+	if fake.findContainerOnDrainingWorkerReturnsOnCall == nil {
+		fake.findContainerOnDrainingWorkerReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findContainerOnDrainingWorkerReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeContainerRepository) FindDestroyingContainers(arg1 string) ([]string, error) {
 	fake.findDestroyingContainersMutex.Lock()
 	ret, specificReturn := fake.findDestroyingContainersReturnsOnCall[len(fake.findDestroyingContainersArgsForCall)]
@@ -563,10 +804,16 @@ func (fake *FakeContainerRepository) UpdateContainersMissingSinceReturnsOnCall(i
 func (fake *FakeContainerRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.destroyBuildContainersOnDrainingWorkersMutex.RLock()
+	defer fake.destroyBuildContainersOnDrainingWorkersMutex.RUnlock()
 	fake.destroyFailedContainersMutex.RLock()
 	defer fake.destroyFailedContainersMutex.RUnlock()
+	fake.destroyIdleWarmContainersMutex.RLock()
+	defer fake.destroyIdleWarmContainersMutex.RUnlock()
 	fake.destroyUnknownContainersMutex.RLock()
 	defer fake.destroyUnknownContainersMutex.RUnlock()
+	fake.findContainerOnDrainingWorkerMutex.RLock()
+	defer fake.findContainerOnDrainingWorkerMutex.RUnlock()
 	fake.findDestroyingContainersMutex.RLock()
 	defer fake.findDestroyingContainersMutex.RUnlock()
 	fake.findOrphanedContainersMutex.RLock()
@@ -335,6 +335,30 @@ type FakeJob struct {
 	pipelineNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PipelineOutputBuildsStub        func() (map[string]int, error)
+	pipelineOutputBuildsMutex       sync.RWMutex
+	pipelineOutputBuildsArgsForCall []struct {
+	}
+	pipelineOutputBuildsReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	pipelineOutputBuildsReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
+	PipelineOutputTriggersStub        func() (map[string]int, error)
+	pipelineOutputTriggersMutex       sync.RWMutex
+	pipelineOutputTriggersArgsForCall []struct {
+	}
+	pipelineOutputTriggersReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	pipelineOutputTriggersReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
 	PipelineRefStub        func() atc.PipelineRef
 	pipelineRefMutex       sync.RWMutex
 	pipelineRefArgsForCall []struct {
@@ -403,6 +427,17 @@ type FakeJob struct {
 	saveNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SavePipelineOutputTriggersStub        func(map[string]int) error
+	savePipelineOutputTriggersMutex       sync.RWMutex
+	savePipelineOutputTriggersArgsForCall []struct {
+		arg1 map[string]int
+	}
+	savePipelineOutputTriggersReturns struct {
+		result1 error
+	}
+	savePipelineOutputTriggersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ScheduleBuildStub        func(db.Build) (bool, error)
 	scheduleBuildMutex       sync.RWMutex
 	scheduleBuildArgsForCall []struct {
@@ -2057,6 +2092,118 @@ func (fake *FakeJob) PipelineNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) PipelineOutputBuilds() (map[string]int, error) {
+	fake.pipelineOutputBuildsMutex.Lock()
+	ret, specificReturn := fake.pipelineOutputBuildsReturnsOnCall[len(fake.pipelineOutputBuildsArgsForCall)]
+	fake.pipelineOutputBuildsArgsForCall = append(fake.pipelineOutputBuildsArgsForCall, struct {
+	}{})
+	stub := fake.PipelineOutputBuildsStub
+	fakeReturns := fake.pipelineOutputBuildsReturns
+	fake.recordInvocation("PipelineOutputBuilds", []interface{}{})
+	fake.pipelineOutputBuildsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) PipelineOutputBuildsCallCount() int {
+	fake.pipelineOutputBuildsMutex.RLock()
+	defer fake.pipelineOutputBuildsMutex.RUnlock()
+	return len(fake.pipelineOutputBuildsArgsForCall)
+}
+
+func (fake *FakeJob) PipelineOutputBuildsCalls(stub func() (map[string]int, error)) {
+	fake.pipelineOutputBuildsMutex.Lock()
+	defer fake.pipelineOutputBuildsMutex.Unlock()
+	fake.PipelineOutputBuildsStub = stub
+}
+
+func (fake *FakeJob) PipelineOutputBuildsReturns(result1 map[string]int, result2 error) {
+	fake.pipelineOutputBuildsMutex.Lock()
+	defer fake.pipelineOutputBuildsMutex.Unlock()
+	fake.PipelineOutputBuildsStub = nil
+	fake.pipelineOutputBuildsReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) PipelineOutputBuildsReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.pipelineOutputBuildsMutex.Lock()
+	defer fake.pipelineOutputBuildsMutex.Unlock()
+	fake.PipelineOutputBuildsStub = nil
+	if fake.pipelineOutputBuildsReturnsOnCall == nil {
+		fake.pipelineOutputBuildsReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.pipelineOutputBuildsReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) PipelineOutputTriggers() (map[string]int, error) {
+	fake.pipelineOutputTriggersMutex.Lock()
+	ret, specificReturn := fake.pipelineOutputTriggersReturnsOnCall[len(fake.pipelineOutputTriggersArgsForCall)]
+	fake.pipelineOutputTriggersArgsForCall = append(fake.pipelineOutputTriggersArgsForCall, struct {
+	}{})
+	stub := fake.PipelineOutputTriggersStub
+	fakeReturns := fake.pipelineOutputTriggersReturns
+	fake.recordInvocation("PipelineOutputTriggers", []interface{}{})
+	fake.pipelineOutputTriggersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) PipelineOutputTriggersCallCount() int {
+	fake.pipelineOutputTriggersMutex.RLock()
+	defer fake.pipelineOutputTriggersMutex.RUnlock()
+	return len(fake.pipelineOutputTriggersArgsForCall)
+}
+
+func (fake *FakeJob) PipelineOutputTriggersCalls(stub func() (map[string]int, error)) {
+	fake.pipelineOutputTriggersMutex.Lock()
+	defer fake.pipelineOutputTriggersMutex.Unlock()
+	fake.PipelineOutputTriggersStub = stub
+}
+
+func (fake *FakeJob) PipelineOutputTriggersReturns(result1 map[string]int, result2 error) {
+	fake.pipelineOutputTriggersMutex.Lock()
+	defer fake.pipelineOutputTriggersMutex.Unlock()
+	fake.PipelineOutputTriggersStub = nil
+	fake.pipelineOutputTriggersReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) PipelineOutputTriggersReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.pipelineOutputTriggersMutex.Lock()
+	defer fake.pipelineOutputTriggersMutex.Unlock()
+	fake.PipelineOutputTriggersStub = nil
+	if fake.pipelineOutputTriggersReturnsOnCall == nil {
+		fake.pipelineOutputTriggersReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.pipelineOutputTriggersReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) PipelineRef() atc.PipelineRef {
 	fake.pipelineRefMutex.Lock()
 	ret, specificReturn := fake.pipelineRefReturnsOnCall[len(fake.pipelineRefArgsForCall)]
@@ -2399,6 +2546,67 @@ func (fake *FakeJob) SaveNextInputMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) SavePipelineOutputTriggers(arg1 map[string]int) error {
+	fake.savePipelineOutputTriggersMutex.Lock()
+	ret, specificReturn := fake.savePipelineOutputTriggersReturnsOnCall[len(fake.savePipelineOutputTriggersArgsForCall)]
+	fake.savePipelineOutputTriggersArgsForCall = append(fake.savePipelineOutputTriggersArgsForCall, struct {
+		arg1 map[string]int
+	}{arg1})
+	stub := fake.SavePipelineOutputTriggersStub
+	fakeReturns := fake.savePipelineOutputTriggersReturns
+	fake.recordInvocation("SavePipelineOutputTriggers", []interface{}{arg1})
+	fake.savePipelineOutputTriggersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) SavePipelineOutputTriggersCallCount() int {
+	fake.savePipelineOutputTriggersMutex.RLock()
+	defer fake.savePipelineOutputTriggersMutex.RUnlock()
+	return len(fake.savePipelineOutputTriggersArgsForCall)
+}
+
+func (fake *FakeJob) SavePipelineOutputTriggersCalls(stub func(map[string]int) error) {
+	fake.savePipelineOutputTriggersMutex.Lock()
+	defer fake.savePipelineOutputTriggersMutex.Unlock()
+	fake.SavePipelineOutputTriggersStub = stub
+}
+
+func (fake *FakeJob) SavePipelineOutputTriggersArgsForCall(i int) map[string]int {
+	fake.savePipelineOutputTriggersMutex.RLock()
+	defer fake.savePipelineOutputTriggersMutex.RUnlock()
+	argsForCall := fake.savePipelineOutputTriggersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) SavePipelineOutputTriggersReturns(result1 error) {
+	fake.savePipelineOutputTriggersMutex.Lock()
+	defer fake.savePipelineOutputTriggersMutex.Unlock()
+	fake.SavePipelineOutputTriggersStub = nil
+	fake.savePipelineOutputTriggersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) SavePipelineOutputTriggersReturnsOnCall(i int, result1 error) {
+	fake.savePipelineOutputTriggersMutex.Lock()
+	defer fake.savePipelineOutputTriggersMutex.Unlock()
+	fake.SavePipelineOutputTriggersStub = nil
+	if fake.savePipelineOutputTriggersReturnsOnCall == nil {
+		fake.savePipelineOutputTriggersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.savePipelineOutputTriggersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleBuild(arg1 db.Build) (bool, error) {
 	fake.scheduleBuildMutex.Lock()
 	ret, specificReturn := fake.scheduleBuildReturnsOnCall[len(fake.scheduleBuildArgsForCall)]
@@ -2968,6 +3176,10 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
+	fake.pipelineOutputBuildsMutex.RLock()
+	defer fake.pipelineOutputBuildsMutex.RUnlock()
+	fake.pipelineOutputTriggersMutex.RLock()
+	defer fake.pipelineOutputTriggersMutex.RUnlock()
 	fake.pipelineRefMutex.RLock()
 	defer fake.pipelineRefMutex.RUnlock()
 	fake.publicMutex.RLock()
@@ -2980,6 +3192,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.rerunBuildMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
+	fake.savePipelineOutputTriggersMutex.RLock()
+	defer fake.savePipelineOutputTriggersMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
 	defer fake.scheduleBuildMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
@@ -110,6 +110,18 @@ type FakePipeline struct {
 	configVersionReturnsOnCall map[int]struct {
 		result1 db.ConfigVersion
 	}
+	ConfigVersionsStub        func() ([]atc.PipelineConfigVersion, error)
+	configVersionsMutex       sync.RWMutex
+	configVersionsArgsForCall []struct {
+	}
+	configVersionsReturns struct {
+		result1 []atc.PipelineConfigVersion
+		result2 error
+	}
+	configVersionsReturnsOnCall map[int]struct {
+		result1 []atc.PipelineConfigVersion
+		result2 error
+	}
 	CreateOneOffBuildStub        func() (db.Build, error)
 	createOneOffBuildMutex       sync.RWMutex
 	createOneOffBuildArgsForCall []struct {
@@ -188,6 +200,21 @@ type FakePipeline struct {
 	exposeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FindConfigVersionStub        func(int) (atc.PipelineConfigVersion, bool, error)
+	findConfigVersionMutex       sync.RWMutex
+	findConfigVersionArgsForCall []struct {
+		arg1 int
+	}
+	findConfigVersionReturns struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	findConfigVersionReturnsOnCall map[int]struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
 	GetBuildsWithVersionAsInputStub        func(int, int) ([]db.Build, error)
 	getBuildsWithVersionAsInputMutex       sync.RWMutex
 	getBuildsWithVersionAsInputArgsForCall []struct {
@@ -500,6 +527,27 @@ type FakePipeline struct {
 	setParentIDsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ShareStub        func([]string) error
+	shareMutex       sync.RWMutex
+	shareArgsForCall []struct {
+		arg1 []string
+	}
+	shareReturns struct {
+		result1 error
+	}
+	shareReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SharedWithTeamsStub        func() []string
+	sharedWithTeamsMutex       sync.RWMutex
+	sharedWithTeamsArgsForCall []struct {
+	}
+	sharedWithTeamsReturns struct {
+		result1 []string
+	}
+	sharedWithTeamsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	TeamIDStub        func() int
 	teamIDMutex       sync.RWMutex
 	teamIDArgsForCall []struct {
@@ -1028,6 +1076,62 @@ func (fake *FakePipeline) ConfigVersionReturnsOnCall(i int, result1 db.ConfigVer
 	}{result1}
 }
 
+func (fake *FakePipeline) ConfigVersions() ([]atc.PipelineConfigVersion, error) {
+	fake.configVersionsMutex.Lock()
+	ret, specificReturn := fake.configVersionsReturnsOnCall[len(fake.configVersionsArgsForCall)]
+	fake.configVersionsArgsForCall = append(fake.configVersionsArgsForCall, struct {
+	}{})
+	stub := fake.ConfigVersionsStub
+	fakeReturns := fake.configVersionsReturns
+	fake.recordInvocation("ConfigVersions", []interface{}{})
+	fake.configVersionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) ConfigVersionsCallCount() int {
+	fake.configVersionsMutex.RLock()
+	defer fake.configVersionsMutex.RUnlock()
+	return len(fake.configVersionsArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigVersionsCalls(stub func() ([]atc.PipelineConfigVersion, error)) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = stub
+}
+
+func (fake *FakePipeline) ConfigVersionsReturns(result1 []atc.PipelineConfigVersion, result2 error) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = nil
+	fake.configVersionsReturns = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigVersionsReturnsOnCall(i int, result1 []atc.PipelineConfigVersion, result2 error) {
+	fake.configVersionsMutex.Lock()
+	defer fake.configVersionsMutex.Unlock()
+	fake.ConfigVersionsStub = nil
+	if fake.configVersionsReturnsOnCall == nil {
+		fake.configVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineConfigVersion
+			result2 error
+		})
+	}
+	fake.configVersionsReturnsOnCall[i] = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) CreateOneOffBuild() (db.Build, error) {
 	fake.createOneOffBuildMutex.Lock()
 	ret, specificReturn := fake.createOneOffBuildReturnsOnCall[len(fake.createOneOffBuildArgsForCall)]
@@ -1429,6 +1533,73 @@ func (fake *FakePipeline) ExposeReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) FindConfigVersion(arg1 int) (atc.PipelineConfigVersion, bool, error) {
+	fake.findConfigVersionMutex.Lock()
+	ret, specificReturn := fake.findConfigVersionReturnsOnCall[len(fake.findConfigVersionArgsForCall)]
+	fake.findConfigVersionArgsForCall = append(fake.findConfigVersionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.FindConfigVersionStub
+	fakeReturns := fake.findConfigVersionReturns
+	fake.recordInvocation("FindConfigVersion", []interface{}{arg1})
+	fake.findConfigVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) FindConfigVersionCallCount() int {
+	fake.findConfigVersionMutex.RLock()
+	defer fake.findConfigVersionMutex.RUnlock()
+	return len(fake.findConfigVersionArgsForCall)
+}
+
+func (fake *FakePipeline) FindConfigVersionCalls(stub func(int) (atc.PipelineConfigVersion, bool, error)) {
+	fake.findConfigVersionMutex.Lock()
+	defer fake.findConfigVersionMutex.Unlock()
+	fake.FindConfigVersionStub = stub
+}
+
+func (fake *FakePipeline) FindConfigVersionArgsForCall(i int) int {
+	fake.findConfigVersionMutex.RLock()
+	defer fake.findConfigVersionMutex.RUnlock()
+	argsForCall := fake.findConfigVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) FindConfigVersionReturns(result1 atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.findConfigVersionMutex.Lock()
+	defer fake.findConfigVersionMutex.Unlock()
+	fake.FindConfigVersionStub = nil
+	fake.findConfigVersionReturns = struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) FindConfigVersionReturnsOnCall(i int, result1 atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.findConfigVersionMutex.Lock()
+	defer fake.findConfigVersionMutex.Unlock()
+	fake.FindConfigVersionStub = nil
+	if fake.findConfigVersionReturnsOnCall == nil {
+		fake.findConfigVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findConfigVersionReturnsOnCall[i] = struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePipeline) GetBuildsWithVersionAsInput(arg1 int, arg2 int) ([]db.Build, error) {
 	fake.getBuildsWithVersionAsInputMutex.Lock()
 	ret, specificReturn := fake.getBuildsWithVersionAsInputReturnsOnCall[len(fake.getBuildsWithVersionAsInputArgsForCall)]
@@ -2942,6 +3113,125 @@ func (fake *FakePipeline) SetParentIDsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) Share(arg1 []string) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.shareMutex.Lock()
+	ret, specificReturn := fake.shareReturnsOnCall[len(fake.shareArgsForCall)]
+	fake.shareArgsForCall = append(fake.shareArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.ShareStub
+	fakeReturns := fake.shareReturns
+	fake.recordInvocation("Share", []interface{}{arg1Copy})
+	fake.shareMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ShareCallCount() int {
+	fake.shareMutex.RLock()
+	defer fake.shareMutex.RUnlock()
+	return len(fake.shareArgsForCall)
+}
+
+func (fake *FakePipeline) ShareCalls(stub func([]string) error) {
+	fake.shareMutex.Lock()
+	defer fake.shareMutex.Unlock()
+	fake.ShareStub = stub
+}
+
+func (fake *FakePipeline) ShareArgsForCall(i int) []string {
+	fake.shareMutex.RLock()
+	defer fake.shareMutex.RUnlock()
+	argsForCall := fake.shareArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) ShareReturns(result1 error) {
+	fake.shareMutex.Lock()
+	defer fake.shareMutex.Unlock()
+	fake.ShareStub = nil
+	fake.shareReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) ShareReturnsOnCall(i int, result1 error) {
+	fake.shareMutex.Lock()
+	defer fake.shareMutex.Unlock()
+	fake.ShareStub = nil
+	if fake.shareReturnsOnCall == nil {
+		fake.shareReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.shareReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) SharedWithTeams() []string {
+	fake.sharedWithTeamsMutex.Lock()
+	ret, specificReturn := fake.sharedWithTeamsReturnsOnCall[len(fake.sharedWithTeamsArgsForCall)]
+	fake.sharedWithTeamsArgsForCall = append(fake.sharedWithTeamsArgsForCall, struct {
+	}{})
+	stub := fake.SharedWithTeamsStub
+	fakeReturns := fake.sharedWithTeamsReturns
+	fake.recordInvocation("SharedWithTeams", []interface{}{})
+	fake.sharedWithTeamsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) SharedWithTeamsCallCount() int {
+	fake.sharedWithTeamsMutex.RLock()
+	defer fake.sharedWithTeamsMutex.RUnlock()
+	return len(fake.sharedWithTeamsArgsForCall)
+}
+
+func (fake *FakePipeline) SharedWithTeamsCalls(stub func() []string) {
+	fake.sharedWithTeamsMutex.Lock()
+	defer fake.sharedWithTeamsMutex.Unlock()
+	fake.SharedWithTeamsStub = stub
+}
+
+func (fake *FakePipeline) SharedWithTeamsReturns(result1 []string) {
+	fake.sharedWithTeamsMutex.Lock()
+	defer fake.sharedWithTeamsMutex.Unlock()
+	fake.SharedWithTeamsStub = nil
+	fake.sharedWithTeamsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakePipeline) SharedWithTeamsReturnsOnCall(i int, result1 []string) {
+	fake.sharedWithTeamsMutex.Lock()
+	defer fake.sharedWithTeamsMutex.Unlock()
+	fake.SharedWithTeamsStub = nil
+	if fake.sharedWithTeamsReturnsOnCall == nil {
+		fake.sharedWithTeamsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.sharedWithTeamsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakePipeline) TeamID() int {
 	fake.teamIDMutex.Lock()
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
@@ -3239,6 +3529,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.configVersionMutex.RLock()
 	defer fake.configVersionMutex.RUnlock()
+	fake.configVersionsMutex.RLock()
+	defer fake.configVersionsMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
@@ -3253,6 +3545,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.displayMutex.RUnlock()
 	fake.exposeMutex.RLock()
 	defer fake.exposeMutex.RUnlock()
+	fake.findConfigVersionMutex.RLock()
+	defer fake.findConfigVersionMutex.RUnlock()
 	fake.getBuildsWithVersionAsInputMutex.RLock()
 	defer fake.getBuildsWithVersionAsInputMutex.RUnlock()
 	fake.getBuildsWithVersionAsOutputMutex.RLock()
@@ -3305,6 +3599,10 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.resourcesMutex.RUnlock()
 	fake.setParentIDsMutex.RLock()
 	defer fake.setParentIDsMutex.RUnlock()
+	fake.shareMutex.RLock()
+	defer fake.shareMutex.RUnlock()
+	fake.sharedWithTeamsMutex.RLock()
+	defer fake.sharedWithTeamsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
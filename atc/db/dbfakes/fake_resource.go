@@ -413,6 +413,16 @@ type FakeResource struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TeamSharedStub        func() bool
+	teamSharedMutex       sync.RWMutex
+	teamSharedArgsForCall []struct {
+	}
+	teamSharedReturns struct {
+		result1 bool
+	}
+	teamSharedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	TypeStub        func() string
 	typeMutex       sync.RWMutex
 	typeArgsForCall []struct {
@@ -2544,6 +2554,59 @@ func (fake *FakeResource) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) TeamShared() bool {
+	fake.teamSharedMutex.Lock()
+	ret, specificReturn := fake.teamSharedReturnsOnCall[len(fake.teamSharedArgsForCall)]
+	fake.teamSharedArgsForCall = append(fake.teamSharedArgsForCall, struct {
+	}{})
+	stub := fake.TeamSharedStub
+	fakeReturns := fake.teamSharedReturns
+	fake.recordInvocation("TeamShared", []interface{}{})
+	fake.teamSharedMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) TeamSharedCallCount() int {
+	fake.teamSharedMutex.RLock()
+	defer fake.teamSharedMutex.RUnlock()
+	return len(fake.teamSharedArgsForCall)
+}
+
+func (fake *FakeResource) TeamSharedCalls(stub func() bool) {
+	fake.teamSharedMutex.Lock()
+	defer fake.teamSharedMutex.Unlock()
+	fake.TeamSharedStub = stub
+}
+
+func (fake *FakeResource) TeamSharedReturns(result1 bool) {
+	fake.teamSharedMutex.Lock()
+	defer fake.teamSharedMutex.Unlock()
+	fake.TeamSharedStub = nil
+	fake.teamSharedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeResource) TeamSharedReturnsOnCall(i int, result1 bool) {
+	fake.teamSharedMutex.Lock()
+	defer fake.teamSharedMutex.Unlock()
+	fake.TeamSharedStub = nil
+	if fake.teamSharedReturnsOnCall == nil {
+		fake.teamSharedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.teamSharedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeResource) Type() string {
 	fake.typeMutex.Lock()
 	ret, specificReturn := fake.typeReturnsOnCall[len(fake.typeArgsForCall)]
@@ -2916,6 +2979,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.teamSharedMutex.RLock()
+	defer fake.teamSharedMutex.RUnlock()
 	fake.typeMutex.RLock()
 	defer fake.typeMutex.RUnlock()
 	fake.unpinVersionMutex.RLock()
@@ -289,6 +289,16 @@ type FakeResourceType struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TeamSharedStub        func() bool
+	teamSharedMutex       sync.RWMutex
+	teamSharedArgsForCall []struct {
+	}
+	teamSharedReturns struct {
+		result1 bool
+	}
+	teamSharedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	TypeStub        func() string
 	typeMutex       sync.RWMutex
 	typeArgsForCall []struct {
@@ -1735,6 +1745,59 @@ func (fake *FakeResourceType) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) TeamShared() bool {
+	fake.teamSharedMutex.Lock()
+	ret, specificReturn := fake.teamSharedReturnsOnCall[len(fake.teamSharedArgsForCall)]
+	fake.teamSharedArgsForCall = append(fake.teamSharedArgsForCall, struct {
+	}{})
+	stub := fake.TeamSharedStub
+	fakeReturns := fake.teamSharedReturns
+	fake.recordInvocation("TeamShared", []interface{}{})
+	fake.teamSharedMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) TeamSharedCallCount() int {
+	fake.teamSharedMutex.RLock()
+	defer fake.teamSharedMutex.RUnlock()
+	return len(fake.teamSharedArgsForCall)
+}
+
+func (fake *FakeResourceType) TeamSharedCalls(stub func() bool) {
+	fake.teamSharedMutex.Lock()
+	defer fake.teamSharedMutex.Unlock()
+	fake.TeamSharedStub = stub
+}
+
+func (fake *FakeResourceType) TeamSharedReturns(result1 bool) {
+	fake.teamSharedMutex.Lock()
+	defer fake.teamSharedMutex.Unlock()
+	fake.TeamSharedStub = nil
+	fake.teamSharedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeResourceType) TeamSharedReturnsOnCall(i int, result1 bool) {
+	fake.teamSharedMutex.Lock()
+	defer fake.teamSharedMutex.Unlock()
+	fake.TeamSharedStub = nil
+	if fake.teamSharedReturnsOnCall == nil {
+		fake.teamSharedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.teamSharedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeResourceType) Type() string {
 	fake.typeMutex.Lock()
 	ret, specificReturn := fake.typeReturnsOnCall[len(fake.typeArgsForCall)]
@@ -1896,6 +1959,8 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.teamSharedMutex.RLock()
+	defer fake.teamSharedMutex.RUnlock()
 	fake.typeMutex.RLock()
 	defer fake.typeMutex.RUnlock()
 	fake.versionMutex.RLock()
@@ -229,6 +229,34 @@ type FakeTeam struct {
 		result1 []db.Worker
 		result2 error
 	}
+	GitOpsStub        func() (atc.GitOpsConfig, bool, error)
+	gitOpsMutex       sync.RWMutex
+	gitOpsArgsForCall []struct {
+	}
+	gitOpsReturns struct {
+		result1 atc.GitOpsConfig
+		result2 bool
+		result3 error
+	}
+	gitOpsReturnsOnCall map[int]struct {
+		result1 atc.GitOpsConfig
+		result2 bool
+		result3 error
+	}
+	GitOpsStatusStub        func() (atc.GitOpsStatus, bool, error)
+	gitOpsStatusMutex       sync.RWMutex
+	gitOpsStatusArgsForCall []struct {
+	}
+	gitOpsStatusReturns struct {
+		result1 atc.GitOpsStatus
+		result2 bool
+		result3 error
+	}
+	gitOpsStatusReturnsOnCall map[int]struct {
+		result1 atc.GitOpsStatus
+		result2 bool
+		result3 error
+	}
 	IDStub        func() int
 	iDMutex       sync.RWMutex
 	iDArgsForCall []struct {
@@ -276,6 +304,31 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(int) ([]atc.NotificationDelivery, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}
+	NotificationsStub        func() (atc.NotificationConfigs, error)
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct {
+	}
+	notificationsReturns struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
 	OrderPipelinesStub        func([]string) error
 	orderPipelinesMutex       sync.RWMutex
 	orderPipelinesArgsForCall []struct {
@@ -314,6 +367,24 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	PipelineOutputStub        func(string, string, string, int) (db.Build, bool, error)
+	pipelineOutputMutex       sync.RWMutex
+	pipelineOutputArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int
+	}
+	pipelineOutputReturns struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
+	pipelineOutputReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
 	PipelinesStub        func() ([]db.Pipeline, error)
 	pipelinesMutex       sync.RWMutex
 	pipelinesArgsForCall []struct {
@@ -378,13 +449,25 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	SaveGitOpsStatusStub        func(atc.GitOpsStatus) error
+	saveGitOpsStatusMutex       sync.RWMutex
+	saveGitOpsStatusArgsForCall []struct {
+		arg1 atc.GitOpsStatus
+	}
+	saveGitOpsStatusReturns struct {
+		result1 error
+	}
+	saveGitOpsStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
 		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
+		arg5 string
 	}
 	savePipelineReturns struct {
 		result1 db.Pipeline
@@ -410,6 +493,51 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SetGitOpsStub        func(*atc.GitOpsConfig) error
+	setGitOpsMutex       sync.RWMutex
+	setGitOpsArgsForCall []struct {
+		arg1 *atc.GitOpsConfig
+	}
+	setGitOpsReturns struct {
+		result1 error
+	}
+	setGitOpsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetNotificationsStub        func(atc.NotificationConfigs) error
+	setNotificationsMutex       sync.RWMutex
+	setNotificationsArgsForCall []struct {
+		arg1 atc.NotificationConfigs
+	}
+	setNotificationsReturns struct {
+		result1 error
+	}
+	setNotificationsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetTeamResourcesStub        func(atc.TeamResources) error
+	setTeamResourcesMutex       sync.RWMutex
+	setTeamResourcesArgsForCall []struct {
+		arg1 atc.TeamResources
+	}
+	setTeamResourcesReturns struct {
+		result1 error
+	}
+	setTeamResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TeamResourcesStub        func() (atc.TeamResources, error)
+	teamResourcesMutex       sync.RWMutex
+	teamResourcesArgsForCall []struct {
+	}
+	teamResourcesReturns struct {
+		result1 atc.TeamResources
+		result2 error
+	}
+	teamResourcesReturnsOnCall map[int]struct {
+		result1 atc.TeamResources
+		result2 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -1440,6 +1568,124 @@ func (fake *FakeTeam) FindWorkersForResourceCacheReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) GitOps() (atc.GitOpsConfig, bool, error) {
+	fake.gitOpsMutex.Lock()
+	ret, specificReturn := fake.gitOpsReturnsOnCall[len(fake.gitOpsArgsForCall)]
+	fake.gitOpsArgsForCall = append(fake.gitOpsArgsForCall, struct {
+	}{})
+	stub := fake.GitOpsStub
+	fakeReturns := fake.gitOpsReturns
+	fake.recordInvocation("GitOps", []interface{}{})
+	fake.gitOpsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) GitOpsCallCount() int {
+	fake.gitOpsMutex.RLock()
+	defer fake.gitOpsMutex.RUnlock()
+	return len(fake.gitOpsArgsForCall)
+}
+
+func (fake *FakeTeam) GitOpsCalls(stub func() (atc.GitOpsConfig, bool, error)) {
+	fake.gitOpsMutex.Lock()
+	defer fake.gitOpsMutex.Unlock()
+	fake.GitOpsStub = stub
+}
+
+func (fake *FakeTeam) GitOpsReturns(result1 atc.GitOpsConfig, result2 bool, result3 error) {
+	fake.gitOpsMutex.Lock()
+	defer fake.gitOpsMutex.Unlock()
+	fake.GitOpsStub = nil
+	fake.gitOpsReturns = struct {
+		result1 atc.GitOpsConfig
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) GitOpsReturnsOnCall(i int, result1 atc.GitOpsConfig, result2 bool, result3 error) {
+	fake.gitOpsMutex.Lock()
+	defer fake.gitOpsMutex.Unlock()
+	fake.GitOpsStub = nil
+	if fake.gitOpsReturnsOnCall == nil {
+		fake.gitOpsReturnsOnCall = make(map[int]struct {
+			result1 atc.GitOpsConfig
+			result2 bool
+			result3 error
+		})
+	}
+	fake.gitOpsReturnsOnCall[i] = struct {
+		result1 atc.GitOpsConfig
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) GitOpsStatus() (atc.GitOpsStatus, bool, error) {
+	fake.gitOpsStatusMutex.Lock()
+	ret, specificReturn := fake.gitOpsStatusReturnsOnCall[len(fake.gitOpsStatusArgsForCall)]
+	fake.gitOpsStatusArgsForCall = append(fake.gitOpsStatusArgsForCall, struct {
+	}{})
+	stub := fake.GitOpsStatusStub
+	fakeReturns := fake.gitOpsStatusReturns
+	fake.recordInvocation("GitOpsStatus", []interface{}{})
+	fake.gitOpsStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) GitOpsStatusCallCount() int {
+	fake.gitOpsStatusMutex.RLock()
+	defer fake.gitOpsStatusMutex.RUnlock()
+	return len(fake.gitOpsStatusArgsForCall)
+}
+
+func (fake *FakeTeam) GitOpsStatusCalls(stub func() (atc.GitOpsStatus, bool, error)) {
+	fake.gitOpsStatusMutex.Lock()
+	defer fake.gitOpsStatusMutex.Unlock()
+	fake.GitOpsStatusStub = stub
+}
+
+func (fake *FakeTeam) GitOpsStatusReturns(result1 atc.GitOpsStatus, result2 bool, result3 error) {
+	fake.gitOpsStatusMutex.Lock()
+	defer fake.gitOpsStatusMutex.Unlock()
+	fake.GitOpsStatusStub = nil
+	fake.gitOpsStatusReturns = struct {
+		result1 atc.GitOpsStatus
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) GitOpsStatusReturnsOnCall(i int, result1 atc.GitOpsStatus, result2 bool, result3 error) {
+	fake.gitOpsStatusMutex.Lock()
+	defer fake.gitOpsStatusMutex.Unlock()
+	fake.GitOpsStatusStub = nil
+	if fake.gitOpsStatusReturnsOnCall == nil {
+		fake.gitOpsStatusReturnsOnCall = make(map[int]struct {
+			result1 atc.GitOpsStatus
+			result2 bool
+			result3 error
+		})
+	}
+	fake.gitOpsStatusReturnsOnCall[i] = struct {
+		result1 atc.GitOpsStatus
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ID() int {
 	fake.iDMutex.Lock()
 	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
@@ -1675,6 +1921,126 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTeam) NotificationDeliveries(arg1 int) ([]atc.NotificationDelivery, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.NotificationDeliveriesStub
+	fakeReturns := fake.notificationDeliveriesReturns
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1})
+	fake.notificationDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCalls(stub func(int) ([]atc.NotificationDelivery, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) NotificationDeliveriesArgsForCall(i int) int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturns(result1 []atc.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) Notifications() (atc.NotificationConfigs, error) {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct {
+	}{})
+	stub := fake.NotificationsStub
+	fakeReturns := fake.notificationsReturns
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationsCalls(stub func() (atc.NotificationConfigs, error)) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = stub
+}
+
+func (fake *FakeTeam) NotificationsReturns(result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) NotificationsReturnsOnCall(i int, result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 atc.NotificationConfigs
+			result2 error
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) OrderPipelines(arg1 []string) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -1875,6 +2241,76 @@ func (fake *FakeTeam) PipelineReturnsOnCall(i int, result1 db.Pipeline, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) PipelineOutput(arg1 string, arg2 string, arg3 string, arg4 int) (db.Build, bool, error) {
+	fake.pipelineOutputMutex.Lock()
+	ret, specificReturn := fake.pipelineOutputReturnsOnCall[len(fake.pipelineOutputArgsForCall)]
+	fake.pipelineOutputArgsForCall = append(fake.pipelineOutputArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PipelineOutputStub
+	fakeReturns := fake.pipelineOutputReturns
+	fake.recordInvocation("PipelineOutput", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pipelineOutputMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineOutputCallCount() int {
+	fake.pipelineOutputMutex.RLock()
+	defer fake.pipelineOutputMutex.RUnlock()
+	return len(fake.pipelineOutputArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineOutputCalls(stub func(string, string, string, int) (db.Build, bool, error)) {
+	fake.pipelineOutputMutex.Lock()
+	defer fake.pipelineOutputMutex.Unlock()
+	fake.PipelineOutputStub = stub
+}
+
+func (fake *FakeTeam) PipelineOutputArgsForCall(i int) (string, string, string, int) {
+	fake.pipelineOutputMutex.RLock()
+	defer fake.pipelineOutputMutex.RUnlock()
+	argsForCall := fake.pipelineOutputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) PipelineOutputReturns(result1 db.Build, result2 bool, result3 error) {
+	fake.pipelineOutputMutex.Lock()
+	defer fake.pipelineOutputMutex.Unlock()
+	fake.PipelineOutputStub = nil
+	fake.pipelineOutputReturns = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineOutputReturnsOnCall(i int, result1 db.Build, result2 bool, result3 error) {
+	fake.pipelineOutputMutex.Lock()
+	defer fake.pipelineOutputMutex.Unlock()
+	fake.PipelineOutputStub = nil
+	if fake.pipelineOutputReturnsOnCall == nil {
+		fake.pipelineOutputReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineOutputReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Pipelines() ([]db.Pipeline, error) {
 	fake.pipelinesMutex.Lock()
 	ret, specificReturn := fake.pipelinesReturnsOnCall[len(fake.pipelinesArgsForCall)]
@@ -2180,7 +2616,68 @@ func (fake *FakeTeam) RenamePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) SaveGitOpsStatus(arg1 atc.GitOpsStatus) error {
+	fake.saveGitOpsStatusMutex.Lock()
+	ret, specificReturn := fake.saveGitOpsStatusReturnsOnCall[len(fake.saveGitOpsStatusArgsForCall)]
+	fake.saveGitOpsStatusArgsForCall = append(fake.saveGitOpsStatusArgsForCall, struct {
+		arg1 atc.GitOpsStatus
+	}{arg1})
+	stub := fake.SaveGitOpsStatusStub
+	fakeReturns := fake.saveGitOpsStatusReturns
+	fake.recordInvocation("SaveGitOpsStatus", []interface{}{arg1})
+	fake.saveGitOpsStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SaveGitOpsStatusCallCount() int {
+	fake.saveGitOpsStatusMutex.RLock()
+	defer fake.saveGitOpsStatusMutex.RUnlock()
+	return len(fake.saveGitOpsStatusArgsForCall)
+}
+
+func (fake *FakeTeam) SaveGitOpsStatusCalls(stub func(atc.GitOpsStatus) error) {
+	fake.saveGitOpsStatusMutex.Lock()
+	defer fake.saveGitOpsStatusMutex.Unlock()
+	fake.SaveGitOpsStatusStub = stub
+}
+
+func (fake *FakeTeam) SaveGitOpsStatusArgsForCall(i int) atc.GitOpsStatus {
+	fake.saveGitOpsStatusMutex.RLock()
+	defer fake.saveGitOpsStatusMutex.RUnlock()
+	argsForCall := fake.saveGitOpsStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SaveGitOpsStatusReturns(result1 error) {
+	fake.saveGitOpsStatusMutex.Lock()
+	defer fake.saveGitOpsStatusMutex.Unlock()
+	fake.SaveGitOpsStatusStub = nil
+	fake.saveGitOpsStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SaveGitOpsStatusReturnsOnCall(i int, result1 error) {
+	fake.saveGitOpsStatusMutex.Lock()
+	defer fake.saveGitOpsStatusMutex.Unlock()
+	fake.SaveGitOpsStatusStub = nil
+	if fake.saveGitOpsStatusReturnsOnCall == nil {
+		fake.saveGitOpsStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveGitOpsStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool, arg5 string) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
@@ -2188,13 +2685,14 @@ func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 d
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
-	}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.SavePipelineStub
 	fakeReturns := fake.savePipelineReturns
-	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.savePipelineMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -2208,17 +2706,17 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTeam) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
@@ -2315,6 +2813,245 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetGitOps(arg1 *atc.GitOpsConfig) error {
+	fake.setGitOpsMutex.Lock()
+	ret, specificReturn := fake.setGitOpsReturnsOnCall[len(fake.setGitOpsArgsForCall)]
+	fake.setGitOpsArgsForCall = append(fake.setGitOpsArgsForCall, struct {
+		arg1 *atc.GitOpsConfig
+	}{arg1})
+	stub := fake.SetGitOpsStub
+	fakeReturns := fake.setGitOpsReturns
+	fake.recordInvocation("SetGitOps", []interface{}{arg1})
+	fake.setGitOpsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetGitOpsCallCount() int {
+	fake.setGitOpsMutex.RLock()
+	defer fake.setGitOpsMutex.RUnlock()
+	return len(fake.setGitOpsArgsForCall)
+}
+
+func (fake *FakeTeam) SetGitOpsCalls(stub func(*atc.GitOpsConfig) error) {
+	fake.setGitOpsMutex.Lock()
+	defer fake.setGitOpsMutex.Unlock()
+	fake.SetGitOpsStub = stub
+}
+
+func (fake *FakeTeam) SetGitOpsArgsForCall(i int) *atc.GitOpsConfig {
+	fake.setGitOpsMutex.RLock()
+	defer fake.setGitOpsMutex.RUnlock()
+	argsForCall := fake.setGitOpsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetGitOpsReturns(result1 error) {
+	fake.setGitOpsMutex.Lock()
+	defer fake.setGitOpsMutex.Unlock()
+	fake.SetGitOpsStub = nil
+	fake.setGitOpsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetGitOpsReturnsOnCall(i int, result1 error) {
+	fake.setGitOpsMutex.Lock()
+	defer fake.setGitOpsMutex.Unlock()
+	fake.SetGitOpsStub = nil
+	if fake.setGitOpsReturnsOnCall == nil {
+		fake.setGitOpsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setGitOpsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetNotifications(arg1 atc.NotificationConfigs) error {
+	fake.setNotificationsMutex.Lock()
+	ret, specificReturn := fake.setNotificationsReturnsOnCall[len(fake.setNotificationsArgsForCall)]
+	fake.setNotificationsArgsForCall = append(fake.setNotificationsArgsForCall, struct {
+		arg1 atc.NotificationConfigs
+	}{arg1})
+	stub := fake.SetNotificationsStub
+	fakeReturns := fake.setNotificationsReturns
+	fake.recordInvocation("SetNotifications", []interface{}{arg1})
+	fake.setNotificationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetNotificationsCallCount() int {
+	fake.setNotificationsMutex.RLock()
+	defer fake.setNotificationsMutex.RUnlock()
+	return len(fake.setNotificationsArgsForCall)
+}
+
+func (fake *FakeTeam) SetNotificationsCalls(stub func(atc.NotificationConfigs) error) {
+	fake.setNotificationsMutex.Lock()
+	defer fake.setNotificationsMutex.Unlock()
+	fake.SetNotificationsStub = stub
+}
+
+func (fake *FakeTeam) SetNotificationsArgsForCall(i int) atc.NotificationConfigs {
+	fake.setNotificationsMutex.RLock()
+	defer fake.setNotificationsMutex.RUnlock()
+	argsForCall := fake.setNotificationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetNotificationsReturns(result1 error) {
+	fake.setNotificationsMutex.Lock()
+	defer fake.setNotificationsMutex.Unlock()
+	fake.SetNotificationsStub = nil
+	fake.setNotificationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetNotificationsReturnsOnCall(i int, result1 error) {
+	fake.setNotificationsMutex.Lock()
+	defer fake.setNotificationsMutex.Unlock()
+	fake.SetNotificationsStub = nil
+	if fake.setNotificationsReturnsOnCall == nil {
+		fake.setNotificationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setNotificationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetTeamResources(arg1 atc.TeamResources) error {
+	fake.setTeamResourcesMutex.Lock()
+	ret, specificReturn := fake.setTeamResourcesReturnsOnCall[len(fake.setTeamResourcesArgsForCall)]
+	fake.setTeamResourcesArgsForCall = append(fake.setTeamResourcesArgsForCall, struct {
+		arg1 atc.TeamResources
+	}{arg1})
+	stub := fake.SetTeamResourcesStub
+	fakeReturns := fake.setTeamResourcesReturns
+	fake.recordInvocation("SetTeamResources", []interface{}{arg1})
+	fake.setTeamResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetTeamResourcesCallCount() int {
+	fake.setTeamResourcesMutex.RLock()
+	defer fake.setTeamResourcesMutex.RUnlock()
+	return len(fake.setTeamResourcesArgsForCall)
+}
+
+func (fake *FakeTeam) SetTeamResourcesCalls(stub func(atc.TeamResources) error) {
+	fake.setTeamResourcesMutex.Lock()
+	defer fake.setTeamResourcesMutex.Unlock()
+	fake.SetTeamResourcesStub = stub
+}
+
+func (fake *FakeTeam) SetTeamResourcesArgsForCall(i int) atc.TeamResources {
+	fake.setTeamResourcesMutex.RLock()
+	defer fake.setTeamResourcesMutex.RUnlock()
+	argsForCall := fake.setTeamResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetTeamResourcesReturns(result1 error) {
+	fake.setTeamResourcesMutex.Lock()
+	defer fake.setTeamResourcesMutex.Unlock()
+	fake.SetTeamResourcesStub = nil
+	fake.setTeamResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetTeamResourcesReturnsOnCall(i int, result1 error) {
+	fake.setTeamResourcesMutex.Lock()
+	defer fake.setTeamResourcesMutex.Unlock()
+	fake.SetTeamResourcesStub = nil
+	if fake.setTeamResourcesReturnsOnCall == nil {
+		fake.setTeamResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setTeamResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) TeamResources() (atc.TeamResources, error) {
+	fake.teamResourcesMutex.Lock()
+	ret, specificReturn := fake.teamResourcesReturnsOnCall[len(fake.teamResourcesArgsForCall)]
+	fake.teamResourcesArgsForCall = append(fake.teamResourcesArgsForCall, struct {
+	}{})
+	stub := fake.TeamResourcesStub
+	fakeReturns := fake.teamResourcesReturns
+	fake.recordInvocation("TeamResources", []interface{}{})
+	fake.teamResourcesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) TeamResourcesCallCount() int {
+	fake.teamResourcesMutex.RLock()
+	defer fake.teamResourcesMutex.RUnlock()
+	return len(fake.teamResourcesArgsForCall)
+}
+
+func (fake *FakeTeam) TeamResourcesCalls(stub func() (atc.TeamResources, error)) {
+	fake.teamResourcesMutex.Lock()
+	defer fake.teamResourcesMutex.Unlock()
+	fake.TeamResourcesStub = stub
+}
+
+func (fake *FakeTeam) TeamResourcesReturns(result1 atc.TeamResources, result2 error) {
+	fake.teamResourcesMutex.Lock()
+	defer fake.teamResourcesMutex.Unlock()
+	fake.TeamResourcesStub = nil
+	fake.teamResourcesReturns = struct {
+		result1 atc.TeamResources
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) TeamResourcesReturnsOnCall(i int, result1 atc.TeamResources, result2 error) {
+	fake.teamResourcesMutex.Lock()
+	defer fake.teamResourcesMutex.Unlock()
+	fake.TeamResourcesStub = nil
+	if fake.teamResourcesReturnsOnCall == nil {
+		fake.teamResourcesReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamResources
+			result2 error
+		})
+	}
+	fake.teamResourcesReturnsOnCall[i] = struct {
+		result1 atc.TeamResources
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2467,6 +3204,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.findWorkerForVolumeMutex.RUnlock()
 	fake.findWorkersForResourceCacheMutex.RLock()
 	defer fake.findWorkersForResourceCacheMutex.RUnlock()
+	fake.gitOpsMutex.RLock()
+	defer fake.gitOpsMutex.RUnlock()
+	fake.gitOpsStatusMutex.RLock()
+	defer fake.gitOpsStatusMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
 	fake.isCheckContainerMutex.RLock()
@@ -2475,12 +3216,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.isContainerWithinTeamMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
 	defer fake.orderPipelinesMutex.RUnlock()
 	fake.orderPipelinesWithinGroupMutex.RLock()
 	defer fake.orderPipelinesWithinGroupMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
+	fake.pipelineOutputMutex.RLock()
+	defer fake.pipelineOutputMutex.RUnlock()
 	fake.pipelinesMutex.RLock()
 	defer fake.pipelinesMutex.RUnlock()
 	fake.privateAndPublicBuildsMutex.RLock()
@@ -2491,10 +3238,20 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.renameMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
+	fake.saveGitOpsStatusMutex.RLock()
+	defer fake.saveGitOpsStatusMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setGitOpsMutex.RLock()
+	defer fake.setGitOpsMutex.RUnlock()
+	fake.setNotificationsMutex.RLock()
+	defer fake.setNotificationsMutex.RUnlock()
+	fake.setTeamResourcesMutex.RLock()
+	defer fake.setTeamResourcesMutex.RUnlock()
+	fake.teamResourcesMutex.RLock()
+	defer fake.teamResourcesMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
 	fake.workersMutex.RLock()
@@ -9,12 +9,13 @@ import (
 )
 
 type FakeUserFactory struct {
-	CreateOrUpdateUserStub        func(string, string, string) error
+	CreateOrUpdateUserStub        func(string, string, string, []string) error
 	createOrUpdateUserMutex       sync.RWMutex
 	createOrUpdateUserArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 string
+		arg4 []string
 	}
 	createOrUpdateUserReturns struct {
 		result1 error
@@ -51,20 +52,26 @@ type FakeUserFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeUserFactory) CreateOrUpdateUser(arg1 string, arg2 string, arg3 string) error {
+func (fake *FakeUserFactory) CreateOrUpdateUser(arg1 string, arg2 string, arg3 string, arg4 []string) error {
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
 	fake.createOrUpdateUserMutex.Lock()
 	ret, specificReturn := fake.createOrUpdateUserReturnsOnCall[len(fake.createOrUpdateUserArgsForCall)]
 	fake.createOrUpdateUserArgsForCall = append(fake.createOrUpdateUserArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
+		arg4 []string
+	}{arg1, arg2, arg3, arg4Copy})
 	stub := fake.CreateOrUpdateUserStub
 	fakeReturns := fake.createOrUpdateUserReturns
-	fake.recordInvocation("CreateOrUpdateUser", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateOrUpdateUser", []interface{}{arg1, arg2, arg3, arg4Copy})
 	fake.createOrUpdateUserMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -78,17 +85,17 @@ func (fake *FakeUserFactory) CreateOrUpdateUserCallCount() int {
 	return len(fake.createOrUpdateUserArgsForCall)
 }
 
-func (fake *FakeUserFactory) CreateOrUpdateUserCalls(stub func(string, string, string) error) {
+func (fake *FakeUserFactory) CreateOrUpdateUserCalls(stub func(string, string, string, []string) error) {
 	fake.createOrUpdateUserMutex.Lock()
 	defer fake.createOrUpdateUserMutex.Unlock()
 	fake.CreateOrUpdateUserStub = stub
 }
 
-func (fake *FakeUserFactory) CreateOrUpdateUserArgsForCall(i int) (string, string, string) {
+func (fake *FakeUserFactory) CreateOrUpdateUserArgsForCall(i int) (string, string, string, []string) {
 	fake.createOrUpdateUserMutex.RLock()
 	defer fake.createOrUpdateUserMutex.RUnlock()
 	argsForCall := fake.createOrUpdateUserArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeUserFactory) CreateOrUpdateUserReturns(result1 error) {
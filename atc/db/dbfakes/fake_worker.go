@@ -62,6 +62,22 @@ type FakeWorker struct {
 	certsPathReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	ClaimWarmContainerStub        func(string, int, db.ContainerOwner, db.ContainerMetadata) (bool, error)
+	claimWarmContainerMutex       sync.RWMutex
+	claimWarmContainerArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 db.ContainerOwner
+		arg4 db.ContainerMetadata
+	}
+	claimWarmContainerReturns struct {
+		result1 bool
+		result2 error
+	}
+	claimWarmContainerReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	CreateContainerStub        func(db.ContainerOwner, db.ContainerMetadata) (db.CreatingContainer, error)
 	createContainerMutex       sync.RWMutex
 	createContainerArgsForCall []struct {
@@ -98,6 +114,26 @@ type FakeWorker struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DrainStub        func() error
+	drainMutex       sync.RWMutex
+	drainArgsForCall []struct {
+	}
+	drainReturns struct {
+		result1 error
+	}
+	drainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DrainingStub        func() bool
+	drainingMutex       sync.RWMutex
+	drainingArgsForCall []struct {
+	}
+	drainingReturns struct {
+		result1 bool
+	}
+	drainingReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	EphemeralStub        func() bool
 	ephemeralMutex       sync.RWMutex
 	ephemeralArgsForCall []struct {
@@ -185,6 +221,16 @@ type FakeWorker struct {
 	landReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MarkForScaleDownStub        func() error
+	markForScaleDownMutex       sync.RWMutex
+	markForScaleDownArgsForCall []struct {
+	}
+	markForScaleDownReturns struct {
+		result1 error
+	}
+	markForScaleDownReturnsOnCall map[int]struct {
+		result1 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -271,6 +317,16 @@ type FakeWorker struct {
 	retireReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ScaleDownCandidateStub        func() bool
+	scaleDownCandidateMutex       sync.RWMutex
+	scaleDownCandidateArgsForCall []struct {
+	}
+	scaleDownCandidateReturns struct {
+		result1 bool
+	}
+	scaleDownCandidateReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	StartTimeStub        func() time.Time
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -603,6 +659,73 @@ func (fake *FakeWorker) CertsPathReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ClaimWarmContainer(arg1 string, arg2 int, arg3 db.ContainerOwner, arg4 db.ContainerMetadata) (bool, error) {
+	fake.claimWarmContainerMutex.Lock()
+	ret, specificReturn := fake.claimWarmContainerReturnsOnCall[len(fake.claimWarmContainerArgsForCall)]
+	fake.claimWarmContainerArgsForCall = append(fake.claimWarmContainerArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 db.ContainerOwner
+		arg4 db.ContainerMetadata
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClaimWarmContainerStub
+	fakeReturns := fake.claimWarmContainerReturns
+	fake.recordInvocation("ClaimWarmContainer", []interface{}{arg1, arg2, arg3, arg4})
+	fake.claimWarmContainerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) ClaimWarmContainerCallCount() int {
+	fake.claimWarmContainerMutex.RLock()
+	defer fake.claimWarmContainerMutex.RUnlock()
+	return len(fake.claimWarmContainerArgsForCall)
+}
+
+func (fake *FakeWorker) ClaimWarmContainerCalls(stub func(string, int, db.ContainerOwner, db.ContainerMetadata) (bool, error)) {
+	fake.claimWarmContainerMutex.Lock()
+	defer fake.claimWarmContainerMutex.Unlock()
+	fake.ClaimWarmContainerStub = stub
+}
+
+func (fake *FakeWorker) ClaimWarmContainerArgsForCall(i int) (string, int, db.ContainerOwner, db.ContainerMetadata) {
+	fake.claimWarmContainerMutex.RLock()
+	defer fake.claimWarmContainerMutex.RUnlock()
+	argsForCall := fake.claimWarmContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeWorker) ClaimWarmContainerReturns(result1 bool, result2 error) {
+	fake.claimWarmContainerMutex.Lock()
+	defer fake.claimWarmContainerMutex.Unlock()
+	fake.ClaimWarmContainerStub = nil
+	fake.claimWarmContainerReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) ClaimWarmContainerReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.claimWarmContainerMutex.Lock()
+	defer fake.claimWarmContainerMutex.Unlock()
+	fake.ClaimWarmContainerStub = nil
+	if fake.claimWarmContainerReturnsOnCall == nil {
+		fake.claimWarmContainerReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.claimWarmContainerReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) CreateContainer(arg1 db.ContainerOwner, arg2 db.ContainerMetadata) (db.CreatingContainer, error) {
 	fake.createContainerMutex.Lock()
 	ret, specificReturn := fake.createContainerReturnsOnCall[len(fake.createContainerArgsForCall)]
@@ -777,6 +900,112 @@ func (fake *FakeWorker) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Drain() error {
+	fake.drainMutex.Lock()
+	ret, specificReturn := fake.drainReturnsOnCall[len(fake.drainArgsForCall)]
+	fake.drainArgsForCall = append(fake.drainArgsForCall, struct {
+	}{})
+	stub := fake.DrainStub
+	fakeReturns := fake.drainReturns
+	fake.recordInvocation("Drain", []interface{}{})
+	fake.drainMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) DrainCallCount() int {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	return len(fake.drainArgsForCall)
+}
+
+func (fake *FakeWorker) DrainCalls(stub func() error) {
+	fake.drainMutex.Lock()
+	defer fake.drainMutex.Unlock()
+	fake.DrainStub = stub
+}
+
+func (fake *FakeWorker) DrainReturns(result1 error) {
+	fake.drainMutex.Lock()
+	defer fake.drainMutex.Unlock()
+	fake.DrainStub = nil
+	fake.drainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) DrainReturnsOnCall(i int, result1 error) {
+	fake.drainMutex.Lock()
+	defer fake.drainMutex.Unlock()
+	fake.DrainStub = nil
+	if fake.drainReturnsOnCall == nil {
+		fake.drainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.drainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) Draining() bool {
+	fake.drainingMutex.Lock()
+	ret, specificReturn := fake.drainingReturnsOnCall[len(fake.drainingArgsForCall)]
+	fake.drainingArgsForCall = append(fake.drainingArgsForCall, struct {
+	}{})
+	stub := fake.DrainingStub
+	fakeReturns := fake.drainingReturns
+	fake.recordInvocation("Draining", []interface{}{})
+	fake.drainingMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) DrainingCallCount() int {
+	fake.drainingMutex.RLock()
+	defer fake.drainingMutex.RUnlock()
+	return len(fake.drainingArgsForCall)
+}
+
+func (fake *FakeWorker) DrainingCalls(stub func() bool) {
+	fake.drainingMutex.Lock()
+	defer fake.drainingMutex.Unlock()
+	fake.DrainingStub = stub
+}
+
+func (fake *FakeWorker) DrainingReturns(result1 bool) {
+	fake.drainingMutex.Lock()
+	defer fake.drainingMutex.Unlock()
+	fake.DrainingStub = nil
+	fake.drainingReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) DrainingReturnsOnCall(i int, result1 bool) {
+	fake.drainingMutex.Lock()
+	defer fake.drainingMutex.Unlock()
+	fake.DrainingStub = nil
+	if fake.drainingReturnsOnCall == nil {
+		fake.drainingReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.drainingReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) Ephemeral() bool {
 	fake.ephemeralMutex.Lock()
 	ret, specificReturn := fake.ephemeralReturnsOnCall[len(fake.ephemeralArgsForCall)]
@@ -1218,6 +1447,59 @@ func (fake *FakeWorker) LandReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) MarkForScaleDown() error {
+	fake.markForScaleDownMutex.Lock()
+	ret, specificReturn := fake.markForScaleDownReturnsOnCall[len(fake.markForScaleDownArgsForCall)]
+	fake.markForScaleDownArgsForCall = append(fake.markForScaleDownArgsForCall, struct {
+	}{})
+	stub := fake.MarkForScaleDownStub
+	fakeReturns := fake.markForScaleDownReturns
+	fake.recordInvocation("MarkForScaleDown", []interface{}{})
+	fake.markForScaleDownMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) MarkForScaleDownCallCount() int {
+	fake.markForScaleDownMutex.RLock()
+	defer fake.markForScaleDownMutex.RUnlock()
+	return len(fake.markForScaleDownArgsForCall)
+}
+
+func (fake *FakeWorker) MarkForScaleDownCalls(stub func() error) {
+	fake.markForScaleDownMutex.Lock()
+	defer fake.markForScaleDownMutex.Unlock()
+	fake.MarkForScaleDownStub = stub
+}
+
+func (fake *FakeWorker) MarkForScaleDownReturns(result1 error) {
+	fake.markForScaleDownMutex.Lock()
+	defer fake.markForScaleDownMutex.Unlock()
+	fake.MarkForScaleDownStub = nil
+	fake.markForScaleDownReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) MarkForScaleDownReturnsOnCall(i int, result1 error) {
+	fake.markForScaleDownMutex.Lock()
+	defer fake.markForScaleDownMutex.Unlock()
+	fake.MarkForScaleDownStub = nil
+	if fake.markForScaleDownReturnsOnCall == nil {
+		fake.markForScaleDownReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markForScaleDownReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1651,6 +1933,59 @@ func (fake *FakeWorker) RetireReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ScaleDownCandidate() bool {
+	fake.scaleDownCandidateMutex.Lock()
+	ret, specificReturn := fake.scaleDownCandidateReturnsOnCall[len(fake.scaleDownCandidateArgsForCall)]
+	fake.scaleDownCandidateArgsForCall = append(fake.scaleDownCandidateArgsForCall, struct {
+	}{})
+	stub := fake.ScaleDownCandidateStub
+	fakeReturns := fake.scaleDownCandidateReturns
+	fake.recordInvocation("ScaleDownCandidate", []interface{}{})
+	fake.scaleDownCandidateMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ScaleDownCandidateCallCount() int {
+	fake.scaleDownCandidateMutex.RLock()
+	defer fake.scaleDownCandidateMutex.RUnlock()
+	return len(fake.scaleDownCandidateArgsForCall)
+}
+
+func (fake *FakeWorker) ScaleDownCandidateCalls(stub func() bool) {
+	fake.scaleDownCandidateMutex.Lock()
+	defer fake.scaleDownCandidateMutex.Unlock()
+	fake.ScaleDownCandidateStub = stub
+}
+
+func (fake *FakeWorker) ScaleDownCandidateReturns(result1 bool) {
+	fake.scaleDownCandidateMutex.Lock()
+	defer fake.scaleDownCandidateMutex.Unlock()
+	fake.ScaleDownCandidateStub = nil
+	fake.scaleDownCandidateReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) ScaleDownCandidateReturnsOnCall(i int, result1 bool) {
+	fake.scaleDownCandidateMutex.Lock()
+	defer fake.scaleDownCandidateMutex.Unlock()
+	fake.ScaleDownCandidateStub = nil
+	if fake.scaleDownCandidateReturnsOnCall == nil {
+		fake.scaleDownCandidateReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.scaleDownCandidateReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) StartTime() time.Time {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
@@ -1982,12 +2317,18 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.baggageclaimURLMutex.RUnlock()
 	fake.certsPathMutex.RLock()
 	defer fake.certsPathMutex.RUnlock()
+	fake.claimWarmContainerMutex.RLock()
+	defer fake.claimWarmContainerMutex.RUnlock()
 	fake.createContainerMutex.RLock()
 	defer fake.createContainerMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
 	defer fake.decreaseActiveTasksMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	fake.drainingMutex.RLock()
+	defer fake.drainingMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
 	defer fake.ephemeralMutex.RUnlock()
 	fake.expiresAtMutex.RLock()
@@ -2004,6 +2345,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.increaseActiveTasksMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
+	fake.markForScaleDownMutex.RLock()
+	defer fake.markForScaleDownMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.noProxyMutex.RLock()
@@ -2020,6 +2363,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
+	fake.scaleDownCandidateMutex.RLock()
+	defer fake.scaleDownCandidateMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.stateMutex.RLock()
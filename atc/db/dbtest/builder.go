@@ -112,7 +112,7 @@ func (builder Builder) WithPipeline(config atc.Config) SetupFunc {
 			from = scenario.Pipeline.ConfigVersion()
 		}
 
-		p, _, err := scenario.Team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, from, false)
+		p, _, err := scenario.Team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, from, false, "")
 		if err != nil {
 			return err
 		}
@@ -12,6 +12,8 @@ const (
 	LatestVersionNotFound ResolutionFailure = "latest version of resource not found"
 	VersionNotFound       ResolutionFailure = "version of resource not found"
 	NoSatisfiableBuilds   ResolutionFailure = "no satisfiable builds from passed jobs found for set of inputs"
+
+	NoVersionsPassedPipelineJob ResolutionFailure = "no versions of resource passed the job of the other pipeline"
 )
 
 type PinnedVersionNotFound struct {
@@ -26,6 +28,14 @@ func (p PinnedVersionNotFound) String() ResolutionFailure {
 	return ResolutionFailure(fmt.Sprintf("pinned version%s not found", text))
 }
 
+type PassedPipelineJobNotFound struct {
+	PassedPipelineJob atc.PassedPipelineJob
+}
+
+func (p PassedPipelineJobNotFound) String() ResolutionFailure {
+	return ResolutionFailure(fmt.Sprintf("passed pipeline job '%s' not found", p.PassedPipelineJob))
+}
+
 type JobSet map[int]bool
 
 type InputMapping map[string]InputResult
@@ -28,6 +28,12 @@ type InputConfig struct {
 	PinnedVersion   atc.Version
 	ResourceID      int
 	JobID           int
+
+	// PassedPipelineJob is the job of another pipeline which the versions must
+	// have passed through. PassedPipelineJobID is zero when that job does not
+	// exist or its pipeline is not shared with the team.
+	PassedPipelineJob   *atc.PassedPipelineJob
+	PassedPipelineJobID int
 }
 
 func (cfgs InputConfigs) String() string {
@@ -96,6 +102,10 @@ type Job interface {
 	GetFullNextBuildInputs() ([]BuildInput, bool, error)
 	SaveNextInputMapping(inputMapping InputMapping, inputsDetermined bool) error
 
+	PipelineOutputTriggers() (map[string]int, error)
+	PipelineOutputBuilds() (map[string]int, error)
+	SavePipelineOutputTriggers(map[string]int) error
+
 	ClearTaskCache(string, string) (int64, error)
 
 	AcquireSchedulingLock(lager.Logger) (lock.Lock, bool, error)
@@ -223,13 +233,30 @@ func (j *job) Config() (atc.JobConfig, error) {
 }
 
 func (j *job) AlgorithmInputs() (InputConfigs, error) {
-	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger").
+	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger", "ji.passed_pipeline_team_name", "ji.passed_pipeline_name", "ji.passed_pipeline_job_name", "pj.id").
 		From("job_inputs ji").
 		LeftJoin("resource_pins rp ON rp.resource_id = ji.resource_id").
+		JoinClause(`LEFT JOIN LATERAL (
+			SELECT uj.id
+			FROM jobs uj
+			JOIN pipelines p ON p.id = uj.pipeline_id
+			JOIN teams ut ON ut.id = p.team_id
+			WHERE ut.name = ji.passed_pipeline_team_name
+			AND p.name = ji.passed_pipeline_name
+			AND p.instance_vars IS NULL
+			AND uj.name = ji.passed_pipeline_job_name
+			AND uj.active
+			AND (p.team_id = ? OR EXISTS (
+				SELECT 1
+				FROM pipeline_shares ps
+				WHERE ps.pipeline_id = p.id
+				AND ps.team_id = ?
+			))
+		) pj ON true`, j.teamID, j.teamID).
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
-		GroupBy("ji.name, ji.job_id, ji.resource_id, ji.version, rp.version, ji.trigger").
+		GroupBy("ji.name, ji.job_id, ji.resource_id, ji.version, rp.version, ji.trigger, ji.passed_pipeline_team_name, ji.passed_pipeline_name, ji.passed_pipeline_job_name, pj.id").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
@@ -240,11 +267,13 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 	for rows.Next() {
 		var passedJobs []sql.NullInt64
 		var configVersionString, pinnedVersionString sql.NullString
+		var passedTeam, passedPipeline, passedJob sql.NullString
+		var passedPipelineJobID sql.NullInt64
 		var inputName string
 		var resourceID int
 		var trigger bool
 
-		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger)
+		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger, &passedTeam, &passedPipeline, &passedJob, &passedPipelineJobID)
 		if err != nil {
 			return nil, err
 		}
@@ -256,6 +285,15 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 			Trigger:    trigger,
 		}
 
+		if passedJob.Valid {
+			inputConfig.PassedPipelineJob = &atc.PassedPipelineJob{
+				Team:     passedTeam.String,
+				Pipeline: passedPipeline.String,
+				Job:      passedJob.String,
+			}
+			inputConfig.PassedPipelineJobID = int(passedPipelineJobID.Int64)
+		}
+
 		if pinnedVersionString.Valid {
 			err = json.Unmarshal([]byte(pinnedVersionString.String), &inputConfig.PinnedVersion)
 			if err != nil {
@@ -296,14 +334,14 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 }
 
 func (j *job) Inputs() ([]atc.JobInput, error) {
-	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "ji.trigger", "ji.version").
+	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "ji.trigger", "ji.version", "ji.passed_pipeline_team_name", "ji.passed_pipeline_name", "ji.passed_pipeline_job_name").
 		From("job_inputs ji").
 		Join("resources r ON r.id = ji.resource_id").
 		LeftJoin("jobs p ON p.id = ji.passed_job_id").
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
-		GroupBy("ji.name, ji.job_id, r.name, ji.trigger, ji.version").
+		GroupBy("ji.name, ji.job_id, r.name, ji.trigger, ji.version, ji.passed_pipeline_team_name, ji.passed_pipeline_name, ji.passed_pipeline_job_name").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
@@ -314,10 +352,11 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 	for rows.Next() {
 		var passedString []sql.NullString
 		var versionString sql.NullString
+		var passedTeam, passedPipeline, passedJob sql.NullString
 		var inputName, resourceName string
 		var trigger bool
 
-		err = rows.Scan(&inputName, &resourceName, pq.Array(&passedString), &trigger, &versionString)
+		err = rows.Scan(&inputName, &resourceName, pq.Array(&passedString), &trigger, &versionString, &passedTeam, &passedPipeline, &passedJob)
 		if err != nil {
 			return nil, err
 		}
@@ -338,12 +377,22 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 			}
 		}
 
+		var passedPipelineJob string
+		if passedJob.Valid {
+			passedPipelineJob = atc.PassedPipelineJob{
+				Team:     passedTeam.String,
+				Pipeline: passedPipeline.String,
+				Job:      passedJob.String,
+			}.String()
+		}
+
 		inputs = append(inputs, atc.JobInput{
-			Name:     inputName,
-			Resource: resourceName,
-			Trigger:  trigger,
-			Version:  version,
-			Passed:   passed,
+			Name:           inputName,
+			Resource:       resourceName,
+			Trigger:        trigger,
+			Version:        version,
+			Passed:         passed,
+			PassedPipeline: passedPipelineJob,
 		})
 	}
 
@@ -1089,10 +1138,17 @@ func (j *job) getNextPendingBuildBySerialGroup(tx Tx, serialGroups []string) (Bu
 }
 
 func (j *job) updatePausedJob(pause bool) error {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
 	result, err := psql.Update("jobs").
 		Set("paused", pause).
 		Where(sq.Eq{"id": j.id}).
-		RunWith(j.conn).
+		RunWith(tx).
 		Exec()
 	if err != nil {
 		return err
@@ -1107,6 +1163,16 @@ func (j *job) updatePausedJob(pause bool) error {
 		return NonOneRowAffectedError{rowsAffected}
 	}
 
+	err = saveJobStatusEvent(tx, j, pause)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
 	if !pause {
 		err = j.RequestSchedule()
 		if err != nil {
@@ -1448,13 +1514,27 @@ func requestSchedule(tx Tx, jobID int) error {
 // The SELECT query orders the jobs for updating to prevent deadlocking.
 // Updating multiple rows using a SELECT subquery does not preserve the same
 // order for the updates, which can lead to deadlocking.
+//
+// Jobs of other pipelines which reference the job through passed_pipeline are
+// downstream jobs too.
 func requestScheduleOnDownstreamJobs(tx Tx, jobID int) error {
-	rows, err := psql.Select("DISTINCT job_id").
-		From("job_inputs").
-		Where(sq.Eq{
-			"passed_job_id": jobID,
+	rows, err := psql.Select("DISTINCT ji.job_id").
+		From("job_inputs ji").
+		Join("jobs uj ON uj.id = ?", jobID).
+		Join("pipelines up ON up.id = uj.pipeline_id").
+		Join("teams ut ON ut.id = up.team_id").
+		Where(sq.Or{
+			sq.Eq{
+				"ji.passed_job_id": jobID,
+			},
+			sq.And{
+				sq.Expr("ji.passed_pipeline_team_name = ut.name"),
+				sq.Expr("ji.passed_pipeline_name = up.name"),
+				sq.Expr("ji.passed_pipeline_job_name = uj.name"),
+				sq.Eq{"up.instance_vars": nil},
+			},
 		}).
-		OrderBy("job_id DESC").
+		OrderBy("ji.job_id DESC").
 		RunWith(tx).
 		Query()
 	if err != nil {
@@ -235,6 +235,7 @@ func (j *jobFactory) VisibleJobs(teamNames []string) ([]atc.JobSummary, error) {
 	dashboardFactory := newDashboardFactory(tx, sq.Or{
 		sq.Eq{"tm.name": teamNames},
 		sq.Eq{"p.public": true},
+		sharedWithTeams(teamNames),
 	})
 
 	dashboard, err := dashboardFactory.buildDashboard()
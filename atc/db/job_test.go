@@ -100,7 +100,7 @@ var _ = Describe("Job", func() {
 					Type: "some-type",
 				},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -217,7 +217,7 @@ var _ = Describe("Job", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -445,7 +445,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -753,7 +753,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -838,7 +838,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -873,7 +873,7 @@ var _ = Describe("Job", func() {
 								Name: "some-job",
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -1686,7 +1686,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild(defaultBuildCreatedBy)
@@ -2212,6 +2212,90 @@ var _ = Describe("Job", func() {
 			})
 		})
 
+		Context("when an input passed a job of another pipeline", func() {
+			var upstream *dbtest.Scenario
+
+			BeforeEach(func() {
+				upstream = dbtest.Setup(
+					builder.WithPipeline(atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name: "upstream-job",
+							},
+						},
+					}),
+				)
+
+				scenario = dbtest.Setup(
+					builder.WithPipeline(atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name: "some-job",
+								PlanSequence: []atc.Step{
+									{
+										Config: &atc.GetStep{
+											Name:           "some-input",
+											Resource:       "some-resource",
+											PassedPipeline: upstream.Team.Name() + "/some-pipeline/upstream-job",
+											Trigger:        true,
+										},
+									},
+								},
+							},
+						},
+						Resources: atc.ResourceConfigs{
+							{
+								Name: "some-resource",
+								Type: "some-type",
+							},
+						},
+					}),
+				)
+			})
+
+			Context("when the other pipeline is not shared with the team", func() {
+				It("does not resolve the job", func() {
+					Expect(inputs).To(Equal(db.InputConfigs{
+						{
+							Name:       "some-input",
+							JobID:      scenario.Job("some-job").ID(),
+							ResourceID: scenario.Resource("some-resource").ID(),
+							Trigger:    true,
+							PassedPipelineJob: &atc.PassedPipelineJob{
+								Team:     upstream.Team.Name(),
+								Pipeline: "some-pipeline",
+								Job:      "upstream-job",
+							},
+						},
+					}))
+				})
+			})
+
+			Context("when the other pipeline is shared with the team", func() {
+				BeforeEach(func() {
+					err := upstream.Pipeline.Share([]string{scenario.Team.Name()})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("resolves the job", func() {
+					Expect(inputs).To(Equal(db.InputConfigs{
+						{
+							Name:       "some-input",
+							JobID:      scenario.Job("some-job").ID(),
+							ResourceID: scenario.Resource("some-resource").ID(),
+							Trigger:    true,
+							PassedPipelineJob: &atc.PassedPipelineJob{
+								Team:     upstream.Team.Name(),
+								Pipeline: "some-pipeline",
+								Job:      "upstream-job",
+							},
+							PassedPipelineJobID: upstream.Job("upstream-job").ID(),
+						},
+					}))
+				})
+			})
+		})
+
 		Context("when the input is pinned through the get step", func() {
 			BeforeEach(func() {
 				scenario = dbtest.Setup(
@@ -2572,7 +2656,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2677,7 +2761,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
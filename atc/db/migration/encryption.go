@@ -9,19 +9,25 @@ import (
 )
 
 var encryptedColumns = []encryptedColumn{
-	{"teams", "legacy_auth", "id"},
-	{"resources", "config", "id"},
-	{"jobs", "config", "id"},
-	{"resource_types", "config", "id"},
-	{"prototypes", "config", "id"},
-	{"builds", "private_plan", "id"},
-	{"cert_cache", "cert", "domain"},
-	{"pipelines", "var_sources", "id"},
+	{"teams", "legacy_auth", "nonce", "id"},
+	{"resources", "config", "nonce", "id"},
+	{"jobs", "config", "nonce", "id"},
+	{"resource_types", "config", "nonce", "id"},
+	{"prototypes", "config", "nonce", "id"},
+	{"builds", "private_plan", "nonce", "id"},
+	{"cert_cache", "cert", "nonce", "domain"},
+	{"pipelines", "var_sources", "nonce", "id"},
+	{"team_gitops", "config", "nonce", "team_id"},
+	{"pipeline_config_versions", "config", "nonce", "id"},
+	{"pipeline_config_versions", "changes", "changes_nonce", "id"},
+	{"team_notifications", "config", "nonce", "team_id"},
+	{"team_resources", "config", "nonce", "team_id"},
 }
 
 type encryptedColumn struct {
 	Table      string
 	Column     string
+	Nonce      string
 	PrimaryKey string
 }
 
@@ -31,7 +37,7 @@ func (m migrator) encryptPlaintext(key *encryption.Key) error {
 		rows, err := m.db.Query(`
 			SELECT ` + ec.PrimaryKey + `, ` + ec.Column + `
 			FROM ` + ec.Table + `
-			WHERE nonce IS NULL
+			WHERE ` + ec.Nonce + ` IS NULL
 			AND ` + ec.Column + ` IS NOT NULL
 		`)
 		if err != nil {
@@ -72,7 +78,7 @@ func (m migrator) encryptPlaintext(key *encryption.Key) error {
 
 			_, err = m.db.Exec(`
 				UPDATE `+ec.Table+`
-				SET `+ec.Column+` = $1, nonce = $2
+				SET `+ec.Column+` = $1, `+ec.Nonce+` = $2
 				WHERE `+ec.PrimaryKey+` = $3
 			`, encrypted, nonce, primaryKey)
 			if err != nil {
@@ -97,9 +103,9 @@ func (m migrator) decryptToPlaintext(oldKey *encryption.Key) error {
 	logger := m.logger.Session("decrypt")
 	for _, ec := range encryptedColumns {
 		rows, err := m.db.Query(`
-			SELECT ` + ec.PrimaryKey + `, nonce, ` + ec.Column + `
+			SELECT ` + ec.PrimaryKey + `, ` + ec.Nonce + `, ` + ec.Column + `
 			FROM ` + ec.Table + `
-			WHERE nonce IS NOT NULL
+			WHERE ` + ec.Nonce + ` IS NOT NULL
 		`)
 		if err != nil {
 			return err
@@ -135,7 +141,7 @@ func (m migrator) decryptToPlaintext(oldKey *encryption.Key) error {
 
 			_, err = m.db.Exec(`
 				UPDATE `+ec.Table+`
-				SET `+ec.Column+` = $1, nonce = NULL
+				SET `+ec.Column+` = $1, `+ec.Nonce+` = NULL
 				WHERE `+ec.PrimaryKey+` = $2
 			`, decrypted, primaryKey)
 			if err != nil {
@@ -162,9 +168,9 @@ func (m migrator) encryptWithNewKey(newKey *encryption.Key, oldKey *encryption.K
 	logger := m.logger.Session("rotate")
 	for _, ec := range encryptedColumns {
 		rows, err := m.db.Query(`
-			SELECT ` + ec.PrimaryKey + `, nonce, ` + ec.Column + `
+			SELECT ` + ec.PrimaryKey + `, ` + ec.Nonce + `, ` + ec.Column + `
 			FROM ` + ec.Table + `
-			WHERE nonce IS NOT NULL
+			WHERE ` + ec.Nonce + ` IS NOT NULL
 		`)
 		if err != nil {
 			return err
@@ -212,7 +218,7 @@ func (m migrator) encryptWithNewKey(newKey *encryption.Key, oldKey *encryption.K
 
 			_, err = m.db.Exec(`
 				UPDATE `+ec.Table+`
-				SET `+ec.Column+` = $1, nonce = $2
+				SET `+ec.Column+` = $1, `+ec.Nonce+` = $2
 				WHERE `+ec.PrimaryKey+` = $3
 			`, encrypted, newNonce, primaryKey)
 			if err != nil {
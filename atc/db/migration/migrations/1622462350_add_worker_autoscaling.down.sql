@@ -0,0 +1,4 @@
+DROP INDEX waiting_steps_updated_at_idx;
+DROP TABLE waiting_steps;
+
+ALTER TABLE workers DROP COLUMN scale_down_candidate;
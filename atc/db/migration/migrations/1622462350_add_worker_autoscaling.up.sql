@@ -0,0 +1,13 @@
+ALTER TABLE workers
+    ADD COLUMN scale_down_candidate boolean NOT NULL DEFAULT false;
+
+CREATE TABLE waiting_steps (
+    id serial PRIMARY KEY,
+    team_id integer REFERENCES teams (id) ON DELETE CASCADE,
+    platform text,
+    tags text,
+    type text NOT NULL,
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX waiting_steps_updated_at_idx ON waiting_steps (updated_at);
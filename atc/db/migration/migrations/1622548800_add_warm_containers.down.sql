@@ -0,0 +1,8 @@
+DROP INDEX containers_warm_key_slot_uniq;
+
+DROP INDEX containers_warm_key_idx;
+
+ALTER TABLE containers
+  DROP COLUMN warm_key,
+  DROP COLUMN warm_slot,
+  DROP COLUMN warm_since;
@@ -0,0 +1,8 @@
+ALTER TABLE containers
+  ADD COLUMN warm_key text,
+  ADD COLUMN warm_slot integer,
+  ADD COLUMN warm_since timestamp with time zone;
+
+CREATE INDEX containers_warm_key_idx ON containers (worker_name, warm_key) WHERE warm_key IS NOT NULL;
+
+CREATE UNIQUE INDEX containers_warm_key_slot_uniq ON containers (worker_name, warm_key, warm_slot) WHERE warm_key IS NOT NULL;
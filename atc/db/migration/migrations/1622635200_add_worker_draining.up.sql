@@ -0,0 +1,2 @@
+ALTER TABLE workers
+    ADD COLUMN draining boolean NOT NULL DEFAULT false;
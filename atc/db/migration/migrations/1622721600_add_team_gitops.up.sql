@@ -0,0 +1,6 @@
+CREATE TABLE team_gitops (
+  team_id integer PRIMARY KEY REFERENCES teams (id) ON DELETE CASCADE,
+  config text,
+  nonce text,
+  status jsonb
+);
@@ -0,0 +1,13 @@
+CREATE TABLE pipeline_config_versions (
+  id serial PRIMARY KEY,
+  pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+  version integer NOT NULL,
+  config text NOT NULL,
+  nonce text,
+  changes text,
+  changes_nonce text,
+  created_by text,
+  build_id integer REFERENCES builds (id) ON DELETE SET NULL,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  UNIQUE (pipeline_id, version)
+);
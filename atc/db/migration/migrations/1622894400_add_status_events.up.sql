@@ -0,0 +1,9 @@
+CREATE TABLE status_events (
+  id bigserial PRIMARY KEY,
+  team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+  pipeline_id integer REFERENCES pipelines (id) ON DELETE CASCADE,
+  payload jsonb NOT NULL,
+  created_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX status_events_created_at_idx ON status_events (created_at);
@@ -0,0 +1,7 @@
+DROP INDEX status_events_unnotified_idx;
+
+ALTER TABLE status_events
+  DROP COLUMN notified;
+
+DROP TABLE notification_deliveries;
+DROP TABLE team_notifications;
@@ -0,0 +1,32 @@
+CREATE TABLE team_notifications (
+  team_id integer PRIMARY KEY REFERENCES teams (id) ON DELETE CASCADE,
+  config text NOT NULL,
+  nonce text
+);
+
+CREATE TABLE notification_deliveries (
+  id bigserial PRIMARY KEY,
+  team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+  notification text NOT NULL,
+  status_event jsonb NOT NULL,
+  payload text NOT NULL,
+  state text NOT NULL DEFAULT 'pending',
+  attempts integer NOT NULL DEFAULT 0,
+  response_status integer,
+  error text,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+  last_attempt_at timestamp with time zone
+);
+
+CREATE INDEX notification_deliveries_team_id_idx ON notification_deliveries (team_id, id);
+CREATE INDEX notification_deliveries_pending_idx ON notification_deliveries (next_attempt_at) WHERE state = 'pending';
+
+-- whether each status event has been considered for notifications; the
+-- existing events are marked so that a backlog isn't sent on upgrade
+ALTER TABLE status_events
+  ADD COLUMN notified boolean NOT NULL DEFAULT false;
+
+UPDATE status_events SET notified = true;
+
+CREATE INDEX status_events_unnotified_idx ON status_events (id) WHERE NOT notified;
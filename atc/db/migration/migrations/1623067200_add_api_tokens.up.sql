@@ -0,0 +1,17 @@
+CREATE TABLE api_tokens (
+  id serial PRIMARY KEY,
+  name text NOT NULL,
+  token_hash text NOT NULL UNIQUE,
+  role text NOT NULL,
+  -- the subject of the user owning a personal token
+  owner text,
+  -- the team of a service account token
+  team_id integer REFERENCES teams (id) ON DELETE CASCADE,
+  service_account text,
+  claims jsonb NOT NULL,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  expires_at timestamp with time zone
+);
+
+CREATE INDEX api_tokens_owner_idx ON api_tokens (owner);
+CREATE INDEX api_tokens_team_id_idx ON api_tokens (team_id);
@@ -0,0 +1,22 @@
+CREATE TABLE audit_events (
+  id bigserial PRIMARY KEY,
+  actor text NOT NULL,
+  -- not a reference, as events outlive the teams they were about
+  team_name text,
+  action text NOT NULL,
+  target text,
+  method text NOT NULL,
+  path text NOT NULL,
+  remote_addr text,
+  user_agent text,
+  parameters jsonb,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  -- when the event was sent to the audit webhook, if one is configured
+  forwarded_at timestamp with time zone
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+CREATE INDEX audit_events_actor_idx ON audit_events (actor);
+CREATE INDEX audit_events_action_idx ON audit_events (action);
+CREATE INDEX audit_events_team_name_idx ON audit_events (team_name);
+CREATE INDEX audit_events_unforwarded_idx ON audit_events (id) WHERE forwarded_at IS NULL;
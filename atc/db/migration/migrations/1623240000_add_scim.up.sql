@@ -0,0 +1,31 @@
+CREATE TABLE scim_users (
+  id text PRIMARY KEY,
+  external_id text,
+  user_name text NOT NULL,
+  display_name text,
+  email text,
+  active boolean NOT NULL DEFAULT true,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX scim_users_user_name_key ON scim_users (lower(user_name));
+CREATE INDEX scim_users_email_idx ON scim_users (lower(email));
+
+CREATE TABLE scim_groups (
+  id text PRIMARY KEY,
+  external_id text,
+  display_name text NOT NULL,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX scim_groups_display_name_key ON scim_groups (lower(display_name));
+
+CREATE TABLE scim_group_members (
+  group_id text NOT NULL REFERENCES scim_groups (id) ON DELETE CASCADE,
+  user_id text NOT NULL REFERENCES scim_users (id) ON DELETE CASCADE,
+  PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX scim_group_members_user_id_idx ON scim_group_members (user_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS access_tokens_sub_idx;
+
+ALTER TABLE access_tokens
+  DROP COLUMN IF EXISTS id,
+  DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,5 @@
+ALTER TABLE access_tokens
+  ADD COLUMN id bigserial NOT NULL UNIQUE,
+  ADD COLUMN created_at timestamp with time zone NOT NULL DEFAULT now();
+
+CREATE INDEX access_tokens_sub_idx ON access_tokens (sub);
@@ -0,0 +1,2 @@
+DROP TABLE job_pipeline_outputs;
+DROP TABLE pipeline_shares;
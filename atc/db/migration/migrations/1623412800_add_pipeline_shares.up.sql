@@ -0,0 +1,20 @@
+CREATE TABLE pipeline_shares (
+  pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+  team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+  PRIMARY KEY (pipeline_id, team_id)
+);
+
+CREATE INDEX pipeline_shares_team_id_idx ON pipeline_shares (team_id);
+
+CREATE TABLE job_pipeline_outputs (
+  job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+  name text NOT NULL,
+  team_name text NOT NULL,
+  pipeline_name text NOT NULL,
+  job_name text NOT NULL,
+  trigger boolean NOT NULL DEFAULT false,
+  triggered_build_id integer,
+  PRIMARY KEY (job_id, name)
+);
+
+CREATE INDEX job_pipeline_outputs_upstream_idx ON job_pipeline_outputs (team_name, pipeline_name, job_name);
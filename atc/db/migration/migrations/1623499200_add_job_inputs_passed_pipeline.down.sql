@@ -0,0 +1,6 @@
+DROP INDEX job_inputs_passed_pipeline_idx;
+
+ALTER TABLE job_inputs
+  DROP COLUMN passed_pipeline_team_name,
+  DROP COLUMN passed_pipeline_name,
+  DROP COLUMN passed_pipeline_job_name;
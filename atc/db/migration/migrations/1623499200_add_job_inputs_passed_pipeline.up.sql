@@ -0,0 +1,6 @@
+ALTER TABLE job_inputs
+  ADD COLUMN passed_pipeline_team_name text,
+  ADD COLUMN passed_pipeline_name text,
+  ADD COLUMN passed_pipeline_job_name text;
+
+CREATE INDEX job_inputs_passed_pipeline_idx ON job_inputs (passed_pipeline_team_name, passed_pipeline_name, passed_pipeline_job_name);
@@ -0,0 +1,7 @@
+ALTER TABLE resource_types
+  DROP COLUMN team_shared;
+
+ALTER TABLE resources
+  DROP COLUMN team_shared;
+
+DROP TABLE team_resources;
@@ -0,0 +1,11 @@
+CREATE TABLE team_resources (
+  team_id integer PRIMARY KEY REFERENCES teams (id) ON DELETE CASCADE,
+  config text NOT NULL,
+  nonce text
+);
+
+ALTER TABLE resources
+  ADD COLUMN team_shared boolean NOT NULL DEFAULT false;
+
+ALTER TABLE resource_types
+  ADD COLUMN team_shared boolean NOT NULL DEFAULT false;
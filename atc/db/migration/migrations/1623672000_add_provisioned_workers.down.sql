@@ -0,0 +1 @@
+DROP TABLE provisioned_workers;
@@ -0,0 +1,4 @@
+CREATE TABLE provisioned_workers (
+  name text PRIMARY KEY,
+  created_at timestamp with time zone NOT NULL DEFAULT now()
+);
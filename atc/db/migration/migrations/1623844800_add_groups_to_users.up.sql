@@ -0,0 +1,3 @@
+-- the groups of the user as of their last login, which their API tokens are
+-- authenticated with
+ALTER TABLE users ADD COLUMN groups json;
@@ -0,0 +1,12 @@
+DELETE FROM resource_config_scopes WHERE team_id IS NOT NULL;
+
+DROP INDEX resource_config_scopes_team_id_resource_config_id_uniq;
+
+DROP INDEX resource_config_scopes_resource_config_id_uniq;
+
+CREATE UNIQUE INDEX resource_config_scopes_resource_config_id_uniq
+ON resource_config_scopes (resource_config_id)
+WHERE resource_id IS NULL;
+
+ALTER TABLE resource_config_scopes
+  DROP COLUMN team_id;
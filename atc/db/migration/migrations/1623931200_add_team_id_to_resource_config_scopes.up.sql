@@ -0,0 +1,14 @@
+-- the version history of a team's shared resources, which is shared by the
+-- pipelines of the team but not with other teams
+ALTER TABLE resource_config_scopes
+  ADD COLUMN team_id integer REFERENCES teams (id) ON DELETE CASCADE;
+
+DROP INDEX resource_config_scopes_resource_config_id_uniq;
+
+CREATE UNIQUE INDEX resource_config_scopes_resource_config_id_uniq
+ON resource_config_scopes (resource_config_id)
+WHERE resource_id IS NULL AND team_id IS NULL;
+
+CREATE UNIQUE INDEX resource_config_scopes_team_id_resource_config_id_uniq
+ON resource_config_scopes (team_id, resource_config_id)
+WHERE team_id IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE provisioned_workers DROP COLUMN build_id;
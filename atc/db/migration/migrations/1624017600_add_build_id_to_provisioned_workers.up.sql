@@ -0,0 +1,4 @@
+-- the build the worker was provisioned for, which it is kept for until the
+-- build finishes; it does not reference builds, so that the reservation
+-- outlives builds which are deleted before the worker is torn down
+ALTER TABLE provisioned_workers ADD COLUMN build_id integer;
@@ -0,0 +1,226 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// NotificationDelivery is a notification to be sent for a build status change.
+type NotificationDelivery struct {
+	ID           int
+	TeamID       int
+	Notification string
+	StatusEvent  atc.StatusEvent
+	Payload      []byte
+
+	State          atc.NotificationDeliveryState
+	Attempts       int
+	ResponseStatus int
+	Error          string
+
+	// NextAttemptAt is when a pending delivery is retried.
+	NextAttemptAt time.Time
+}
+
+//counterfeiter:generate . NotificationRepository
+type NotificationRepository interface {
+	// UnnotifiedStatusEvents returns the status events which haven't been
+	// considered for notifications yet, oldest first.
+	UnnotifiedStatusEvents(limit int) ([]atc.StatusEvent, error)
+
+	// EnqueueDeliveries queues the deliveries and marks the status events as
+	// considered, in the same transaction.
+	EnqueueDeliveries(deliveries []NotificationDelivery, statusEventIDs []int) error
+
+	// PendingDeliveries returns the pending deliveries which are due.
+	PendingDeliveries(limit int) ([]NotificationDelivery, error)
+
+	// SaveDeliveryAttempt records the outcome of an attempt at sending the
+	// delivery.
+	SaveDeliveryAttempt(delivery NotificationDelivery) error
+
+	RemoveDeliveriesOlderThan(age time.Duration) (int, error)
+}
+
+type notificationRepository struct {
+	conn Conn
+}
+
+func NewNotificationRepository(conn Conn) NotificationRepository {
+	return &notificationRepository{conn}
+}
+
+func (r *notificationRepository) UnnotifiedStatusEvents(limit int) ([]atc.StatusEvent, error) {
+	rows, err := psql.Select("e.id", "e.payload").
+		From("status_events e").
+		// each event is marked on its own rather than moving past an id, as
+		// ids are taken before the transactions inserting them commit, so an
+		// event may show up after events with later ids
+		Where(sq.Eq{"e.notified": false}).
+		OrderBy("e.id ASC").
+		Limit(uint64(limit)).
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var events []atc.StatusEvent
+	for rows.Next() {
+		var id int
+		var payload []byte
+		err = rows.Scan(&id, &payload)
+		if err != nil {
+			return nil, err
+		}
+
+		var event atc.StatusEvent
+		err = json.Unmarshal(payload, &event)
+		if err != nil {
+			return nil, err
+		}
+
+		event.ID = id
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *notificationRepository) EnqueueDeliveries(deliveries []NotificationDelivery, statusEventIDs []int) error {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for _, delivery := range deliveries {
+		statusEvent, err := json.Marshal(delivery.StatusEvent)
+		if err != nil {
+			return err
+		}
+
+		_, err = psql.Insert("notification_deliveries").
+			Columns("team_id", "notification", "status_event", "payload").
+			Values(delivery.TeamID, delivery.Notification, statusEvent, string(delivery.Payload)).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = psql.Update("status_events").
+		Set("notified", true).
+		Where(sq.Eq{"id": statusEventIDs}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *notificationRepository) PendingDeliveries(limit int) ([]NotificationDelivery, error) {
+	rows, err := psql.Select("id", "team_id", "notification", "status_event", "payload", "attempts").
+		From("notification_deliveries").
+		Where(sq.Eq{"state": atc.NotificationDeliveryPending}).
+		Where(sq.Expr("next_attempt_at <= now()")).
+		OrderBy("next_attempt_at ASC").
+		Limit(uint64(limit)).
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var deliveries []NotificationDelivery
+	for rows.Next() {
+		var statusEvent, payload []byte
+
+		delivery := NotificationDelivery{State: atc.NotificationDeliveryPending}
+		err = rows.Scan(&delivery.ID, &delivery.TeamID, &delivery.Notification, &statusEvent, &payload, &delivery.Attempts)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(statusEvent, &delivery.StatusEvent)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.Payload = payload
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (r *notificationRepository) SaveDeliveryAttempt(delivery NotificationDelivery) error {
+	var responseStatus sql.NullInt64
+	if delivery.ResponseStatus != 0 {
+		responseStatus = sql.NullInt64{Int64: int64(delivery.ResponseStatus), Valid: true}
+	}
+
+	var errMsg sql.NullString
+	if delivery.Error != "" {
+		errMsg = sql.NullString{String: delivery.Error, Valid: true}
+	}
+
+	query := psql.Update("notification_deliveries").
+		Set("state", delivery.State).
+		Set("attempts", delivery.Attempts).
+		Set("response_status", responseStatus).
+		Set("error", errMsg).
+		Set("last_attempt_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": delivery.ID})
+
+	if !delivery.NextAttemptAt.IsZero() {
+		query = query.Set("next_attempt_at", delivery.NextAttemptAt)
+	}
+
+	result, err := query.RunWith(r.conn).Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return NonOneRowAffectedError{rowsAffected}
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) RemoveDeliveriesOlderThan(age time.Duration) (int, error) {
+	res, err := psql.Delete("notification_deliveries").
+		Where(sq.NotEq{"state": atc.NotificationDeliveryPending}).
+		Where(sq.Expr(fmt.Sprintf("created_at < now() - '%d seconds'::interval", int(age.Seconds())))).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
@@ -0,0 +1,136 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Notifications", func() {
+	var repository db.NotificationRepository
+
+	BeforeEach(func() {
+		repository = db.NewNotificationRepository(dbConn)
+	})
+
+	Describe("team notifications", func() {
+		It("has none by default", func() {
+			configs, err := defaultTeam.Notifications()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configs).To(BeEmpty())
+		})
+
+		It("can be set and cleared", func() {
+			configs := atc.NotificationConfigs{
+				{Name: "some-hook", URL: "https://example.com", Secret: "shh"},
+			}
+
+			Expect(defaultTeam.SetNotifications(configs)).To(Succeed())
+			Expect(defaultTeam.Notifications()).To(Equal(configs))
+
+			Expect(defaultTeam.SetNotifications(nil)).To(Succeed())
+			Expect(defaultTeam.Notifications()).To(BeEmpty())
+		})
+	})
+
+	Describe("deliveries", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(build.Finish(db.BuildStatusFailed)).To(Succeed())
+		})
+
+		It("finds the status events which have not been considered yet", func() {
+			events, err := repository.UnnotifiedStatusEvents(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(events)).To(BeNumerically(">=", 2))
+
+			finished := events[len(events)-1]
+			Expect(finished.BuildID).To(Equal(build.ID()))
+			Expect(finished.Status).To(Equal(atc.StatusFailed))
+
+			var eventIDs []int
+			for _, event := range events {
+				eventIDs = append(eventIDs, event.ID)
+			}
+
+			err = repository.EnqueueDeliveries([]db.NotificationDelivery{
+				{
+					TeamID:       defaultTeam.ID(),
+					Notification: "some-hook",
+					StatusEvent:  finished,
+					Payload:      []byte(`{"some":"payload"}`),
+				},
+			}, eventIDs)
+			Expect(err).ToNot(HaveOccurred())
+
+			events, err = repository.UnnotifiedStatusEvents(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(BeEmpty())
+
+			By("sending the pending deliveries")
+			deliveries, err := repository.PendingDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Notification).To(Equal("some-hook"))
+			Expect(deliveries[0].Payload).To(MatchJSON(`{"some":"payload"}`))
+			Expect(deliveries[0].StatusEvent.BuildID).To(Equal(build.ID()))
+
+			delivery := deliveries[0]
+			delivery.Attempts = 1
+			delivery.ResponseStatus = 500
+			delivery.NextAttemptAt = time.Now().Add(time.Hour)
+			Expect(repository.SaveDeliveryAttempt(delivery)).To(Succeed())
+
+			deliveries, err = repository.PendingDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(BeEmpty())
+
+			By("recording the history of the team")
+			history, err := defaultTeam.NotificationDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].State).To(Equal(atc.NotificationDeliveryPending))
+			Expect(history[0].Attempts).To(Equal(1))
+			Expect(history[0].ResponseStatus).To(Equal(500))
+			Expect(history[0].JobName).To(Equal(defaultJob.Name()))
+			Expect(history[0].BuildStatus).To(Equal(atc.StatusFailed))
+			Expect(history[0].LastAttemptAt).ToNot(BeZero())
+
+			By("removing old finished deliveries")
+			delivery.State = atc.NotificationDeliverySucceeded
+			Expect(repository.SaveDeliveryAttempt(delivery)).To(Succeed())
+
+			n, err := repository.RemoveDeliveriesOlderThan(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(BeZero())
+
+			n, err = repository.RemoveDeliveriesOlderThan(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(1))
+		})
+
+		It("still finds events older than the ones considered", func() {
+			events, err := repository.UnnotifiedStatusEvents(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(events)).To(BeNumerically(">=", 2))
+
+			latest := events[len(events)-1]
+
+			err = repository.EnqueueDeliveries(nil, []int{latest.ID})
+			Expect(err).ToNot(HaveOccurred())
+
+			remaining, err := repository.UnnotifiedStatusEvents(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(remaining).To(Equal(events[:len(events)-1]))
+		})
+	})
+})
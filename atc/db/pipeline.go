@@ -15,6 +15,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/vars"
@@ -47,6 +48,14 @@ func (e ErrResourceNotFound) Error() string {
 	return fmt.Sprintf("resource '%s' not found", e.Name)
 }
 
+type ErrTeamsNotFound struct {
+	Names []string
+}
+
+func (e ErrTeamsNotFound) Error() string {
+	return fmt.Sprintf("teams not found: %s", strings.Join(e.Names, ", "))
+}
+
 //counterfeiter:generate . Pipeline
 type Cause struct {
 	ResourceVersionID int `json:"resource_version_id"`
@@ -66,7 +75,10 @@ type Pipeline interface {
 	Display() *atc.DisplayConfig
 	ConfigVersion() ConfigVersion
 	Config() (atc.Config, error)
+	ConfigVersions() ([]atc.PipelineConfigVersion, error)
+	FindConfigVersion(version int) (atc.PipelineConfigVersion, bool, error)
 	Public() bool
+	SharedWithTeams() []string
 	Paused() bool
 	Archived() bool
 	LastUpdated() time.Time
@@ -110,6 +122,7 @@ type Pipeline interface {
 
 	Expose() error
 	Hide() error
+	Share(teamNames []string) error
 
 	Pause() error
 	Unpause() error
@@ -137,6 +150,7 @@ type pipeline struct {
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
+	sharedWith    []string
 	archived      bool
 	lastUpdated   time.Time
 
@@ -163,7 +177,14 @@ var pipelinesQuery = psql.Select(`
 		p.last_updated,
 		p.parent_job_id,
 		p.parent_build_id,
-		p.instance_vars
+		p.instance_vars,
+		ARRAY(
+			SELECT st.name
+			FROM pipeline_shares ps
+			JOIN teams st ON st.id = ps.team_id
+			WHERE ps.pipeline_id = p.id
+			ORDER BY st.name
+		)
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -188,6 +209,7 @@ func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
 func (p *pipeline) Display() *atc.DisplayConfig      { return p.display }
 func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
 func (p *pipeline) Public() bool                     { return p.public }
+func (p *pipeline) SharedWithTeams() []string        { return p.sharedWith }
 func (p *pipeline) Paused() bool                     { return p.paused }
 func (p *pipeline) Archived() bool                   { return p.archived }
 func (p *pipeline) LastUpdated() time.Time           { return p.lastUpdated }
@@ -298,11 +320,27 @@ func (p *pipeline) Config() (atc.Config, error) {
 		return atc.Config{}, fmt.Errorf("failed to get job configs: %w", err)
 	}
 
+	// the team's shared resources and resource types are left out, as they
+	// aren't part of the config the pipeline was set with
+	var ownResources Resources
+	for _, resource := range resources {
+		if !resource.TeamShared() {
+			ownResources = append(ownResources, resource)
+		}
+	}
+
+	var ownResourceTypes ResourceTypes
+	for _, resourceType := range resourceTypes {
+		if !resourceType.TeamShared() {
+			ownResourceTypes = append(ownResourceTypes, resourceType)
+		}
+	}
+
 	config := atc.Config{
 		Groups:        p.Groups(),
 		VarSources:    p.VarSources(),
-		Resources:     resources.Configs(),
-		ResourceTypes: resourceTypes.Configs(),
+		Resources:     ownResources.Configs(),
+		ResourceTypes: ownResourceTypes.Configs(),
 		Prototypes:    prototypes.Configs(),
 		Jobs:          jobConfigs,
 		Display:       p.Display(),
@@ -311,6 +349,208 @@ func (p *pipeline) Config() (atc.Config, error) {
 	return config, nil
 }
 
+// ConfigVersions returns the history of the pipeline's config, newest first.
+// The configs themselves are left out.
+func (p *pipeline) ConfigVersions() ([]atc.PipelineConfigVersion, error) {
+	rows, err := pipelineConfigVersionsQuery.
+		Where(sq.Eq{"pipeline_id": p.id}).
+		OrderBy("version DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	versions := []atc.PipelineConfigVersion{}
+	for rows.Next() {
+		version, _, _, err := scanPipelineConfigVersion(p.conn.EncryptionStrategy(), rows)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// FindConfigVersion returns a single version of the pipeline's config,
+// including the config itself.
+func (p *pipeline) FindConfigVersion(version int) (atc.PipelineConfigVersion, bool, error) {
+	configVersion, configBlob, nonce, err := scanPipelineConfigVersion(
+		p.conn.EncryptionStrategy(),
+		pipelineConfigVersionsQuery.
+			Where(sq.Eq{
+				"pipeline_id": p.id,
+				"version":     version,
+			}).
+			RunWith(p.conn).
+			QueryRow(),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.PipelineConfigVersion{}, false, nil
+		}
+
+		return atc.PipelineConfigVersion{}, false, err
+	}
+
+	config, err := decryptPipelineConfig(p.conn.EncryptionStrategy(), configBlob, nonce)
+	if err != nil {
+		return atc.PipelineConfigVersion{}, false, err
+	}
+
+	configVersion.Config = &config
+
+	return configVersion, true, nil
+}
+
+var pipelineConfigVersionsQuery = psql.Select(
+	"version",
+	"created_by",
+	"build_id",
+	"created_at",
+	"changes",
+	"changes_nonce",
+	"config",
+	"nonce",
+).From("pipeline_config_versions")
+
+// scanPipelineConfigVersion scans a version of a pipeline's config, decrypting
+// its changes. The config itself is returned still encrypted, along with its
+// nonce, as only some callers need it.
+func scanPipelineConfigVersion(es encryption.Strategy, row scannable) (atc.PipelineConfigVersion, string, *string, error) {
+	var (
+		version      atc.PipelineConfigVersion
+		createdBy    sql.NullString
+		buildID      sql.NullInt64
+		createdAt    time.Time
+		changes      sql.NullString
+		changesNonce sql.NullString
+		config       string
+		nonce        sql.NullString
+	)
+
+	err := row.Scan(&version.Version, &createdBy, &buildID, &createdAt, &changes, &changesNonce, &config, &nonce)
+	if err != nil {
+		return atc.PipelineConfigVersion{}, "", nil, err
+	}
+
+	version.CreatedBy = createdBy.String
+	version.BuildID = int(buildID.Int64)
+	version.CreatedAt = createdAt.Unix()
+
+	if changes.Valid {
+		var noncense *string
+		if changesNonce.Valid {
+			noncense = &changesNonce.String
+		}
+
+		decryptedChanges, err := es.Decrypt(changes.String, noncense)
+		if err != nil {
+			return atc.PipelineConfigVersion{}, "", nil, err
+		}
+
+		err = json.Unmarshal(decryptedChanges, &version.Changes)
+		if err != nil {
+			return atc.PipelineConfigVersion{}, "", nil, err
+		}
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	return version, config, noncense, nil
+}
+
+func decryptPipelineConfig(es encryption.Strategy, configBlob string, nonce *string) (atc.Config, error) {
+	decryptedConfig, err := es.Decrypt(configBlob, nonce)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	var config atc.Config
+	err = json.Unmarshal(decryptedConfig, &config)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	return config, nil
+}
+
+// savePipelineConfigVersion records the config which was just saved for the
+// pipeline, along with what changed since the previously recorded version.
+func savePipelineConfigVersion(tx Tx, pipelineID int, config atc.Config, createdBy string, buildID sql.NullInt64) error {
+	var (
+		changes      sql.NullString
+		changesNonce *string
+	)
+
+	_, previousBlob, previousNonce, err := scanPipelineConfigVersion(
+		tx.EncryptionStrategy(),
+		pipelineConfigVersionsQuery.
+			Where(sq.Eq{"pipeline_id": pipelineID}).
+			OrderBy("version DESC").
+			Limit(1).
+			RunWith(tx).
+			QueryRow(),
+	)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	if err == nil {
+		previousConfig, err := decryptPipelineConfig(tx.EncryptionStrategy(), previousBlob, previousNonce)
+		if err != nil {
+			return err
+		}
+
+		changesPayload, err := json.Marshal(previousConfig.Changes(config))
+		if err != nil {
+			return err
+		}
+
+		// changes include the sources and var_sources of the config, so they
+		// are kept as secret as the config itself
+		encryptedChanges, nonce, err := tx.EncryptionStrategy().Encrypt(changesPayload)
+		if err != nil {
+			return err
+		}
+
+		changes = sql.NullString{String: encryptedChanges, Valid: true}
+		changesNonce = nonce
+	}
+
+	configPayload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedConfig, nonce, err := tx.EncryptionStrategy().Encrypt(configPayload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("pipeline_config_versions").
+		SetMap(map[string]interface{}{
+			"pipeline_id":   pipelineID,
+			"version":       sq.Expr("(SELECT version FROM pipelines WHERE id = ?)", pipelineID),
+			"config":        encryptedConfig,
+			"nonce":         nonce,
+			"changes":       changes,
+			"changes_nonce": changesNonce,
+			"created_by":    sql.NullString{String: createdBy, Valid: createdBy != ""},
+			"build_id":      buildID,
+		}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
 func (p *pipeline) CreateJobBuild(jobName string) (Build, error) {
 	tx, err := p.conn.Begin()
 	if err != nil {
@@ -785,6 +1025,82 @@ func (p *pipeline) Expose() error {
 	return err
 }
 
+// Share replaces the teams the pipeline is shared with. Teams it is shared
+// with may see the pipeline, its jobs and builds, but not change them, and
+// may consume its jobs' builds as pipeline outputs.
+func (p *pipeline) Share(teamNames []string) error {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("pipeline_shares").
+		Where(sq.Eq{"pipeline_id": p.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	if len(teamNames) != 0 {
+		rows, err := psql.Select("id", "name").
+			From("teams").
+			Where(sq.Eq{"name": teamNames}).
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return err
+		}
+
+		teamIDs := map[string]int{}
+		for rows.Next() {
+			var (
+				id   int
+				name string
+			)
+			err = rows.Scan(&id, &name)
+			if err != nil {
+				Close(rows)
+				return err
+			}
+
+			teamIDs[name] = id
+		}
+
+		Close(rows)
+
+		var missing []string
+		for _, name := range teamNames {
+			if _, found := teamIDs[name]; !found {
+				missing = append(missing, name)
+			}
+		}
+
+		if len(missing) != 0 {
+			return ErrTeamsNotFound{Names: missing}
+		}
+
+		for _, teamID := range teamIDs {
+			if teamID == p.teamID {
+				continue
+			}
+
+			_, err = psql.Insert("pipeline_shares").
+				Columns("pipeline_id", "team_id").
+				Values(p.id, teamID).
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (p *pipeline) Destroy() error {
 	_, err := psql.Delete("pipelines").
 		Where(sq.Eq{
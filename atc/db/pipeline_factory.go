@@ -3,6 +3,7 @@ package db
 import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
 )
 
 //counterfeiter:generate . PipelineFactory
@@ -48,7 +49,10 @@ func (f *pipelineFactory) VisiblePipelines(teamNames []string) ([]Pipeline, erro
 
 	rows, err = pipelinesQuery.
 		Where(sq.NotEq{"t.name": teamNames}).
-		Where(sq.Eq{"public": true}).
+		Where(sq.Or{
+			sq.Eq{"public": true},
+			sharedWithTeams(teamNames),
+		}).
 		OrderBy("t.name ASC", "p.ordering ASC", "p.id ASC").
 		RunWith(tx).
 		Query()
@@ -56,7 +60,7 @@ func (f *pipelineFactory) VisiblePipelines(teamNames []string) ([]Pipeline, erro
 		return nil, err
 	}
 
-	otherTeamPublicPipelines, err := scanPipelines(f.conn, f.lockFactory, rows)
+	otherTeamVisiblePipelines, err := scanPipelines(f.conn, f.lockFactory, rows)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +70,7 @@ func (f *pipelineFactory) VisiblePipelines(teamNames []string) ([]Pipeline, erro
 		return nil, err
 	}
 
-	return append(currentTeamPipelines, otherTeamPublicPipelines...), nil
+	return append(currentTeamPipelines, otherTeamVisiblePipelines...), nil
 }
 
 func (f *pipelineFactory) AllPipelines() ([]Pipeline, error) {
@@ -93,3 +97,15 @@ func (f *pipelineFactory) PipelinesToSchedule() ([]Pipeline, error) {
 
 	return scanPipelines(f.conn, f.lockFactory, rows)
 }
+
+// sharedWithTeams matches the pipelines, aliased as p, which are shared with
+// any of the teams.
+func sharedWithTeams(teamNames []string) sq.Sqlizer {
+	return sq.Expr(`EXISTS (
+		SELECT 1
+		FROM pipeline_shares ps
+		JOIN teams st ON st.id = ps.team_id
+		WHERE ps.pipeline_id = p.id
+		AND st.name = ANY(?)
+	)`, pq.Array(teamNames))
+}
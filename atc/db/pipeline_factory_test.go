@@ -34,7 +34,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline1.Reload()).To(BeTrue())
 
@@ -42,7 +42,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline2.Reload()).To(BeTrue())
 
@@ -50,7 +50,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake-two"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline3.Expose()).To(Succeed())
 			Expect(pipeline3.Reload()).To(BeTrue())
@@ -59,7 +59,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline4.Reload()).To(BeTrue())
 		})
@@ -98,6 +98,61 @@ var _ = Describe("Pipeline Factory", func() {
 			}))
 		})
 
+		Context("when a private pipeline of another team is shared with the team", func() {
+			BeforeEach(func() {
+				Expect(pipeline2.Share([]string{"some-team"})).To(Succeed())
+				Expect(pipeline2.Reload()).To(BeTrue())
+			})
+
+			It("is visible to the team", func() {
+				pipelines, err := pipelineFactory.VisiblePipelines([]string{"some-team"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pipelineRefs(pipelines)).To(Equal([]atc.PipelineRef{
+					pipelineRef(pipeline1),
+					pipelineRef(pipeline4),
+					pipelineRef(pipeline2),
+					pipelineRef(pipeline3),
+				}))
+			})
+
+			It("lists the teams the pipeline is shared with", func() {
+				Expect(pipeline2.SharedWithTeams()).To(Equal([]string{"some-team"}))
+			})
+
+			It("is not visible to other teams", func() {
+				pipelines, err := pipelineFactory.VisiblePipelines([]string{"other-team"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pipelineRefs(pipelines)).To(Equal([]atc.PipelineRef{
+					pipelineRef(pipeline3),
+				}))
+			})
+
+			Context("when the pipeline is no longer shared", func() {
+				BeforeEach(func() {
+					Expect(pipeline2.Share(nil)).To(Succeed())
+					Expect(pipeline2.Reload()).To(BeTrue())
+				})
+
+				It("is no longer visible to the team", func() {
+					pipelines, err := pipelineFactory.VisiblePipelines([]string{"some-team"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pipelineRefs(pipelines)).To(Equal([]atc.PipelineRef{
+						pipelineRef(pipeline1),
+						pipelineRef(pipeline4),
+						pipelineRef(pipeline3),
+					}))
+					Expect(pipeline2.SharedWithTeams()).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when sharing a pipeline with a team that does not exist", func() {
+			It("returns an error naming the team", func() {
+				err := pipeline2.Share([]string{"some-team", "bogus-team"})
+				Expect(err).To(Equal(db.ErrTeamsNotFound{Names: []string{"bogus-team"}}))
+			})
+		})
+
 		Describe("When instance pipeline ordered is change", func() {
 			BeforeEach(func() {
 				err := team.OrderPipelinesWithinGroup("fake-pipeline", []atc.InstanceVars{
@@ -139,7 +194,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline2.Reload()).To(BeTrue())
 
@@ -147,7 +202,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake-two"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline3.Expose()).To(Succeed())
 			Expect(pipeline3.Reload()).To(BeTrue())
@@ -156,7 +211,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline1.Expose()).To(Succeed())
 			Expect(pipeline1.Reload()).To(BeTrue())
@@ -165,7 +220,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline4.Reload()).To(BeTrue())
 
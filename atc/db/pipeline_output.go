@@ -0,0 +1,292 @@
+package db
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// pipelineOutputSharedWith matches the pipelines, aliased as p, which belong
+// to the team or are shared with it. Only those pipelines' jobs may be
+// consumed as pipeline outputs by the team.
+func pipelineOutputSharedWith(teamID interface{}) sq.Sqlizer {
+	return sq.Or{
+		sq.Eq{"p.team_id": teamID},
+		sq.Expr(`EXISTS (
+			SELECT 1
+			FROM pipeline_shares ps
+			WHERE ps.pipeline_id = p.id
+			AND ps.team_id = ?
+		)`, teamID),
+	}
+}
+
+// PipelineOutput returns the successful build of the job with the given id,
+// or its latest successful build if buildID is 0. The job must belong to a
+// pipeline of the team or to a pipeline shared with it.
+func (t *team) PipelineOutput(teamName string, pipelineName string, jobName string, buildID int) (Build, bool, error) {
+	build := newEmptyBuild(t.conn, t.lockFactory)
+
+	query := buildsQuery.
+		Where(sq.Eq{
+			"t.name":   teamName,
+			"p.name":   pipelineName,
+			"j.name":   jobName,
+			"b.status": BuildStatusSucceeded,
+		}).
+		Where(sq.Expr("p.instance_vars IS NULL")).
+		Where(pipelineOutputSharedWith(t.id))
+
+	if buildID != 0 {
+		query = query.Where(sq.Eq{"b.id": buildID})
+	}
+
+	row := query.
+		OrderBy("b.id DESC").
+		Limit(1).
+		RunWith(t.conn).
+		QueryRow()
+
+	err := scanBuild(build, row, t.conn.EncryptionStrategy())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return build, true, nil
+}
+
+// PipelineOutputTriggers returns, for each of the job's triggering pipeline
+// outputs, the latest successful build of the consumed job if it has not
+// triggered the job yet.
+func (j *job) PipelineOutputTriggers() (map[string]int, error) {
+	rows, err := psql.Select("o.name", "latest.id").
+		From("job_pipeline_outputs o").
+		Join("teams ut ON ut.name = o.team_name").
+		Join("pipelines p ON p.team_id = ut.id AND p.name = o.pipeline_name AND p.instance_vars IS NULL").
+		Join("jobs uj ON uj.pipeline_id = p.id AND uj.name = o.job_name").
+		JoinClause(`JOIN LATERAL (
+			SELECT b.id
+			FROM builds b
+			WHERE b.job_id = uj.id
+			AND b.status = 'succeeded'
+			ORDER BY b.id DESC
+			LIMIT 1
+		) latest ON true`).
+		Where(sq.Eq{
+			"o.job_id":  j.id,
+			"o.trigger": true,
+		}).
+		Where(pipelineOutputSharedWith(j.teamID)).
+		Where(sq.Or{
+			sq.Eq{"o.triggered_build_id": nil},
+			sq.Expr("latest.id > o.triggered_build_id"),
+		}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	triggers := map[string]int{}
+	for rows.Next() {
+		var (
+			name    string
+			buildID int
+		)
+		err = rows.Scan(&name, &buildID)
+		if err != nil {
+			return nil, err
+		}
+
+		triggers[name] = buildID
+	}
+
+	return triggers, nil
+}
+
+// PipelineOutputBuilds returns, for each of the job's triggering pipeline
+// outputs, the build of the consumed job which last triggered the job.
+func (j *job) PipelineOutputBuilds() (map[string]int, error) {
+	rows, err := psql.Select("name", "triggered_build_id").
+		From("job_pipeline_outputs").
+		Where(sq.Eq{
+			"job_id":  j.id,
+			"trigger": true,
+		}).
+		Where(sq.NotEq{"triggered_build_id": nil}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	builds := map[string]int{}
+	for rows.Next() {
+		var (
+			name    string
+			buildID int
+		)
+		err = rows.Scan(&name, &buildID)
+		if err != nil {
+			return nil, err
+		}
+
+		builds[name] = buildID
+	}
+
+	return builds, nil
+}
+
+// SavePipelineOutputTriggers records the builds of the pipeline outputs which
+// have triggered the job, so that they do not trigger it again.
+func (j *job) SavePipelineOutputTriggers(triggers map[string]int) error {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for name, buildID := range triggers {
+		_, err = psql.Update("job_pipeline_outputs").
+			Set("triggered_build_id", buildID).
+			Where(sq.Eq{
+				"job_id": j.id,
+				"name":   name,
+			}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// insertJobPipelineOutputs saves the pipeline outputs consumed by the jobs.
+// Outputs which are still configured keep the build which last triggered
+// them, so that saving the config does not trigger the jobs again.
+func insertJobPipelineOutputs(tx Tx, jobConfigs atc.JobConfigs, jobNameToID map[string]int, teamID int, pipelineID int) error {
+	type outputKey struct {
+		jobID int
+		name  string
+	}
+
+	var teamName string
+	err := psql.Select("name").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&teamName)
+	if err != nil {
+		return err
+	}
+
+	configured := map[outputKey]bool{}
+	for _, jobConfig := range jobConfigs {
+		jobID := jobNameToID[jobConfig.Name]
+
+		err := jobConfig.StepConfig().Visit(atc.StepRecursor{
+			OnPipelineOutput: func(step *atc.PipelineOutputStep) error {
+				upstreamTeam := step.Team
+				if upstreamTeam == "" {
+					upstreamTeam = teamName
+				}
+
+				_, err := psql.Insert("job_pipeline_outputs").
+					Columns("job_id", "name", "team_name", "pipeline_name", "job_name", "trigger").
+					Values(jobID, step.Name, upstreamTeam, step.Pipeline, step.Job, step.Trigger).
+					Suffix(`ON CONFLICT (job_id, name) DO UPDATE SET
+						team_name = EXCLUDED.team_name,
+						pipeline_name = EXCLUDED.pipeline_name,
+						job_name = EXCLUDED.job_name,
+						trigger = EXCLUDED.trigger`).
+					RunWith(tx).
+					Exec()
+				if err != nil {
+					return err
+				}
+
+				configured[outputKey{jobID, step.Name}] = true
+				return nil
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	rows, err := psql.Select("o.job_id", "o.name").
+		From("job_pipeline_outputs o").
+		Join("jobs j ON j.id = o.job_id").
+		Where(sq.Eq{"j.pipeline_id": pipelineID}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	var stale []outputKey
+	for rows.Next() {
+		var key outputKey
+		err = rows.Scan(&key.jobID, &key.name)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		if !configured[key] {
+			stale = append(stale, key)
+		}
+	}
+
+	Close(rows)
+
+	for _, key := range stale {
+		_, err = psql.Delete("job_pipeline_outputs").
+			Where(sq.Eq{
+				"job_id": key.jobID,
+				"name":   key.name,
+			}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// requestScheduleOnPipelineOutputConsumers requests scheduling of the jobs
+// triggered by the job's builds through pipeline outputs.
+func requestScheduleOnPipelineOutputConsumers(tx Tx, jobID int) error {
+	_, err := tx.Exec(`
+		UPDATE jobs
+		SET schedule_requested = now()
+		WHERE id IN (
+			SELECT o.job_id
+			FROM job_pipeline_outputs o
+			JOIN jobs uj ON uj.id = $1
+			JOIN pipelines up ON up.id = uj.pipeline_id
+			JOIN teams ut ON ut.id = up.team_id
+			WHERE o.trigger
+			AND o.team_name = ut.name
+			AND o.pipeline_name = up.name
+			AND o.job_name = uj.name
+			AND up.instance_vars IS NULL
+		)
+	`, jobID)
+	return err
+}
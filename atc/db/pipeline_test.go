@@ -173,7 +173,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false)
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -1942,7 +1942,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -1962,7 +1962,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
@@ -2118,7 +2118,7 @@ var _ = Describe("Pipeline", func() {
 				})
 
 				var created bool
-				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false)
+				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
 			})
@@ -2185,6 +2185,76 @@ var _ = Describe("Pipeline", func() {
 			Expect(pipeline.Config()).To(Equal(pipelineConfig))
 		})
 	})
+
+	Describe("ConfigVersions", func() {
+		var firstVersion db.ConfigVersion
+
+		BeforeEach(func() {
+			firstVersion = pipeline.ConfigVersion()
+
+			newConfig := pipelineConfig
+			newConfig.Jobs = append(atc.JobConfigs{}, pipelineConfig.Jobs...)
+			newConfig.Jobs = append(newConfig.Jobs, atc.JobConfig{
+				Name:         "new-job",
+				PlanSequence: []atc.Step{{Config: &atc.GetStep{Name: "some-resource"}}},
+			})
+
+			var err error
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, newConfig, firstVersion, false, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns every saved version, newest first", func() {
+			versions, err := pipeline.ConfigVersions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(HaveLen(2))
+
+			Expect(versions[0].Version).To(Equal(int(pipeline.ConfigVersion())))
+			Expect(versions[0].CreatedBy).To(Equal("some-user"))
+			Expect(versions[0].CreatedAt).ToNot(BeZero())
+			Expect(versions[0].Config).To(BeNil())
+			Expect(versions[0].Changes).To(Equal(&atc.ConfigChanges{
+				Jobs: []atc.ObjectChange{{Name: "new-job", Action: atc.DiffAdded}},
+			}))
+
+			Expect(versions[1].Version).To(Equal(int(firstVersion)))
+			Expect(versions[1].CreatedBy).To(BeEmpty())
+			Expect(versions[1].Changes).To(BeNil())
+		})
+
+		It("finds a version along with its config", func() {
+			version, found, err := pipeline.FindConfigVersion(int(firstVersion))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(version.Config).To(Equal(&pipelineConfig))
+		})
+
+		It("does not find versions which were never saved", func() {
+			_, found, err := pipeline.FindConfigVersion(int(firstVersion) - 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the pipeline is set by a build", func() {
+			var build db.Build
+
+			BeforeEach(func() {
+				var err error
+				build, err = team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				pipeline, _, err = build.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, team.ID(), pipelineConfig, pipeline.ConfigVersion(), false)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records the build", func() {
+				versions, err := pipeline.ConfigVersions()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(versions[0].BuildID).To(Equal(build.ID()))
+				Expect(versions[0].CreatedBy).To(BeEmpty())
+			})
+		})
+	})
 })
 
 func intptr(i int) *int {
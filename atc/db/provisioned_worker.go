@@ -0,0 +1,119 @@
+package db
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// ProvisionedWorkerRepository keeps track of the workers provisioned for a
+// single step, so that no web node places other steps on them. Workers
+// provisioned for a step of a build are kept until the build finishes, as
+// later steps may use the volumes the step left on the worker.
+//
+//counterfeiter:generate . ProvisionedWorkerRepository
+type ProvisionedWorkerRepository interface {
+	Reserve(name string, buildID int) error
+	Release(name string) error
+	ReservedWorkers() (map[string]int, error)
+	FinishedReservations() ([]string, error)
+}
+
+type provisionedWorkerRepository struct {
+	conn Conn
+}
+
+func NewProvisionedWorkerRepository(conn Conn) ProvisionedWorkerRepository {
+	return &provisionedWorkerRepository{
+		conn: conn,
+	}
+}
+
+// Reserve records that the worker was provisioned for a step of the build,
+// or of no build if buildID is 0. The worker does not need to have registered
+// yet.
+func (repository *provisionedWorkerRepository) Reserve(name string, buildID int) error {
+	var build interface{}
+	if buildID != 0 {
+		build = buildID
+	}
+
+	_, err := psql.Insert("provisioned_workers").
+		Columns("name", "build_id").
+		Values(name, build).
+		Suffix("ON CONFLICT (name) DO NOTHING").
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+// Release removes the reservation once the worker has been torn down.
+func (repository *provisionedWorkerRepository) Release(name string) error {
+	_, err := psql.Delete("provisioned_workers").
+		Where(sq.Eq{"name": name}).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+// ReservedWorkers returns the ID of the build each reserved worker was
+// provisioned for, which is 0 if it was not provisioned for a build.
+func (repository *provisionedWorkerRepository) ReservedWorkers() (map[string]int, error) {
+	rows, err := psql.Select("name", "build_id").
+		From("provisioned_workers").
+		RunWith(repository.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	reserved := map[string]int{}
+	for rows.Next() {
+		var name string
+		var buildID sql.NullInt64
+		err = rows.Scan(&name, &buildID)
+		if err != nil {
+			return nil, err
+		}
+
+		reserved[name] = int(buildID.Int64)
+	}
+
+	return reserved, nil
+}
+
+// FinishedReservations returns the workers provisioned for builds which have
+// finished, or which no longer exist.
+func (repository *provisionedWorkerRepository) FinishedReservations() ([]string, error) {
+	rows, err := psql.Select("pw.name").
+		From("provisioned_workers pw").
+		Where(sq.NotEq{"pw.build_id": nil}).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1
+			FROM builds b
+			WHERE b.id = pw.build_id
+			AND NOT b.completed
+		)`)).
+		RunWith(repository.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var names []string
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
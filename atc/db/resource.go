@@ -46,6 +46,9 @@ type Resource interface {
 	ResourceConfigScopeID() int
 	Icon() string
 
+	// TeamShared is true for a team's shared resource used by the pipeline.
+	TeamShared() bool
+
 	HasWebhook() bool
 
 	CurrentPinnedVersion() atc.Version
@@ -86,6 +89,7 @@ var (
 		"r.nonce",
 		"r.resource_config_id",
 		"r.resource_config_scope_id",
+		"r.team_shared",
 		"p.name",
 		"p.instance_vars",
 		"t.id",
@@ -124,6 +128,7 @@ type resource struct {
 	pinComment            string
 	resourceConfigID      int
 	resourceConfigScopeID int
+	teamShared            bool
 	buildSummary          *atc.BuildSummary
 }
 
@@ -179,6 +184,7 @@ func (r *resource) PinComment() string               { return r.pinComment }
 func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
 func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
 func (r *resource) Icon() string                     { return r.config.Icon }
+func (r *resource) TeamShared() bool                 { return r.teamShared }
 
 func (r *resource) HasWebhook() bool { return r.WebhookToken() != "" }
 
@@ -806,7 +812,7 @@ func scanResource(r *resource, row scannable) error {
 		endTime   pq.NullTime
 	}
 
-	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName, &pinnedVersion, &pinComment, &pinnedThroughConfig, &build.id, &build.name, &build.status, &build.startTime, &build.endTime)
+	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.teamShared, &r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName, &pinnedVersion, &pinComment, &pinnedThroughConfig, &build.id, &build.name, &build.status, &build.startTime, &build.endTime)
 	if err != nil {
 		return err
 	}
@@ -173,7 +173,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), false)
+							}, db.ConfigVersion(0), false, "")
 							Expect(err).NotTo(HaveOccurred())
 
 							By("creating an image resource cache tied to the job in the second pipeline")
@@ -248,10 +248,14 @@ func findOrCreateResourceConfigScope(
 ) (ResourceConfigScope, error) {
 	var uniqueResource Resource
 	var resourceID *int
+	var teamID *int
 
 	if resource != nil {
+		// a team's shared resource has one version history across all of
+		// the pipelines of the team using it, as if global resources were
+		// enabled for the team only
 		var unique bool
-		if !atc.EnableGlobalResources {
+		if !atc.EnableGlobalResources && !resource.TeamShared() {
 			unique = true
 		} else {
 			if brt := resourceConfig.CreatedByBaseResourceType(); brt != nil {
@@ -264,6 +268,10 @@ func findOrCreateResourceConfigScope(
 
 			resourceID = &id
 			uniqueResource = resource
+		} else if resource.TeamShared() {
+			id := resource.TeamID()
+
+			teamID = &id
 		}
 	}
 
@@ -273,6 +281,7 @@ func findOrCreateResourceConfigScope(
 		From("resource_config_scopes").
 		Where(sq.Eq{
 			"resource_id":        resourceID,
+			"team_id":            teamID,
 			"resource_config_id": resourceConfig.ID(),
 		}).
 		RunWith(tx).
@@ -351,12 +360,27 @@ func findOrCreateResourceConfigScope(
 		if err != nil {
 			return nil, err
 		}
+	} else if teamID != nil {
+		err = psql.Insert("resource_config_scopes").
+			Columns("team_id", "resource_config_id").
+			Values(*teamID, resourceConfig.ID()).
+			Suffix(`
+				ON CONFLICT (team_id, resource_config_id) WHERE team_id IS NOT NULL DO UPDATE SET
+					resource_config_id = ?
+				RETURNING id
+			`, resourceConfig.ID()).
+			RunWith(tx).
+			QueryRow().
+			Scan(&scopeID)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		err = psql.Insert("resource_config_scopes").
 			Columns("resource_id", "resource_config_id").
 			Values(nil, resourceConfig.ID()).
 			Suffix(`
-				ON CONFLICT (resource_config_id) WHERE resource_id IS NULL DO UPDATE SET
+				ON CONFLICT (resource_config_id) WHERE resource_id IS NULL AND team_id IS NULL DO UPDATE SET
 					resource_config_id = ?
 				RETURNING id
 			`, resourceConfig.ID()).
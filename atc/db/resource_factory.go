@@ -50,7 +50,10 @@ func (r *resourceFactory) VisibleResources(teamNames []string) ([]Resource, erro
 			sq.Eq{"t.name": teamNames},
 			sq.And{
 				sq.NotEq{"t.name": teamNames},
-				sq.Eq{"p.public": true},
+				sq.Or{
+					sq.Eq{"p.public": true},
+					sharedWithTeams(teamNames),
+				},
 			},
 		}).
 		OrderBy("r.id ASC").
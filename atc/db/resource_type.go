@@ -44,6 +44,10 @@ type ResourceType interface {
 	ResourceConfigID() int
 	ResourceConfigScopeID() int
 
+	// TeamShared is true for a team's shared resource type used by the
+	// pipeline.
+	TeamShared() bool
+
 	HasWebhook() bool
 
 	SetResourceConfigScope(ResourceConfigScope) error
@@ -149,6 +153,7 @@ var resourceTypesQuery = psql.Select(
 	"t.id",
 	"t.name",
 	"r.resource_config_id",
+	"r.team_shared",
 	"ro.id",
 	"ro.last_check_start_time",
 	"ro.last_check_end_time",
@@ -186,6 +191,7 @@ type resourceType struct {
 	checkEvery            *atc.CheckEvery
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
+	teamShared            bool
 }
 
 func (t *resourceType) ID() int                       { return t.id }
@@ -204,6 +210,7 @@ func (t *resourceType) Params() atc.Params            { return t.params }
 func (t *resourceType) Tags() atc.Tags                { return t.tags }
 func (t *resourceType) ResourceConfigID() int         { return t.resourceConfigID }
 func (t *resourceType) ResourceConfigScopeID() int    { return t.resourceConfigScopeID }
+func (t *resourceType) TeamShared() bool              { return t.teamShared }
 
 func (t *resourceType) Version() atc.Version              { return t.version }
 func (t *resourceType) CurrentPinnedVersion() atc.Version { return nil }
@@ -336,7 +343,7 @@ func scanResourceType(t *resourceType, row scannable) error {
 		resourceConfigID                     sql.NullInt64
 	)
 
-	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &t.pipelineName, &pipelineInstanceVars, &t.teamID, &t.teamName, &resourceConfigID, &rcsID, &lastCheckStartTime, &lastCheckEndTime)
+	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &t.pipelineName, &pipelineInstanceVars, &t.teamID, &t.teamName, &resourceConfigID, &t.teamShared, &rcsID, &lastCheckStartTime, &lastCheckEndTime)
 	if err != nil {
 		return err
 	}
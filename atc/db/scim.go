@@ -0,0 +1,522 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+var (
+	ErrSCIMConflict      = errors.New("a user or group with the same name already exists")
+	ErrSCIMUnknownMember = errors.New("group member is not a known user")
+)
+
+// SCIMUser is a user provisioned by an identity provider through SCIM. User
+// names and emails are matched case-insensitively against the claims of
+// users logging in.
+type SCIMUser struct {
+	ID          string
+	ExternalID  string
+	UserName    string
+	DisplayName string
+	Email       string
+	Active      bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SCIMGroup is a group provisioned by an identity provider through SCIM,
+// granting its members the roles bound to "scim:<display name>" in the auth
+// config of teams.
+type SCIMGroup struct {
+	ID          string
+	ExternalID  string
+	DisplayName string
+	Members     []SCIMMember
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type SCIMMember struct {
+	UserID   string
+	UserName string
+}
+
+// SCIMFilter narrows down the users or groups returned. Name is matched
+// against the user name of users and the display name of groups. Zero values
+// match everything.
+type SCIMFilter struct {
+	Name       string
+	ExternalID string
+}
+
+//counterfeiter:generate . SCIMRepository
+type SCIMRepository interface {
+	CreateSCIMUser(user SCIMUser) (SCIMUser, error)
+	FindSCIMUser(id string) (SCIMUser, bool, error)
+	SCIMUsers(filter SCIMFilter) ([]SCIMUser, error)
+	UpdateSCIMUser(user SCIMUser) (SCIMUser, bool, error)
+	DeleteSCIMUser(id string) (bool, error)
+
+	CreateSCIMGroup(group SCIMGroup) (SCIMGroup, error)
+	FindSCIMGroup(id string) (SCIMGroup, bool, error)
+	SCIMGroups(filter SCIMFilter) ([]SCIMGroup, error)
+
+	// UpdateSCIMGroup replaces the group's name and members.
+	UpdateSCIMGroup(group SCIMGroup) (SCIMGroup, bool, error)
+	DeleteSCIMGroup(id string) (bool, error)
+
+	// SCIMUserGroups returns the names of the groups of the active user
+	// whose externalId, or failing that userName, is the given ID of the user
+	// at the identity provider.
+	SCIMUserGroups(userID string) ([]string, error)
+
+	// SCIMGroupMembers returns the active members of the named group.
+	SCIMGroupMembers(groupName string) ([]SCIMUser, error)
+}
+
+func NewSCIMRepository(conn Conn) SCIMRepository {
+	return &scimRepository{conn}
+}
+
+type scimRepository struct {
+	conn Conn
+}
+
+var scimUsersQuery = psql.Select(
+	"u.id",
+	"u.external_id",
+	"u.user_name",
+	"u.display_name",
+	"u.email",
+	"u.active",
+	"u.created_at",
+	"u.updated_at",
+).From("scim_users u")
+
+var scimGroupsQuery = psql.Select(
+	"g.id",
+	"g.external_id",
+	"g.display_name",
+	"g.created_at",
+	"g.updated_at",
+).From("scim_groups g")
+
+func (r *scimRepository) CreateSCIMUser(user SCIMUser) (SCIMUser, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return SCIMUser{}, err
+	}
+
+	user.ID = id.String()
+
+	err = psql.Insert("scim_users").
+		Columns("id", "external_id", "user_name", "display_name", "email", "active").
+		Values(user.ID, nullString(user.ExternalID), user.UserName, nullString(user.DisplayName), nullString(user.Email), user.Active).
+		Suffix("RETURNING created_at, updated_at").
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		return SCIMUser{}, scimError(err)
+	}
+
+	return user, nil
+}
+
+func (r *scimRepository) FindSCIMUser(id string) (SCIMUser, bool, error) {
+	users, err := r.scimUsers(scimUsersQuery.Where(sq.Eq{"u.id": id}))
+	if err != nil {
+		return SCIMUser{}, false, err
+	}
+
+	if len(users) == 0 {
+		return SCIMUser{}, false, nil
+	}
+
+	return users[0], true, nil
+}
+
+func (r *scimRepository) SCIMUsers(filter SCIMFilter) ([]SCIMUser, error) {
+	query := scimUsersQuery.OrderBy("u.created_at", "u.id")
+
+	if filter.Name != "" {
+		query = query.Where(sq.Expr("lower(u.user_name) = lower(?)", filter.Name))
+	}
+
+	if filter.ExternalID != "" {
+		query = query.Where(sq.Eq{"u.external_id": filter.ExternalID})
+	}
+
+	return r.scimUsers(query)
+}
+
+func (r *scimRepository) UpdateSCIMUser(user SCIMUser) (SCIMUser, bool, error) {
+	err := psql.Update("scim_users").
+		SetMap(map[string]interface{}{
+			"external_id":  nullString(user.ExternalID),
+			"user_name":    user.UserName,
+			"display_name": nullString(user.DisplayName),
+			"email":        nullString(user.Email),
+			"active":       user.Active,
+			"updated_at":   sq.Expr("now()"),
+		}).
+		Where(sq.Eq{"id": user.ID}).
+		Suffix("RETURNING created_at, updated_at").
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return SCIMUser{}, false, nil
+		}
+
+		return SCIMUser{}, false, scimError(err)
+	}
+
+	return user, true, nil
+}
+
+func (r *scimRepository) DeleteSCIMUser(id string) (bool, error) {
+	return r.delete("scim_users", id)
+}
+
+func (r *scimRepository) CreateSCIMGroup(group SCIMGroup) (SCIMGroup, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+
+	group.ID = id.String()
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+
+	defer Rollback(tx)
+
+	err = psql.Insert("scim_groups").
+		Columns("id", "external_id", "display_name").
+		Values(group.ID, nullString(group.ExternalID), group.DisplayName).
+		Suffix("RETURNING created_at, updated_at").
+		RunWith(tx).
+		QueryRow().
+		Scan(&group.CreatedAt, &group.UpdatedAt)
+	if err != nil {
+		return SCIMGroup{}, scimError(err)
+	}
+
+	err = addSCIMGroupMembers(tx, group.ID, memberIDs(group.Members))
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+
+	group, _, err = r.FindSCIMGroup(group.ID)
+	return group, err
+}
+
+func (r *scimRepository) FindSCIMGroup(id string) (SCIMGroup, bool, error) {
+	groups, err := r.scimGroups(scimGroupsQuery.Where(sq.Eq{"g.id": id}))
+	if err != nil {
+		return SCIMGroup{}, false, err
+	}
+
+	if len(groups) == 0 {
+		return SCIMGroup{}, false, nil
+	}
+
+	return groups[0], true, nil
+}
+
+func (r *scimRepository) SCIMGroups(filter SCIMFilter) ([]SCIMGroup, error) {
+	query := scimGroupsQuery.OrderBy("g.created_at", "g.id")
+
+	if filter.Name != "" {
+		query = query.Where(sq.Expr("lower(g.display_name) = lower(?)", filter.Name))
+	}
+
+	if filter.ExternalID != "" {
+		query = query.Where(sq.Eq{"g.external_id": filter.ExternalID})
+	}
+
+	return r.scimGroups(query)
+}
+
+func (r *scimRepository) UpdateSCIMGroup(group SCIMGroup) (SCIMGroup, bool, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return SCIMGroup{}, false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("scim_groups").
+		Set("external_id", nullString(group.ExternalID)).
+		Set("display_name", group.DisplayName).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": group.ID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return SCIMGroup{}, false, scimError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return SCIMGroup{}, false, err
+	}
+
+	if affected == 0 {
+		return SCIMGroup{}, false, nil
+	}
+
+	_, err = psql.Delete("scim_group_members").
+		Where(sq.Eq{"group_id": group.ID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return SCIMGroup{}, false, err
+	}
+
+	err = addSCIMGroupMembers(tx, group.ID, memberIDs(group.Members))
+	if err != nil {
+		return SCIMGroup{}, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return SCIMGroup{}, false, err
+	}
+
+	return r.FindSCIMGroup(group.ID)
+}
+
+func (r *scimRepository) DeleteSCIMGroup(id string) (bool, error) {
+	return r.delete("scim_groups", id)
+}
+
+func (r *scimRepository) SCIMUserGroups(userID string) ([]string, error) {
+	if userID == "" {
+		return nil, nil
+	}
+
+	rows, err := psql.Select("DISTINCT g.display_name").
+		From("scim_groups g").
+		Join("scim_group_members m ON m.group_id = g.id").
+		Join("scim_users u ON u.id = m.user_id").
+		Where(sq.Eq{"u.active": true}).
+		Where(sq.Or{
+			sq.Eq{"u.external_id": userID},
+			sq.And{
+				sq.Eq{"u.external_id": nil},
+				sq.Eq{"u.user_name": userID},
+			},
+		}).
+		OrderBy("g.display_name").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var groups []string
+	for rows.Next() {
+		var group string
+		err = rows.Scan(&group)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, rows.Err()
+}
+
+func (r *scimRepository) SCIMGroupMembers(groupName string) ([]SCIMUser, error) {
+	return r.scimUsers(scimUsersQuery.
+		Join("scim_group_members m ON m.user_id = u.id").
+		Join("scim_groups g ON g.id = m.group_id").
+		Where(sq.Eq{"u.active": true}).
+		Where(sq.Expr("lower(g.display_name) = lower(?)", groupName)).
+		OrderBy("u.user_name"))
+}
+
+func (r *scimRepository) scimUsers(query sq.SelectBuilder) ([]SCIMUser, error) {
+	rows, err := query.RunWith(r.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	users := []SCIMUser{}
+	for rows.Next() {
+		var (
+			user                           SCIMUser
+			externalID, displayName, email sql.NullString
+		)
+
+		err = rows.Scan(&user.ID, &externalID, &user.UserName, &displayName, &email, &user.Active, &user.CreatedAt, &user.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		user.ExternalID = externalID.String
+		user.DisplayName = displayName.String
+		user.Email = email.String
+
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+func (r *scimRepository) scimGroups(query sq.SelectBuilder) ([]SCIMGroup, error) {
+	rows, err := query.RunWith(r.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	groups := []SCIMGroup{}
+	indexes := map[string]int{}
+	for rows.Next() {
+		var (
+			group      SCIMGroup
+			externalID sql.NullString
+		)
+
+		err = rows.Scan(&group.ID, &externalID, &group.DisplayName, &group.CreatedAt, &group.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		group.ExternalID = externalID.String
+		group.Members = []SCIMMember{}
+
+		indexes[group.ID] = len(groups)
+		groups = append(groups, group)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return groups, nil
+	}
+
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+
+	memberRows, err := psql.Select("m.group_id", "u.id", "u.user_name").
+		From("scim_group_members m").
+		Join("scim_users u ON u.id = m.user_id").
+		Where(sq.Eq{"m.group_id": groupIDs}).
+		OrderBy("u.user_name").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(memberRows)
+
+	for memberRows.Next() {
+		var (
+			groupID string
+			member  SCIMMember
+		)
+
+		err = memberRows.Scan(&groupID, &member.UserID, &member.UserName)
+		if err != nil {
+			return nil, err
+		}
+
+		group := &groups[indexes[groupID]]
+		group.Members = append(group.Members, member)
+	}
+
+	return groups, memberRows.Err()
+}
+
+func (r *scimRepository) delete(table string, id string) (bool, error) {
+	result, err := psql.Delete(table).
+		Where(sq.Eq{"id": id}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected != 0, nil
+}
+
+func addSCIMGroupMembers(tx Tx, groupID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	insert := psql.Insert("scim_group_members").
+		Columns("group_id", "user_id")
+
+	for _, userID := range userIDs {
+		insert = insert.Values(groupID, userID)
+	}
+
+	_, err := insert.
+		Suffix("ON CONFLICT DO NOTHING").
+		RunWith(tx).
+		Exec()
+	return scimError(err)
+}
+
+func memberIDs(members []SCIMMember) []string {
+	ids := make([]string, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.UserID)
+	}
+
+	return ids
+}
+
+func scimError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code.Name() {
+		case pqUniqueViolationErrCode:
+			return ErrSCIMConflict
+		case pqFKeyViolationErrCode:
+			return ErrSCIMUnknownMember
+		}
+	}
+
+	return err
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
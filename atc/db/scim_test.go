@@ -0,0 +1,159 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCIM", func() {
+	var (
+		repository db.SCIMRepository
+		alice, bob db.SCIMUser
+		group      db.SCIMGroup
+	)
+
+	BeforeEach(func() {
+		repository = db.NewSCIMRepository(dbConn)
+
+		var err error
+		alice, err = repository.CreateSCIMUser(db.SCIMUser{UserName: "alice", Email: "alice@example.com", ExternalID: "a1", Active: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		bob, err = repository.CreateSCIMUser(db.SCIMUser{UserName: "bob", Active: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		group, err = repository.CreateSCIMGroup(db.SCIMGroup{
+			DisplayName: "platform",
+			Members:     []db.SCIMMember{{UserID: alice.ID}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("users", func() {
+		It("finds them by id and filter", func() {
+			found, exists, err := repository.FindSCIMUser(alice.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(found.Email).To(Equal("alice@example.com"))
+
+			users, err := repository.SCIMUsers(db.SCIMFilter{Name: "ALICE"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(HaveLen(1))
+			Expect(users[0].ID).To(Equal(alice.ID))
+
+			users, err = repository.SCIMUsers(db.SCIMFilter{ExternalID: "a1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(HaveLen(1))
+
+			users, err = repository.SCIMUsers(db.SCIMFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(HaveLen(2))
+		})
+
+		It("rejects duplicate user names", func() {
+			_, err := repository.CreateSCIMUser(db.SCIMUser{UserName: "Alice"})
+			Expect(err).To(Equal(db.ErrSCIMConflict))
+		})
+
+		It("updates and deletes them", func() {
+			alice.DisplayName = "Alice Liddell"
+			alice.Active = false
+
+			updated, found, err := repository.UpdateSCIMUser(alice)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(updated.DisplayName).To(Equal("Alice Liddell"))
+
+			deleted, err := repository.DeleteSCIMUser(alice.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			_, found, err = repository.FindSCIMUser(alice.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("groups", func() {
+		It("stores their members", func() {
+			Expect(group.Members).To(Equal([]db.SCIMMember{{UserID: alice.ID, UserName: "alice"}}))
+
+			groups, err := repository.SCIMGroups(db.SCIMFilter{Name: "Platform"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(HaveLen(1))
+			Expect(groups[0].Members).To(Equal(group.Members))
+		})
+
+		It("rejects unknown members", func() {
+			group.Members = append(group.Members, db.SCIMMember{UserID: "bogus"})
+
+			_, _, err := repository.UpdateSCIMGroup(group)
+			Expect(err).To(Equal(db.ErrSCIMUnknownMember))
+		})
+
+		It("replaces them on update", func() {
+			group.DisplayName = "infra"
+			group.Members = []db.SCIMMember{{UserID: bob.ID}}
+
+			updated, found, err := repository.UpdateSCIMGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(updated.DisplayName).To(Equal("infra"))
+			Expect(updated.Members).To(Equal([]db.SCIMMember{{UserID: bob.ID, UserName: "bob"}}))
+		})
+
+		It("drops members of deleted users", func() {
+			_, err := repository.DeleteSCIMUser(alice.ID)
+			Expect(err).ToNot(HaveOccurred())
+
+			group, _, err = repository.FindSCIMGroup(group.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group.Members).To(BeEmpty())
+		})
+	})
+
+	Describe("SCIMUserGroups", func() {
+		It("finds the groups of a user by their external ID", func() {
+			groups, err := repository.SCIMUserGroups("a1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(Equal([]string{"platform"}))
+
+			groups, err = repository.SCIMUserGroups("alice")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(BeEmpty())
+
+			groups, err = repository.SCIMUserGroups("alice@example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(BeEmpty())
+		})
+
+		It("finds the groups of a user without an external ID by their user name", func() {
+			_, _, err := repository.UpdateSCIMGroup(db.SCIMGroup{
+				ID:          group.ID,
+				DisplayName: "platform",
+				Members:     []db.SCIMMember{{UserID: bob.ID}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			groups, err := repository.SCIMUserGroups("bob")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(Equal([]string{"platform"}))
+		})
+
+		It("ignores inactive users", func() {
+			alice.Active = false
+			_, _, err := repository.UpdateSCIMUser(alice)
+			Expect(err).ToNot(HaveOccurred())
+
+			groups, err := repository.SCIMUserGroups("a1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(BeEmpty())
+
+			members, err := repository.SCIMGroupMembers("platform")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(members).To(BeEmpty())
+		})
+	})
+})
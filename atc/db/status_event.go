@@ -0,0 +1,305 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+const statusEventsChannel = "status_events"
+
+var ErrStatusEventStreamClosed = errors.New("status event stream closed")
+
+//counterfeiter:generate . StatusEventFactory
+type StatusEventFactory interface {
+	// Events streams the status events the filter lets through. Only events
+	// after the given ID are sent; if it is 0, only events which happen from
+	// now on are sent.
+	Events(filter StatusEventFilter, after int) (StatusEventSource, error)
+}
+
+// StatusEventFilter narrows status events down to the pipelines a user can
+// see, using the same rules as VisiblePipelines: those of the user's teams,
+// public ones, and ones shared with the user's teams.
+type StatusEventFilter struct {
+	// TeamNames are the user's teams. If nil, every event is let through.
+	TeamNames []string
+
+	// PipelineIDs are other private pipelines the user can see, i.e. through
+	// roles scoped to them.
+	PipelineIDs []int
+}
+
+//counterfeiter:generate . StatusEventSource
+type StatusEventSource interface {
+	Next() (atc.StatusEvent, error)
+	Close() error
+}
+
+//counterfeiter:generate . StatusEventLifecycle
+type StatusEventLifecycle interface {
+	RemoveStatusEventsOlderThan(age time.Duration) (int, error)
+}
+
+type statusEventFactory struct {
+	conn Conn
+}
+
+func NewStatusEventFactory(conn Conn) StatusEventFactory {
+	return &statusEventFactory{conn}
+}
+
+func (f *statusEventFactory) Events(filter StatusEventFilter, after int) (StatusEventSource, error) {
+	if after == 0 {
+		err := psql.Select("COALESCE(MAX(id), 0)").
+			From("status_events").
+			RunWith(f.conn).
+			QueryRow().
+			Scan(&after)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	notifier, err := newConditionNotifier(f.conn.Bus(), statusEventsChannel, func() (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newStatusEventSource(f.conn, notifier, filter, after), nil
+}
+
+type statusEventLifecycle struct {
+	conn Conn
+}
+
+func NewStatusEventLifecycle(conn Conn) StatusEventLifecycle {
+	return &statusEventLifecycle{conn}
+}
+
+func (l *statusEventLifecycle) RemoveStatusEventsOlderThan(age time.Duration) (int, error) {
+	res, err := psql.Delete("status_events").
+		Where(sq.Expr(fmt.Sprintf("created_at < now() - '%d seconds'::interval", int(age.Seconds())))).
+		RunWith(l.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
+
+// saveStatusEvent records the event as part of the transaction. The
+// notification is queued on the transaction too, so that listeners only hear
+// about the event once it has been committed.
+func saveStatusEvent(tx Tx, teamID int, event atc.StatusEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("status_events").
+		Columns("team_id", "pipeline_id", "payload").
+		Values(teamID, sql.NullInt64{Int64: int64(event.PipelineID), Valid: event.PipelineID != 0}, payload).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("NOTIFY " + statusEventsChannel)
+	return err
+}
+
+// saveBuildStatusEvent records the build's new status. Check builds are left
+// out; they are far too frequent to be of interest.
+func saveBuildStatusEvent(tx Tx, b *build, status BuildStatus) error {
+	if b.resourceID != 0 || b.resourceTypeID != 0 || b.prototypeID != 0 {
+		return nil
+	}
+
+	return saveStatusEvent(tx, b.teamID, atc.StatusEvent{
+		Type:                 atc.StatusEventBuild,
+		TeamName:             b.teamName,
+		PipelineID:           b.pipelineID,
+		PipelineName:         b.pipelineName,
+		PipelineInstanceVars: b.pipelineInstanceVars,
+		JobName:              b.jobName,
+		BuildID:              b.id,
+		BuildName:            b.name,
+		Status:               atc.BuildStatus(status),
+	})
+}
+
+func saveJobStatusEvent(tx Tx, j *job, paused bool) error {
+	return saveStatusEvent(tx, j.teamID, atc.StatusEvent{
+		Type:                 atc.StatusEventJob,
+		TeamName:             j.teamName,
+		PipelineID:           j.pipelineID,
+		PipelineName:         j.pipelineName,
+		PipelineInstanceVars: j.pipelineInstanceVars,
+		JobName:              j.name,
+		Paused:               &paused,
+	})
+}
+
+type statusEventSource struct {
+	conn     Conn
+	notifier Notifier
+	filter   StatusEventFilter
+
+	events chan atc.StatusEvent
+	stop   chan struct{}
+	err    error
+	wg     *sync.WaitGroup
+}
+
+func newStatusEventSource(conn Conn, notifier Notifier, filter StatusEventFilter, after int) *statusEventSource {
+	source := &statusEventSource{
+		conn:     conn,
+		notifier: notifier,
+		filter:   filter,
+
+		events: make(chan atc.StatusEvent, 100),
+		stop:   make(chan struct{}),
+		wg:     new(sync.WaitGroup),
+	}
+
+	source.wg.Add(1)
+	go source.collectEvents(after)
+
+	return source
+}
+
+func (source *statusEventSource) Next() (atc.StatusEvent, error) {
+	e, ok := <-source.events
+	if !ok {
+		return atc.StatusEvent{}, source.err
+	}
+
+	return e, nil
+}
+
+func (source *statusEventSource) Close() error {
+	select {
+	case <-source.stop:
+		return nil
+	default:
+		close(source.stop)
+	}
+
+	source.wg.Wait()
+
+	return source.notifier.Close()
+}
+
+func (source *statusEventSource) collectEvents(cursor int) {
+	defer source.wg.Done()
+	defer close(source.events)
+
+	batchSize := cap(source.events)
+
+	for {
+		select {
+		case <-source.stop:
+			source.err = ErrStatusEventStreamClosed
+			return
+		default:
+		}
+
+		query := psql.Select("id", "payload", "created_at").
+			From("status_events").
+			Where(sq.Gt{"id": cursor}).
+			OrderBy("id ASC").
+			Limit(uint64(batchSize))
+
+		if source.filter.TeamNames != nil {
+			query = query.Where(source.filter.where())
+		}
+
+		rows, err := query.RunWith(source.conn).Query()
+		if err != nil {
+			source.err = err
+			return
+		}
+
+		var events []atc.StatusEvent
+		for rows.Next() {
+			var payload []byte
+			var createdAt time.Time
+			var ev atc.StatusEvent
+
+			err = rows.Scan(&cursor, &payload, &createdAt)
+			if err == nil {
+				err = json.Unmarshal(payload, &ev)
+			}
+
+			if err != nil {
+				_ = rows.Close()
+				source.err = err
+				return
+			}
+
+			ev.ID = cursor
+			ev.Time = createdAt.Unix()
+
+			events = append(events, ev)
+		}
+
+		err = rows.Close()
+		if err != nil {
+			source.err = err
+			return
+		}
+
+		for _, ev := range events {
+			select {
+			case source.events <- ev:
+			case <-source.stop:
+				source.err = ErrStatusEventStreamClosed
+				return
+			}
+		}
+
+		if len(events) == batchSize {
+			// still more events
+			continue
+		}
+
+		select {
+		case <-source.notifier.Notify():
+		case <-source.stop:
+			source.err = ErrStatusEventStreamClosed
+			return
+		}
+	}
+}
+
+func (filter StatusEventFilter) where() sq.Sqlizer {
+	visiblePipelines := sq.Select("p.id").
+		From("pipelines p").
+		Where(sq.Or{
+			sq.Eq{"p.public": true},
+			sharedWithTeams(filter.TeamNames),
+		})
+
+	return sq.Or{
+		sq.Expr("team_id IN (SELECT id FROM teams WHERE name = ANY(?))", pq.Array(filter.TeamNames)),
+		sq.Expr("pipeline_id IN (?)", visiblePipelines),
+		sq.Expr("pipeline_id = ANY(?)", pq.Array(filter.PipelineIDs)),
+	}
+}
@@ -0,0 +1,171 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Status Events", func() {
+	var (
+		factory   db.StatusEventFactory
+		lifecycle db.StatusEventLifecycle
+	)
+
+	BeforeEach(func() {
+		factory = db.NewStatusEventFactory(dbConn)
+		lifecycle = db.NewStatusEventLifecycle(dbConn)
+	})
+
+	nextEvent := func(source db.StatusEventSource) atc.StatusEvent {
+		events := make(chan atc.StatusEvent, 1)
+		go func() {
+			defer GinkgoRecover()
+
+			ev, err := source.Next()
+			Expect(err).ToNot(HaveOccurred())
+			events <- ev
+		}()
+
+		var ev atc.StatusEvent
+		Eventually(events).Should(Receive(&ev))
+		return ev
+	}
+
+	It("streams build status changes as they happen", func() {
+		source, err := factory.Events(db.StatusEventFilter{}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		defer source.Close()
+
+		build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		ev := nextEvent(source)
+		Expect(ev.ID).ToNot(BeZero())
+		Expect(ev.Time).ToNot(BeZero())
+		Expect(ev.Type).To(Equal(atc.StatusEventBuild))
+		Expect(ev.TeamName).To(Equal(defaultTeam.Name()))
+		Expect(ev.PipelineName).To(Equal(defaultPipeline.Name()))
+		Expect(ev.JobName).To(Equal(defaultJob.Name()))
+		Expect(ev.BuildID).To(Equal(build.ID()))
+		Expect(ev.Status).To(Equal(atc.StatusPending))
+
+		_, err = build.Start(atc.Plan{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nextEvent(source).Status).To(Equal(atc.StatusStarted))
+
+		err = build.Finish(db.BuildStatusSucceeded)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nextEvent(source).Status).To(Equal(atc.StatusSucceeded))
+	})
+
+	It("streams jobs being paused and unpaused", func() {
+		source, err := factory.Events(db.StatusEventFilter{}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		defer source.Close()
+
+		Expect(defaultJob.Pause()).To(Succeed())
+		ev := nextEvent(source)
+		Expect(ev.Type).To(Equal(atc.StatusEventJob))
+		Expect(ev.JobName).To(Equal(defaultJob.Name()))
+		Expect(*ev.Paused).To(BeTrue())
+
+		Expect(defaultJob.Unpause()).To(Succeed())
+		Expect(*nextEvent(source).Paused).To(BeFalse())
+	})
+
+	It("resumes after the given event", func() {
+		_, err := defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		source, err := factory.Events(db.StatusEventFilter{}, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		second, err := defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		ev := nextEvent(source)
+		Expect(ev.BuildID).To(Equal(second.ID()))
+		Expect(source.Close()).To(Succeed())
+
+		source, err = factory.Events(db.StatusEventFilter{}, ev.ID-1)
+		Expect(err).ToNot(HaveOccurred())
+		defer source.Close()
+
+		Expect(nextEvent(source).BuildID).To(Equal(second.ID()))
+	})
+
+	It("only streams the events of the given teams", func() {
+		otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		source, err := factory.Events(db.StatusEventFilter{TeamNames: []string{"some-other-team"}}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		defer source.Close()
+
+		_, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		build, err := otherTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		ev := nextEvent(source)
+		Expect(ev.TeamName).To(Equal("some-other-team"))
+		Expect(ev.BuildID).To(Equal(build.ID()))
+	})
+
+	Context("when streaming the events of other teams", func() {
+		var filter db.StatusEventFilter
+
+		BeforeEach(func() {
+			_, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			filter = db.StatusEventFilter{TeamNames: []string{"some-other-team"}}
+		})
+
+		streamsPipelineEvents := func() {
+			source, err := factory.Events(filter, 0)
+			Expect(err).ToNot(HaveOccurred())
+			defer source.Close()
+
+			Expect(defaultJob.Pause()).To(Succeed())
+
+			ev := nextEvent(source)
+			Expect(ev.PipelineID).To(Equal(defaultPipeline.ID()))
+			Expect(ev.JobName).To(Equal(defaultJob.Name()))
+		}
+
+		It("streams the events of public pipelines", func() {
+			Expect(defaultPipeline.Expose()).To(Succeed())
+			streamsPipelineEvents()
+		})
+
+		It("streams the events of pipelines shared with the teams", func() {
+			Expect(defaultPipeline.Share([]string{"some-other-team"})).To(Succeed())
+			streamsPipelineEvents()
+		})
+
+		It("streams the events of the given pipelines", func() {
+			filter.PipelineIDs = []int{defaultPipeline.ID()}
+			streamsPipelineEvents()
+		})
+	})
+
+	It("removes old events", func() {
+		_, err := defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		n, err := lifecycle.RemoveStatusEventsOlderThan(time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(BeZero())
+
+		n, err = lifecycle.RemoveStatusEventsOlderThan(0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).ToNot(BeZero())
+	})
+})
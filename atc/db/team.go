@@ -15,6 +15,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
 )
@@ -27,6 +28,18 @@ func (e ErrPipelineNotFound) Error() string {
 	return fmt.Sprintf("pipeline '%s' not found", atc.PipelineRef(e))
 }
 
+// ErrTeamResourceInUse is returned when removing a shared resource or
+// resource type which a pipeline of the team still uses.
+type ErrTeamResourceInUse struct {
+	Kind     string
+	Name     string
+	Pipeline atc.PipelineRef
+}
+
+func (e ErrTeamResourceInUse) Error() string {
+	return fmt.Sprintf("shared %s '%s' is still used by pipeline '%s'", e.Kind, e.Name, e.Pipeline)
+}
+
 //counterfeiter:generate . Team
 type Team interface {
 	ID() int
@@ -43,6 +56,7 @@ type Team interface {
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
+		createdBy string,
 	) (Pipeline, bool, error)
 	RenamePipeline(oldName string, newName string) (bool, error)
 
@@ -56,6 +70,7 @@ type Team interface {
 	CreateStartedBuild(plan atc.Plan) (Build, error)
 
 	PrivateAndPublicBuilds(Page) ([]Build, Pagination, error)
+	PipelineOutput(teamName string, pipelineName string, jobName string, buildID int) (Build, bool, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 
@@ -76,6 +91,18 @@ type Team interface {
 	FindWorkersForResourceCache(rcId int) ([]Worker, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	GitOps() (atc.GitOpsConfig, bool, error)
+	SetGitOps(config *atc.GitOpsConfig) error
+	GitOpsStatus() (atc.GitOpsStatus, bool, error)
+	SaveGitOpsStatus(status atc.GitOpsStatus) error
+
+	Notifications() (atc.NotificationConfigs, error)
+	SetNotifications(configs atc.NotificationConfigs) error
+	NotificationDeliveries(limit int) ([]atc.NotificationDelivery, error)
+
+	TeamResources() (atc.TeamResources, error)
+	SetTeamResources(shared atc.TeamResources) error
 }
 
 type team struct {
@@ -401,6 +428,7 @@ func savePipeline(
 	teamID int,
 	jobID sql.NullInt64,
 	buildID sql.NullInt64,
+	createdBy string,
 ) (int, bool, error) {
 
 	var instanceVars sql.NullString
@@ -547,12 +575,21 @@ func savePipeline(
 		}
 	}
 
-	err = updateResourcesName(tx, config.Resources, pipelineID)
+	shared, err := teamResources(tx, tx.EncryptionStrategy(), teamID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// the config is saved as it was given, but the pipeline runs with the
+	// team's shared resources it uses
+	applied, used := shared.ApplyTo(config)
+
+	err = updateResourcesName(tx, applied.Resources, pipelineID)
 	if err != nil {
 		return 0, false, err
 	}
 
-	resourceNameToID, err := saveResources(tx, config.Resources, pipelineID)
+	resourceNameToID, err := saveResources(tx, applied.Resources, pipelineID)
 	if err != nil {
 		return 0, false, err
 	}
@@ -569,7 +606,12 @@ func savePipeline(
 		return 0, false, err
 	}
 
-	err = saveResourceTypes(tx, config.ResourceTypes, pipelineID)
+	err = saveResourceTypes(tx, applied.ResourceTypes, pipelineID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = markTeamShared(tx, used, pipelineID)
 	if err != nil {
 		return 0, false, err
 	}
@@ -599,11 +641,21 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	err = insertJobPipelineOutputs(tx, config.Jobs, jobNameToID, teamID, pipelineID)
+	if err != nil {
+		return 0, false, err
+	}
+
 	err = requestScheduleForJobsInPipeline(tx, pipelineID)
 	if err != nil {
 		return 0, false, err
 	}
 
+	err = savePipelineConfigVersion(tx, pipelineID, config, createdBy, buildID)
+	if err != nil {
+		return 0, false, err
+	}
+
 	return pipelineID, !existingConfig, nil
 }
 
@@ -612,6 +664,7 @@ func (t *team) SavePipeline(
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
+	createdBy string,
 ) (Pipeline, bool, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -621,7 +674,7 @@ func (t *team) SavePipeline(
 	defer Rollback(tx)
 
 	nullID := sql.NullInt64{Valid: false}
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID)
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID, createdBy)
 	if err != nil {
 		return nil, false, err
 	}
@@ -970,6 +1023,492 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+// GitOps returns the config of the repository the team's pipelines are
+// reconciled from, if GitOps is enabled for the team.
+func (t *team) GitOps() (atc.GitOpsConfig, bool, error) {
+	var configBlob, nonce sql.NullString
+	err := psql.Select("config", "nonce").
+		From("team_gitops").
+		Where(sq.Eq{"team_id": t.id}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&configBlob, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.GitOpsConfig{}, false, nil
+		}
+
+		return atc.GitOpsConfig{}, false, err
+	}
+
+	if !configBlob.Valid {
+		return atc.GitOpsConfig{}, false, nil
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := t.conn.EncryptionStrategy().Decrypt(configBlob.String, noncense)
+	if err != nil {
+		return atc.GitOpsConfig{}, false, err
+	}
+
+	var config atc.GitOpsConfig
+	err = json.Unmarshal(decryptedConfig, &config)
+	if err != nil {
+		return atc.GitOpsConfig{}, false, err
+	}
+
+	return config, true, nil
+}
+
+// SetGitOps enables GitOps for the team, or disables it and forgets the last
+// sync status when config is nil.
+func (t *team) SetGitOps(config *atc.GitOpsConfig) error {
+	if config == nil {
+		_, err := psql.Delete("team_gitops").
+			Where(sq.Eq{"team_id": t.id}).
+			RunWith(t.conn).
+			Exec()
+		return err
+	}
+
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_gitops").
+		Columns("team_id", "config", "nonce").
+		Values(t.id, encryptedPayload, nonce).
+		Suffix("ON CONFLICT (team_id) DO UPDATE SET config = EXCLUDED.config, nonce = EXCLUDED.nonce").
+		RunWith(t.conn).
+		Exec()
+	return err
+}
+
+func (t *team) GitOpsStatus() (atc.GitOpsStatus, bool, error) {
+	var payload sql.NullString
+	err := psql.Select("status").
+		From("team_gitops").
+		Where(sq.Eq{"team_id": t.id}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&payload)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.GitOpsStatus{}, false, nil
+		}
+
+		return atc.GitOpsStatus{}, false, err
+	}
+
+	if !payload.Valid {
+		return atc.GitOpsStatus{}, false, nil
+	}
+
+	var status atc.GitOpsStatus
+	err = json.Unmarshal([]byte(payload.String), &status)
+	if err != nil {
+		return atc.GitOpsStatus{}, false, err
+	}
+
+	return status, true, nil
+}
+
+// SaveGitOpsStatus records the result of a sync. It does nothing if GitOps
+// has been disabled for the team in the meantime.
+func (t *team) SaveGitOpsStatus(status atc.GitOpsStatus) error {
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("team_gitops").
+		Set("status", payload).
+		Where(sq.Eq{"team_id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	return err
+}
+
+func (t *team) Notifications() (atc.NotificationConfigs, error) {
+	var configBlob string
+	var nonce sql.NullString
+	err := psql.Select("config", "nonce").
+		From("team_notifications").
+		Where(sq.Eq{"team_id": t.id}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&configBlob, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := t.conn.EncryptionStrategy().Decrypt(configBlob, noncense)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs atc.NotificationConfigs
+	err = json.Unmarshal(decryptedConfig, &configs)
+	if err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
+
+// SetNotifications replaces the team's notifications. Deliveries which are
+// still pending for a removed notification will fail.
+func (t *team) SetNotifications(configs atc.NotificationConfigs) error {
+	if len(configs) == 0 {
+		_, err := psql.Delete("team_notifications").
+			Where(sq.Eq{"team_id": t.id}).
+			RunWith(t.conn).
+			Exec()
+		return err
+	}
+
+	payload, err := json.Marshal(configs)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_notifications").
+		Columns("team_id", "config", "nonce").
+		Values(t.id, encryptedPayload, nonce).
+		Suffix("ON CONFLICT (team_id) DO UPDATE SET config = EXCLUDED.config, nonce = EXCLUDED.nonce").
+		RunWith(t.conn).
+		Exec()
+	return err
+}
+
+// NotificationDeliveries returns the team's most recent deliveries, newest
+// first.
+func (t *team) NotificationDeliveries(limit int) ([]atc.NotificationDelivery, error) {
+	rows, err := psql.Select("id", "notification", "status_event", "state", "attempts", "response_status", "error", "created_at", "last_attempt_at").
+		From("notification_deliveries").
+		Where(sq.Eq{"team_id": t.id}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []atc.NotificationDelivery{}
+	for rows.Next() {
+		var (
+			delivery       atc.NotificationDelivery
+			statusEvent    []byte
+			responseStatus sql.NullInt64
+			errMsg         sql.NullString
+			createdAt      time.Time
+			lastAttemptAt  pq.NullTime
+		)
+
+		err = rows.Scan(&delivery.ID, &delivery.Notification, &statusEvent, &delivery.State, &delivery.Attempts, &responseStatus, &errMsg, &createdAt, &lastAttemptAt)
+		if err != nil {
+			return nil, err
+		}
+
+		var event atc.StatusEvent
+		err = json.Unmarshal(statusEvent, &event)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.PipelineName = event.PipelineName
+		delivery.PipelineInstanceVars = event.PipelineInstanceVars
+		delivery.JobName = event.JobName
+		delivery.BuildID = event.BuildID
+		delivery.BuildName = event.BuildName
+		delivery.BuildStatus = event.Status
+		delivery.ResponseStatus = int(responseStatus.Int64)
+		delivery.Error = errMsg.String
+		delivery.CreatedAt = createdAt.Unix()
+
+		if lastAttemptAt.Valid {
+			delivery.LastAttemptAt = lastAttemptAt.Time.Unix()
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (t *team) TeamResources() (atc.TeamResources, error) {
+	return teamResources(t.conn, t.conn.EncryptionStrategy(), t.id)
+}
+
+// SetTeamResources replaces the team's shared resources and resource types
+// and applies the changes to every pipeline using them at once. Removing one
+// which is still used by a pipeline fails with ErrTeamResourceInUse.
+func (t *team) SetTeamResources(shared atc.TeamResources) error {
+	rows, err := pipelinesQuery.
+		Where(sq.Eq{
+			"team_id":  t.id,
+			"archived": false,
+		}).
+		Where(sq.Or{
+			sq.Expr("EXISTS (SELECT 1 FROM resources r WHERE r.pipeline_id = p.id AND r.active AND r.team_shared)"),
+			sq.Expr("EXISTS (SELECT 1 FROM resource_types r WHERE r.pipeline_id = p.id AND r.active AND r.team_shared)"),
+		}).
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	pipelines, err := scanPipelines(t.conn, t.lockFactory, rows)
+	if err != nil {
+		return err
+	}
+
+	// the configs hide the shared resources, so they are what the pipelines
+	// were set with regardless of the shared resources changing below
+	configs := make([]atc.Config, len(pipelines))
+	for i, pipeline := range pipelines {
+		configs[i], err = pipeline.Config()
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	if shared.IsEmpty() {
+		_, err = psql.Delete("team_resources").
+			Where(sq.Eq{"team_id": t.id}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	} else {
+		payload, err := json.Marshal(shared)
+		if err != nil {
+			return err
+		}
+
+		encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+		if err != nil {
+			return err
+		}
+
+		_, err = psql.Insert("team_resources").
+			Columns("team_id", "config", "nonce").
+			Values(t.id, encryptedPayload, nonce).
+			Suffix("ON CONFLICT (team_id) DO UPDATE SET config = EXCLUDED.config, nonce = EXCLUDED.nonce").
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, pipeline := range pipelines {
+		err = applyTeamResources(tx, pipeline, configs[i], shared)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func teamResources(runner sq.Runner, es encryption.Strategy, teamID int) (atc.TeamResources, error) {
+	var configBlob string
+	var nonce sql.NullString
+	err := psql.Select("config", "nonce").
+		From("team_resources").
+		Where(sq.Eq{"team_id": teamID}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&configBlob, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.TeamResources{}, nil
+		}
+
+		return atc.TeamResources{}, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := es.Decrypt(configBlob, noncense)
+	if err != nil {
+		return atc.TeamResources{}, err
+	}
+
+	var shared atc.TeamResources
+	err = json.Unmarshal(decryptedConfig, &shared)
+	if err != nil {
+		return atc.TeamResources{}, err
+	}
+
+	return shared, nil
+}
+
+// applyTeamResources brings the shared resources and resource types used by a
+// pipeline up to date with the team's definitions.
+func applyTeamResources(tx Tx, pipeline Pipeline, config atc.Config, shared atc.TeamResources) error {
+	_, used := shared.ApplyTo(config)
+
+	sharedResources, err := sharedNames(tx, "resources", pipeline.ID())
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sharedResources {
+		if _, found := shared.Resources.Lookup(name); !found {
+			return ErrTeamResourceInUse{Kind: "resource", Name: name, Pipeline: atc.PipelineRef{Name: pipeline.Name(), InstanceVars: pipeline.InstanceVars()}}
+		}
+	}
+
+	sharedResourceTypes, err := sharedNames(tx, "resource_types", pipeline.ID())
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sharedResourceTypes {
+		if _, found := shared.ResourceTypes.Lookup(name); !found {
+			return ErrTeamResourceInUse{Kind: "resource type", Name: name, Pipeline: atc.PipelineRef{Name: pipeline.Name(), InstanceVars: pipeline.InstanceVars()}}
+		}
+	}
+
+	_, err = saveResources(tx, used.Resources, pipeline.ID())
+	if err != nil {
+		return err
+	}
+
+	// a shared resource type may no longer be used once a shared resource
+	// changes its type
+	var usedTypeNames []string
+	for _, resourceType := range used.ResourceTypes {
+		usedTypeNames = append(usedTypeNames, resourceType.Name)
+	}
+
+	_, err = psql.Update("resource_types").
+		Set("active", false).
+		Where(sq.Eq{
+			"pipeline_id": pipeline.ID(),
+			"team_shared": true,
+		}).
+		Where(sq.NotEq{"name": usedTypeNames}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = saveResourceTypes(tx, used.ResourceTypes, pipeline.ID())
+	if err != nil {
+		return err
+	}
+
+	err = markTeamShared(tx, used, pipeline.ID())
+	if err != nil {
+		return err
+	}
+
+	return requestScheduleForJobsInPipeline(tx, pipeline.ID())
+}
+
+func sharedNames(tx Tx, table string, pipelineID int) ([]string, error) {
+	rows, err := psql.Select("name").
+		From(table).
+		Where(sq.Eq{
+			"pipeline_id": pipelineID,
+			"active":      true,
+			"team_shared": true,
+		}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var names []string
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	return names, rows.Err()
+}
+
+// markTeamShared flags which of a pipeline's resources and resource types are
+// the team's shared ones, rather than declared by the pipeline itself.
+func markTeamShared(tx Tx, used atc.TeamResources, pipelineID int) error {
+	var resourceNames []string
+	for _, resource := range used.Resources {
+		resourceNames = append(resourceNames, resource.Name)
+	}
+
+	_, err := psql.Update("resources").
+		Set("team_shared", sq.Eq{"name": resourceNames}).
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	var resourceTypeNames []string
+	for _, resourceType := range used.ResourceTypes {
+		resourceTypeNames = append(resourceTypeNames, resourceType.Name)
+	}
+
+	_, err = psql.Update("resource_types").
+		Set("team_shared", sq.Eq{"name": resourceTypeNames}).
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
@@ -1419,8 +1958,9 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		parentJobID   sql.NullInt64
 		parentBuildID sql.NullInt64
 		instanceVars  sql.NullString
+		sharedWith    []string
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &display, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &instanceVars)
+	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &display, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &instanceVars, pq.Array(&sharedWith))
 	if err != nil {
 		return err
 	}
@@ -1428,6 +1968,7 @@ func scanPipeline(p *pipeline, scan scannable) error {
 	p.lastUpdated = lastUpdated.Time
 	p.parentJobID = int(parentJobID.Int64)
 	p.parentBuildID = int(parentBuildID.Int64)
+	p.sharedWith = sharedWith
 
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
@@ -1734,9 +2275,21 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 			version = sql.NullString{Valid: true, String: string(versionJSON)}
 		}
 
+		var passedTeam, passedPipeline, passedJob sql.NullString
+		if step.PassedPipeline != "" {
+			passedPipelineJob, err := atc.ParsePassedPipelineJob(step.PassedPipeline)
+			if err != nil {
+				return err
+			}
+
+			passedTeam = sql.NullString{Valid: true, String: passedPipelineJob.Team}
+			passedPipeline = sql.NullString{Valid: true, String: passedPipelineJob.Pipeline}
+			passedJob = sql.NullString{Valid: true, String: passedPipelineJob.Job}
+		}
+
 		_, err := psql.Insert("job_inputs").
-			Columns("name", "job_id", "resource_id", "trigger", "version").
-			Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], step.Trigger, version).
+			Columns("name", "job_id", "resource_id", "trigger", "version", "passed_pipeline_team_name", "passed_pipeline_name", "passed_pipeline_job_name").
+			Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], step.Trigger, version, passedTeam, passedPipeline, passedJob).
 			RunWith(tx).
 			Exec()
 		if err != nil {
@@ -918,6 +918,234 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("GitOps", func() {
+		var config atc.GitOpsConfig
+
+		BeforeEach(func() {
+			config = atc.GitOpsConfig{
+				Type:   "git",
+				Source: atc.Source{"uri": "https://example.com/ci.git", "private_key": "secret"},
+				Path:   "pipelines.yml",
+			}
+		})
+
+		It("is disabled by default", func() {
+			_, found, err := defaultTeam.GitOps()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("saves the config", func() {
+			err := defaultTeam.SetGitOps(&config)
+			Expect(err).ToNot(HaveOccurred())
+
+			saved, found, err := defaultTeam.GitOps()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(saved).To(Equal(config))
+		})
+
+		It("saves the status of the last sync", func() {
+			err := defaultTeam.SetGitOps(&config)
+			Expect(err).ToNot(HaveOccurred())
+
+			status := atc.GitOpsStatus{
+				BuildID: 42,
+				Result:  atc.GitOpsSucceeded,
+				Created: []string{"some-pipeline"},
+				Managed: map[string]int{"some-pipeline": 3},
+			}
+
+			err = defaultTeam.SaveGitOpsStatus(status)
+			Expect(err).ToNot(HaveOccurred())
+
+			saved, found, err := defaultTeam.GitOpsStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(saved).To(Equal(status))
+		})
+
+		It("forgets the config and status when disabled", func() {
+			err := defaultTeam.SetGitOps(&config)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = defaultTeam.SaveGitOpsStatus(atc.GitOpsStatus{BuildID: 42})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = defaultTeam.SetGitOps(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := defaultTeam.GitOps()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = defaultTeam.GitOpsStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("TeamResources", func() {
+		var (
+			shared atc.TeamResources
+			config atc.Config
+		)
+
+		BeforeEach(func() {
+			shared = atc.TeamResources{
+				Resources: atc.ResourceConfigs{
+					{Name: "repo", Type: "some-type", Source: atc.Source{"uri": "some-uri"}},
+				},
+				ResourceTypes: atc.ResourceTypes{
+					{Name: "some-type", Type: "registry-image", Source: atc.Source{"repository": "some-repository"}},
+				},
+			}
+
+			config = atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						PlanSequence: []atc.Step{
+							{Config: &atc.GetStep{Name: "repo"}},
+						},
+					},
+				},
+			}
+		})
+
+		It("has none by default", func() {
+			saved, err := defaultTeam.TeamResources()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(saved.IsEmpty()).To(BeTrue())
+		})
+
+		It("saves them", func() {
+			err := defaultTeam.SetTeamResources(shared)
+			Expect(err).ToNot(HaveOccurred())
+
+			saved, err := defaultTeam.TeamResources()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(saved).To(Equal(shared))
+		})
+
+		Context("when a pipeline uses a shared resource", func() {
+			var pipeline db.Pipeline
+
+			BeforeEach(func() {
+				err := defaultTeam.SetTeamResources(shared)
+				Expect(err).ToNot(HaveOccurred())
+
+				pipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "shared-pipeline"}, config, db.ConfigVersion(0), false, "")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("runs the pipeline with the shared resource and its resource type", func() {
+				resource, found, err := pipeline.Resource("repo")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(resource.TeamShared()).To(BeTrue())
+				Expect(resource.Source()).To(Equal(atc.Source{"uri": "some-uri"}))
+
+				resourceType, found, err := pipeline.ResourceType("some-type")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(resourceType.TeamShared()).To(BeTrue())
+			})
+
+			It("leaves them out of the pipeline's config", func() {
+				savedConfig, err := pipeline.Config()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(savedConfig.Resources).To(BeEmpty())
+				Expect(savedConfig.ResourceTypes).To(BeEmpty())
+			})
+
+			It("applies changes to the shared resource to the pipeline", func() {
+				shared.Resources[0].Source = atc.Source{"uri": "other-uri"}
+
+				err := defaultTeam.SetTeamResources(shared)
+				Expect(err).ToNot(HaveOccurred())
+
+				resource, found, err := pipeline.Resource("repo")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(resource.Source()).To(Equal(atc.Source{"uri": "other-uri"}))
+			})
+
+			It("shares its version history with the team's pipelines only", func() {
+				resourceConfig, err := resourceConfigFactory.FindOrCreateResourceConfig(
+					defaultWorkerResourceType.Type,
+					atc.Source{"uri": "some-uri"},
+					nil,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				scopeOf := func(pipeline db.Pipeline) db.ResourceConfigScope {
+					resource, found, err := pipeline.Resource("repo")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					scope, err := resourceConfig.FindOrCreateScope(resource)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scope.Resource()).To(BeNil())
+
+					return scope
+				}
+
+				otherPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-shared-pipeline"}, config, db.ConfigVersion(0), false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				err = otherTeam.SetTeamResources(shared)
+				Expect(err).ToNot(HaveOccurred())
+
+				otherTeamPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "shared-pipeline"}, config, db.ConfigVersion(0), false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				globalScope, err := resourceConfig.FindOrCreateScope(nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				teamScope := scopeOf(pipeline)
+				Expect(scopeOf(otherPipeline).ID()).To(Equal(teamScope.ID()))
+				Expect(scopeOf(otherTeamPipeline).ID()).ToNot(Equal(teamScope.ID()))
+				Expect(globalScope.ID()).ToNot(Equal(teamScope.ID()))
+			})
+
+			It("does not allow removing the shared resource", func() {
+				shared.Resources = nil
+
+				err := defaultTeam.SetTeamResources(shared)
+				Expect(err).To(Equal(db.ErrTeamResourceInUse{
+					Kind:     "resource",
+					Name:     "repo",
+					Pipeline: atc.PipelineRef{Name: "shared-pipeline"},
+				}))
+			})
+
+			Context("when the pipeline declares the resource itself", func() {
+				BeforeEach(func() {
+					config.Resources = atc.ResourceConfigs{
+						{Name: "repo", Type: "git", Source: atc.Source{"uri": "own-uri"}},
+					}
+
+					var err error
+					pipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "shared-pipeline"}, config, pipeline.ConfigVersion(), false, "")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("uses its own declaration", func() {
+					resource, found, err := pipeline.Resource("repo")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(resource.TeamShared()).To(BeFalse())
+					Expect(resource.Source()).To(Equal(atc.Source{"uri": "own-uri"}))
+
+					_, found, err = pipeline.ResourceType("some-type")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+		})
+	})
+
 	Describe("Updating Auth", func() {
 		var (
 			authProvider atc.TeamAuth
@@ -991,21 +1219,21 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline3, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1029,7 +1257,7 @@ var _ = Describe("Team", func() {
 						Jobs: atc.JobConfigs{
 							{Name: "job-name"},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -1074,14 +1302,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = pipeline2.Expose()
@@ -1116,19 +1344,19 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			instancePipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "master"}}, atc.Config{}, 0, false)
+			instancePipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "master"}}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			instancePipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{}, 0, false)
+			instancePipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false)
+			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherTeamPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false)
+			otherTeamPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherTeamPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false)
+			otherTeamPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1285,7 +1513,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1436,7 +1664,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1542,7 +1770,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1697,7 +1925,7 @@ var _ = Describe("Team", func() {
 					Name:         "fake-pipeline",
 					InstanceVars: atc.InstanceVars{"branch": "feature"},
 				}
-				instancedPipeline, _, err = team.SavePipeline(instancedPipelineRef, atc.Config{}, db.ConfigVersion(0), false)
+				instancedPipeline, _, err = team.SavePipeline(instancedPipelineRef, atc.Config{}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1724,7 +1952,7 @@ var _ = Describe("Team", func() {
 				BeforeEach(func() {
 					var err error
 					namedPipelineRef = atc.PipelineRef{Name: "fake-pipeline"}
-					namedPipeline, _, err = team.SavePipeline(namedPipelineRef, atc.Config{}, db.ConfigVersion(0), false)
+					namedPipeline, _, err = team.SavePipeline(namedPipelineRef, atc.Config{}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -1872,13 +2100,13 @@ var _ = Describe("Team", func() {
 		})
 
 		It("returns true for created", func() {
-			_, created, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, created, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
 
 		It("caches the team id", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -1888,7 +2116,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as paused", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -1899,7 +2127,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -1910,7 +2138,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("is not archived by default", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -1921,10 +2149,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("requests schedule on the pipeline", func() {
-			requestedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			requestedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			requestedJob, found, err := requestedPipeline.Job("some-job")
@@ -1942,7 +2170,7 @@ var _ = Describe("Team", func() {
 				"source-other-config": "some-other-value",
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, requestedPipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, requestedPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			found, err = requestedJob.Reload()
@@ -1958,7 +2186,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resources from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1971,14 +2199,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -2021,7 +2249,7 @@ var _ = Describe("Team", func() {
 				"version": "v1",
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -2032,7 +2260,7 @@ var _ = Describe("Team", func() {
 
 			config.Resources[0].Version = nil
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -2064,7 +2292,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources = []atc.ResourceConfig{}
@@ -2112,7 +2340,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Resource("some-other-resource")
@@ -2121,7 +2349,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resource types from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2134,14 +2362,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource type config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2154,12 +2382,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource type as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes = []atc.ResourceType{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2168,7 +2396,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the prototypes from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			prototype, found, err := savedPipeline.Prototype("some-prototype")
@@ -2181,14 +2409,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates prototype config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Prototypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			prototype, found, err := savedPipeline.Prototype("some-prototype")
@@ -2201,12 +2429,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks prototype as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Prototypes = atc.Prototypes{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Prototype("some-resource-type")
@@ -2215,7 +2443,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the jobs from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-job")
@@ -2225,12 +2453,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates job config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs[0].Public = false
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2240,12 +2468,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks job inactive when it is no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs = []atc.JobConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Job("some-job")
@@ -2308,7 +2536,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2320,7 +2548,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[3].Name = "new-other-job"
 				config.Jobs[3].OldName = "new-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("new-job")
@@ -2331,7 +2559,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when old job has the same name as new job", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2339,7 +2567,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[0].Name = "some-job"
 				config.Jobs[0].OldName = "some-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("some-job")
@@ -2347,7 +2575,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with job name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Jobs[0].Name = "new-job"
@@ -2356,24 +2584,24 @@ var _ = Describe("Team", func() {
 				config.Jobs[1].Name = "some-job"
 				config.Jobs[1].OldName = "new-job"
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 			})
 
 			Context("when new job name is in database but is inactive", func() {
 				It("should successfully update job name", func() {
-					pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+					pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs = config.Jobs[:len(config.Jobs)-1]
 
-					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs[0].Name = "new-job"
 					config.Jobs[0].OldName = "some-job"
 
-					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion()+1, false)
+					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion()+1, false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
@@ -2393,7 +2621,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should successfully update resource name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2415,7 +2643,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("renamed-resource")
@@ -2423,7 +2651,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2449,7 +2677,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("new-resource")
@@ -2460,7 +2688,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when old resource has the same name as new resource", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2468,7 +2696,7 @@ var _ = Describe("Team", func() {
 				config.Resources[0].Name = "some-resource"
 				config.Resources[0].OldName = "some-resource"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("some-resource")
@@ -2476,7 +2704,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with resource name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Resources[0].Name = "new-resource"
@@ -2485,7 +2713,7 @@ var _ = Describe("Team", func() {
 				config.Resources[1].Name = "some-resource"
 				config.Resources[1].OldName = "new-resource"
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 			})
 
@@ -2592,7 +2820,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for jobs that are no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2615,7 +2843,7 @@ var _ = Describe("Team", func() {
 
 			config.Jobs = []atc.JobConfig{}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2628,7 +2856,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for tasks that are no longer exist", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2663,7 +2891,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2676,10 +2904,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("should not remove task caches in other pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2718,7 +2946,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2731,7 +2959,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the serial groups from the jobs in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			serialGroups := []SerialGroup{}
@@ -2762,7 +2990,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("saves tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2774,7 +3002,7 @@ var _ = Describe("Team", func() {
 
 		It("saves tags in the jobs table based on globs", func() {
 			otherConfig.Groups[0].Jobs = []string{"*-other-job"}
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2785,7 +3013,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2805,7 +3033,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err = team.SavePipeline(pipelineRef, otherConfig, savedPipeline.ConfigVersion(), false)
+			savedPipeline, _, err = team.SavePipeline(pipelineRef, otherConfig, savedPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = savedPipeline.Job("some-other-job")
@@ -2816,10 +3044,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("it returns created as false when updated", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			_, created, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, created, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeFalse())
 		})
@@ -2930,7 +3158,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err := psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -3086,7 +3314,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err = psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -3131,7 +3359,7 @@ var _ = Describe("Team", func() {
 
 		Context("updating an existing pipeline", func() {
 			It("maintains paused if the pipeline is paused", func() {
-				_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+				_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3139,7 +3367,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineRef)
@@ -3149,7 +3377,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("maintains unpaused if the pipeline is unpaused", func() {
-				_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3157,7 +3385,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), true, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineRef)
@@ -3167,11 +3395,11 @@ var _ = Describe("Team", func() {
 			})
 
 			It("resets to unarchived", func() {
-				team.SavePipeline(pipelineRef, config, 0, false)
+				team.SavePipeline(pipelineRef, config, 0, false, "")
 				pipeline, _, _ := team.Pipeline(pipelineRef)
 				pipeline.Archive()
 
-				team.SavePipeline(pipelineRef, config, db.ConfigVersion(0), true)
+				team.SavePipeline(pipelineRef, config, db.ConfigVersion(0), true, "")
 				pipeline.Reload()
 				Expect(pipeline.Archived()).To(BeFalse(), "the pipeline remained archived")
 			})
@@ -3180,9 +3408,9 @@ var _ = Describe("Team", func() {
 		It("can lookup a pipeline by name", func() {
 			otherPipelineFilter := atc.PipelineRef{Name: "an-other-pipeline-name"}
 
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			_, _, err = team.SavePipeline(otherPipelineFilter, otherConfig, 0, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3231,10 +3459,10 @@ var _ = Describe("Team", func() {
 			otherPipelineFilter := atc.PipelineRef{Name: "an-other-pipeline-name"}
 
 			By("being able to save the config")
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(otherPipelineFilter, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(otherPipelineFilter, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the saved config to later gets")
@@ -3312,22 +3540,22 @@ var _ = Describe("Team", func() {
 			})
 
 			By("not allowing non-sequential updates")
-			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()-1, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()+10, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()-1, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()+10, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
 			By("being able to update the config with a valid con")
-			pipeline, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion(), false)
+			pipeline, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion(), false)
+			otherPipeline, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the updated config")
@@ -3386,7 +3614,7 @@ var _ = Describe("Team", func() {
 				},
 			})
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceTypes, err := pipeline.ResourceTypes()
@@ -3406,27 +3634,27 @@ var _ = Describe("Team", func() {
 			It("can allow pipelines with the same name across teams", func() {
 				pipelineRef := atc.PipelineRef{Name: "steve"}
 
-				teamPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+				teamPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(teamPipeline.Paused()).To(BeTrue())
 
 				By("allowing you to save a pipeline with the same name in another team")
-				otherTeamPipeline, _, err := otherTeam.SavePipeline(pipelineRef, otherConfig, 0, true)
+				otherTeamPipeline, _, err := otherTeam.SavePipeline(pipelineRef, otherConfig, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(otherTeamPipeline.Paused()).To(BeTrue())
 
 				By("updating the pipeline config for the correct team's pipeline")
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, teamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, teamPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = otherTeam.SavePipeline(pipelineRef, config, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = otherTeam.SavePipeline(pipelineRef, config, otherTeamPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("cannot cross update configs")
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), true, "")
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -3496,6 +3724,7 @@ var _ = Describe("Team", func() {
 					config,
 					pipeline.ConfigVersion(),
 					false,
+					"",
 				)
 				if err != nil {
 					panic(err)
@@ -3567,19 +3796,19 @@ var _ = Describe("Team", func() {
 				p1, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: atc.InstanceVars{"version": "6.7.x"},
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				p2, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: atc.InstanceVars{"version": "7.0.x"},
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				p3, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: nil,
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -3687,7 +3916,7 @@ var _ = Describe("Team", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), false)
+							}, db.ConfigVersion(0), false, "")
 							Expect(err).NotTo(HaveOccurred())
 
 							otherResource, found, err = otherPipeline.Resource("some-resource")
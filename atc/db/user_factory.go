@@ -1,6 +1,7 @@
 package db
 
 import (
+	"encoding/json"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -8,7 +9,9 @@ import (
 
 //counterfeiter:generate . UserFactory
 type UserFactory interface {
-	CreateOrUpdateUser(username, connector, sub string) error
+	// CreateOrUpdateUser records a login of the user, along with the groups
+	// they are a member of, which their API tokens are authenticated with.
+	CreateOrUpdateUser(username, connector, sub string, groups []string) error
 	GetAllUsers() ([]User, error)
 	GetAllUsersByLoginDate(LastLogin time.Time) ([]User, error)
 }
@@ -23,7 +26,16 @@ func NewUserFactory(conn Conn) UserFactory {
 	}
 }
 
-func (f *userFactory) CreateOrUpdateUser(username, connector, sub string) error {
+func (f *userFactory) CreateOrUpdateUser(username, connector, sub string, groups []string) error {
+	if groups == nil {
+		groups = []string{}
+	}
+
+	groupsJSON, err := json.Marshal(groups)
+	if err != nil {
+		return err
+	}
+
 	tx, err := f.conn.Begin()
 
 	if err != nil {
@@ -32,13 +44,14 @@ func (f *userFactory) CreateOrUpdateUser(username, connector, sub string) error
 	defer Rollback(tx)
 
 	builder := psql.Insert("users").
-		Columns("username", "connector", "sub").
-		Values(username, connector, sub)
+		Columns("username", "connector", "sub", "groups").
+		Values(username, connector, sub, groupsJSON)
 
 	_, err = builder.Suffix(`ON CONFLICT (sub) DO UPDATE SET
 					username = EXCLUDED.username,
 					connector = EXCLUDED.connector,
 					sub = EXCLUDED.sub,
+					groups = EXCLUDED.groups,
 					last_login = now()`).
 		RunWith(tx).
 		Exec()
@@ -19,7 +19,7 @@ var _ = Describe("User Factory", func() {
 
 	JustBeforeEach(func() {
 		err = userFactory.CreateOrUpdateUser("test", "github",
-			base64.StdEncoding.EncodeToString([]byte("test"+"github")))
+			base64.StdEncoding.EncodeToString([]byte("test"+"github")), nil)
 		Expect(err).ToNot(HaveOccurred())
 
 		users, err = userFactory.GetAllUsers()
@@ -37,7 +37,7 @@ var _ = Describe("User Factory", func() {
 	Context("when username exists but with different connector", func() {
 		BeforeEach(func() {
 			err = userFactory.CreateOrUpdateUser("test", "basic",
-				base64.StdEncoding.EncodeToString([]byte("test"+"basic")))
+				base64.StdEncoding.EncodeToString([]byte("test"+"basic")), nil)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -52,7 +52,7 @@ var _ = Describe("User Factory", func() {
 
 		BeforeEach(func() {
 			err = userFactory.CreateOrUpdateUser("test", "github",
-				base64.StdEncoding.EncodeToString([]byte("test"+"github")))
+				base64.StdEncoding.EncodeToString([]byte("test"+"github")), nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			users, err = userFactory.GetAllUsers()
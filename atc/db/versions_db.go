@@ -230,6 +230,159 @@ func (versions VersionsDB) VersionExists(ctx context.Context, resourceID int, ve
 	return exists, nil
 }
 
+// LatestVersionPassedPipelineJob returns the latest version of the resource
+// which passed the job, possibly of another pipeline. When version is not
+// empty, only that version is considered.
+func (versions VersionsDB) LatestVersionPassedPipelineJob(ctx context.Context, resourceID int, passedJobID int, version ResourceVersion) (ResourceVersion, bool, error) {
+	builder := passedPipelineJobVersions(resourceID, passedJobID)
+
+	if version != "" {
+		builder = builder.Where(sq.Eq{"v.version_md5": version})
+	}
+
+	var latest ResourceVersion
+	err := builder.
+		OrderBy("v.check_order DESC").
+		Limit(1).
+		RunWith(versions.conn).
+		QueryRowContext(ctx).
+		Scan(&latest)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	return latest, true, nil
+}
+
+// NextEveryVersionPassedPipelineJob is NextEveryVersion for the versions of
+// the resource which passed the job: the oldest of them newer than the latest
+// version used by jobID, or the latest of them if there is none.
+func (versions VersionsDB) NextEveryVersionPassedPipelineJob(ctx context.Context, jobID int, resourceID int, passedJobID int) (ResourceVersion, bool, bool, error) {
+	tx, err := versions.conn.Begin()
+	if err != nil {
+		return "", false, false, err
+	}
+
+	defer tx.Rollback()
+
+	latest := func(builder sq.SelectBuilder) (ResourceVersion, bool, bool, error) {
+		var version ResourceVersion
+		err := builder.
+			OrderBy("v.check_order DESC").
+			Limit(1).
+			RunWith(tx).
+			QueryRowContext(ctx).
+			Scan(&version)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return "", false, false, nil
+			}
+			return "", false, false, err
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return "", false, false, err
+		}
+
+		return version, false, true, nil
+	}
+
+	var checkOrder int
+	err = psql.Select("v.check_order").
+		From("resources r").
+		Join("resource_config_versions v ON v.resource_config_scope_id = r.resource_config_scope_id").
+		Where(sq.Eq{
+			"r.id": resourceID,
+		}).
+		Where(sq.Expr(`EXISTS (
+			SELECT 1
+			FROM build_resource_config_version_inputs i
+			JOIN builds b ON b.id = i.build_id
+			WHERE b.job_id = ?
+			AND i.resource_id = r.id
+			AND i.version_md5 = v.version_md5
+		)`, jobID)).
+		OrderBy("v.check_order DESC").
+		Limit(1).
+		RunWith(tx).
+		QueryRowContext(ctx).
+		Scan(&checkOrder)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return latest(passedPipelineJobVersions(resourceID, passedJobID))
+		}
+
+		return "", false, false, err
+	}
+
+	rows, err := passedPipelineJobVersions(resourceID, passedJobID).
+		Where(sq.Gt{"v.check_order": checkOrder}).
+		OrderBy("v.check_order ASC").
+		Limit(2).
+		RunWith(tx).
+		QueryContext(ctx)
+	if err != nil {
+		return "", false, false, err
+	}
+
+	if rows.Next() {
+		var nextVersion ResourceVersion
+		err = rows.Scan(&nextVersion)
+		if err != nil {
+			rows.Close()
+			return "", false, false, err
+		}
+
+		hasNext := rows.Next()
+
+		rows.Close()
+
+		err = tx.Commit()
+		if err != nil {
+			return "", false, false, err
+		}
+
+		return nextVersion, hasNext, true, nil
+	}
+
+	rows.Close()
+
+	return latest(passedPipelineJobVersions(resourceID, passedJobID).
+		Where(sq.LtOrEq{"v.check_order": checkOrder}))
+}
+
+// passedPipelineJobVersions selects the versions of the resource which were
+// an input or an output of a successful build of the job. The job may belong
+// to another pipeline, so the versions are matched through the resources
+// sharing the same resource config.
+func passedPipelineJobVersions(resourceID int, passedJobID int) sq.SelectBuilder {
+	return psql.Select("v.version_md5").
+		From("resources r").
+		Join("resource_config_versions v ON v.resource_config_scope_id = r.resource_config_scope_id").
+		Where(sq.Eq{
+			"r.id": resourceID,
+		}).
+		Where(sq.Expr("v.version_md5 NOT IN (SELECT version_md5 FROM resource_disabled_versions WHERE resource_id = ?)", resourceID)).
+		Where(sq.Expr(`EXISTS (
+			SELECT 1
+			FROM builds b
+			JOIN (
+				SELECT build_id, resource_id, version_md5 FROM build_resource_config_version_inputs
+				UNION ALL
+				SELECT build_id, resource_id, version_md5 FROM build_resource_config_version_outputs
+			) bv ON bv.build_id = b.id
+			JOIN resources ur ON ur.id = bv.resource_id
+			WHERE b.job_id = ?
+			AND b.status = 'succeeded'
+			AND bv.version_md5 = v.version_md5
+			AND ur.resource_config_id = r.resource_config_id
+		)`, passedJobID))
+}
+
 func (versions VersionsDB) FindVersionOfResource(ctx context.Context, resourceID int, v atc.Version) (ResourceVersion, bool, error) {
 	versionJSON, err := json.Marshal(v)
 	if err != nil {
@@ -770,4 +770,281 @@ var _ = Describe("VersionsDB", func() {
 			})
 		})
 	})
+
+	Describe("LatestVersionPassedPipelineJob", func() {
+		var (
+			upstream   *dbtest.Scenario
+			downstream *dbtest.Scenario
+
+			upstreamBuild   db.Build
+			upstreamStatus  db.BuildStatus
+			pinnedVersion   db.ResourceVersion
+			resourceVersion db.ResourceVersion
+			found           bool
+		)
+
+		resources := atc.ResourceConfigs{
+			{
+				Name:   "some-resource",
+				Type:   dbtest.BaseResourceType,
+				Source: atc.Source{"some": "source"},
+			},
+		}
+
+		BeforeEach(func() {
+			upstreamStatus = db.BuildStatusSucceeded
+			pinnedVersion = ""
+		})
+
+		JustBeforeEach(func() {
+			upstream = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "upstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name: "some-resource",
+									},
+								},
+							},
+						},
+					},
+					Resources: resources,
+				}),
+				builder.WithResourceVersions(
+					"some-resource",
+					atc.Version{"v": "1"},
+					atc.Version{"v": "2"},
+					atc.Version{"v": "3"},
+				),
+				builder.WithJobBuild(&upstreamBuild, "upstream-job", dbtest.JobInputs{
+					{
+						Name:    "some-resource",
+						Version: atc.Version{"v": "2"},
+					},
+				}, dbtest.JobOutputs{}),
+			)
+
+			err := upstreamBuild.Finish(upstreamStatus)
+			Expect(err).ToNot(HaveOccurred())
+
+			downstream = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "downstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name:           "some-resource",
+										PassedPipeline: upstream.Team.Name() + "/some-pipeline/upstream-job",
+									},
+								},
+							},
+						},
+					},
+					Resources: resources,
+				}),
+				builder.WithResourceVersions(
+					"some-resource",
+					atc.Version{"v": "1"},
+					atc.Version{"v": "2"},
+					atc.Version{"v": "3"},
+				),
+			)
+
+			resourceVersion, found, err = vdb.LatestVersionPassedPipelineJob(
+				ctx,
+				downstream.Resource("some-resource").ID(),
+				upstream.Job("upstream-job").ID(),
+				pinnedVersion,
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the version used by the successful build of the other pipeline", func() {
+			Expect(found).To(BeTrue())
+			Expect(string(resourceVersion)).To(Equal(convertToMD5(atc.Version{"v": "2"})))
+		})
+
+		Context("when the build of the other pipeline failed", func() {
+			BeforeEach(func() {
+				upstreamStatus = db.BuildStatusFailed
+			})
+
+			It("does not find a version", func() {
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when a version which did not pass the job is given", func() {
+			BeforeEach(func() {
+				pinnedVersion = db.ResourceVersion(convertToMD5(atc.Version{"v": "3"}))
+			})
+
+			It("does not find a version", func() {
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the version which passed the job is given", func() {
+			BeforeEach(func() {
+				pinnedVersion = db.ResourceVersion(convertToMD5(atc.Version{"v": "2"}))
+			})
+
+			It("returns the version", func() {
+				Expect(found).To(BeTrue())
+				Expect(string(resourceVersion)).To(Equal(convertToMD5(atc.Version{"v": "2"})))
+			})
+		})
+	})
+
+	Describe("NextEveryVersionPassedPipelineJob", func() {
+		var (
+			upstream   *dbtest.Scenario
+			downstream *dbtest.Scenario
+
+			downstreamVersions []atc.Version
+
+			resourceVersion db.ResourceVersion
+			hasNext         bool
+			found           bool
+		)
+
+		resources := atc.ResourceConfigs{
+			{
+				Name:   "some-resource",
+				Type:   dbtest.BaseResourceType,
+				Source: atc.Source{"some": "source"},
+			},
+		}
+
+		jobConfig := func(name string, passedPipeline string) atc.JobConfig {
+			return atc.JobConfig{
+				Name: name,
+				PlanSequence: []atc.Step{
+					{
+						Config: &atc.GetStep{
+							Name:           "some-resource",
+							PassedPipeline: passedPipeline,
+							Version:        &atc.VersionConfig{Every: true},
+						},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			downstreamVersions = nil
+		})
+
+		JustBeforeEach(func() {
+			upstream = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					Jobs:      atc.JobConfigs{jobConfig("upstream-job", "")},
+					Resources: resources,
+				}),
+				builder.WithResourceVersions(
+					"some-resource",
+					atc.Version{"v": "1"},
+					atc.Version{"v": "2"},
+					atc.Version{"v": "3"},
+					atc.Version{"v": "4"},
+				),
+			)
+
+			// v3 does not pass the job
+			for _, version := range []atc.Version{{"v": "1"}, {"v": "2"}, {"v": "4"}} {
+				var build db.Build
+				upstream.Run(builder.WithJobBuild(&build, "upstream-job", dbtest.JobInputs{
+					{
+						Name:    "some-resource",
+						Version: version,
+					},
+				}, dbtest.JobOutputs{}))
+
+				err := build.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			downstream = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					Jobs:      atc.JobConfigs{jobConfig("downstream-job", upstream.Team.Name()+"/some-pipeline/upstream-job")},
+					Resources: resources,
+				}),
+				builder.WithResourceVersions(
+					"some-resource",
+					atc.Version{"v": "1"},
+					atc.Version{"v": "2"},
+					atc.Version{"v": "3"},
+					atc.Version{"v": "4"},
+				),
+			)
+
+			for _, version := range downstreamVersions {
+				var build db.Build
+				downstream.Run(builder.WithJobBuild(&build, "downstream-job", dbtest.JobInputs{
+					{
+						Name:    "some-resource",
+						Version: version,
+					},
+				}, dbtest.JobOutputs{}))
+			}
+
+			var err error
+			resourceVersion, hasNext, found, err = vdb.NextEveryVersionPassedPipelineJob(
+				ctx,
+				downstream.Job("downstream-job").ID(),
+				downstream.Resource("some-resource").ID(),
+				upstream.Job("upstream-job").ID(),
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the job has not run", func() {
+			It("returns the latest version which passed the job of the other pipeline", func() {
+				Expect(found).To(BeTrue())
+				Expect(hasNext).To(BeFalse())
+				Expect(string(resourceVersion)).To(Equal(convertToMD5(atc.Version{"v": "4"})))
+			})
+		})
+
+		Context("when the job has run with a version", func() {
+			BeforeEach(func() {
+				downstreamVersions = []atc.Version{{"v": "1"}}
+			})
+
+			It("returns the next version which passed the job of the other pipeline", func() {
+				Expect(found).To(BeTrue())
+				Expect(hasNext).To(BeTrue())
+				Expect(string(resourceVersion)).To(Equal(convertToMD5(atc.Version{"v": "2"})))
+			})
+		})
+
+		Context("when the next version did not pass the job of the other pipeline", func() {
+			BeforeEach(func() {
+				downstreamVersions = []atc.Version{{"v": "2"}}
+			})
+
+			It("skips it", func() {
+				Expect(found).To(BeTrue())
+				Expect(hasNext).To(BeFalse())
+				Expect(string(resourceVersion)).To(Equal(convertToMD5(atc.Version{"v": "4"})))
+			})
+		})
+
+		Context("when the job has run with the latest version", func() {
+			BeforeEach(func() {
+				downstreamVersions = []atc.Version{{"v": "4"}}
+			})
+
+			It("returns it again", func() {
+				Expect(found).To(BeTrue())
+				Expect(hasNext).To(BeFalse())
+				Expect(string(resourceVersion)).To(Equal(convertToMD5(atc.Version{"v": "4"})))
+			})
+		})
+	})
 })
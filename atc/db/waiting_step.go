@@ -0,0 +1,185 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// WaitingStepSpec describes the worker that a step is waiting for.
+type WaitingStepSpec struct {
+	TeamID   int
+	Platform string
+	Tags     []string
+	Type     string
+}
+
+// WaitingStepSummary is the number of steps currently waiting for a worker
+// matching the same platform, tags and team.
+type WaitingStepSummary struct {
+	TeamID   int
+	TeamName string
+	Platform string
+	Tags     []string
+	Count    int
+}
+
+//counterfeiter:generate . WaitingStepRepository
+type WaitingStepRepository interface {
+	StartWaiting(WaitingStepSpec) (WaitingStep, error)
+	WaitingSteps(freshness time.Duration) ([]WaitingStepSummary, error)
+	RemoveStaleWaitingSteps(freshness time.Duration) (int, error)
+}
+
+//counterfeiter:generate . WaitingStep
+type WaitingStep interface {
+	ID() int
+	Refresh() error
+	Done() error
+}
+
+type waitingStepRepository struct {
+	conn Conn
+}
+
+func NewWaitingStepRepository(conn Conn) WaitingStepRepository {
+	return &waitingStepRepository{
+		conn: conn,
+	}
+}
+
+func (repository *waitingStepRepository) StartWaiting(spec WaitingStepSpec) (WaitingStep, error) {
+	tags, err := json.Marshal(spec.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var teamID *int
+	if spec.TeamID != 0 {
+		teamID = &spec.TeamID
+	}
+
+	var platform *string
+	if spec.Platform != "" {
+		platform = &spec.Platform
+	}
+
+	var id int
+	err = psql.Insert("waiting_steps").
+		Columns("team_id", "platform", "tags", "type").
+		Values(teamID, platform, tags, spec.Type).
+		Suffix("RETURNING id").
+		RunWith(repository.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &waitingStep{
+		id:   id,
+		conn: repository.conn,
+	}, nil
+}
+
+// WaitingSteps returns the steps that are waiting for a worker, grouped by
+// the platform, tags and team they require. Steps that have not been
+// refreshed within the freshness window are assumed to belong to an ATC that
+// went away and are ignored.
+func (repository *waitingStepRepository) WaitingSteps(freshness time.Duration) ([]WaitingStepSummary, error) {
+	rows, err := psql.Select("ws.team_id", "t.name", "ws.platform", "ws.tags", "COUNT(*)").
+		From("waiting_steps ws").
+		LeftJoin("teams t ON t.id = ws.team_id").
+		Where(sq.Expr(fmt.Sprintf("ws.updated_at > now() - '%d seconds'::interval", int(freshness.Seconds())))).
+		GroupBy("ws.team_id", "t.name", "ws.platform", "ws.tags").
+		OrderBy("ws.team_id", "ws.platform", "ws.tags").
+		RunWith(repository.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var summaries []WaitingStepSummary
+	for rows.Next() {
+		var (
+			summary  WaitingStepSummary
+			teamID   *int
+			teamName *string
+			platform *string
+			tags     *string
+		)
+
+		err = rows.Scan(&teamID, &teamName, &platform, &tags, &summary.Count)
+		if err != nil {
+			return nil, err
+		}
+
+		if teamID != nil {
+			summary.TeamID = *teamID
+		}
+
+		if teamName != nil {
+			summary.TeamName = *teamName
+		}
+
+		if platform != nil {
+			summary.Platform = *platform
+		}
+
+		if tags != nil {
+			err = json.Unmarshal([]byte(*tags), &summary.Tags)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, nil
+}
+
+func (repository *waitingStepRepository) RemoveStaleWaitingSteps(freshness time.Duration) (int, error) {
+	result, err := psql.Delete("waiting_steps").
+		Where(sq.Expr(fmt.Sprintf("updated_at <= now() - '%d seconds'::interval", int(freshness.Seconds())))).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+type waitingStep struct {
+	id   int
+	conn Conn
+}
+
+func (step *waitingStep) ID() int { return step.id }
+
+func (step *waitingStep) Refresh() error {
+	_, err := psql.Update("waiting_steps").
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": step.id}).
+		RunWith(step.conn).
+		Exec()
+	return err
+}
+
+func (step *waitingStep) Done() error {
+	_, err := psql.Delete("waiting_steps").
+		Where(sq.Eq{"id": step.id}).
+		RunWith(step.conn).
+		Exec()
+	return err
+}
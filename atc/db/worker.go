@@ -68,11 +68,15 @@ type Worker interface {
 	StartTime() time.Time
 	ExpiresAt() time.Time
 	Ephemeral() bool
+	ScaleDownCandidate() bool
+	Draining() bool
 
 	Reload() (bool, error)
 
 	Land() error
+	Drain() error
 	Retire() error
+	MarkForScaleDown() error
 	Prune() error
 	Delete() error
 
@@ -82,6 +86,7 @@ type Worker interface {
 
 	FindContainer(owner ContainerOwner) (CreatingContainer, CreatedContainer, error)
 	CreateContainer(owner ContainerOwner, meta ContainerMetadata) (CreatingContainer, error)
+	ClaimWarmContainer(key string, teamID int, owner ContainerOwner, meta ContainerMetadata) (bool, error)
 }
 
 type worker struct {
@@ -107,6 +112,9 @@ type worker struct {
 	expiresAt        time.Time
 	certsPath        *string
 	ephemeral        bool
+
+	scaleDownCandidate bool
+	draining           bool
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -127,6 +135,8 @@ func (worker *worker) Tags() []string                          { return worker.t
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
+func (worker *worker) ScaleDownCandidate() bool                { return worker.scaleDownCandidate }
+func (worker *worker) Draining() bool                          { return worker.draining }
 
 func (worker *worker) StartTime() time.Time { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
@@ -178,6 +188,42 @@ func (worker *worker) Land() error {
 	return nil
 }
 
+// Drain lands the worker like Land does, but also flags it as draining so
+// that steps which can safely be re-run elsewhere are moved off of it instead
+// of being waited on.
+func (worker *worker) Drain() error {
+	cSQL, _, err := sq.Case("state").
+		When("'landed'::worker_state", "'landed'::worker_state").
+		Else("'landing'::worker_state").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := psql.Update("workers").
+		Set("state", sq.Expr("("+cSQL+")")).
+		Set("draining", true).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	worker.draining = true
+
+	return worker.conn.Bus().Notify(atc.WorkerDrainingChannel)
+}
+
 func (worker *worker) Retire() error {
 	result, err := psql.Update("workers").
 		SetMap(map[string]interface{}{
@@ -202,6 +248,32 @@ func (worker *worker) Retire() error {
 	return nil
 }
 
+// MarkForScaleDown flags the worker as no longer needed. The flag is returned
+// to the TSA on the worker's next heartbeat, which then retires the worker.
+func (worker *worker) MarkForScaleDown() error {
+	result, err := psql.Update("workers").
+		Set("scale_down_candidate", true).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	worker.scaleDownCandidate = true
+
+	return nil
+}
+
 func (worker *worker) Prune() error {
 	tx, err := worker.conn.Begin()
 	if err != nil {
@@ -351,6 +423,69 @@ func (worker *worker) CreateContainer(owner ContainerOwner, meta ContainerMetada
 	), nil
 }
 
+// ClaimWarmContainer hands a created warm container with the given key over
+// to the owner, so that it is found by FindContainer from then on.
+func (worker *worker) ClaimWarmContainer(key string, teamID int, owner ContainerOwner, meta ContainerMetadata) (bool, error) {
+	tx, err := worker.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var containerID int
+	err = psql.Select("id").
+		From("containers").
+		Where(sq.Eq{
+			"worker_name": worker.name,
+			"warm_key":    key,
+			"team_id":     teamID,
+			"state":       atc.ContainerStateCreated,
+		}).
+		Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		RunWith(tx).
+		QueryRow().
+		Scan(&containerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	updates := meta.SQLMap()
+	updates["warm_key"] = nil
+	updates["warm_slot"] = nil
+	updates["warm_since"] = nil
+
+	ownerCols, err := owner.Create(tx, worker.name)
+	if err != nil {
+		return false, fmt.Errorf("create owner: %w", err)
+	}
+
+	for k, v := range ownerCols {
+		updates[k] = v
+	}
+
+	_, err = psql.Update("containers").
+		SetMap(updates).
+		Where(sq.Eq{"id": containerID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (worker *worker) findContainer(whereClause sq.Sqlizer) (CreatingContainer, CreatedContainer, error) {
 	creating, created, destroying, _, err := scanContainer(
 		selectContainers().
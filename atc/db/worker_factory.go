@@ -53,7 +53,9 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.scale_down_candidate,
+		w.draining
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -161,6 +163,8 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&worker.scaleDownCandidate,
+		&worker.draining,
 	)
 	if err != nil {
 		return err
@@ -465,7 +469,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				version = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				draining = false
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
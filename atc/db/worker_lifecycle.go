@@ -4,6 +4,7 @@ import (
 	"database/sql"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
 )
 
 //counterfeiter:generate . WorkerLifecycle
@@ -122,6 +123,8 @@ func (lifecycle *workerLifecycle) DeleteFinishedRetiringWorkers() ([]string, err
 }
 
 func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error) {
+	// containers of builds which were moved off of a draining worker are
+	// destroyed, and should no longer hold it back
 	subQ, subQArgs, err := sq.Select("w.name").
 		Distinct().
 		From("builds b").
@@ -136,6 +139,14 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 			sq.Eq{
 				"b.job_id": nil,
 			},
+		}).
+		Where(sq.Or{
+			sq.Eq{
+				"w.draining": false,
+			},
+			sq.NotEq{
+				"c.state": atc.ContainerStateDestroying,
+			},
 		}).ToSql()
 
 	if err != nil {
@@ -590,6 +590,21 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that contains a sync_pipelines step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.SyncPipelinesPlan{
+								Name: "gitops",
+								File: "gitops/pipelines.yml",
+							})
+						})
+
+						It("constructs sync_pipelines correctly", func() {
+							plan, stepMetadata, _ := fakeCoreStepFactory.SyncPipelinesStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+							Expect(stepMetadata).To(Equal(expectedMetadataWithoutCreatedBy))
+						})
+					})
+
 					Context("that contains a load_var step", func() {
 						BeforeEach(func() {
 							expectedPlan = planFactory.NewPlan(atc.LoadVarPlan{
@@ -605,6 +620,22 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that contains a pipeline_output step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.PipelineOutputPlan{
+								Name:     "some-output",
+								Pipeline: "some-other-pipeline",
+								Job:      "some-job",
+							})
+						})
+
+						It("constructs pipeline_output correctly", func() {
+							plan, stepMetadata, _ := fakeCoreStepFactory.PipelineOutputStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+							Expect(stepMetadata).To(Equal(expectedMetadataWithoutCreatedBy))
+						})
+					})
+
 					Context("that contains a check step", func() {
 						BeforeEach(func() {
 							expectedPlan = planFactory.NewPlan(atc.CheckPlan{
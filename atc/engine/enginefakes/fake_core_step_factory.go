@@ -76,6 +76,19 @@ type FakeCoreStepFactory struct {
 	loadVarStepReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	PipelineOutputStepStub        func(atc.Plan, exec.StepMetadata, engine.DelegateFactory) exec.Step
+	pipelineOutputStepMutex       sync.RWMutex
+	pipelineOutputStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 engine.DelegateFactory
+	}
+	pipelineOutputStepReturns struct {
+		result1 exec.Step
+	}
+	pipelineOutputStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	PutStepStub        func(atc.Plan, exec.StepMetadata, db.ContainerMetadata, engine.DelegateFactory) exec.Step
 	putStepMutex       sync.RWMutex
 	putStepArgsForCall []struct {
@@ -117,6 +130,19 @@ type FakeCoreStepFactory struct {
 	setPipelineStepReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	SyncPipelinesStepStub        func(atc.Plan, exec.StepMetadata, engine.DelegateFactory) exec.Step
+	syncPipelinesStepMutex       sync.RWMutex
+	syncPipelinesStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 engine.DelegateFactory
+	}
+	syncPipelinesStepReturns struct {
+		result1 exec.Step
+	}
+	syncPipelinesStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	TaskStepStub        func(atc.Plan, exec.StepMetadata, db.ContainerMetadata, engine.DelegateFactory) exec.Step
 	taskStepMutex       sync.RWMutex
 	taskStepArgsForCall []struct {
@@ -450,6 +476,69 @@ func (fake *FakeCoreStepFactory) LoadVarStepReturnsOnCall(i int, result1 exec.St
 	}{result1}
 }
 
+func (fake *FakeCoreStepFactory) PipelineOutputStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 engine.DelegateFactory) exec.Step {
+	fake.pipelineOutputStepMutex.Lock()
+	ret, specificReturn := fake.pipelineOutputStepReturnsOnCall[len(fake.pipelineOutputStepArgsForCall)]
+	fake.pipelineOutputStepArgsForCall = append(fake.pipelineOutputStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 engine.DelegateFactory
+	}{arg1, arg2, arg3})
+	stub := fake.PipelineOutputStepStub
+	fakeReturns := fake.pipelineOutputStepReturns
+	fake.recordInvocation("PipelineOutputStep", []interface{}{arg1, arg2, arg3})
+	fake.pipelineOutputStepMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCoreStepFactory) PipelineOutputStepCallCount() int {
+	fake.pipelineOutputStepMutex.RLock()
+	defer fake.pipelineOutputStepMutex.RUnlock()
+	return len(fake.pipelineOutputStepArgsForCall)
+}
+
+func (fake *FakeCoreStepFactory) PipelineOutputStepCalls(stub func(atc.Plan, exec.StepMetadata, engine.DelegateFactory) exec.Step) {
+	fake.pipelineOutputStepMutex.Lock()
+	defer fake.pipelineOutputStepMutex.Unlock()
+	fake.PipelineOutputStepStub = stub
+}
+
+func (fake *FakeCoreStepFactory) PipelineOutputStepArgsForCall(i int) (atc.Plan, exec.StepMetadata, engine.DelegateFactory) {
+	fake.pipelineOutputStepMutex.RLock()
+	defer fake.pipelineOutputStepMutex.RUnlock()
+	argsForCall := fake.pipelineOutputStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCoreStepFactory) PipelineOutputStepReturns(result1 exec.Step) {
+	fake.pipelineOutputStepMutex.Lock()
+	defer fake.pipelineOutputStepMutex.Unlock()
+	fake.PipelineOutputStepStub = nil
+	fake.pipelineOutputStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeCoreStepFactory) PipelineOutputStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.pipelineOutputStepMutex.Lock()
+	defer fake.pipelineOutputStepMutex.Unlock()
+	fake.PipelineOutputStepStub = nil
+	if fake.pipelineOutputStepReturnsOnCall == nil {
+		fake.pipelineOutputStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.pipelineOutputStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeCoreStepFactory) PutStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 db.ContainerMetadata, arg4 engine.DelegateFactory) exec.Step {
 	fake.putStepMutex.Lock()
 	ret, specificReturn := fake.putStepReturnsOnCall[len(fake.putStepArgsForCall)]
@@ -641,6 +730,69 @@ func (fake *FakeCoreStepFactory) SetPipelineStepReturnsOnCall(i int, result1 exe
 	}{result1}
 }
 
+func (fake *FakeCoreStepFactory) SyncPipelinesStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 engine.DelegateFactory) exec.Step {
+	fake.syncPipelinesStepMutex.Lock()
+	ret, specificReturn := fake.syncPipelinesStepReturnsOnCall[len(fake.syncPipelinesStepArgsForCall)]
+	fake.syncPipelinesStepArgsForCall = append(fake.syncPipelinesStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 exec.StepMetadata
+		arg3 engine.DelegateFactory
+	}{arg1, arg2, arg3})
+	stub := fake.SyncPipelinesStepStub
+	fakeReturns := fake.syncPipelinesStepReturns
+	fake.recordInvocation("SyncPipelinesStep", []interface{}{arg1, arg2, arg3})
+	fake.syncPipelinesStepMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCoreStepFactory) SyncPipelinesStepCallCount() int {
+	fake.syncPipelinesStepMutex.RLock()
+	defer fake.syncPipelinesStepMutex.RUnlock()
+	return len(fake.syncPipelinesStepArgsForCall)
+}
+
+func (fake *FakeCoreStepFactory) SyncPipelinesStepCalls(stub func(atc.Plan, exec.StepMetadata, engine.DelegateFactory) exec.Step) {
+	fake.syncPipelinesStepMutex.Lock()
+	defer fake.syncPipelinesStepMutex.Unlock()
+	fake.SyncPipelinesStepStub = stub
+}
+
+func (fake *FakeCoreStepFactory) SyncPipelinesStepArgsForCall(i int) (atc.Plan, exec.StepMetadata, engine.DelegateFactory) {
+	fake.syncPipelinesStepMutex.RLock()
+	defer fake.syncPipelinesStepMutex.RUnlock()
+	argsForCall := fake.syncPipelinesStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCoreStepFactory) SyncPipelinesStepReturns(result1 exec.Step) {
+	fake.syncPipelinesStepMutex.Lock()
+	defer fake.syncPipelinesStepMutex.Unlock()
+	fake.SyncPipelinesStepStub = nil
+	fake.syncPipelinesStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeCoreStepFactory) SyncPipelinesStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.syncPipelinesStepMutex.Lock()
+	defer fake.syncPipelinesStepMutex.Unlock()
+	fake.SyncPipelinesStepStub = nil
+	if fake.syncPipelinesStepReturnsOnCall == nil {
+		fake.syncPipelinesStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.syncPipelinesStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeCoreStepFactory) TaskStep(arg1 atc.Plan, arg2 exec.StepMetadata, arg3 db.ContainerMetadata, arg4 engine.DelegateFactory) exec.Step {
 	fake.taskStepMutex.Lock()
 	ret, specificReturn := fake.taskStepReturnsOnCall[len(fake.taskStepArgsForCall)]
@@ -718,12 +870,16 @@ func (fake *FakeCoreStepFactory) Invocations() map[string][][]interface{} {
 	defer fake.getStepMutex.RUnlock()
 	fake.loadVarStepMutex.RLock()
 	defer fake.loadVarStepMutex.RUnlock()
+	fake.pipelineOutputStepMutex.RLock()
+	defer fake.pipelineOutputStepMutex.RUnlock()
 	fake.putStepMutex.RLock()
 	defer fake.putStepMutex.RUnlock()
 	fake.runStepMutex.RLock()
 	defer fake.runStepMutex.RUnlock()
 	fake.setPipelineStepMutex.RLock()
 	defer fake.setPipelineStepMutex.RUnlock()
+	fake.syncPipelinesStepMutex.RLock()
+	defer fake.syncPipelinesStepMutex.RUnlock()
 	fake.taskStepMutex.RLock()
 	defer fake.taskStepMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -22,9 +22,12 @@ type coreStepFactory struct {
 	buildFactory          db.BuildFactory
 	resourceCacheFactory  db.ResourceCacheFactory
 	resourceConfigFactory db.ResourceConfigFactory
+	containerRepository   db.ContainerRepository
+	notifications         exec.Notifications
 	defaultLimits         atc.ContainerLimits
 	strategy              worker.ContainerPlacementStrategy
 	defaultCheckTimeout   time.Duration
+	oidcTokenIssuer       exec.OIDCTokenIssuer
 }
 
 func NewCoreStepFactory(
@@ -36,9 +39,12 @@ func NewCoreStepFactory(
 	buildFactory db.BuildFactory,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	containerRepository db.ContainerRepository,
+	notifications exec.Notifications,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	defaultCheckTimeout time.Duration,
+	oidcTokenIssuer exec.OIDCTokenIssuer,
 ) CoreStepFactory {
 	return &coreStepFactory{
 		pool:                  pool,
@@ -49,9 +55,12 @@ func NewCoreStepFactory(
 		buildFactory:          buildFactory,
 		resourceCacheFactory:  resourceCacheFactory,
 		resourceConfigFactory: resourceConfigFactory,
+		containerRepository:   containerRepository,
+		notifications:         notifications,
 		defaultLimits:         defaultLimits,
 		strategy:              strategy,
 		defaultCheckTimeout:   defaultCheckTimeout,
+		oidcTokenIssuer:       oidcTokenIssuer,
 	}
 }
 
@@ -75,6 +84,7 @@ func (factory *coreStepFactory) GetStep(
 		factory.pool,
 	)
 
+	getStep = exec.MigrateOnDrain(getStep, plan.ID, stepMetadata.BuildID, plan.Get.Retryable, factory.containerRepository, factory.notifications)
 	getStep = exec.LogError(getStep, delegateFactory)
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		getStep = exec.RetryError(getStep, delegateFactory)
@@ -103,6 +113,7 @@ func (factory *coreStepFactory) PutStep(
 		delegateFactory,
 	)
 
+	putStep = exec.MigrateOnDrain(putStep, plan.ID, stepMetadata.BuildID, plan.Put.Retryable, factory.containerRepository, factory.notifications)
 	putStep = exec.LogError(putStep, delegateFactory)
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		putStep = exec.RetryError(putStep, delegateFactory)
@@ -178,9 +189,11 @@ func (factory *coreStepFactory) TaskStep(
 		factory.pool,
 		factory.artifactStreamer,
 		factory.artifactSourcer,
+		factory.oidcTokenIssuer,
 		delegateFactory,
 	)
 
+	taskStep = exec.MigrateOnDrain(taskStep, plan.ID, stepMetadata.BuildID, plan.Task.Retryable, factory.containerRepository, factory.notifications)
 	taskStep = exec.LogError(taskStep, delegateFactory)
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		taskStep = exec.RetryError(taskStep, delegateFactory)
@@ -211,6 +224,29 @@ func (factory *coreStepFactory) SetPipelineStep(
 	return spStep
 }
 
+func (factory *coreStepFactory) SyncPipelinesStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory DelegateFactory,
+) exec.Step {
+	syncStep := exec.NewSyncPipelinesStep(
+		plan.ID,
+		*plan.SyncPipelines,
+		stepMetadata,
+		delegateFactory,
+		factory.teamFactory,
+		factory.buildFactory,
+		factory.artifactStreamer,
+		delegateFactory.policyChecker,
+	)
+
+	syncStep = exec.LogError(syncStep, delegateFactory)
+	if atc.EnableBuildRerunWhenWorkerDisappears {
+		syncStep = exec.RetryError(syncStep, delegateFactory)
+	}
+	return syncStep
+}
+
 func (factory *coreStepFactory) LoadVarStep(
 	plan atc.Plan,
 	stepMetadata exec.StepMetadata,
@@ -231,6 +267,22 @@ func (factory *coreStepFactory) LoadVarStep(
 	return loadVarStep
 }
 
+func (factory *coreStepFactory) PipelineOutputStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory DelegateFactory,
+) exec.Step {
+	pipelineOutputStep := exec.NewPipelineOutputStep(
+		plan.ID,
+		*plan.PipelineOutput,
+		stepMetadata,
+		delegateFactory,
+		factory.teamFactory,
+	)
+
+	return exec.LogError(pipelineOutputStep, delegateFactory)
+}
+
 func (factory *coreStepFactory) ArtifactInputStep(
 	plan atc.Plan,
 	build db.Build,
@@ -135,6 +135,7 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 		Tags:         step.plan.Tags,
 		TeamID:       step.metadata.TeamID,
 		ResourceType: step.plan.VersionedResourceTypes.Base(step.plan.Type),
+		BuildID:      step.metadata.BuildID,
 	}
 
 	var imageSpec worker.ImageSpec
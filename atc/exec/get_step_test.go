@@ -395,6 +395,7 @@ var _ = Describe("GetStep", func() {
 				worker.WorkerSpec{
 					ResourceType: "some-base-type",
 					TeamID:       stepMetadata.TeamID,
+					BuildID:      stepMetadata.BuildID,
 				},
 			))
 		})
@@ -565,6 +566,7 @@ var _ = Describe("GetStep", func() {
 				worker.WorkerSpec{
 					TeamID:       stepMetadata.TeamID,
 					ResourceType: "registry-image",
+					BuildID:      stepMetadata.BuildID,
 				},
 			))
 		})
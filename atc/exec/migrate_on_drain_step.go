@@ -0,0 +1,134 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Notifications are the notifications of the bus shared by every step of the
+// ATC, so that steps only hear about draining workers rather than polling.
+//
+//counterfeiter:generate . Notifications
+type Notifications interface {
+	Listen(channel string) (chan bool, error)
+	Unlisten(channel string, notify chan bool) error
+}
+
+type WorkerDrainingError struct {
+	WorkerName string
+}
+
+func (err WorkerDrainingError) Error() string {
+	return fmt.Sprintf("worker %s is draining", err.WorkerName)
+}
+
+// MigrateOnDrainStep aborts the step when the worker running it is drained
+// and the step can safely be re-run, i.e. it belongs to an interruptible job
+// or is marked as retryable. The step then fails with a Retriable error, so
+// that the build is re-run like it is when a worker disappears, placing the
+// step on another worker.
+type MigrateOnDrainStep struct {
+	Step
+
+	planID              atc.PlanID
+	buildID             int
+	retryable           bool
+	containerRepository db.ContainerRepository
+	notifications       Notifications
+}
+
+func MigrateOnDrain(
+	step Step,
+	planID atc.PlanID,
+	buildID int,
+	retryable bool,
+	containerRepository db.ContainerRepository,
+	notifications Notifications,
+) Step {
+	return MigrateOnDrainStep{
+		Step:                step,
+		planID:              planID,
+		buildID:             buildID,
+		retryable:           retryable,
+		containerRepository: containerRepository,
+		notifications:       notifications,
+	}
+}
+
+func (step MigrateOnDrainStep) Run(ctx context.Context, state RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var drainingWorker string
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		drainingWorker = step.waitForDrain(runCtx, logger)
+		if drainingWorker != "" {
+			cancel()
+		}
+	}()
+
+	runOk, runErr := step.Step.Run(runCtx, state)
+	cancel()
+	wg.Wait()
+
+	// If the build has been aborted, or the step finished regardless, there is
+	// nothing to migrate.
+	if drainingWorker == "" || runErr == nil || ctx.Err() != nil {
+		return runOk, runErr
+	}
+
+	_, err := step.containerRepository.DestroyBuildContainersOnDrainingWorkers(step.buildID)
+	if err != nil {
+		logger.Error("failed-to-destroy-containers-on-draining-workers", err)
+	}
+
+	logger.Info("migrating", lager.Data{"worker": drainingWorker})
+
+	return false, Retriable{WorkerDrainingError{WorkerName: drainingWorker}}
+}
+
+// waitForDrain returns the name of the worker running the step's container
+// once it is drained, only checking for it whenever a worker is drained.
+func (step MigrateOnDrainStep) waitForDrain(ctx context.Context, logger lager.Logger) string {
+	notifier, err := step.notifications.Listen(atc.WorkerDrainingChannel)
+	if err != nil {
+		logger.Error("failed-to-listen-for-draining-workers", err)
+		return ""
+	}
+
+	defer step.notifications.Unlisten(atc.WorkerDrainingChannel, notifier)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ""
+		case <-notifier:
+			workerName, found, err := step.containerRepository.FindContainerOnDrainingWorker(
+				step.buildID,
+				step.planID,
+				step.retryable,
+			)
+			if err != nil {
+				logger.Error("failed-to-check-for-draining-worker", err)
+				continue
+			}
+
+			if found {
+				logger.Info("worker-draining", lager.Data{"worker": workerName})
+				return workerName
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MigrateOnDrainStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep                *execfakes.FakeStep
+		fakeContainerRepository *dbfakes.FakeContainerRepository
+		fakeNotifications       *execfakes.FakeNotifications
+
+		notifier chan bool
+
+		state *execfakes.FakeRunState
+
+		retryable bool
+
+		step Step
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeContainerRepository = new(dbfakes.FakeContainerRepository)
+
+		notifier = make(chan bool, 1)
+		fakeNotifications = new(execfakes.FakeNotifications)
+		fakeNotifications.ListenReturns(notifier, nil)
+
+		state = new(execfakes.FakeRunState)
+
+		retryable = true
+	})
+
+	JustBeforeEach(func() {
+		step = MigrateOnDrain(fakeStep, "some-plan-id", 42, retryable, fakeContainerRepository, fakeNotifications)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Describe("Run", func() {
+		var runOk bool
+		var runErr error
+
+		JustBeforeEach(func() {
+			runOk, runErr = step.Run(ctx, state)
+		})
+
+		Context("when the step finishes before its worker is drained", func() {
+			BeforeEach(func() {
+				fakeStep.RunReturns(true, nil)
+			})
+
+			It("returns its result", func() {
+				Expect(runOk).To(BeTrue())
+				Expect(runErr).ToNot(HaveOccurred())
+			})
+
+			It("stops listening for draining workers", func() {
+				Eventually(fakeNotifications.UnlistenCallCount).Should(Equal(1))
+
+				channel, actualNotifier := fakeNotifications.UnlistenArgsForCall(0)
+				Expect(channel).To(Equal(atc.WorkerDrainingChannel))
+				Expect(actualNotifier).To(Equal(notifier))
+			})
+		})
+
+		Context("when the step's worker is drained", func() {
+			BeforeEach(func() {
+				fakeContainerRepository.FindContainerOnDrainingWorkerReturns("some-worker", true, nil)
+
+				fakeStep.RunStub = func(ctx context.Context, state RunState) (bool, error) {
+					notifier <- true
+					<-ctx.Done()
+					return false, ctx.Err()
+				}
+			})
+
+			It("listens for draining workers", func() {
+				Expect(fakeNotifications.ListenCallCount()).To(Equal(1))
+				Expect(fakeNotifications.ListenArgsForCall(0)).To(Equal(atc.WorkerDrainingChannel))
+			})
+
+			It("checks for the step's container", func() {
+				buildID, planID, actualRetryable := fakeContainerRepository.FindContainerOnDrainingWorkerArgsForCall(0)
+				Expect(buildID).To(Equal(42))
+				Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+				Expect(actualRetryable).To(BeTrue())
+			})
+
+			It("returns a retriable error", func() {
+				Expect(runOk).To(BeFalse())
+				Expect(errors.As(runErr, &Retriable{})).To(BeTrue())
+				Expect(runErr).To(MatchError(ContainSubstring("worker some-worker is draining")))
+			})
+
+			It("frees up the draining workers", func() {
+				Expect(fakeContainerRepository.DestroyBuildContainersOnDrainingWorkersCallCount()).To(Equal(1))
+				Expect(fakeContainerRepository.DestroyBuildContainersOnDrainingWorkersArgsForCall(0)).To(Equal(42))
+			})
+
+		})
+
+		Context("when another worker is drained", func() {
+			BeforeEach(func() {
+				fakeStep.RunStub = func(context.Context, RunState) (bool, error) {
+					notifier <- true
+					Eventually(fakeContainerRepository.FindContainerOnDrainingWorkerCallCount).Should(Equal(1))
+					return true, nil
+				}
+			})
+
+			It("keeps running the step", func() {
+				Expect(runOk).To(BeTrue())
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeContainerRepository.DestroyBuildContainersOnDrainingWorkersCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when listening for draining workers fails", func() {
+			BeforeEach(func() {
+				fakeNotifications.ListenReturns(nil, errors.New("nope"))
+				fakeStep.RunReturns(true, nil)
+			})
+
+			It("runs the step regardless", func() {
+				Expect(runOk).To(BeTrue())
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeContainerRepository.FindContainerOnDrainingWorkerCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the step is not retryable", func() {
+			BeforeEach(func() {
+				retryable = false
+				fakeStep.RunStub = func(context.Context, RunState) (bool, error) {
+					notifier <- true
+					Eventually(fakeContainerRepository.FindContainerOnDrainingWorkerCallCount).Should(Equal(1))
+					return true, nil
+				}
+			})
+
+			It("only asks for interruptible builds to be migrated", func() {
+				_, _, actualRetryable := fakeContainerRepository.FindContainerOnDrainingWorkerArgsForCall(0)
+				Expect(actualRetryable).To(BeFalse())
+				Expect(runErr).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the build is aborted", func() {
+			BeforeEach(func() {
+				fakeStep.RunStub = func(context.Context, RunState) (bool, error) {
+					cancel()
+					return false, context.Canceled
+				}
+			})
+
+			It("propagates the error", func() {
+				Expect(runErr).To(Equal(context.Canceled))
+			})
+		})
+	})
+})
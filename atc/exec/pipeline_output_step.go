@@ -0,0 +1,117 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// PipelineOutputStep finds a successful build of a job, which may belong to a
+// pipeline shared by another team, and sets it as a build-local var. The build
+// which triggered the job is used if the plan has one, and the latest
+// successful build otherwise.
+type PipelineOutputStep struct {
+	planID          atc.PlanID
+	plan            atc.PipelineOutputPlan
+	metadata        StepMetadata
+	delegateFactory BuildStepDelegateFactory
+	teamFactory     db.TeamFactory
+}
+
+func NewPipelineOutputStep(
+	planID atc.PlanID,
+	plan atc.PipelineOutputPlan,
+	metadata StepMetadata,
+	delegateFactory BuildStepDelegateFactory,
+	teamFactory db.TeamFactory,
+) Step {
+	return &PipelineOutputStep{
+		planID:          planID,
+		plan:            plan,
+		metadata:        metadata,
+		delegateFactory: delegateFactory,
+		teamFactory:     teamFactory,
+	}
+}
+
+type PipelineOutputNotFoundError struct {
+	Team     string
+	Pipeline string
+	Job      string
+}
+
+// Error returns a human-friendly error message.
+func (err PipelineOutputNotFoundError) Error() string {
+	return fmt.Sprintf(
+		"no successful build of job '%s' found in pipeline '%s' of team '%s', or the pipeline is not shared with this team",
+		err.Job,
+		err.Pipeline,
+		err.Team,
+	)
+}
+
+func (step *PipelineOutputStep) Run(ctx context.Context, state RunState) (bool, error) {
+	delegate := step.delegateFactory.BuildStepDelegate(state)
+	ctx, span := delegate.StartSpan(ctx, "pipeline_output", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	ok, err := step.run(ctx, state, delegate)
+	tracing.End(span, err)
+
+	return ok, err
+}
+
+func (step *PipelineOutputStep) run(ctx context.Context, state RunState, delegate BuildStepDelegate) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("pipeline-output-step", lager.Data{
+		"step-name": step.plan.Name,
+		"job-id":    step.metadata.JobID,
+	})
+
+	delegate.Initializing(logger)
+	stdout := delegate.Stdout()
+
+	delegate.Starting(logger)
+
+	teamName := step.plan.Team
+	if teamName == "" {
+		teamName = step.metadata.TeamName
+	}
+
+	team := step.teamFactory.GetByID(step.metadata.TeamID)
+
+	build, found, err := team.PipelineOutput(teamName, step.plan.Pipeline, step.plan.Job, step.plan.BuildID)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, PipelineOutputNotFoundError{
+			Team:     teamName,
+			Pipeline: step.plan.Pipeline,
+			Job:      step.plan.Job,
+		}
+	}
+
+	fmt.Fprintf(stdout, "using build %s/%s/%s #%s.\n", teamName, step.plan.Pipeline, step.plan.Job, build.Name())
+
+	state.AddLocalVar(step.plan.Name, map[string]interface{}{
+		"team":       teamName,
+		"pipeline":   step.plan.Pipeline,
+		"job":        step.plan.Job,
+		"build_id":   build.ID(),
+		"build_name": build.Name(),
+	}, false)
+	fmt.Fprintf(stdout, "added var %s to build.\n", step.plan.Name)
+
+	delegate.Finished(logger, true)
+
+	return true, nil
+}
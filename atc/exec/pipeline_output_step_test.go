@@ -0,0 +1,199 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/tracing"
+)
+
+var _ = Describe("PipelineOutputStep", func() {
+	var (
+		ctx        context.Context
+		cancel     func()
+		testLogger *lagertest.TestLogger
+
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeTeam        *dbfakes.FakeTeam
+		fakeBuild       *dbfakes.FakeBuild
+
+		pipelineOutputPlan *atc.PipelineOutputPlan
+		state              *execfakes.FakeRunState
+
+		step    exec.Step
+		stepOk  bool
+		stepErr error
+
+		stepMetadata = exec.StepMetadata{
+			TeamID:       123,
+			TeamName:     "some-team",
+			BuildID:      42,
+			BuildName:    "some-build",
+			PipelineID:   4567,
+			PipelineName: "some-pipeline",
+			JobID:        89,
+			JobName:      "some-job",
+		}
+
+		stdout *gbytes.Buffer
+	)
+
+	BeforeEach(func() {
+		testLogger = lagertest.NewTestLogger("pipeline-output-step-test")
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, testLogger)
+
+		state = new(execfakes.FakeRunState)
+
+		stdout = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.StderrReturns(gbytes.NewBuffer())
+		fakeDelegate.StartSpanReturns(context.Background(), tracing.NoopSpan)
+
+		fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+		fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(7)
+		fakeBuild.NameReturns("3")
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.PipelineOutputReturns(fakeBuild, true, nil)
+
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		pipelineOutputPlan = &atc.PipelineOutputPlan{
+			Name:     "some-output",
+			Team:     "platform",
+			Pipeline: "base-images",
+			Job:      "publish",
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		plan := atc.Plan{
+			ID:             atc.PlanID("56"),
+			PipelineOutput: pipelineOutputPlan,
+		}
+
+		step = exec.NewPipelineOutputStep(
+			plan.ID,
+			*plan.PipelineOutput,
+			stepMetadata,
+			fakeDelegateFactory,
+			fakeTeamFactory,
+		)
+
+		stepOk, stepErr = step.Run(ctx, state)
+	})
+
+	It("looks up the latest output as the build's team", func() {
+		Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(123))
+
+		teamName, pipelineName, jobName, buildID := fakeTeam.PipelineOutputArgsForCall(0)
+		Expect(teamName).To(Equal("platform"))
+		Expect(pipelineName).To(Equal("base-images"))
+		Expect(jobName).To(Equal("publish"))
+		Expect(buildID).To(BeZero())
+	})
+
+	Context("when the plan has the build which triggered the job", func() {
+		BeforeEach(func() {
+			pipelineOutputPlan.BuildID = 7
+		})
+
+		It("looks up that build", func() {
+			_, _, _, buildID := fakeTeam.PipelineOutputArgsForCall(0)
+			Expect(buildID).To(Equal(7))
+		})
+	})
+
+	It("adds the build as a local var", func() {
+		Expect(stepErr).NotTo(HaveOccurred())
+		Expect(stepOk).To(BeTrue())
+
+		Expect(state.AddLocalVarCallCount()).To(Equal(1))
+		name, value, redact := state.AddLocalVarArgsForCall(0)
+		Expect(name).To(Equal("some-output"))
+		Expect(value).To(Equal(map[string]interface{}{
+			"team":       "platform",
+			"pipeline":   "base-images",
+			"job":        "publish",
+			"build_id":   7,
+			"build_name": "3",
+		}))
+		Expect(redact).To(BeFalse())
+	})
+
+	It("finishes the step via the delegate", func() {
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	It("logs the build being used", func() {
+		Expect(stdout).To(gbytes.Say("using build platform/base-images/publish #3."))
+	})
+
+	Context("when no team is given", func() {
+		BeforeEach(func() {
+			pipelineOutputPlan.Team = ""
+		})
+
+		It("defaults to the build's team", func() {
+			teamName, _, _, _ := fakeTeam.PipelineOutputArgsForCall(0)
+			Expect(teamName).To(Equal("some-team"))
+		})
+	})
+
+	Context("when no successful build is found", func() {
+		BeforeEach(func() {
+			fakeTeam.PipelineOutputReturns(nil, false, nil)
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(Equal(exec.PipelineOutputNotFoundError{
+				Team:     "platform",
+				Pipeline: "base-images",
+				Job:      "publish",
+			}))
+			Expect(stepOk).To(BeFalse())
+		})
+
+		It("does not add a local var", func() {
+			Expect(state.AddLocalVarCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when finding the build fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeTeam.PipelineOutputReturns(nil, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+	})
+})
@@ -157,6 +157,7 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 		Tags:         step.plan.Tags,
 		TeamID:       step.metadata.TeamID,
 		ResourceType: step.plan.VersionedResourceTypes.Base(step.plan.Type),
+		BuildID:      step.metadata.BuildID,
 	}
 
 	var imageSpec worker.ImageSpec
@@ -273,6 +273,7 @@ var _ = Describe("PutStep", func() {
 				worker.WorkerSpec{
 					ResourceType: "some-resource-type",
 					TeamID:       stepMetadata.TeamID,
+					BuildID:      stepMetadata.BuildID,
 				},
 			))
 		})
@@ -501,6 +502,7 @@ var _ = Describe("PutStep", func() {
 			Expect(workerSpec).To(Equal(worker.WorkerSpec{
 				TeamID:       stepMetadata.TeamID,
 				ResourceType: "registry-image",
+				BuildID:      stepMetadata.BuildID,
 			}))
 		})
 
@@ -129,22 +129,6 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState, delegate S
 
 	delegate.Starting(logger)
 
-	warnings, errors := configvalidate.Validate(atcConfig)
-	for _, warning := range warnings {
-		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
-	}
-
-	if len(errors) > 0 {
-		fmt.Fprintln(delegate.Stderr(), "invalid pipeline:")
-
-		for _, e := range errors {
-			fmt.Fprintf(stderr, "- %s", e)
-		}
-
-		delegate.Finished(logger, false)
-		return false, nil
-	}
-
 	var team db.Team
 	if step.plan.Team == "" {
 		team = step.teamFactory.GetByID(step.metadata.TeamID)
@@ -187,6 +171,30 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState, delegate S
 		team = targetTeam
 	}
 
+	shared, err := team.TeamResources()
+	if err != nil {
+		return false, err
+	}
+
+	// the pipeline may use the team's shared resources without declaring them
+	appliedConfig, _ := shared.ApplyTo(atcConfig)
+
+	warnings, errors := configvalidate.Validate(appliedConfig)
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+	}
+
+	if len(errors) > 0 {
+		fmt.Fprintln(delegate.Stderr(), "invalid pipeline:")
+
+		for _, e := range errors {
+			fmt.Fprintf(stderr, "- %s", e)
+		}
+
+		delegate.Finished(logger, false)
+		return false, nil
+	}
+
 	pipelineRef := atc.PipelineRef{
 		Name:         step.plan.Name,
 		InstanceVars: step.plan.InstanceVars,
@@ -328,8 +336,20 @@ func (s setPipelineSource) FetchPipelineConfig() (atc.Config, error) {
 		staticVars = append(staticVars, iv)
 	}
 
-	if len(staticVars) > 0 {
-		config, err = vars.NewTemplateResolver(config, staticVars).Resolve(false, false)
+	declarations, err := vars.ParseVarDeclarations(config)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	if len(staticVars) > 0 || len(declarations) > 0 {
+		resolver := vars.NewTemplateResolver(config, staticVars)
+
+		err = resolver.ValidateVars()
+		if err != nil {
+			return atc.Config{}, fmt.Errorf("invalid vars: %w", err)
+		}
+
+		config, err = resolver.Resolve(false, false)
 		if err != nil {
 			return atc.Config{}, err
 		}
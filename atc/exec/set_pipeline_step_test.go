@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -286,6 +287,67 @@ jobs:
 			})
 		})
 
+		Context("when pipeline file declares a required var which is not provided", func() {
+			BeforeEach(func() {
+				fakeArtifactStreamer.StreamFileFromArtifactReturns(&fakeReadCloser{str: `
+vars:
+- name: branch
+  type: string
+- name: env
+  required: true
+` + strings.TrimPrefix(pipelineContent, "\n---\n")}, nil)
+			})
+
+			It("should return an error", func() {
+				Expect(stepErr).To(HaveOccurred())
+				Expect(stepErr.Error()).To(ContainSubstring("invalid vars:"))
+				Expect(stepErr.Error()).To(ContainSubstring("required var 'env' is not provided"))
+			})
+
+			It("should not save the pipeline", func() {
+				Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when pipeline file uses a resource it does not declare", func() {
+			BeforeEach(func() {
+				fakeArtifactStreamer.StreamFileFromArtifactReturns(&fakeReadCloser{str: `
+jobs:
+- name: some-job
+  plan:
+  - get: shared-repo
+`}, nil)
+				fakeTeam.PipelineReturns(nil, false, nil)
+				fakeBuild.SavePipelineReturns(fakePipeline, true, nil)
+			})
+
+			Context("when the team shares the resource", func() {
+				BeforeEach(func() {
+					fakeTeam.TeamResourcesReturns(atc.TeamResources{
+						Resources: atc.ResourceConfigs{
+							{Name: "shared-repo", Type: "git"},
+						},
+					}, nil)
+				})
+
+				It("should save the pipeline without declaring it", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+					_, _, config, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+					Expect(config.Resources).To(BeEmpty())
+				})
+			})
+
+			Context("when the team does not share the resource", func() {
+				It("should finish unsuccessfully", func() {
+					Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+					_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+					Expect(succeeded).To(BeFalse())
+					Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when pipeline file is good", func() {
 			BeforeEach(func() {
 				fakeArtifactStreamer.StreamFileFromArtifactReturns(&fakeReadCloser{str: pipelineContent}, nil)
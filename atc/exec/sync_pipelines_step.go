@@ -0,0 +1,362 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/tracing"
+)
+
+// SyncPipelinesStep reconciles all pipelines of the build's team with a
+// GitOps manifest: pipelines of the manifest are created or updated, and all
+// other pipelines of the team are archived. The result is saved as the
+// team's GitOps status.
+type SyncPipelinesStep struct {
+	planID           atc.PlanID
+	plan             atc.SyncPipelinesPlan
+	metadata         StepMetadata
+	delegateFactory  BuildStepDelegateFactory
+	teamFactory      db.TeamFactory
+	buildFactory     db.BuildFactory
+	artifactStreamer worker.ArtifactStreamer
+	policyChecker    policy.Checker
+}
+
+func NewSyncPipelinesStep(
+	planID atc.PlanID,
+	plan atc.SyncPipelinesPlan,
+	metadata StepMetadata,
+	delegateFactory BuildStepDelegateFactory,
+	teamFactory db.TeamFactory,
+	buildFactory db.BuildFactory,
+	artifactStreamer worker.ArtifactStreamer,
+	policyChecker policy.Checker,
+) Step {
+	return &SyncPipelinesStep{
+		planID:           planID,
+		plan:             plan,
+		metadata:         metadata,
+		delegateFactory:  delegateFactory,
+		teamFactory:      teamFactory,
+		buildFactory:     buildFactory,
+		artifactStreamer: artifactStreamer,
+		policyChecker:    policyChecker,
+	}
+}
+
+func (step *SyncPipelinesStep) Run(ctx context.Context, state RunState) (bool, error) {
+	delegate := step.delegateFactory.BuildStepDelegate(state)
+	ctx, span := delegate.StartSpan(ctx, "sync_pipelines", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	ok, err := step.run(ctx, state, delegate)
+	tracing.End(span, err)
+
+	return ok, err
+}
+
+type syncedPipeline struct {
+	ref    atc.PipelineRef
+	config atc.Config
+}
+
+func (step *SyncPipelinesStep) run(ctx context.Context, state RunState, delegate BuildStepDelegate) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("sync-pipelines-step", lager.Data{
+		"step-name": step.plan.Name,
+		"build-id":  step.metadata.BuildID,
+	})
+
+	delegate.Initializing(logger)
+
+	stdout := delegate.Stdout()
+	stderr := delegate.Stderr()
+
+	team := step.teamFactory.GetByID(step.metadata.TeamID)
+
+	previous, _, err := team.GitOpsStatus()
+	if err != nil {
+		return false, err
+	}
+
+	status := atc.GitOpsStatus{
+		BuildID:   step.metadata.BuildID,
+		StartedAt: previous.StartedAt,
+		Result:    atc.GitOpsSucceeded,
+		Managed:   map[string]int{},
+	}
+
+	if step.plan.VersionFrom != nil {
+		version, err := (&PutStepVersionSource{planID: *step.plan.VersionFrom}).Version(state)
+		if err == nil {
+			status.Version = version
+		}
+	}
+
+	ok, syncErr := step.sync(ctx, logger, state, delegate, team, previous, &status)
+	if syncErr != nil {
+		status.Result = atc.GitOpsErrored
+		status.Error = syncErr.Error()
+	} else if !ok {
+		status.Result = atc.GitOpsFailed
+	}
+
+	status.FinishedAt = time.Now().Unix()
+
+	err = team.SaveGitOpsStatus(status)
+	if err != nil {
+		logger.Error("failed-to-save-status", err)
+		if syncErr == nil {
+			return false, err
+		}
+	}
+
+	if syncErr != nil {
+		return false, syncErr
+	}
+
+	if ok {
+		fmt.Fprintf(stdout, "synced %d pipelines\n", len(status.Managed))
+	} else {
+		fmt.Fprintf(stderr, "%s\n", status.Error)
+	}
+
+	delegate.Finished(logger, ok)
+
+	return ok, nil
+}
+
+func (step *SyncPipelinesStep) sync(
+	ctx context.Context,
+	logger lager.Logger,
+	state RunState,
+	delegate BuildStepDelegate,
+	team db.Team,
+	previous atc.GitOpsStatus,
+	status *atc.GitOpsStatus,
+) (bool, error) {
+	stdout := delegate.Stdout()
+	stderr := delegate.Stderr()
+
+	segs := strings.SplitN(step.plan.File, "/", 2)
+	if len(segs) != 2 {
+		return false, UnspecifiedArtifactSourceError{step.plan.File}
+	}
+
+	artifactName := segs[0]
+
+	source := setPipelineSource{
+		ctx:              ctx,
+		logger:           logger,
+		repo:             state.ArtifactRepository(),
+		artifactStreamer: step.artifactStreamer,
+	}
+
+	payload, err := source.fetchPipelineBits(step.plan.File)
+	if err != nil {
+		return false, err
+	}
+
+	var manifest atc.GitOpsManifest
+	err = yaml.UnmarshalStrict(payload, &manifest)
+	if err != nil {
+		status.Error = fmt.Sprintf("malformed manifest: %s", err)
+		return false, nil
+	}
+
+	err = manifest.Validate()
+	if err != nil {
+		status.Error = fmt.Sprintf("invalid manifest: %s", err)
+		return false, nil
+	}
+
+	delegate.Starting(logger)
+
+	// pipelines may use the team's shared resources without declaring them
+	shared, err := team.TeamResources()
+	if err != nil {
+		return false, err
+	}
+
+	// every pipeline is validated before any is saved so that a broken
+	// config never leaves the team half-synced
+	var pipelines []syncedPipeline
+	var invalid []string
+	for _, entry := range manifest.Pipelines {
+		spPlan := atc.SetPipelinePlan{
+			Name:         entry.Name,
+			File:         artifactName + "/" + entry.File,
+			Vars:         entry.Vars,
+			InstanceVars: entry.InstanceVars,
+		}
+
+		for _, varFile := range entry.VarFiles {
+			spPlan.VarFiles = append(spPlan.VarFiles, artifactName+"/"+varFile)
+		}
+
+		source.step = &SetPipelineStep{plan: spPlan}
+
+		err := source.Validate()
+		if err != nil {
+			return false, err
+		}
+
+		config, err := source.FetchPipelineConfig()
+		if err != nil {
+			return false, fmt.Errorf("pipeline %s: %w", entry.Ref(), err)
+		}
+
+		applied, _ := shared.ApplyTo(config)
+
+		warnings, errors := configvalidate.Validate(applied)
+		for _, warning := range warnings {
+			fmt.Fprintf(stderr, "WARNING: pipeline %s: %s\n", entry.Ref(), warning.Message)
+		}
+
+		if len(errors) > 0 {
+			fmt.Fprintf(stderr, "invalid pipeline %s:\n", entry.Ref())
+
+			for _, e := range errors {
+				fmt.Fprintf(stderr, "- %s\n", e)
+			}
+
+			invalid = append(invalid, entry.Ref().String())
+			continue
+		}
+
+		pipelines = append(pipelines, syncedPipeline{
+			ref:    entry.Ref(),
+			config: config,
+		})
+	}
+
+	if len(invalid) > 0 {
+		status.Error = fmt.Sprintf("invalid pipelines: %s", strings.Join(invalid, ", "))
+		return false, nil
+	}
+
+	parentBuild, found, err := step.buildFactory.Build(step.metadata.BuildID)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, fmt.Errorf("sync_pipelines step not attached to a buildID")
+	}
+
+	desired := map[string]bool{}
+	for _, p := range pipelines {
+		ref := p.ref.String()
+		desired[ref] = true
+
+		pipeline, found, err := team.Pipeline(p.ref)
+		if err != nil {
+			return false, err
+		}
+
+		fromVersion := db.ConfigVersion(0)
+		existingConfig := atc.Config{}
+		if found {
+			fromVersion = pipeline.ConfigVersion()
+			existingConfig, err = pipeline.Config()
+			if err != nil {
+				return false, err
+			}
+
+			managedVersion, managed := previous.Managed[ref]
+			if managed && db.ConfigVersion(managedVersion) != fromVersion {
+				fmt.Fprintf(stderr, "\x1b[1;33mWARNING: pipeline %s was changed outside of the repository, overwriting\x1b[0m\n", ref)
+				status.Drifted = append(status.Drifted, ref)
+			}
+		}
+
+		if !existingConfig.Diff(stdout, p.config) && found && !pipeline.Archived() {
+			status.Managed[ref] = int(fromVersion)
+			continue
+		}
+
+		if step.policyChecker != nil && step.policyChecker.ShouldCheckAction(ActionRunSetPipeline) {
+			result, err := step.policyChecker.Check(policy.PolicyCheckInput{
+				Action:   ActionRunSetPipeline,
+				Team:     team.Name(),
+				Pipeline: p.ref.Name,
+				Data:     &p.config,
+			})
+			if err != nil {
+				return false, fmt.Errorf("error checking policy enforcement")
+			}
+
+			if !result.Allowed {
+				return false, fmt.Errorf("policy check failed for pipeline %s: %s", ref, strings.Join(result.Reasons, ", "))
+			}
+		}
+
+		fmt.Fprintf(stdout, "setting pipeline: %s\n", ref)
+
+		saved, _, err := parentBuild.SavePipeline(p.ref, team.ID(), p.config, fromVersion, false)
+		if err != nil {
+			if err == db.ErrSetByNewerBuild {
+				fmt.Fprintln(stderr, "\x1b[1;33mWARNING: not syncing further because a newer build is already syncing\x1b[0m")
+				return true, nil
+			}
+
+			return false, err
+		}
+
+		if found {
+			status.Updated = append(status.Updated, ref)
+		} else {
+			status.Created = append(status.Created, ref)
+		}
+
+		status.Managed[ref] = int(saved.ConfigVersion())
+	}
+
+	existing, err := team.Pipelines()
+	if err != nil {
+		return false, err
+	}
+
+	for _, pipeline := range existing {
+		ref := atc.PipelineRef{
+			Name:         pipeline.Name(),
+			InstanceVars: pipeline.InstanceVars(),
+		}.String()
+		if desired[ref] || pipeline.Archived() {
+			continue
+		}
+
+		fmt.Fprintf(stdout, "archiving pipeline: %s\n", ref)
+
+		err := pipeline.Archive()
+		if err != nil {
+			return false, err
+		}
+
+		status.Archived = append(status.Archived, ref)
+	}
+
+	sort.Strings(status.Archived)
+
+	logger.Info("synced-pipelines", lager.Data{
+		"created":  status.Created,
+		"updated":  status.Updated,
+		"archived": status.Archived,
+		"drifted":  status.Drifted,
+	})
+
+	return true, nil
+}
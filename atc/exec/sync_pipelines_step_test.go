@@ -0,0 +1,323 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/build/buildfakes"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/tracing"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("SyncPipelinesStep", func() {
+	const manifest = `
+pipelines:
+- name: some-pipeline
+  file: ci/pipeline.yml
+- name: other-pipeline
+  file: ci/pipeline.yml
+`
+
+	const pipelineContent = `
+jobs:
+- name: some-job
+  plan:
+  - task: some-task
+    file: some/task.yml
+`
+
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeTeamFactory  *dbfakes.FakeTeamFactory
+		fakeBuildFactory *dbfakes.FakeBuildFactory
+		fakeBuild        *dbfakes.FakeBuild
+		fakeTeam         *dbfakes.FakeTeam
+
+		existingPipeline *dbfakes.FakePipeline
+		strayPipeline    *dbfakes.FakePipeline
+		savedPipeline    *dbfakes.FakePipeline
+
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+
+		fakeArtifactStreamer *workerfakes.FakeArtifactStreamer
+
+		files map[string]string
+
+		state         *execfakes.FakeRunState
+		syncPlan      atc.SyncPipelinesPlan
+		stdout        *gbytes.Buffer
+		stderr        *gbytes.Buffer
+		savedStatuses []atc.GitOpsStatus
+
+		stepOk  bool
+		stepErr error
+
+		stepMetadata = exec.StepMetadata{
+			TeamID:    123,
+			TeamName:  "some-team",
+			BuildID:   42,
+			BuildName: "1",
+		}
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, lagertest.NewTestLogger("sync-pipelines-test"))
+
+		artifactRepository := build.NewRepository()
+		artifactRepository.RegisterArtifact("gitops", new(buildfakes.FakeRegisterableArtifact))
+
+		state = new(execfakes.FakeRunState)
+		state.ArtifactRepositoryReturns(artifactRepository)
+
+		stdout = gbytes.NewBuffer()
+		stderr = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.StderrReturns(stderr)
+		fakeDelegate.StartSpanReturns(context.Background(), tracing.NoopSpan)
+
+		fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+		fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+		files = map[string]string{
+			"pipelines.yml":   manifest,
+			"ci/pipeline.yml": pipelineContent,
+		}
+
+		fakeArtifactStreamer = new(workerfakes.FakeArtifactStreamer)
+		fakeArtifactStreamer.StreamFileFromArtifactStub = func(_ context.Context, _ runtime.Artifact, path string) (io.ReadCloser, error) {
+			content, found := files[path]
+			if !found {
+				return nil, errors.New("file not found")
+			}
+
+			return &fakeReadCloser{str: content}, nil
+		}
+
+		existingPipeline = new(dbfakes.FakePipeline)
+		existingPipeline.NameReturns("some-pipeline")
+		existingPipeline.ConfigVersionReturns(5)
+		existingPipeline.ConfigReturns(atc.Config{}, nil)
+
+		strayPipeline = new(dbfakes.FakePipeline)
+		strayPipeline.NameReturns("stray-pipeline")
+
+		savedPipeline = new(dbfakes.FakePipeline)
+		savedPipeline.ConfigVersionReturns(9)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.IDReturns(stepMetadata.TeamID)
+		fakeTeam.NameReturns(stepMetadata.TeamName)
+		fakeTeam.PipelineStub = func(ref atc.PipelineRef) (db.Pipeline, bool, error) {
+			if ref.Name == "some-pipeline" {
+				return existingPipeline, true, nil
+			}
+
+			return nil, false, nil
+		}
+		fakeTeam.PipelinesReturns([]db.Pipeline{existingPipeline, strayPipeline}, nil)
+		fakeTeam.GitOpsStatusReturns(atc.GitOpsStatus{
+			BuildID:   42,
+			StartedAt: 100,
+			Result:    atc.GitOpsStarted,
+			Managed:   map[string]int{"some-pipeline": 5},
+		}, true, nil)
+
+		savedStatuses = nil
+		fakeTeam.SaveGitOpsStatusStub = func(status atc.GitOpsStatus) error {
+			savedStatuses = append(savedStatuses, status)
+			return nil
+		}
+
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.SavePipelineReturns(savedPipeline, true, nil)
+
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
+		versionFrom := atc.PlanID("check")
+		state.ResultStub = func(id atc.PlanID, to interface{}) bool {
+			if id != versionFrom {
+				return false
+			}
+
+			if version, ok := to.(*atc.Version); ok {
+				*version = atc.Version{"ref": "abc"}
+				return true
+			}
+
+			return false
+		}
+
+		syncPlan = atc.SyncPipelinesPlan{
+			Name:        "gitops",
+			File:        "gitops/pipelines.yml",
+			VersionFrom: &versionFrom,
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step := exec.NewSyncPipelinesStep(
+			"some-plan",
+			syncPlan,
+			stepMetadata,
+			fakeDelegateFactory,
+			fakeTeamFactory,
+			fakeBuildFactory,
+			fakeArtifactStreamer,
+			nil,
+		)
+
+		stepOk, stepErr = step.Run(ctx, state)
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(stepOk).To(BeTrue())
+
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	It("updates existing pipelines and creates missing ones", func() {
+		Expect(fakeBuild.SavePipelineCallCount()).To(Equal(2))
+
+		ref, teamID, _, from, paused := fakeBuild.SavePipelineArgsForCall(0)
+		Expect(ref).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		Expect(teamID).To(Equal(123))
+		Expect(from).To(Equal(db.ConfigVersion(5)))
+		Expect(paused).To(BeFalse())
+
+		ref, _, _, from, _ = fakeBuild.SavePipelineArgsForCall(1)
+		Expect(ref).To(Equal(atc.PipelineRef{Name: "other-pipeline"}))
+		Expect(from).To(Equal(db.ConfigVersion(0)))
+	})
+
+	It("archives pipelines which are not in the manifest", func() {
+		Expect(strayPipeline.ArchiveCallCount()).To(Equal(1))
+		Expect(existingPipeline.ArchiveCallCount()).To(Equal(0))
+	})
+
+	It("saves the result", func() {
+		Expect(savedStatuses).To(HaveLen(1))
+
+		status := savedStatuses[0]
+		Expect(status.BuildID).To(Equal(42))
+		Expect(status.StartedAt).To(Equal(int64(100)))
+		Expect(status.FinishedAt).ToNot(BeZero())
+		Expect(status.Result).To(Equal(atc.GitOpsSucceeded))
+		Expect(status.Version).To(Equal(atc.Version{"ref": "abc"}))
+		Expect(status.Created).To(Equal([]string{"other-pipeline"}))
+		Expect(status.Updated).To(Equal([]string{"some-pipeline"}))
+		Expect(status.Archived).To(Equal([]string{"stray-pipeline"}))
+		Expect(status.Drifted).To(BeEmpty())
+		Expect(status.Managed).To(Equal(map[string]int{
+			"some-pipeline":  9,
+			"other-pipeline": 9,
+		}))
+	})
+
+	Context("when a pipeline was changed since the last sync", func() {
+		BeforeEach(func() {
+			existingPipeline.ConfigVersionReturns(7)
+		})
+
+		It("reports the drift and overwrites the change", func() {
+			Expect(savedStatuses[0].Drifted).To(Equal([]string{"some-pipeline"}))
+			Expect(stderr).To(gbytes.Say("pipeline some-pipeline was changed outside of the repository"))
+
+			_, _, _, from, _ := fakeBuild.SavePipelineArgsForCall(0)
+			Expect(from).To(Equal(db.ConfigVersion(7)))
+		})
+	})
+
+	Context("when a pipeline is unchanged", func() {
+		BeforeEach(func() {
+			var config atc.Config
+			Expect(atc.UnmarshalConfig([]byte(pipelineContent), &config)).To(Succeed())
+			existingPipeline.ConfigReturns(config, nil)
+		})
+
+		It("does not save it again", func() {
+			Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+
+			ref, _, _, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+			Expect(ref).To(Equal(atc.PipelineRef{Name: "other-pipeline"}))
+
+			Expect(savedStatuses[0].Updated).To(BeEmpty())
+			Expect(savedStatuses[0].Managed).To(HaveKeyWithValue("some-pipeline", 5))
+		})
+	})
+
+	Context("when a pipeline is invalid", func() {
+		BeforeEach(func() {
+			files["ci/pipeline.yml"] = "jobs: []"
+		})
+
+		It("fails without changing any pipeline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+
+			Expect(fakeBuild.SavePipelineCallCount()).To(BeZero())
+			Expect(strayPipeline.ArchiveCallCount()).To(BeZero())
+
+			Expect(savedStatuses[0].Result).To(Equal(atc.GitOpsFailed))
+			Expect(savedStatuses[0].Error).To(Equal("invalid pipelines: some-pipeline, other-pipeline"))
+		})
+	})
+
+	Context("when the manifest is malformed", func() {
+		BeforeEach(func() {
+			files["pipelines.yml"] = "pipelines: [{name: foo}]"
+		})
+
+		It("fails", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+
+			Expect(savedStatuses[0].Result).To(Equal(atc.GitOpsFailed))
+			Expect(savedStatuses[0].Error).To(Equal("invalid manifest: pipeline 'foo' has no file"))
+			Expect(stderr).To(gbytes.Say("invalid manifest"))
+		})
+	})
+
+	Context("when the manifest cannot be fetched", func() {
+		BeforeEach(func() {
+			delete(files, "pipelines.yml")
+		})
+
+		It("errors and saves the error", func() {
+			Expect(stepErr).To(MatchError("file not found"))
+
+			Expect(savedStatuses[0].Result).To(Equal(atc.GitOpsErrored))
+			Expect(savedStatuses[0].Error).To(Equal("file not found"))
+		})
+	})
+})
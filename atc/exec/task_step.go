@@ -51,6 +51,11 @@ func (err TaskImageSourceParametersError) Error() string {
 	return fmt.Sprintf("failed to evaluate image resource parameters: %s", err.Err)
 }
 
+//counterfeiter:generate . OIDCTokenIssuer
+type OIDCTokenIssuer interface {
+	IssueOIDCToken(identity atc.WorkloadIdentity, audience []string) (string, error)
+}
+
 //counterfeiter:generate . TaskDelegateFactory
 type TaskDelegateFactory interface {
 	TaskDelegate(state RunState) TaskDelegate
@@ -88,6 +93,7 @@ type TaskStep struct {
 	workerPool        worker.Pool
 	artifactSourcer   worker.ArtifactSourcer
 	artifactStreamer  worker.ArtifactStreamer
+	oidcTokenIssuer   OIDCTokenIssuer
 	delegateFactory   TaskDelegateFactory
 }
 
@@ -101,6 +107,7 @@ func NewTaskStep(
 	workerPool worker.Pool,
 	artifactStreamer worker.ArtifactStreamer,
 	artifactSourcer worker.ArtifactSourcer,
+	oidcTokenIssuer OIDCTokenIssuer,
 	delegateFactory TaskDelegateFactory,
 ) Step {
 	return &TaskStep{
@@ -113,6 +120,7 @@ func NewTaskStep(
 		workerPool:        workerPool,
 		artifactStreamer:  artifactStreamer,
 		artifactSourcer:   artifactSourcer,
+		oidcTokenIssuer:   oidcTokenIssuer,
 		delegateFactory:   delegateFactory,
 	}
 }
@@ -280,6 +288,13 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		defer cancel()
 	}
 
+	if step.plan.OIDCToken != nil {
+		err = step.injectOIDCToken(*step.plan.OIDCToken, containerSpec.Dir, &processSpec)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	result, runErr := chosenWorker.RunTaskStep(
 		lagerctx.NewContext(processCtx, logger),
 		owner,
@@ -440,11 +455,40 @@ func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSp
 	return containerSpec, nil
 }
 
+// injectOIDCToken issues the token as late as possible, as it is
+// short-lived. It is only given to the process, so that it is not persisted
+// with the container's spec.
+func (step *TaskStep) injectOIDCToken(config atc.OIDCTokenConfig, dir string, processSpec *runtime.ProcessSpec) error {
+	token, err := step.oidcTokenIssuer.IssueOIDCToken(atc.WorkloadIdentity{
+		TeamName:     step.metadata.TeamName,
+		PipelineName: step.metadata.PipelineName,
+		InstanceVars: step.metadata.PipelineInstanceVars,
+		JobName:      step.metadata.JobName,
+		BuildID:      step.metadata.BuildID,
+		BuildName:    step.metadata.BuildName,
+		StepName:     step.plan.Name,
+	}, config.Audience)
+	if err != nil {
+		return fmt.Errorf("issue oidc token: %w", err)
+	}
+
+	processSpec.Env = append(processSpec.Env, config.EnvName()+"="+token)
+
+	if config.File != "" {
+		processSpec.Files = map[string][]byte{
+			path.Join(dir, config.File): []byte(token),
+		}
+	}
+
+	return nil
+}
+
 func (step *TaskStep) workerSpec(config atc.TaskConfig) worker.WorkerSpec {
 	return worker.WorkerSpec{
 		Platform: config.Platform,
 		Tags:     step.plan.Tags,
 		TeamID:   step.metadata.TeamID,
+		BuildID:  step.metadata.BuildID,
 	}
 }
 
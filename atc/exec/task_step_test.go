@@ -39,6 +39,7 @@ var _ = Describe("TaskStep", func() {
 		fakeArtifactStreamer *workerfakes.FakeArtifactStreamer
 		fakeArtifactSourcer  *workerfakes.FakeArtifactSourcer
 		fakeStrategy         *workerfakes.FakeContainerPlacementStrategy
+		fakeOIDCTokenIssuer  *execfakes.FakeOIDCTokenIssuer
 
 		spanCtx      context.Context
 		fakeDelegate *execfakes.FakeTaskDelegate
@@ -88,6 +89,7 @@ var _ = Describe("TaskStep", func() {
 		fakeArtifactStreamer = new(workerfakes.FakeArtifactStreamer)
 		fakeArtifactSourcer = new(workerfakes.FakeArtifactSourcer)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
+		fakeOIDCTokenIssuer = new(execfakes.FakeOIDCTokenIssuer)
 
 		fakeDelegate = new(execfakes.FakeTaskDelegate)
 		fakeDelegate.StdoutReturns(stdoutBuf)
@@ -145,6 +147,7 @@ var _ = Describe("TaskStep", func() {
 			fakePool,
 			fakeArtifactStreamer,
 			fakeArtifactSourcer,
+			fakeOIDCTokenIssuer,
 			fakeDelegateFactory,
 		)
 
@@ -224,6 +227,10 @@ var _ = Describe("TaskStep", func() {
 				Expect(workerName).To(Equal("some-worker"))
 			})
 
+			It("creates a worker spec with the build", func() {
+				Expect(workerSpec.BuildID).To(Equal(stepMetadata.BuildID))
+			})
+
 			Context("when tags are configured", func() {
 				BeforeEach(func() {
 					taskPlan.Tags = atc.Tags{"plan", "tags"}
@@ -307,6 +314,73 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when an OIDC token is requested", func() {
+			BeforeEach(func() {
+				stepMetadata.TeamName = "some-team"
+				stepMetadata.PipelineName = "some-pipeline"
+				stepMetadata.JobName = "some-job"
+				stepMetadata.BuildName = "42"
+
+				taskPlan.OIDCToken = &atc.OIDCTokenConfig{
+					Audience: []string{"sts.amazonaws.com"},
+				}
+
+				fakeOIDCTokenIssuer.IssueOIDCTokenReturns("some-token", nil)
+			})
+
+			AfterEach(func() {
+				stepMetadata.TeamName = ""
+				stepMetadata.PipelineName = ""
+				stepMetadata.JobName = ""
+				stepMetadata.BuildName = ""
+			})
+
+			It("issues a token identifying the step", func() {
+				Expect(fakeOIDCTokenIssuer.IssueOIDCTokenCallCount()).To(Equal(1))
+				identity, audience := fakeOIDCTokenIssuer.IssueOIDCTokenArgsForCall(0)
+				Expect(identity).To(Equal(atc.WorkloadIdentity{
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					BuildID:      1234,
+					BuildName:    "42",
+					StepName:     "some-task",
+				}))
+				Expect(audience).To(Equal([]string{"sts.amazonaws.com"}))
+			})
+
+			It("sets the token in the default env var of the process only", func() {
+				Expect(processSpec.Env).To(Equal([]string{"CONCOURSE_OIDC_TOKEN=some-token"}))
+				Expect(containerSpec.Env).ToNot(ContainElement(ContainSubstring("some-token")))
+				Expect(processSpec.Files).To(BeEmpty())
+			})
+
+			Context("when an env var and a file are configured", func() {
+				BeforeEach(func() {
+					taskPlan.OIDCToken.Env = "AWS_WEB_IDENTITY_TOKEN"
+					taskPlan.OIDCToken.File = "oidc/token"
+				})
+
+				It("sets the token in the env var and writes it to the file", func() {
+					Expect(processSpec.Env).To(Equal([]string{"AWS_WEB_IDENTITY_TOKEN=some-token"}))
+					Expect(processSpec.Files).To(Equal(map[string][]byte{
+						"some-artifact-root/oidc/token": []byte("some-token"),
+					}))
+				})
+			})
+
+			Context("when issuing the token fails", func() {
+				BeforeEach(func() {
+					fakeOIDCTokenIssuer.IssueOIDCTokenReturns("", errors.New("nope"))
+					shouldRunTaskStep = false
+				})
+
+				It("errors", func() {
+					Expect(stepErr).To(MatchError(ContainSubstring("nope")))
+				})
+			})
+		})
+
 		Context("when a timeout is configured", func() {
 			BeforeEach(func() {
 				taskPlan.Timeout = "1h"
@@ -41,11 +41,17 @@ type PutStepVersionSource struct {
 
 func (p *PutStepVersionSource) Version(state RunState) (atc.Version, error) {
 	var info runtime.VersionResult
-	if !state.Result(p.planID, &info) {
-		return atc.Version{}, ErrPutStepVersionMissing
+	if state.Result(p.planID, &info) {
+		return info.Version, nil
 	}
 
-	return info.Version, nil
+	// check steps store the latest version they found
+	var version atc.Version
+	if state.Result(p.planID, &version) {
+		return version, nil
+	}
+
+	return atc.Version{}, ErrPutStepVersionMissing
 }
 
 type EmptyVersionSource struct{}
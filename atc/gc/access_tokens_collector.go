@@ -32,5 +32,11 @@ func (c *accessTokensCollector) Run(ctx context.Context) error {
 		return err
 	}
 
+	_, err = c.lifecycle.RemoveExpiredAPITokens(c.leeway)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-api-tokens", err)
+		return err
+	}
+
 	return nil
 }
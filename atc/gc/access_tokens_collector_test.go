@@ -29,5 +29,14 @@ var _ = Describe("AccessTokensCollector", func() {
 			leeway := fakeLifecycle.RemoveExpiredAccessTokensArgsForCall(0)
 			Expect(leeway).To(Equal(jwt.DefaultLeeway))
 		})
+
+		It("tells the access token lifecycle to remove expired API tokens", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeLifecycle.RemoveExpiredAPITokensCallCount()).To(Equal(1))
+			leeway := fakeLifecycle.RemoveExpiredAPITokensArgsForCall(0)
+			Expect(leeway).To(Equal(jwt.DefaultLeeway))
+		})
 	})
 })
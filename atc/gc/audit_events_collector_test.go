@@ -0,0 +1,40 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventsCollector", func() {
+	var collector GcCollector
+	var fakeLifecycle *dbfakes.FakeAuditEventLifecycle
+
+	BeforeEach(func() {
+		fakeLifecycle = new(dbfakes.FakeAuditEventLifecycle)
+
+		collector = gc.NewAuditEventsCollector(fakeLifecycle, 90*24*time.Hour)
+	})
+
+	Describe("Run", func() {
+		It("removes audit events older than the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeLifecycle.RemoveAuditEventsOlderThanCallCount()).To(Equal(1))
+			Expect(fakeLifecycle.RemoveAuditEventsOlderThanArgsForCall(0)).To(Equal(90 * 24 * time.Hour))
+		})
+
+		It("errors when removing them fails", func() {
+			fakeLifecycle.RemoveAuditEventsOlderThanReturns(0, errors.New("nope"))
+
+			err := collector.Run(context.TODO())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
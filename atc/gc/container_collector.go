@@ -15,17 +15,20 @@ type containerCollector struct {
 	containerRepository         db.ContainerRepository
 	missingContainerGracePeriod time.Duration
 	hijackContainerGracePeriod  time.Duration
+	warmContainerIdleTimeout    time.Duration
 }
 
 func NewContainerCollector(
 	containerRepository db.ContainerRepository,
 	missingContainerGracePeriod time.Duration,
 	hijackContainerGracePeriod time.Duration,
+	warmContainerIdleTimeout time.Duration,
 ) *containerCollector {
 	return &containerCollector{
 		containerRepository:         containerRepository,
 		missingContainerGracePeriod: missingContainerGracePeriod,
 		hijackContainerGracePeriod:  hijackContainerGracePeriod,
+		warmContainerIdleTimeout:    warmContainerIdleTimeout,
 	}
 }
 
@@ -56,6 +59,12 @@ func (c *containerCollector) Run(ctx context.Context) error {
 		logger.Error("failed-to-clean-up-failed-containers", err)
 	}
 
+	err = c.markIdleWarmContainersAsDestroying(logger.Session("idle-warm-containers"))
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-clean-up-idle-warm-containers", err)
+	}
+
 	_, err = c.containerRepository.RemoveMissingContainers(c.missingContainerGracePeriod)
 	if err != nil {
 		errs = multierror.Append(errs, err)
@@ -86,6 +95,22 @@ func (c *containerCollector) markFailedContainersAsDestroying(logger lager.Logge
 	return nil
 }
 
+func (c *containerCollector) markIdleWarmContainersAsDestroying(logger lager.Logger) error {
+	numIdleContainers, err := c.containerRepository.DestroyIdleWarmContainers(c.warmContainerIdleTimeout)
+	if err != nil {
+		logger.Error("failed-to-find-idle-warm-containers-for-deletion", err)
+		return err
+	}
+
+	if numIdleContainers > 0 {
+		logger.Debug("found-idle-warm-containers-for-deletion", lager.Data{
+			"number": numIdleContainers,
+		})
+	}
+
+	return nil
+}
+
 func (c *containerCollector) cleanupOrphanedContainers(logger lager.Logger) error {
 
 	creatingContainers, createdContainers, destroyingContainers, err := c.containerRepository.FindOrphanedContainers()
@@ -25,6 +25,7 @@ var _ = Describe("ContainerCollector", func() {
 
 		missingContainerGracePeriod time.Duration
 		hijackContainerGracePeriod  time.Duration
+		warmContainerIdleTimeout    time.Duration
 	)
 
 	BeforeEach(func() {
@@ -37,11 +38,13 @@ var _ = Describe("ContainerCollector", func() {
 
 		missingContainerGracePeriod = 1 * time.Minute
 		hijackContainerGracePeriod = 1 * time.Minute
+		warmContainerIdleTimeout = 30 * time.Minute
 
 		collector = gc.NewContainerCollector(
 			fakeContainerRepository,
 			missingContainerGracePeriod,
 			hijackContainerGracePeriod,
+			warmContainerIdleTimeout,
 		)
 	})
 
@@ -83,6 +86,27 @@ var _ = Describe("ContainerCollector", func() {
 			})
 		})
 
+		Describe("Idle Warm Containers", func() {
+			It("tries to delete them from the database", func() {
+				Expect(fakeContainerRepository.DestroyIdleWarmContainersCallCount()).To(Equal(1))
+				Expect(fakeContainerRepository.DestroyIdleWarmContainersArgsForCall(0)).To(Equal(warmContainerIdleTimeout))
+			})
+
+			Context("when destroying idle warm containers fails", func() {
+				BeforeEach(func() {
+					fakeContainerRepository.DestroyIdleWarmContainersReturns(0, errors.New("disaster"))
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("still tries to delete expired containers", func() {
+					Expect(fakeContainerRepository.RemoveMissingContainersCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		Describe("Orphaned Containers", func() {
 
 			var (
@@ -0,0 +1,57 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+type provisionedWorkerCollector struct {
+	reservations db.ProvisionedWorkerRepository
+	provisioner  worker.Provisioner
+}
+
+// NewProvisionedWorkerCollector tears down the workers provisioned for build
+// steps once their build has finished. They are kept until then so that later
+// steps can still stream the volumes left on them.
+func NewProvisionedWorkerCollector(reservations db.ProvisionedWorkerRepository, provisioner worker.Provisioner) *provisionedWorkerCollector {
+	return &provisionedWorkerCollector{
+		reservations: reservations,
+		provisioner:  provisioner,
+	}
+}
+
+func (pwc *provisionedWorkerCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("provisioned-worker-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	names, err := pwc.reservations.FinishedReservations()
+	if err != nil {
+		logger.Error("failed-to-get-finished-reservations", err)
+		return err
+	}
+
+	for _, name := range names {
+		err := pwc.provisioner.Destroy(lagerctx.NewContext(ctx, logger), name)
+		if err != nil {
+			// keep the reservation so that the next run tries again
+			logger.Error("failed-to-destroy-worker", err, lager.Data{"worker": name})
+			continue
+		}
+
+		err = pwc.reservations.Release(name)
+		if err != nil {
+			logger.Error("failed-to-release-worker", err, lager.Data{"worker": name})
+			continue
+		}
+
+		logger.Info("destroyed-worker", lager.Data{"worker": name})
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProvisionedWorkerCollector", func() {
+	var (
+		collector        GcCollector
+		fakeReservations *dbfakes.FakeProvisionedWorkerRepository
+		fakeProvisioner  *workerfakes.FakeProvisioner
+
+		err error
+	)
+
+	BeforeEach(func() {
+		fakeReservations = new(dbfakes.FakeProvisionedWorkerRepository)
+		fakeProvisioner = new(workerfakes.FakeProvisioner)
+
+		collector = gc.NewProvisionedWorkerCollector(fakeReservations, fakeProvisioner)
+
+		fakeReservations.FinishedReservationsReturns([]string{"worker-1", "worker-2"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		err = collector.Run(context.TODO())
+	})
+
+	It("destroys and releases the workers of finished builds", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeProvisioner.DestroyCallCount()).To(Equal(2))
+		_, name := fakeProvisioner.DestroyArgsForCall(0)
+		Expect(name).To(Equal("worker-1"))
+		_, name = fakeProvisioner.DestroyArgsForCall(1)
+		Expect(name).To(Equal("worker-2"))
+
+		Expect(fakeReservations.ReleaseCallCount()).To(Equal(2))
+		Expect(fakeReservations.ReleaseArgsForCall(0)).To(Equal("worker-1"))
+		Expect(fakeReservations.ReleaseArgsForCall(1)).To(Equal("worker-2"))
+	})
+
+	Context("when destroying a worker fails", func() {
+		BeforeEach(func() {
+			fakeProvisioner.DestroyReturnsOnCall(0, errors.New("disaster"))
+		})
+
+		It("keeps its reservation and carries on with the others", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeReservations.ReleaseCallCount()).To(Equal(1))
+			Expect(fakeReservations.ReleaseArgsForCall(0)).To(Equal("worker-2"))
+		})
+	})
+
+	Context("when getting the finished reservations fails", func() {
+		BeforeEach(func() {
+			fakeReservations.FinishedReservationsReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(fakeProvisioner.DestroyCallCount()).To(BeZero())
+		})
+	})
+})
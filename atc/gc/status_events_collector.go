@@ -0,0 +1,41 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type statusEventsCollector struct {
+	lifecycle db.StatusEventLifecycle
+	retention time.Duration
+}
+
+func NewStatusEventsCollector(lifecycle db.StatusEventLifecycle, retention time.Duration) *statusEventsCollector {
+	return &statusEventsCollector{
+		lifecycle: lifecycle,
+		retention: retention,
+	}
+}
+
+func (c *statusEventsCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("status-events-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.lifecycle.RemoveStatusEventsOlderThan(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-old-status-events", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-old-status-events", lager.Data{"count": removed})
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StatusEventsCollector", func() {
+	var collector GcCollector
+	var fakeLifecycle *dbfakes.FakeStatusEventLifecycle
+
+	BeforeEach(func() {
+		fakeLifecycle = new(dbfakes.FakeStatusEventLifecycle)
+
+		collector = gc.NewStatusEventsCollector(fakeLifecycle, time.Hour)
+	})
+
+	Describe("Run", func() {
+		It("removes status events older than the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeLifecycle.RemoveStatusEventsOlderThanCallCount()).To(Equal(1))
+			Expect(fakeLifecycle.RemoveStatusEventsOlderThanArgsForCall(0)).To(Equal(time.Hour))
+		})
+
+		It("errors when removing them fails", func() {
+			fakeLifecycle.RemoveStatusEventsOlderThanReturns(0, errors.New("nope"))
+
+			err := collector.Run(context.TODO())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,139 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const DefaultGitOpsInterval = time.Minute
+
+// GitOpsConfig points a team at a repository from which all of its pipelines
+// are reconciled. The repository is fetched with the given resource type, so
+// any resource type which produces files (git, s3, ...) can be used.
+type GitOpsConfig struct {
+	Type   string `json:"type"`
+	Source Source `json:"source"`
+	Tags   Tags   `json:"tags,omitempty"`
+
+	// Path is the location of the manifest within the fetched repository.
+	Path string `json:"path"`
+
+	// Interval is how often the repository is synced. Defaults to 1m.
+	Interval string `json:"interval,omitempty"`
+}
+
+func (config GitOpsConfig) Validate() error {
+	if config.Type == "" {
+		return errors.New("gitops config must specify a type")
+	}
+
+	if config.Path == "" {
+		return errors.New("gitops config must specify the path of the manifest")
+	}
+
+	if config.Interval != "" {
+		interval, err := time.ParseDuration(config.Interval)
+		if err != nil {
+			return fmt.Errorf("invalid interval: %w", err)
+		}
+
+		if interval <= 0 {
+			return errors.New("interval must be positive")
+		}
+	}
+
+	return nil
+}
+
+func (config GitOpsConfig) SyncInterval() time.Duration {
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil || interval <= 0 {
+		return DefaultGitOpsInterval
+	}
+
+	return interval
+}
+
+// GitOpsManifest lists every pipeline of a team. Pipelines of the team which
+// are not listed are archived.
+type GitOpsManifest struct {
+	Pipelines []GitOpsPipeline `json:"pipelines"`
+}
+
+// GitOpsPipeline declares a pipeline of the manifest. File and VarFiles are
+// relative to the root of the repository.
+type GitOpsPipeline struct {
+	Name         string       `json:"name"`
+	File         string       `json:"file"`
+	Vars         Params       `json:"vars,omitempty"`
+	VarFiles     []string     `json:"var_files,omitempty"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+func (pipeline GitOpsPipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         pipeline.Name,
+		InstanceVars: pipeline.InstanceVars,
+	}
+}
+
+func (manifest GitOpsManifest) Validate() error {
+	seen := map[string]bool{}
+
+	for i, pipeline := range manifest.Pipelines {
+		if pipeline.Name == "" {
+			return fmt.Errorf("pipelines[%d] has no name", i)
+		}
+
+		if pipeline.File == "" {
+			return fmt.Errorf("pipeline '%s' has no file", pipeline.Name)
+		}
+
+		ref := pipeline.Ref().String()
+		if seen[ref] {
+			return fmt.Errorf("pipeline '%s' is declared more than once", ref)
+		}
+
+		seen[ref] = true
+	}
+
+	return nil
+}
+
+const (
+	GitOpsStarted   = "started"
+	GitOpsSucceeded = "succeeded"
+	GitOpsFailed    = "failed"
+	GitOpsErrored   = "errored"
+)
+
+// GitOpsStatus is the result of the last sync of a team's pipelines.
+type GitOpsStatus struct {
+	BuildID    int    `json:"build_id,omitempty"`
+	StartedAt  int64  `json:"started_at,omitempty"`
+	FinishedAt int64  `json:"finished_at,omitempty"`
+	Result     string `json:"result,omitempty"`
+	Error      string `json:"error,omitempty"`
+
+	// Version is the version of the repository which was synced.
+	Version Version `json:"version,omitempty"`
+
+	Created  []string `json:"created,omitempty"`
+	Updated  []string `json:"updated,omitempty"`
+	Archived []string `json:"archived,omitempty"`
+
+	// Drifted lists pipelines which were changed outside of the repository,
+	// e.g. with fly set-pipeline, since the previous sync. Their changes are
+	// overwritten.
+	Drifted []string `json:"drifted,omitempty"`
+
+	// Managed maps the pipelines of the manifest to the config version saved
+	// by the last sync, used to detect drift.
+	Managed map[string]int `json:"managed,omitempty"`
+}
+
+type GitOps struct {
+	Config *GitOpsConfig `json:"config,omitempty"`
+	Status *GitOpsStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,151 @@
+// Package gitops periodically syncs the pipelines of teams which have GitOps
+// enabled with the manifest in their repository.
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ArtifactName is the name the repository is fetched as within a sync build.
+const ArtifactName = "gitops"
+
+func NewReconciler(
+	teamFactory db.TeamFactory,
+	buildFactory db.BuildFactory,
+	clock clock.Clock,
+) *reconciler {
+	return &reconciler{
+		teamFactory:  teamFactory,
+		buildFactory: buildFactory,
+		clock:        clock,
+	}
+}
+
+type reconciler struct {
+	teamFactory  db.TeamFactory
+	buildFactory db.BuildFactory
+	clock        clock.Clock
+}
+
+// Run starts a sync build for every team whose sync interval has elapsed and
+// whose previous sync has finished.
+func (r *reconciler) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx)
+
+	teams, err := r.teamFactory.GetTeams()
+	if err != nil {
+		logger.Error("failed-to-get-teams", err)
+		return err
+	}
+
+	for _, team := range teams {
+		err := r.reconcile(logger.Session("reconcile", lager.Data{"team": team.Name()}), team)
+		if err != nil {
+			logger.Error("failed-to-reconcile", err, lager.Data{"team": team.Name()})
+		}
+	}
+
+	return nil
+}
+
+func (r *reconciler) reconcile(logger lager.Logger, team db.Team) error {
+	config, found, err := team.GitOps()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	status, found, err := team.GitOpsStatus()
+	if err != nil {
+		return err
+	}
+
+	if found && status.Result == atc.GitOpsStarted {
+		build, found, err := r.buildFactory.Build(status.BuildID)
+		if err != nil {
+			return err
+		}
+
+		if found && !build.IsCompleted() {
+			return nil
+		}
+
+		// the build finished without reaching the sync step, e.g. because
+		// the repository could not be fetched
+		status.Result = atc.GitOpsErrored
+		status.Error = fmt.Sprintf("build %d did not complete the sync", status.BuildID)
+		status.FinishedAt = r.clock.Now().Unix()
+
+		if found {
+			if build.Status() == db.BuildStatusFailed {
+				status.Result = atc.GitOpsFailed
+			}
+
+			status.Error = fmt.Sprintf("build %d %s", status.BuildID, build.Status())
+			status.FinishedAt = build.EndTime().Unix()
+		}
+
+		err = team.SaveGitOpsStatus(status)
+		if err != nil {
+			return err
+		}
+	}
+
+	if found && r.clock.Now().Sub(time.Unix(status.StartedAt, 0)) < config.SyncInterval() {
+		return nil
+	}
+
+	build, err := team.CreateStartedBuild(SyncPlan(atc.NewPlanFactory(r.clock.Now().Unix()), config))
+	if err != nil {
+		return err
+	}
+
+	logger.Info("started-sync", lager.Data{"build": build.ID()})
+
+	return team.SaveGitOpsStatus(atc.GitOpsStatus{
+		BuildID:   build.ID(),
+		StartedAt: r.clock.Now().Unix(),
+		Result:    atc.GitOpsStarted,
+		Version:   status.Version,
+		Managed:   status.Managed,
+	})
+}
+
+// SyncPlan checks the repository for its latest version, fetches it, and
+// syncs the team's pipelines with the manifest.
+func SyncPlan(planFactory atc.PlanFactory, config atc.GitOpsConfig) atc.Plan {
+	checkPlan := planFactory.NewPlan(atc.CheckPlan{
+		Name:   ArtifactName,
+		Type:   config.Type,
+		Source: config.Source,
+		Tags:   config.Tags,
+	})
+
+	getPlan := planFactory.NewPlan(atc.GetPlan{
+		Name:        ArtifactName,
+		Type:        config.Type,
+		Source:      config.Source,
+		Tags:        config.Tags,
+		VersionFrom: &checkPlan.ID,
+	})
+
+	syncPlan := planFactory.NewPlan(atc.SyncPipelinesPlan{
+		Name:        ArtifactName,
+		File:        ArtifactName + "/" + config.Path,
+		VersionFrom: &checkPlan.ID,
+	})
+
+	return planFactory.NewPlan(atc.DoPlan{checkPlan, getPlan, syncPlan})
+}
@@ -0,0 +1,219 @@
+package gitops_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gitops"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type Reconciler interface {
+	Run(ctx context.Context) error
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		fakeTeamFactory  *dbfakes.FakeTeamFactory
+		fakeBuildFactory *dbfakes.FakeBuildFactory
+		fakeTeam         *dbfakes.FakeTeam
+		fakeClock        *fakeclock.FakeClock
+
+		now    time.Time
+		config atc.GitOpsConfig
+
+		reconciler Reconciler
+		err        error
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+		fakeClock = fakeclock.NewFakeClock(now)
+
+		config = atc.GitOpsConfig{
+			Type:     "git",
+			Source:   atc.Source{"uri": "https://example.com/ci.git"},
+			Tags:     atc.Tags{"some-tag"},
+			Path:     "ci/pipelines.yml",
+			Interval: "5m",
+		}
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("some-team")
+		fakeTeam.GitOpsReturns(config, true, nil)
+
+		fakeBuild := new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(99)
+		fakeTeam.CreateStartedBuildReturns(fakeBuild, nil)
+
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetTeamsReturns([]db.Team{fakeTeam}, nil)
+
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+
+		reconciler = gitops.NewReconciler(fakeTeamFactory, fakeBuildFactory, fakeClock)
+	})
+
+	JustBeforeEach(func() {
+		err = reconciler.Run(context.TODO())
+	})
+
+	Context("when fetching teams fails", func() {
+		BeforeEach(func() {
+			fakeTeamFactory.GetTeamsReturns(nil, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when gitops is not enabled", func() {
+		BeforeEach(func() {
+			fakeTeam.GitOpsReturns(atc.GitOpsConfig{}, false, nil)
+		})
+
+		It("does not start a build", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeTeam.CreateStartedBuildCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the team has never been synced", func() {
+		It("starts a build which fetches the repository and syncs it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeTeam.CreateStartedBuildCallCount()).To(Equal(1))
+
+			plan := fakeTeam.CreateStartedBuildArgsForCall(0)
+			Expect(plan.Do).ToNot(BeNil())
+			Expect(*plan.Do).To(HaveLen(3))
+
+			check := (*plan.Do)[0]
+			Expect(check.Check).To(Equal(&atc.CheckPlan{
+				Name:   "gitops",
+				Type:   "git",
+				Source: atc.Source{"uri": "https://example.com/ci.git"},
+				Tags:   atc.Tags{"some-tag"},
+			}))
+
+			get := (*plan.Do)[1]
+			Expect(get.Get).To(Equal(&atc.GetPlan{
+				Name:        "gitops",
+				Type:        "git",
+				Source:      atc.Source{"uri": "https://example.com/ci.git"},
+				Tags:        atc.Tags{"some-tag"},
+				VersionFrom: &check.ID,
+			}))
+
+			sync := (*plan.Do)[2]
+			Expect(sync.SyncPipelines).To(Equal(&atc.SyncPipelinesPlan{
+				Name:        "gitops",
+				File:        "gitops/ci/pipelines.yml",
+				VersionFrom: &check.ID,
+			}))
+		})
+
+		It("records that the sync started", func() {
+			Expect(fakeTeam.SaveGitOpsStatusCallCount()).To(Equal(1))
+			Expect(fakeTeam.SaveGitOpsStatusArgsForCall(0)).To(Equal(atc.GitOpsStatus{
+				BuildID:   99,
+				StartedAt: now.Unix(),
+				Result:    atc.GitOpsStarted,
+			}))
+		})
+	})
+
+	Context("when the last sync finished", func() {
+		var status atc.GitOpsStatus
+
+		BeforeEach(func() {
+			status = atc.GitOpsStatus{
+				BuildID:    42,
+				Result:     atc.GitOpsSucceeded,
+				Version:    atc.Version{"ref": "abc"},
+				Managed:    map[string]int{"some-pipeline": 3},
+				FinishedAt: now.Unix(),
+			}
+		})
+
+		Context("within the interval", func() {
+			BeforeEach(func() {
+				status.StartedAt = now.Add(-4 * time.Minute).Unix()
+				fakeTeam.GitOpsStatusReturns(status, true, nil)
+			})
+
+			It("does not start a build", func() {
+				Expect(fakeTeam.CreateStartedBuildCallCount()).To(BeZero())
+			})
+		})
+
+		Context("longer than the interval ago", func() {
+			BeforeEach(func() {
+				status.StartedAt = now.Add(-6 * time.Minute).Unix()
+				fakeTeam.GitOpsStatusReturns(status, true, nil)
+			})
+
+			It("starts a build and keeps track of the managed pipelines", func() {
+				Expect(fakeTeam.CreateStartedBuildCallCount()).To(Equal(1))
+
+				Expect(fakeTeam.SaveGitOpsStatusArgsForCall(0)).To(Equal(atc.GitOpsStatus{
+					BuildID:   99,
+					StartedAt: now.Unix(),
+					Result:    atc.GitOpsStarted,
+					Version:   atc.Version{"ref": "abc"},
+					Managed:   map[string]int{"some-pipeline": 3},
+				}))
+			})
+		})
+	})
+
+	Context("when the last sync is still running", func() {
+		var fakeBuild *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			fakeTeam.GitOpsStatusReturns(atc.GitOpsStatus{
+				BuildID:   42,
+				StartedAt: now.Add(-time.Hour).Unix(),
+				Result:    atc.GitOpsStarted,
+			}, true, nil)
+
+			fakeBuild = new(dbfakes.FakeBuild)
+			fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+		})
+
+		Context("when the build has not completed", func() {
+			It("waits for it", func() {
+				Expect(fakeBuildFactory.BuildArgsForCall(0)).To(Equal(42))
+				Expect(fakeTeam.CreateStartedBuildCallCount()).To(BeZero())
+				Expect(fakeTeam.SaveGitOpsStatusCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the build completed without syncing", func() {
+			BeforeEach(func() {
+				fakeBuild.IsCompletedReturns(true)
+				fakeBuild.StatusReturns(db.BuildStatusErrored)
+				fakeBuild.EndTimeReturns(now.Add(-time.Minute))
+			})
+
+			It("records the result of the build and starts a new one", func() {
+				Expect(fakeTeam.SaveGitOpsStatusCallCount()).To(Equal(2))
+				Expect(fakeTeam.SaveGitOpsStatusArgsForCall(0)).To(Equal(atc.GitOpsStatus{
+					BuildID:    42,
+					StartedAt:  now.Add(-time.Hour).Unix(),
+					FinishedAt: now.Add(-time.Minute).Unix(),
+					Result:     atc.GitOpsErrored,
+					Error:      "build 42 errored",
+				}))
+
+				Expect(fakeTeam.CreateStartedBuildCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
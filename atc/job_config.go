@@ -95,11 +95,12 @@ func (config JobConfig) Inputs() []JobInputParams {
 		OnGet: func(step *GetStep) error {
 			inputs = append(inputs, JobInputParams{
 				JobInput: JobInput{
-					Name:     step.Name,
-					Resource: step.ResourceName(),
-					Passed:   step.Passed,
-					Version:  step.Version,
-					Trigger:  step.Trigger,
+					Name:           step.Name,
+					Resource:       step.ResourceName(),
+					Passed:         step.Passed,
+					PassedPipeline: step.PassedPipeline,
+					Version:        step.Version,
+					Trigger:        step.Trigger,
 				},
 				Params: step.Params,
 				Tags:   step.Tags,
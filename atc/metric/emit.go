@@ -85,6 +85,10 @@ type Monitor struct {
 
 	GetStepCacheHits       Counter
 	StreamedResourceCaches Counter
+
+	WarmContainerHits       Counter
+	WarmContainerMisses     Counter
+	WarmContainerIneligible Counter
 }
 
 var Metrics = NewMonitor()
@@ -69,12 +69,18 @@ type PrometheusEmitter struct {
 	getStepCacheHits       prometheus.Counter
 	streamedResourceCaches prometheus.Counter
 
+	warmContainerHits       prometheus.Counter
+	warmContainerMisses     prometheus.Counter
+	warmContainerIneligible prometheus.Counter
+
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
 	workerVolumes           *prometheus.GaugeVec
 	workerUnknownVolumes    *prometheus.GaugeVec
 	workerTasks             *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
+	workersDesired          prometheus.Gauge
+	stepsWaitingForWorkers  prometheus.Gauge
 
 	workerContainersLabels map[string]map[string]prometheus.Labels
 	workerVolumesLabels    map[string]map[string]prometheus.Labels
@@ -392,6 +398,26 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workersRegistered)
 
+	workersDesired := prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "desired",
+			Help:      "Number of workers needed to run the current workload, as computed by the worker autoscaler",
+		},
+	)
+	prometheus.MustRegister(workersDesired)
+
+	stepsWaitingForWorkers := prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "steps_waiting",
+			Help:      "Number of steps waiting for a worker across all web nodes",
+		},
+	)
+	prometheus.MustRegister(stepsWaitingForWorkers)
+
 	// http metrics
 	httpRequestsDuration := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -484,6 +510,36 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(streamedResourceCaches)
 
+	warmContainerHits := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "caches",
+			Name:      "warm_container_hits",
+			Help:      "Total number of task steps that claimed a warm container",
+		},
+	)
+	prometheus.MustRegister(warmContainerHits)
+
+	warmContainerMisses := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "caches",
+			Name:      "warm_container_misses",
+			Help:      "Total number of task steps that could have used a warm container but found none, or whose image was not on the worker",
+		},
+	)
+	prometheus.MustRegister(warmContainerMisses)
+
+	warmContainerIneligible := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "caches",
+			Name:      "warm_container_ineligible",
+			Help:      "Total number of task steps that could not use a warm container, e.g. as they have inputs or outputs",
+		},
+	)
+	prometheus.MustRegister(warmContainerIneligible)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -538,6 +594,8 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 		workerContainers:        workerContainers,
 		workersRegistered:       workersRegistered,
+		workersDesired:          workersDesired,
+		stepsWaitingForWorkers:  stepsWaitingForWorkers,
 		workerContainersLabels:  map[string]map[string]prometheus.Labels{},
 		workerVolumesLabels:     map[string]map[string]prometheus.Labels{},
 		workerTasksLabels:       map[string]map[string]prometheus.Labels{},
@@ -551,6 +609,10 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 		getStepCacheHits:       getStepCacheHits,
 		streamedResourceCaches: streamedResourceCaches,
+
+		warmContainerHits:       warmContainerHits,
+		warmContainerMisses:     warmContainerMisses,
+		warmContainerIneligible: warmContainerIneligible,
 	}
 	go emitter.periodicMetricGC()
 
@@ -620,6 +682,10 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.workerTasksMetric(logger, event)
 	case "worker state":
 		emitter.workersRegisteredMetric(logger, event)
+	case "workers desired":
+		emitter.workersDesired.Set(event.Value)
+	case "steps waiting for workers":
+		emitter.stepsWaitingForWorkers.Set(event.Value)
 	case "http response time":
 		emitter.httpResponseTimeMetrics(logger, event)
 	case "database queries":
@@ -640,6 +706,12 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.getStepCacheHits.Add(event.Value)
 	case "streamed resource caches":
 		emitter.streamedResourceCaches.Add(event.Value)
+	case "warm container hits":
+		emitter.warmContainerHits.Add(event.Value)
+	case "warm container misses":
+		emitter.warmContainerMisses.Add(event.Value)
+	case "warm container ineligible":
+		emitter.warmContainerIneligible.Add(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -609,6 +609,29 @@ func LogLockReleased(logger lager.Logger, lockID lock.LockID) {
 	}
 }
 
+type WorkerCapacity struct {
+	DesiredWorkers int
+	WaitingSteps   int
+}
+
+func (event WorkerCapacity) Emit(logger lager.Logger) {
+	Metrics.emit(
+		logger.Session("workers-desired"),
+		Event{
+			Name:  "workers desired",
+			Value: float64(event.DesiredWorkers),
+		},
+	)
+
+	Metrics.emit(
+		logger.Session("steps-waiting-for-workers"),
+		Event{
+			Name:  "steps waiting for workers",
+			Value: float64(event.WaitingSteps),
+		},
+	)
+}
+
 type WorkersState struct {
 	WorkerStateByName map[string]db.WorkerState
 }
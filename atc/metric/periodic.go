@@ -91,6 +91,30 @@ func tick(logger lager.Logger, m *Monitor) {
 		},
 	)
 
+	m.emit(
+		logger.Session("warm-container-hits"),
+		Event{
+			Name:  "warm container hits",
+			Value: m.WarmContainerHits.Delta(),
+		},
+	)
+
+	m.emit(
+		logger.Session("warm-container-misses"),
+		Event{
+			Name:  "warm container misses",
+			Value: m.WarmContainerMisses.Delta(),
+		},
+	)
+
+	m.emit(
+		logger.Session("warm-container-ineligible"),
+		Event{
+			Name:  "warm container ineligible",
+			Value: m.WarmContainerIneligible.Delta(),
+		},
+	)
+
 	m.emit(
 		logger.Session("containers-created"),
 		Event{
@@ -0,0 +1,220 @@
+package atc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"text/template"
+)
+
+// DefaultNotificationStatuses are the build statuses a notification is sent
+// for when none are configured.
+var DefaultNotificationStatuses = []BuildStatus{
+	StatusSucceeded,
+	StatusFailed,
+	StatusErrored,
+	StatusAborted,
+}
+
+// NotificationConfig subscribes a webhook to the status changes of the builds
+// of a team's jobs.
+type NotificationConfig struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+
+	// Secret signs the payload with HMAC-SHA256, sent as the
+	// X-Concourse-Signature header.
+	Secret string `json:"secret,omitempty"`
+
+	// Pipelines limits the notification to the named pipelines. Defaults to
+	// every pipeline of the team.
+	Pipelines []string `json:"pipelines,omitempty"`
+
+	// Statuses are the build statuses to notify about. Defaults to
+	// DefaultNotificationStatuses.
+	Statuses []BuildStatus `json:"statuses,omitempty"`
+
+	// Template renders the body of the request from a NotificationPayload,
+	// e.g. to fit the format of a chat service. Defaults to the payload as
+	// JSON.
+	Template string `json:"template,omitempty"`
+}
+
+type NotificationConfigs []NotificationConfig
+
+func (configs NotificationConfigs) Validate() error {
+	names := map[string]bool{}
+
+	for i, config := range configs {
+		if config.Name == "" {
+			return fmt.Errorf("notifications[%d] must specify a name", i)
+		}
+
+		if names[config.Name] {
+			return fmt.Errorf("notification '%s' is declared more than once", config.Name)
+		}
+
+		names[config.Name] = true
+
+		err := config.Validate()
+		if err != nil {
+			return fmt.Errorf("invalid notification '%s': %w", config.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (configs NotificationConfigs) Lookup(name string) (NotificationConfig, bool) {
+	for _, config := range configs {
+		if config.Name == name {
+			return config, true
+		}
+	}
+
+	return NotificationConfig{}, false
+}
+
+func (config NotificationConfig) Validate() error {
+	if config.URL == "" {
+		return errors.New("must specify a url")
+	}
+
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("url must be http or https")
+	}
+
+	for _, status := range config.Statuses {
+		switch status {
+		case StatusPending, StatusStarted, StatusSucceeded, StatusFailed, StatusErrored, StatusAborted:
+		default:
+			return fmt.Errorf("unknown status '%s'", status)
+		}
+	}
+
+	_, err = config.parseTemplate()
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	return nil
+}
+
+// Matches returns whether a notification should be sent for the event.
+func (config NotificationConfig) Matches(event StatusEvent) bool {
+	if event.Type != StatusEventBuild || event.JobName == "" {
+		return false
+	}
+
+	if len(config.Pipelines) > 0 && !containsString(config.Pipelines, event.PipelineName) {
+		return false
+	}
+
+	statuses := config.Statuses
+	if len(statuses) == 0 {
+		statuses = DefaultNotificationStatuses
+	}
+
+	for _, status := range statuses {
+		if status == event.Status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Render produces the body of the request sent for the payload. Templates can
+// use the json function to quote values.
+func (config NotificationConfig) Render(payload NotificationPayload) ([]byte, error) {
+	tmpl, err := config.parseTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	if tmpl == nil {
+		return json.Marshal(payload)
+	}
+
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (config NotificationConfig) parseTemplate() (*template.Template, error) {
+	if config.Template == "" {
+		return nil, nil
+	}
+
+	return template.New(config.Name).
+		Option("missingkey=error").
+		Funcs(template.FuncMap{
+			"json": func(v interface{}) (string, error) {
+				payload, err := json.Marshal(v)
+				return string(payload), err
+			},
+		}).
+		Parse(config.Template)
+}
+
+// NotificationPayload is sent for a build status change, and is the data
+// given to a notification's template.
+type NotificationPayload struct {
+	StatusEvent
+
+	Notification string `json:"notification"`
+
+	// URL is the build's page in the web UI.
+	URL string `json:"url"`
+}
+
+type NotificationDeliveryState string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryState = "pending"
+	NotificationDeliverySucceeded NotificationDeliveryState = "succeeded"
+	NotificationDeliveryFailed    NotificationDeliveryState = "failed"
+)
+
+// NotificationDelivery records the attempts at sending a notification.
+type NotificationDelivery struct {
+	ID           int                       `json:"id"`
+	Notification string                    `json:"notification"`
+	State        NotificationDeliveryState `json:"state"`
+	Attempts     int                       `json:"attempts"`
+
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name"`
+	BuildID              int          `json:"build_id"`
+	BuildName            string       `json:"build_name"`
+	BuildStatus          BuildStatus  `json:"build_status"`
+
+	// ResponseStatus and Error describe the outcome of the last attempt.
+	ResponseStatus int    `json:"response_status,omitempty"`
+	Error          string `json:"error,omitempty"`
+
+	CreatedAt     int64 `json:"created_at"`
+	LastAttemptAt int64 `json:"last_attempt_at,omitempty"`
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+package atc_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Notifications", func() {
+	var config atc.NotificationConfig
+
+	BeforeEach(func() {
+		config = atc.NotificationConfig{
+			Name: "some-notification",
+			URL:  "https://example.com/hook",
+		}
+	})
+
+	Describe("Validate", func() {
+		It("accepts a notification with just a url", func() {
+			Expect(atc.NotificationConfigs{config}.Validate()).To(Succeed())
+		})
+
+		It("requires a name", func() {
+			config.Name = ""
+			Expect(atc.NotificationConfigs{config}.Validate()).To(MatchError("notifications[0] must specify a name"))
+		})
+
+		It("requires unique names", func() {
+			Expect(atc.NotificationConfigs{config, config}.Validate()).To(MatchError("notification 'some-notification' is declared more than once"))
+		})
+
+		It("requires an http url", func() {
+			config.URL = "ftp://example.com"
+			Expect(config.Validate()).To(MatchError("url must be http or https"))
+		})
+
+		It("rejects unknown statuses", func() {
+			config.Statuses = []atc.BuildStatus{"bogus"}
+			Expect(config.Validate()).To(MatchError("unknown status 'bogus'"))
+		})
+
+		It("rejects malformed templates", func() {
+			config.Template = "{{.JobName"
+			Expect(config.Validate()).To(MatchError(ContainSubstring("invalid template")))
+		})
+	})
+
+	Describe("Matches", func() {
+		var event atc.StatusEvent
+
+		BeforeEach(func() {
+			event = atc.StatusEvent{
+				Type:         atc.StatusEventBuild,
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				Status:       atc.StatusFailed,
+			}
+		})
+
+		It("matches finished job builds by default", func() {
+			Expect(config.Matches(event)).To(BeTrue())
+
+			event.Status = atc.StatusStarted
+			Expect(config.Matches(event)).To(BeFalse())
+		})
+
+		It("does not match one-off builds or job events", func() {
+			event.JobName = ""
+			Expect(config.Matches(event)).To(BeFalse())
+
+			event.JobName = "some-job"
+			event.Type = atc.StatusEventJob
+			Expect(config.Matches(event)).To(BeFalse())
+		})
+
+		It("matches the configured statuses and pipelines", func() {
+			config.Statuses = []atc.BuildStatus{atc.StatusStarted}
+			config.Pipelines = []string{"other-pipeline"}
+
+			event.Status = atc.StatusStarted
+			Expect(config.Matches(event)).To(BeFalse())
+
+			event.PipelineName = "other-pipeline"
+			Expect(config.Matches(event)).To(BeTrue())
+		})
+	})
+
+	Describe("Render", func() {
+		var payload atc.NotificationPayload
+
+		BeforeEach(func() {
+			payload = atc.NotificationPayload{
+				StatusEvent: atc.StatusEvent{
+					ID:        1,
+					Type:      atc.StatusEventBuild,
+					TeamName:  "main",
+					JobName:   "some-job",
+					BuildName: "42",
+					Status:    atc.StatusFailed,
+				},
+				Notification: "some-notification",
+				URL:          "https://ci.example.com/builds/1",
+			}
+		})
+
+		It("renders the payload as json by default", func() {
+			Expect(config.Render(payload)).To(MatchJSON(`{
+				"id": 1,
+				"type": "build",
+				"time": 0,
+				"team_name": "main",
+				"job_name": "some-job",
+				"build_name": "42",
+				"status": "failed",
+				"notification": "some-notification",
+				"url": "https://ci.example.com/builds/1"
+			}`))
+		})
+
+		It("renders the template", func() {
+			config.Template = `{"text": {{json (printf "%s #%s %s" .JobName .BuildName .Status)}}}`
+			Expect(config.Render(payload)).To(MatchJSON(`{"text": "some-job #42 failed"}`))
+		})
+	})
+})
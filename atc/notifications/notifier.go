@@ -0,0 +1,270 @@
+// Package notifications sends the webhooks teams have subscribed to when the
+// builds of their jobs change status.
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/webhooks"
+)
+
+const (
+	// SignatureHeader carries the signature of the payload, as made by
+	// webhooks.Sign, so that receivers can verify it was sent by Concourse.
+	SignatureHeader = webhooks.GenericSignatureHeader
+	DeliveryHeader  = "X-Concourse-Delivery"
+
+	// MaxAttempts is how many times a delivery is attempted before it is
+	// given up on.
+	MaxAttempts = 5
+
+	// RetryBackoff is how long to wait before the first retry. It doubles
+	// with every attempt.
+	RetryBackoff = 30 * time.Second
+
+	// DeliveryRetention is how long the history of finished deliveries is
+	// kept.
+	DeliveryRetention = 7 * 24 * time.Hour
+
+	batchSize = 100
+)
+
+func NewNotifier(
+	teamFactory db.TeamFactory,
+	repository db.NotificationRepository,
+	httpClient *http.Client,
+	externalURL string,
+	clock clock.Clock,
+) *notifier {
+	return &notifier{
+		teamFactory: teamFactory,
+		repository:  repository,
+		httpClient:  httpClient,
+		externalURL: externalURL,
+		clock:       clock,
+	}
+}
+
+type notifier struct {
+	teamFactory db.TeamFactory
+	repository  db.NotificationRepository
+	httpClient  *http.Client
+	externalURL string
+	clock       clock.Clock
+}
+
+// Run queues a delivery for every notification matching the build status
+// changes since the last run, then sends the deliveries which are due.
+func (n *notifier) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx)
+
+	err := n.enqueue(logger.Session("enqueue"))
+	if err != nil {
+		logger.Error("failed-to-enqueue-deliveries", err)
+		return err
+	}
+
+	err = n.deliver(ctx, logger.Session("deliver"))
+	if err != nil {
+		logger.Error("failed-to-send-deliveries", err)
+		return err
+	}
+
+	_, err = n.repository.RemoveDeliveriesOlderThan(DeliveryRetention)
+	if err != nil {
+		logger.Error("failed-to-remove-old-deliveries", err)
+		return err
+	}
+
+	return nil
+}
+
+func (n *notifier) enqueue(logger lager.Logger) error {
+	for {
+		events, err := n.repository.UnnotifiedStatusEvents(batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		teams, err := n.teamFactory.GetTeams()
+		if err != nil {
+			return err
+		}
+
+		teamsByName := map[string]db.Team{}
+		for _, team := range teams {
+			teamsByName[team.Name()] = team
+		}
+
+		configsByTeam := map[string]atc.NotificationConfigs{}
+
+		var (
+			deliveries []db.NotificationDelivery
+			eventIDs   []int
+		)
+
+		for _, event := range events {
+			eventIDs = append(eventIDs, event.ID)
+
+			team, found := teamsByName[event.TeamName]
+			if !found {
+				continue
+			}
+
+			configs, cached := configsByTeam[event.TeamName]
+			if !cached {
+				configs, err = team.Notifications()
+				if err != nil {
+					return err
+				}
+
+				configsByTeam[event.TeamName] = configs
+			}
+
+			for _, config := range configs {
+				if !config.Matches(event) {
+					continue
+				}
+
+				payload, err := config.Render(atc.NotificationPayload{
+					StatusEvent:  event,
+					Notification: config.Name,
+					URL:          n.buildURL(event),
+				})
+				if err != nil {
+					logger.Error("failed-to-render-payload", err, lager.Data{"team": team.Name(), "notification": config.Name})
+					continue
+				}
+
+				deliveries = append(deliveries, db.NotificationDelivery{
+					TeamID:       team.ID(),
+					Notification: config.Name,
+					StatusEvent:  event,
+					Payload:      payload,
+				})
+			}
+		}
+
+		err = n.repository.EnqueueDeliveries(deliveries, eventIDs)
+		if err != nil {
+			return err
+		}
+
+		if len(events) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (n *notifier) deliver(ctx context.Context, logger lager.Logger) error {
+	deliveries, err := n.repository.PendingDeliveries(batchSize)
+	if err != nil {
+		return err
+	}
+
+	configsByTeam := map[int]atc.NotificationConfigs{}
+
+	for _, delivery := range deliveries {
+		configs, cached := configsByTeam[delivery.TeamID]
+		if !cached {
+			configs, err = n.teamFactory.GetByID(delivery.TeamID).Notifications()
+			if err != nil {
+				return err
+			}
+
+			configsByTeam[delivery.TeamID] = configs
+		}
+
+		delivery.Attempts++
+
+		config, found := configs.Lookup(delivery.Notification)
+		if found {
+			n.send(ctx, config, &delivery)
+		} else {
+			delivery.State = atc.NotificationDeliveryFailed
+			delivery.Error = "notification is no longer configured"
+		}
+
+		if delivery.State == atc.NotificationDeliveryFailed {
+			logger.Info("delivery-failed", lager.Data{
+				"delivery":     delivery.ID,
+				"notification": delivery.Notification,
+				"error":        delivery.Error,
+			})
+		}
+
+		err = n.repository.SaveDeliveryAttempt(delivery)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// send attempts the delivery, updating its state with the outcome.
+func (n *notifier) send(ctx context.Context, config atc.NotificationConfig, delivery *db.NotificationDelivery) {
+	delivery.ResponseStatus = 0
+	delivery.Error = ""
+
+	req, err := http.NewRequestWithContext(ctx, "POST", config.URL, bytes.NewReader(delivery.Payload))
+	if err == nil {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(DeliveryHeader, strconv.Itoa(delivery.ID))
+
+		if config.Secret != "" {
+			req.Header.Set(SignatureHeader, webhooks.Sign(config.Secret, delivery.Payload))
+		}
+
+		var resp *http.Response
+		resp, err = n.httpClient.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+
+			delivery.ResponseStatus = resp.StatusCode
+			if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+				delivery.State = atc.NotificationDeliverySucceeded
+				return
+			}
+
+			err = fmt.Errorf("unexpected response: %s", resp.Status)
+		}
+	}
+
+	delivery.Error = err.Error()
+
+	if delivery.Attempts >= MaxAttempts {
+		delivery.State = atc.NotificationDeliveryFailed
+		return
+	}
+
+	delivery.NextAttemptAt = n.clock.Now().Add(RetryBackoff << (delivery.Attempts - 1))
+}
+
+func (n *notifier) buildURL(event atc.StatusEvent) string {
+	pipelineRef := atc.PipelineRef{Name: event.PipelineName, InstanceVars: event.PipelineInstanceVars}
+
+	u := url.URL{
+		Path: fmt.Sprintf("/teams/%s/pipelines/%s/jobs/%s/builds/%s",
+			event.TeamName, event.PipelineName, event.JobName, event.BuildName),
+		RawQuery: pipelineRef.QueryParams().Encode(),
+	}
+
+	return n.externalURL + u.String()
+}
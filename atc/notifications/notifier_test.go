@@ -0,0 +1,261 @@
+package notifications_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/notifications"
+	"github.com/concourse/concourse/atc/webhooks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+type Notifier interface {
+	Run(ctx context.Context) error
+}
+
+var _ = Describe("Notifier", func() {
+	var (
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeRepository  *dbfakes.FakeNotificationRepository
+		fakeTeam        *dbfakes.FakeTeam
+		fakeClock       *fakeclock.FakeClock
+		hookServer      *ghttp.Server
+
+		now     time.Time
+		configs atc.NotificationConfigs
+		event   atc.StatusEvent
+
+		notifier Notifier
+		err      error
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+		fakeClock = fakeclock.NewFakeClock(now)
+
+		hookServer = ghttp.NewServer()
+
+		configs = atc.NotificationConfigs{
+			{Name: "some-hook", URL: hookServer.URL() + "/hook", Secret: "shh"},
+		}
+
+		event = atc.StatusEvent{
+			ID:                   42,
+			Type:                 atc.StatusEventBuild,
+			TeamName:             "some-team",
+			PipelineName:         "some-pipeline",
+			PipelineInstanceVars: atc.InstanceVars{"branch": "main"},
+			JobName:              "some-job",
+			BuildID:              7,
+			BuildName:            "3",
+			Status:               atc.StatusFailed,
+		}
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.IDReturns(1)
+		fakeTeam.NameReturns("some-team")
+		fakeTeam.NotificationsStub = func() (atc.NotificationConfigs, error) {
+			return configs, nil
+		}
+
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetTeamsReturns([]db.Team{fakeTeam}, nil)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		fakeRepository = new(dbfakes.FakeNotificationRepository)
+
+		notifier = notifications.NewNotifier(
+			fakeTeamFactory,
+			fakeRepository,
+			http.DefaultClient,
+			"https://ci.example.com",
+			fakeClock,
+		)
+	})
+
+	AfterEach(func() {
+		hookServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		err = notifier.Run(context.TODO())
+	})
+
+	Describe("enqueueing deliveries", func() {
+		BeforeEach(func() {
+			fakeRepository.UnnotifiedStatusEventsReturnsOnCall(0, []atc.StatusEvent{
+				event,
+				{ID: 43, Type: atc.StatusEventJob, TeamName: "some-team", JobName: "some-job"},
+			}, nil)
+		})
+
+		It("queues a delivery for each matching notification and marks the events as considered", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeRepository.EnqueueDeliveriesCallCount()).To(Equal(1))
+
+			deliveries, eventIDs := fakeRepository.EnqueueDeliveriesArgsForCall(0)
+			Expect(eventIDs).To(Equal([]int{42, 43}))
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].TeamID).To(Equal(1))
+			Expect(deliveries[0].Notification).To(Equal("some-hook"))
+			Expect(deliveries[0].StatusEvent).To(Equal(event))
+			Expect(deliveries[0].Payload).To(MatchJSON(`{
+				"id": 42,
+				"type": "build",
+				"time": 0,
+				"team_name": "some-team",
+				"pipeline_name": "some-pipeline",
+				"pipeline_instance_vars": {"branch": "main"},
+				"job_name": "some-job",
+				"build_id": 7,
+				"build_name": "3",
+				"status": "failed",
+				"notification": "some-hook",
+				"url": "https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/3?vars.branch=%22main%22"
+			}`))
+		})
+
+		Context("when the notification has a template", func() {
+			BeforeEach(func() {
+				configs[0].Template = `{"text": {{json .JobName}}}`
+			})
+
+			It("renders the payload with it", func() {
+				deliveries, _ := fakeRepository.EnqueueDeliveriesArgsForCall(0)
+				Expect(deliveries[0].Payload).To(MatchJSON(`{"text": "some-job"}`))
+			})
+		})
+
+		Context("when no notification matches", func() {
+			BeforeEach(func() {
+				configs[0].Statuses = []atc.BuildStatus{atc.StatusSucceeded}
+			})
+
+			It("still marks the events as considered", func() {
+				deliveries, eventIDs := fakeRepository.EnqueueDeliveriesArgsForCall(0)
+				Expect(deliveries).To(BeEmpty())
+				Expect(eventIDs).To(Equal([]int{42, 43}))
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			BeforeEach(func() {
+				fakeRepository.UnnotifiedStatusEventsReturnsOnCall(0, nil, errors.New("nope"))
+			})
+
+			It("errors", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(fakeRepository.EnqueueDeliveriesCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("sending deliveries", func() {
+		var delivery db.NotificationDelivery
+
+		BeforeEach(func() {
+			delivery = db.NotificationDelivery{
+				ID:           5,
+				TeamID:       1,
+				Notification: "some-hook",
+				StatusEvent:  event,
+				Payload:      []byte(`{"some":"payload"}`),
+				State:        atc.NotificationDeliveryPending,
+			}
+
+			fakeRepository.PendingDeliveriesReturns([]db.NotificationDelivery{delivery}, nil)
+		})
+
+		Context("when the hook accepts it", func() {
+			BeforeEach(func() {
+				hookServer.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/hook"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+					ghttp.VerifyHeaderKV(notifications.DeliveryHeader, "5"),
+					ghttp.VerifyHeaderKV(notifications.SignatureHeader, webhooks.Sign("shh", []byte(`{"some":"payload"}`))),
+					ghttp.VerifyBody([]byte(`{"some":"payload"}`)),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				))
+			})
+
+			It("records that it succeeded", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hookServer.ReceivedRequests()).To(HaveLen(1))
+
+				Expect(fakeRepository.SaveDeliveryAttemptCallCount()).To(Equal(1))
+				saved := fakeRepository.SaveDeliveryAttemptArgsForCall(0)
+				Expect(saved.State).To(Equal(atc.NotificationDeliverySucceeded))
+				Expect(saved.Attempts).To(Equal(1))
+				Expect(saved.ResponseStatus).To(Equal(http.StatusNoContent))
+				Expect(saved.Error).To(BeEmpty())
+			})
+		})
+
+		Context("when the hook fails", func() {
+			BeforeEach(func() {
+				hookServer.AppendHandlers(ghttp.RespondWith(http.StatusBadGateway, ""))
+			})
+
+			It("retries later, backing off", func() {
+				saved := fakeRepository.SaveDeliveryAttemptArgsForCall(0)
+				Expect(saved.State).To(Equal(atc.NotificationDeliveryPending))
+				Expect(saved.Attempts).To(Equal(1))
+				Expect(saved.ResponseStatus).To(Equal(http.StatusBadGateway))
+				Expect(saved.Error).To(Equal("unexpected response: 502 Bad Gateway"))
+				Expect(saved.NextAttemptAt).To(Equal(now.Add(notifications.RetryBackoff)))
+			})
+
+			Context("after a few attempts", func() {
+				BeforeEach(func() {
+					delivery.Attempts = 2
+					fakeRepository.PendingDeliveriesReturns([]db.NotificationDelivery{delivery}, nil)
+				})
+
+				It("waits longer", func() {
+					saved := fakeRepository.SaveDeliveryAttemptArgsForCall(0)
+					Expect(saved.NextAttemptAt).To(Equal(now.Add(4 * notifications.RetryBackoff)))
+				})
+			})
+
+			Context("on the last attempt", func() {
+				BeforeEach(func() {
+					delivery.Attempts = notifications.MaxAttempts - 1
+					fakeRepository.PendingDeliveriesReturns([]db.NotificationDelivery{delivery}, nil)
+				})
+
+				It("gives up", func() {
+					saved := fakeRepository.SaveDeliveryAttemptArgsForCall(0)
+					Expect(saved.State).To(Equal(atc.NotificationDeliveryFailed))
+					Expect(saved.Attempts).To(Equal(notifications.MaxAttempts))
+				})
+			})
+		})
+
+		Context("when the notification has been removed", func() {
+			BeforeEach(func() {
+				configs = nil
+			})
+
+			It("fails the delivery without sending it", func() {
+				Expect(hookServer.ReceivedRequests()).To(BeEmpty())
+
+				saved := fakeRepository.SaveDeliveryAttemptArgsForCall(0)
+				Expect(saved.State).To(Equal(atc.NotificationDeliveryFailed))
+				Expect(saved.Error).To(Equal("notification is no longer configured"))
+			})
+		})
+	})
+
+	It("removes old deliveries", func() {
+		Expect(fakeRepository.RemoveDeliveriesOlderThanCallCount()).To(Equal(1))
+		Expect(fakeRepository.RemoveDeliveriesOlderThanArgsForCall(0)).To(Equal(notifications.DeliveryRetention))
+	})
+})
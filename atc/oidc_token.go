@@ -0,0 +1,120 @@
+package atc
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultOIDCTokenEnv is the env var a task's OIDC token is set in unless
+// configured otherwise.
+const DefaultOIDCTokenEnv = "CONCOURSE_OIDC_TOKEN"
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// OIDCTokenConfig requests a short-lived OIDC token, signed by the ATC and
+// identifying the build, to be given to a task so that it can authenticate
+// with e.g. a cloud provider which trusts the ATC as an identity provider.
+type OIDCTokenConfig struct {
+	// Audience defaults to the ATC's issuer URL.
+	Audience []string `json:"audience,omitempty"`
+
+	// Env is the env var the token is set in.
+	Env string `json:"env,omitempty"`
+
+	// File is the path, relative to the task's working directory, the token
+	// is written to in addition to being set in Env.
+	File string `json:"file,omitempty"`
+}
+
+func (config OIDCTokenConfig) EnvName() string {
+	if config.Env == "" {
+		return DefaultOIDCTokenEnv
+	}
+
+	return config.Env
+}
+
+func (config OIDCTokenConfig) Validate() []string {
+	var errs []string
+
+	for _, audience := range config.Audience {
+		if audience == "" {
+			errs = append(errs, "audience must not be empty")
+			break
+		}
+	}
+
+	if config.Env != "" && !envNameRegexp.MatchString(config.Env) {
+		errs = append(errs, fmt.Sprintf("invalid env var name: %s", config.Env))
+	}
+
+	if config.File != "" {
+		cleaned := path.Clean(config.File)
+		if path.IsAbs(cleaned) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			errs = append(errs, fmt.Sprintf("file must be a path within the task's working directory: %s", config.File))
+		}
+	}
+
+	return errs
+}
+
+// WorkloadIdentity is the identity of a step, as described by the claims of
+// the OIDC tokens issued to it.
+type WorkloadIdentity struct {
+	TeamName     string
+	PipelineName string
+	InstanceVars InstanceVars
+	JobName      string
+	BuildID      int
+	BuildName    string
+	StepName     string
+}
+
+// Subject is the 'sub' claim of the identity's tokens. It identifies the
+// step independently of the build, so that it can be trusted across builds,
+// e.g. "team:main:pipeline:deploy:job:prod:step:terraform".
+func (identity WorkloadIdentity) Subject() string {
+	parts := []string{"team", identity.TeamName}
+
+	if identity.PipelineName != "" {
+		pipeline := identity.PipelineName
+		if len(identity.InstanceVars) != 0 {
+			pipeline = PipelineRef{Name: identity.PipelineName, InstanceVars: identity.InstanceVars}.String()
+		}
+
+		parts = append(parts, "pipeline", pipeline)
+	}
+
+	if identity.JobName != "" {
+		parts = append(parts, "job", identity.JobName)
+	}
+
+	return strings.Join(append(parts, "step", identity.StepName), ":")
+}
+
+// Claims are the claims specific to Concourse of the identity's tokens.
+func (identity WorkloadIdentity) Claims() map[string]interface{} {
+	claims := map[string]interface{}{
+		"team":       identity.TeamName,
+		"build_id":   strconv.Itoa(identity.BuildID),
+		"build_name": identity.BuildName,
+		"step":       identity.StepName,
+	}
+
+	if identity.PipelineName != "" {
+		claims["pipeline"] = identity.PipelineName
+	}
+
+	if len(identity.InstanceVars) != 0 {
+		claims["instance_vars"] = identity.InstanceVars
+	}
+
+	if identity.JobName != "" {
+		claims["job"] = identity.JobName
+	}
+
+	return claims
+}
@@ -17,6 +17,7 @@ type Pipeline struct {
 	InstanceVars  InstanceVars   `json:"instance_vars,omitempty"`
 	Paused        bool           `json:"paused"`
 	Public        bool           `json:"public"`
+	SharedWith    []string       `json:"shared_with_teams,omitempty"`
 	Archived      bool           `json:"archived"`
 	Groups        GroupConfigs   `json:"groups,omitempty"`
 	TeamName      string         `json:"team_name"`
@@ -37,6 +38,10 @@ type RenameRequest struct {
 	NewName string `json:"name"`
 }
 
+type PipelineShares struct {
+	Teams []string `json:"teams"`
+}
+
 type InstanceVars map[string]interface{}
 
 func (iv InstanceVars) String() string {
@@ -0,0 +1,16 @@
+package atc
+
+// PipelineConfigVersion is a config which was saved for a pipeline, along
+// with who saved it and what changed compared to the version before it.
+type PipelineConfigVersion struct {
+	Version   int    `json:"version"`
+	CreatedBy string `json:"created_by,omitempty"`
+	BuildID   int    `json:"build_id,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+
+	// Changes is nil for the first version of a pipeline.
+	Changes *ConfigChanges `json:"changes,omitempty"`
+
+	// Config is only included when fetching a single version.
+	Config *Config `json:"config,omitempty"`
+}
@@ -0,0 +1,497 @@
+package pipelinetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/builds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// StepRun records a step which ran during a test.
+type StepRun struct {
+	ID     string          `json:"id"`
+	Status atc.BuildStatus `json:"status"`
+	Inputs []string        `json:"inputs,omitempty"`
+	Params atc.Params      `json:"params,omitempty"`
+}
+
+// Result is the outcome of a test.
+type Result struct {
+	Name     string          `json:"name"`
+	Job      string          `json:"job"`
+	Status   atc.BuildStatus `json:"status"`
+	Steps    []StepRun       `json:"steps"`
+	Failures []string        `json:"failures,omitempty"`
+}
+
+func (result Result) Passed() bool {
+	return len(result.Failures) == 0
+}
+
+// Run simulates a build of the test's job and checks its expectations. An
+// error is returned if the job cannot be planned.
+func Run(config atc.Config, test Test) (Result, error) {
+	job, found := config.Jobs.Lookup(test.Job)
+	if !found {
+		return Result{}, fmt.Errorf("job '%s' not found", test.Job)
+	}
+
+	var resources db.SchedulerResources
+	for _, resource := range config.Resources {
+		resources = append(resources, db.SchedulerResource{
+			Name:                 resource.Name,
+			Type:                 resource.Type,
+			Source:               resource.Source,
+			ExposeBuildCreatedBy: resource.ExposeBuildCreatedBy,
+		})
+	}
+
+	var resourceTypes atc.VersionedResourceTypes
+	for _, resourceType := range config.ResourceTypes {
+		resourceTypes = append(resourceTypes, atc.VersionedResourceType{
+			ResourceType: resourceType,
+		})
+	}
+
+	var inputs []db.BuildInput
+	for _, input := range job.Inputs() {
+		version, found := test.Inputs[input.Name]
+		if !found {
+			version = atc.Version{}
+		}
+
+		inputs = append(inputs, db.BuildInput{
+			Name:    input.Name,
+			Version: version,
+		})
+	}
+
+	plan, err := builds.NewPlanner(atc.NewPlanFactory(0)).Create(
+		job.StepConfig(),
+		resources,
+		resourceTypes,
+		config.Prototypes,
+		inputs,
+	)
+	if err != nil {
+		return Result{}, err
+	}
+
+	sim := &simulation{
+		results:   test.Steps,
+		artifacts: map[string]bool{},
+	}
+
+	ok, err := sim.run(plan)
+
+	result := Result{
+		Name:   test.Name,
+		Job:    test.Job,
+		Status: atc.StatusSucceeded,
+		Steps:  sim.ran,
+	}
+
+	if err != nil {
+		result.Status = atc.StatusErrored
+	} else if !ok {
+		result.Status = atc.StatusFailed
+	}
+
+	result.Failures = test.Expect.check(result)
+
+	return result, nil
+}
+
+type stepError struct {
+	id string
+}
+
+func (err stepError) Error() string {
+	return fmt.Sprintf("%s errored", err.id)
+}
+
+type simulation struct {
+	results   map[string]StepResult
+	artifacts map[string]bool
+	ran       []StepRun
+}
+
+func (sim *simulation) run(plan atc.Plan) (bool, error) {
+	switch {
+	case plan.Get != nil:
+		// the implicit get following a put is part of the put
+		if plan.Get.VersionFrom != nil {
+			return true, nil
+		}
+
+		ok, err := sim.step(StepRun{
+			ID:     StepID("get", plan.Get.Name),
+			Params: plan.Get.Params,
+		})
+		if ok {
+			sim.artifacts[plan.Get.Name] = true
+		}
+
+		return ok, err
+
+	case plan.Put != nil:
+		var inputs []string
+		if plan.Put.Inputs != nil && plan.Put.Inputs.Specified != nil {
+			inputs = plan.Put.Inputs.Specified
+		} else {
+			inputs = sim.artifactNames()
+		}
+
+		ok, err := sim.step(StepRun{
+			ID:     StepID("put", plan.Put.Name),
+			Inputs: inputs,
+			Params: plan.Put.Params,
+		})
+		if ok {
+			sim.artifacts[plan.Put.Name] = true
+		}
+
+		return ok, err
+
+	case plan.Task != nil:
+		return sim.task(plan.Task)
+
+	case plan.Run != nil:
+		return sim.step(StepRun{
+			ID:     StepID("run", plan.Run.Message),
+			Params: plan.Run.Object,
+		})
+
+	case plan.SetPipeline != nil:
+		return sim.step(StepRun{
+			ID:     StepID("set_pipeline", plan.SetPipeline.Name),
+			Params: plan.SetPipeline.Vars,
+		})
+
+	case plan.LoadVar != nil:
+		return sim.step(StepRun{ID: StepID("load_var", plan.LoadVar.Name)})
+
+	case plan.PipelineOutput != nil:
+		return sim.step(StepRun{ID: StepID("pipeline_output", plan.PipelineOutput.Name)})
+
+	case plan.Do != nil:
+		for _, p := range *plan.Do {
+			ok, err := sim.run(p)
+			if err != nil || !ok {
+				return ok, err
+			}
+		}
+
+		return true, nil
+
+	case plan.InParallel != nil:
+		return sim.all(plan.InParallel.Steps, plan.InParallel.FailFast)
+
+	case plan.Across != nil:
+		var steps []atc.Plan
+		for _, scoped := range plan.Across.Steps {
+			steps = append(steps, scoped.Step)
+		}
+
+		return sim.all(steps, plan.Across.FailFast)
+
+	case plan.OnSuccess != nil:
+		ok, err := sim.run(plan.OnSuccess.Step)
+		if err != nil || !ok {
+			return ok, err
+		}
+
+		return sim.run(plan.OnSuccess.Next)
+
+	case plan.OnFailure != nil:
+		ok, err := sim.run(plan.OnFailure.Step)
+		if err != nil || ok {
+			return ok, err
+		}
+
+		_, err = sim.run(plan.OnFailure.Next)
+		return false, err
+
+	case plan.OnError != nil:
+		ok, err := sim.run(plan.OnError.Step)
+		if err == nil {
+			return ok, nil
+		}
+
+		_, hookErr := sim.run(plan.OnError.Next)
+		if hookErr != nil {
+			return false, hookErr
+		}
+
+		return false, err
+
+	case plan.OnAbort != nil:
+		// builds are never aborted while testing
+		return sim.run(plan.OnAbort.Step)
+
+	case plan.Ensure != nil:
+		ok, err := sim.run(plan.Ensure.Step)
+
+		hookOk, hookErr := sim.run(plan.Ensure.Next)
+		if err != nil {
+			return false, err
+		}
+
+		if hookErr != nil {
+			return false, hookErr
+		}
+
+		return ok && hookOk, nil
+
+	case plan.Try != nil:
+		_, _ = sim.run(plan.Try.Step)
+		return true, nil
+
+	case plan.Timeout != nil:
+		return sim.run(plan.Timeout.Step)
+
+	case plan.Retry != nil:
+		var ok bool
+		var err error
+		for _, attempt := range *plan.Retry {
+			ok, err = sim.run(attempt)
+			if ok {
+				break
+			}
+		}
+
+		return ok, err
+
+	default:
+		return false, fmt.Errorf("unsupported plan %s", plan.ID)
+	}
+}
+
+// all runs the steps one after another so that reports are deterministic.
+func (sim *simulation) all(steps []atc.Plan, failFast bool) (bool, error) {
+	succeeded := true
+	var firstErr error
+
+	for _, p := range steps {
+		ok, err := sim.run(p)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+
+		if !ok {
+			succeeded = false
+			if failFast {
+				break
+			}
+		}
+	}
+
+	if firstErr != nil {
+		return false, firstErr
+	}
+
+	return succeeded, nil
+}
+
+func (sim *simulation) task(plan *atc.TaskPlan) (bool, error) {
+	id := StepID("task", plan.Name)
+
+	params := atc.Params{}
+
+	var inputs []string
+	var outputs []string
+
+	if plan.Config != nil {
+		for k, v := range plan.Config.Params {
+			params[k] = v
+		}
+
+		for _, input := range plan.Config.Inputs {
+			name := input.Name
+			if mapped, found := plan.InputMapping[name]; found {
+				name = mapped
+			}
+
+			if !sim.artifacts[name] {
+				if input.Optional {
+					continue
+				}
+
+				sim.ran = append(sim.ran, StepRun{ID: id, Status: atc.StatusErrored})
+				return false, fmt.Errorf("%s: missing inputs: %s", id, name)
+			}
+
+			inputs = append(inputs, name)
+		}
+
+		for _, output := range plan.Config.Outputs {
+			outputs = append(outputs, output.Name)
+		}
+	}
+
+	for k, v := range plan.Params {
+		params[k] = v
+	}
+
+	if len(params) == 0 {
+		params = nil
+	}
+
+	outputs = append(outputs, sim.results[id].Outputs...)
+
+	ok, err := sim.step(StepRun{ID: id, Inputs: inputs, Params: params})
+	if ok {
+		for _, output := range outputs {
+			name := output
+			if mapped, found := plan.OutputMapping[name]; found {
+				name = mapped
+			}
+
+			sim.artifacts[name] = true
+		}
+	}
+
+	return ok, err
+}
+
+func (sim *simulation) step(run StepRun) (bool, error) {
+	run.Status = sim.results[run.ID].Status
+	if run.Status == "" {
+		run.Status = atc.StatusSucceeded
+	}
+
+	sim.ran = append(sim.ran, run)
+
+	switch run.Status {
+	case atc.StatusErrored:
+		return false, stepError{run.ID}
+	case atc.StatusFailed:
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+func (sim *simulation) artifactNames() []string {
+	names := []string{}
+	for name := range sim.artifacts {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (expect Expectation) check(result Result) []string {
+	var failures []string
+
+	if expect.Status != "" && expect.Status != result.Status {
+		failures = append(failures, fmt.Sprintf("expected status %s, got %s", expect.Status, result.Status))
+	}
+
+	runs := map[string][]StepRun{}
+	var order []string
+	for _, run := range result.Steps {
+		runs[run.ID] = append(runs[run.ID], run)
+		order = append(order, run.ID)
+	}
+
+	// ran steps must appear in order, but other steps may run in between
+	next := 0
+	for _, id := range expect.Ran {
+		found := false
+		for i := next; i < len(order); i++ {
+			if order[i] == id {
+				found = true
+				next = i + 1
+				break
+			}
+		}
+
+		if found {
+			continue
+		}
+
+		if len(runs[id]) > 0 {
+			failures = append(failures, fmt.Sprintf("expected %s to run later", id))
+		} else {
+			failures = append(failures, fmt.Sprintf("expected %s to run", id))
+		}
+	}
+
+	for _, id := range expect.NotRan {
+		if len(runs[id]) > 0 {
+			failures = append(failures, fmt.Sprintf("expected %s not to run", id))
+		}
+	}
+
+	for _, id := range sortedKeys(expect.Params) {
+		matched := false
+		for _, run := range runs[id] {
+			if paramsContain(run.Params, expect.Params[id]) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			failures = append(failures, fmt.Sprintf("expected %s to run with params %s", id, toJSON(expect.Params[id])))
+		}
+	}
+
+	for _, id := range sortedKeys(expect.Inputs) {
+		expected := append([]string{}, expect.Inputs[id]...)
+		sort.Strings(expected)
+
+		matched := false
+		for _, run := range runs[id] {
+			actual := append([]string{}, run.Inputs...)
+			sort.Strings(actual)
+
+			if len(actual) == len(expected) && (len(actual) == 0 || reflect.DeepEqual(actual, expected)) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			failures = append(failures, fmt.Sprintf("expected %s to run with inputs %s", id, toJSON(expect.Inputs[id])))
+		}
+	}
+
+	return failures
+}
+
+// paramsContain compares params by their JSON form, so that values parsed
+// from YAML match regardless of their Go types.
+func paramsContain(actual atc.Params, expected atc.Params) bool {
+	for k, v := range expected {
+		a, found := actual[k]
+		if !found || toJSON(a) != toJSON(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toJSON(v interface{}) string {
+	payload, _ := json.Marshal(v)
+	return string(payload)
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,194 @@
+package pipelinetest_test
+
+import (
+	"testing"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/pipelinetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
+)
+
+const pipelineYAML = `
+resources:
+- name: repo
+  type: git
+  source: {uri: https://example.com/repo.git}
+- name: slack
+  type: slack-notification
+  source: {url: https://hooks.example.com}
+- name: release
+  type: s3
+  source: {bucket: releases}
+
+jobs:
+- name: unit
+  plan:
+  - get: repo
+  - task: test
+    config:
+      platform: linux
+      inputs: [{name: repo}]
+      outputs: [{name: built}]
+      params: {MODE: unit}
+      run: {path: make}
+    params: {VERBOSE: "1"}
+  - put: release
+    inputs: [built]
+    params: {file: built/release.tgz}
+  on_failure:
+    put: slack
+    params: {text: unit failed}
+  ensure:
+    task: cleanup
+    file: repo/ci/cleanup.yml
+`
+
+type RunnerSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	config atc.Config
+}
+
+func TestRunner(t *testing.T) {
+	suite.Run(t, &RunnerSuite{
+		Assertions: require.New(t),
+	})
+}
+
+func (s *RunnerSuite) SetupTest() {
+	s.config = atc.Config{}
+	s.NoError(yaml.Unmarshal([]byte(pipelineYAML), &s.config))
+}
+
+func (s *RunnerSuite) load(payload string) pipelinetest.Test {
+	testSuite, err := pipelinetest.LoadSuite([]byte(payload))
+	s.NoError(err)
+	s.Len(testSuite.Tests, 1)
+	return testSuite.Tests[0]
+}
+
+func (s *RunnerSuite) TestSucceeds() {
+	result, err := pipelinetest.Run(s.config, s.load(`
+tests:
+- name: happy path
+  job: unit
+  inputs:
+    repo: {ref: abc}
+  expect:
+    status: succeeded
+    ran: [get:repo, task:test, put:release, task:cleanup]
+    not_ran: [put:slack]
+    params:
+      task:test: {MODE: unit, VERBOSE: "1"}
+      put:release: {file: built/release.tgz}
+    inputs:
+      task:test: [repo]
+      put:release: [built]
+`))
+	s.NoError(err)
+	s.Empty(result.Failures)
+	s.True(result.Passed())
+	s.Equal(atc.StatusSucceeded, result.Status)
+
+	s.Equal([]pipelinetest.StepRun{
+		{ID: "get:repo", Status: atc.StatusSucceeded},
+		{
+			ID:     "task:test",
+			Status: atc.StatusSucceeded,
+			Inputs: []string{"repo"},
+			Params: atc.Params{"MODE": "unit", "VERBOSE": "1"},
+		},
+		{
+			ID:     "put:release",
+			Status: atc.StatusSucceeded,
+			Inputs: []string{"built"},
+			Params: atc.Params{"file": "built/release.tgz"},
+		},
+		{ID: "task:cleanup", Status: atc.StatusSucceeded},
+	}, result.Steps)
+}
+
+func (s *RunnerSuite) TestFailureRunsHook() {
+	result, err := pipelinetest.Run(s.config, s.load(`
+tests:
+- name: notifies on failure
+  job: unit
+  steps:
+    task:test: {status: failed}
+  expect:
+    status: failed
+    ran: [task:test, put:slack, task:cleanup]
+    not_ran: [put:release]
+    params:
+      put:slack: {text: unit failed}
+`))
+	s.NoError(err)
+	s.Empty(result.Failures)
+	s.Equal(atc.StatusFailed, result.Status)
+}
+
+func (s *RunnerSuite) TestErrorSkipsFailureHook() {
+	result, err := pipelinetest.Run(s.config, s.load(`
+tests:
+- name: errors
+  job: unit
+  steps:
+    get:repo: {status: errored}
+  expect:
+    status: errored
+    ran: [get:repo, task:cleanup]
+    not_ran: [put:slack, task:test]
+`))
+	s.NoError(err)
+	s.Empty(result.Failures)
+}
+
+func (s *RunnerSuite) TestReportsUnmetExpectations() {
+	result, err := pipelinetest.Run(s.config, s.load(`
+tests:
+- name: wrong
+  job: unit
+  expect:
+    status: failed
+    ran: [put:release, task:test, put:slack]
+    not_ran: [get:repo]
+    params:
+      put:release: {file: other.tgz}
+    inputs:
+      task:test: [repo, other]
+`))
+	s.NoError(err)
+	s.False(result.Passed())
+	s.Equal([]string{
+		"expected status failed, got succeeded",
+		"expected task:test to run later",
+		"expected put:slack to run",
+		"expected get:repo not to run",
+		`expected put:release to run with params {"file":"other.tgz"}`,
+		`expected task:test to run with inputs ["repo","other"]`,
+	}, result.Failures)
+}
+
+func (s *RunnerSuite) TestUnknownJob() {
+	_, err := pipelinetest.Run(s.config, pipelinetest.Test{Job: "bogus"})
+	s.EqualError(err, "job 'bogus' not found")
+}
+
+func (s *RunnerSuite) TestInvalidSuite() {
+	_, err := pipelinetest.LoadSuite([]byte(`
+tests:
+- name: no job
+`))
+	s.EqualError(err, "test 'no job' has no job")
+
+	_, err = pipelinetest.LoadSuite([]byte(`
+tests:
+- job: unit
+  steps:
+    task:test: {status: pending}
+`))
+	s.EqualError(err, "test '#1': step task:test has invalid status 'pending'")
+}
@@ -0,0 +1,99 @@
+// Package pipelinetest checks the behaviour of a pipeline's jobs without
+// running any builds.
+//
+// A job is planned exactly like the scheduler would plan it, and the plan is
+// then simulated against fake resources and tasks whose results are declared
+// by each test. The steps which ran, along with their inputs and params, are
+// checked against the test's expectations.
+package pipelinetest
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"sigs.k8s.io/yaml"
+)
+
+// Suite is a set of tests for the jobs of one pipeline.
+type Suite struct {
+	Tests []Test `json:"tests"`
+}
+
+// Test simulates one build of a job.
+type Test struct {
+	Name string `json:"name"`
+	Job  string `json:"job"`
+
+	// Inputs are the versions of the job's get steps. Get steps without a
+	// version fetch an empty version.
+	Inputs map[string]atc.Version `json:"inputs,omitempty"`
+
+	// Steps declare the results of the job's steps, keyed by step ID (e.g.
+	// "task:unit" or "put:slack"). Steps which are not declared succeed.
+	Steps map[string]StepResult `json:"steps,omitempty"`
+
+	Expect Expectation `json:"expect"`
+}
+
+// StepResult is the fake result of a step.
+type StepResult struct {
+	// Status is one of succeeded (the default), failed or errored.
+	Status atc.BuildStatus `json:"status,omitempty"`
+
+	// Outputs are the artifacts produced by a task, for tasks whose config
+	// is loaded from a file and is therefore unknown when testing.
+	Outputs []string `json:"outputs,omitempty"`
+}
+
+// Expectation is checked against the result of a test.
+type Expectation struct {
+	// Status is the expected status of the build.
+	Status atc.BuildStatus `json:"status,omitempty"`
+
+	// Ran lists step IDs which are expected to have run, in order.
+	Ran []string `json:"ran,omitempty"`
+
+	// NotRan lists step IDs which are expected not to have run.
+	NotRan []string `json:"not_ran,omitempty"`
+
+	// Params are expected to be a subset of the params a step ran with.
+	Params map[string]atc.Params `json:"params,omitempty"`
+
+	// Inputs are the artifacts a task is expected to have run with.
+	Inputs map[string][]string `json:"inputs,omitempty"`
+}
+
+// LoadSuite parses a test suite from YAML.
+func LoadSuite(payload []byte) (Suite, error) {
+	var suite Suite
+	err := yaml.UnmarshalStrict(payload, &suite)
+	if err != nil {
+		return Suite{}, fmt.Errorf("malformed test suite: %w", err)
+	}
+
+	for i, test := range suite.Tests {
+		name := test.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+
+		if test.Job == "" {
+			return Suite{}, fmt.Errorf("test '%s' has no job", name)
+		}
+
+		for id, result := range test.Steps {
+			switch result.Status {
+			case "", atc.StatusSucceeded, atc.StatusFailed, atc.StatusErrored:
+			default:
+				return Suite{}, fmt.Errorf("test '%s': step %s has invalid status '%s'", name, id, result.Status)
+			}
+		}
+	}
+
+	return suite, nil
+}
+
+// StepID identifies a step by its type and name, e.g. "put:slack".
+func StepID(stepType string, name string) string {
+	return stepType + ":" + name
+}
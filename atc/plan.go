@@ -12,6 +12,10 @@ type Plan struct {
 	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
 	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
 
+	PipelineOutput *PipelineOutputPlan `json:"pipeline_output,omitempty"`
+
+	SyncPipelines *SyncPipelinesPlan `json:"sync_pipelines,omitempty"`
+
 	Do         *DoPlan         `json:"do,omitempty"`
 	InParallel *InParallelPlan `json:"in_parallel,omitempty"`
 	Across     *AcrossPlan     `json:"across,omitempty"`
@@ -198,6 +202,10 @@ type GetPlan struct {
 	// A timeout to enforce on the resource `get` process. Note that fetching the
 	// resource's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
+
+	// Whether the step may be aborted and re-run on another worker when its
+	// worker is drained.
+	Retryable bool `json:"retryable,omitempty"`
 }
 
 type PutPlan struct {
@@ -225,6 +233,10 @@ type PutPlan struct {
 	// resource's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
 
+	// Whether the step may be aborted and re-run on another worker when its
+	// worker is drained.
+	Retryable bool `json:"retryable,omitempty"`
+
 	// If or not expose BUILD_CREATED_BY to build metadata
 	ExposeBuildCreatedBy bool `json:"expose_build_created_by,omitempty"`
 }
@@ -303,6 +315,13 @@ type TaskPlan struct {
 	// image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
 
+	// Whether the step may be aborted and re-run on another worker when its
+	// worker is drained.
+	Retryable bool `json:"retryable,omitempty"`
+
+	// An OIDC token identifying the step to give to the task.
+	OIDCToken *OIDCTokenConfig `json:"oidc_token,omitempty"`
+
 	// Resource types to have available for use when fetching the task's image.
 	//
 	// XXX(check-refactor): Eliminating this would be great - if we can replace
@@ -349,6 +368,16 @@ type SetPipelinePlan struct {
 	InstanceVars map[string]interface{} `json:"instance_vars,omitempty"`
 }
 
+// SyncPipelinesPlan reconciles the pipelines of the build's team with the
+// GitOps manifest at File. It is only used by GitOps builds.
+type SyncPipelinesPlan struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+
+	// VersionFrom is the plan which fetched the repository's version.
+	VersionFrom *PlanID `json:"version_from,omitempty"`
+}
+
 type LoadVarPlan struct {
 	Name   string `json:"name"`
 	File   string `json:"file"`
@@ -356,6 +385,19 @@ type LoadVarPlan struct {
 	Reveal bool   `json:"reveal,omitempty"`
 }
 
+// PipelineOutputPlan exposes a successful build of the job as the local var
+// Name. The pipeline belongs to the build's team unless Team is set.
+//
+// BuildID is set to the build which triggered the job, if any; otherwise the
+// latest successful build is used.
+type PipelineOutputPlan struct {
+	Name     string `json:"name"`
+	Team     string `json:"team,omitempty"`
+	Pipeline string `json:"pipeline"`
+	Job      string `json:"job"`
+	BuildID  int    `json:"build_id,omitempty"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
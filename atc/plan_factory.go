@@ -41,8 +41,12 @@ func (factory PlanFactory) NewPlan(step PlanConfig) Plan {
 		plan.Run = &t
 	case SetPipelinePlan:
 		plan.SetPipeline = &t
+	case SyncPipelinesPlan:
+		plan.SyncPipelines = &t
 	case LoadVarPlan:
 		plan.LoadVar = &t
+	case PipelineOutputPlan:
+		plan.PipelineOutput = &t
 	case CheckPlan:
 		plan.Check = &t
 	case OnAbortPlan:
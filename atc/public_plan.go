@@ -16,6 +16,8 @@ func (plan Plan) Public() *json.RawMessage {
 		Run            *json.RawMessage `json:"run,omitempty"`
 		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
 		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		SyncPipelines  *json.RawMessage `json:"sync_pipelines,omitempty"`
+		PipelineOutput *json.RawMessage `json:"pipeline_output,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		OnError        *json.RawMessage `json:"on_error,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
@@ -71,6 +73,14 @@ func (plan Plan) Public() *json.RawMessage {
 		public.LoadVar = plan.LoadVar.Public()
 	}
 
+	if plan.SyncPipelines != nil {
+		public.SyncPipelines = plan.SyncPipelines.Public()
+	}
+
+	if plan.PipelineOutput != nil {
+		public.PipelineOutput = plan.PipelineOutput.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -303,6 +313,14 @@ func (plan SetPipelinePlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan SyncPipelinesPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
 func (plan LoadVarPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Name string `json:"name"`
@@ -311,6 +329,20 @@ func (plan LoadVarPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan PipelineOutputPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name     string `json:"name"`
+		Team     string `json:"team,omitempty"`
+		Pipeline string `json:"pipeline"`
+		Job      string `json:"job"`
+	}{
+		Name:     plan.Name,
+		Team:     plan.Team,
+		Pipeline: plan.Pipeline,
+		Job:      plan.Job,
+	})
+}
+
 func (plan TimeoutPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step     *json.RawMessage `json:"step"`
@@ -9,6 +9,8 @@ import (
 var _ = Describe("Plan", func() {
 	Describe("Public", func() {
 		It("returns a sanitized form of the plan", func() {
+			versionFrom := atc.PlanID("40")
+
 			plan := atc.Plan{
 				ID: "0",
 				InParallel: &atc.InParallelPlan{
@@ -397,6 +399,14 @@ var _ = Describe("Plan", func() {
 								FailFast: true,
 							},
 						},
+						{
+							ID: "41",
+							SyncPipelines: &atc.SyncPipelinesPlan{
+								Name:        "gitops",
+								File:        "gitops/pipelines.yml",
+								VersionFrom: &versionFrom,
+							},
+						},
 					},
 				},
 			}
@@ -706,6 +716,12 @@ var _ = Describe("Plan", func() {
           ],
           "fail_fast": true
         }
+      },
+      {
+        "id": "41",
+        "sync_pipelines": {
+          "name": "gitops"
+        }
       }
     ]
   }
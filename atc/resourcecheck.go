@@ -3,3 +3,13 @@ package atc
 type CheckRequestBody struct {
 	From Version `json:"from"`
 }
+
+// WebhookCheck describes a check of a resource triggered by a team-level
+// webhook.
+type WebhookCheck struct {
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	ResourceName         string       `json:"resource_name"`
+	BuildID              int          `json:"build_id,omitempty"`
+	Error                string       `json:"error,omitempty"`
+}
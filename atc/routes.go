@@ -6,6 +6,9 @@ const (
 	SaveConfig = "SaveConfig"
 	GetConfig  = "GetConfig"
 
+	ListPipelineConfigVersions = "ListPipelineConfigVersions"
+	GetPipelineConfigVersion   = "GetPipelineConfigVersion"
+
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
 	CreateBuild         = "CreateBuild"
@@ -38,6 +41,7 @@ const (
 	GetResource          = "GetResource"
 	CheckResource        = "CheckResource"
 	CheckResourceWebHook = "CheckResourceWebHook"
+	CheckTeamWebHook     = "CheckTeamWebHook"
 	CheckResourceType    = "CheckResourceType"
 	CheckPrototype       = "CheckPrototype"
 
@@ -66,6 +70,7 @@ const (
 	UnpausePipeline           = "UnpausePipeline"
 	ExposePipeline            = "ExposePipeline"
 	HidePipeline              = "HidePipeline"
+	SharePipeline             = "SharePipeline"
 	RenamePipeline            = "RenamePipeline"
 	ListPipelineBuilds        = "ListPipelineBuilds"
 	CreatePipelineBuild       = "CreatePipelineBuild"
@@ -73,11 +78,15 @@ const (
 
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
+	DrainWorker     = "DrainWorker"
 	RetireWorker    = "RetireWorker"
 	PruneWorker     = "PruneWorker"
 	HeartbeatWorker = "HeartbeatWorker"
 	ListWorkers     = "ListWorkers"
 	DeleteWorker    = "DeleteWorker"
+	ScaleDownWorker = "ScaleDownWorker"
+
+	GetWorkerCapacity = "GetWorkerCapacity"
 
 	SetLogLevel = "SetLogLevel"
 	GetLogLevel = "GetLogLevel"
@@ -86,6 +95,8 @@ const (
 	GetInfo      = "GetInfo"
 	GetInfoCreds = "GetInfoCreds"
 
+	StatusEvents = "StatusEvents"
+
 	ListContainers           = "ListContainers"
 	GetContainer             = "GetContainer"
 	HijackContainer          = "HijackContainer"
@@ -103,6 +114,19 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	GetTeamGitOps    = "GetTeamGitOps"
+	SetTeamGitOps    = "SetTeamGitOps"
+	DeleteTeamGitOps = "DeleteTeamGitOps"
+
+	GetTeamNotifications           = "GetTeamNotifications"
+	SetTeamNotifications           = "SetTeamNotifications"
+	ListTeamNotificationDeliveries = "ListTeamNotificationDeliveries"
+
+	GetTeamResources = "GetTeamResources"
+	SetTeamResources = "SetTeamResources"
+
+	ListTeamMembers = "ListTeamMembers"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
@@ -110,6 +134,19 @@ const (
 	GetUser              = "GetUser"
 	ListActiveUsersSince = "ListActiveUsersSince"
 
+	ListSessions       = "ListSessions"
+	RevokeSession      = "RevokeSession"
+	RevokeUserSessions = "RevokeUserSessions"
+
+	ListUserTokens  = "ListUserTokens"
+	CreateUserToken = "CreateUserToken"
+	RevokeUserToken = "RevokeUserToken"
+	ListTeamTokens  = "ListTeamTokens"
+	CreateTeamToken = "CreateTeamToken"
+	RevokeTeamToken = "RevokeTeamToken"
+
+	ListAuditEvents = "ListAuditEvents"
+
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
@@ -123,6 +160,8 @@ const (
 var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "PUT", Name: SaveConfig},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "GET", Name: GetConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", Method: "GET", Name: ListPipelineConfigVersions},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", Method: "GET", Name: GetPipelineConfigVersion},
 
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
 
@@ -162,6 +201,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/unpause", Method: "PUT", Name: UnpausePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/expose", Method: "PUT", Name: ExposePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/hide", Method: "PUT", Name: HidePipeline},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/shares", Method: "PUT", Name: SharePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/versions-db", Method: "GET", Name: GetVersionsDB},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/rename", Method: "PUT", Name: RenamePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
@@ -174,6 +214,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name", Method: "GET", Name: GetResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", Method: "POST", Name: CheckResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
+	{Path: "/api/v1/teams/:team_name/webhook", Method: "POST", Name: CheckTeamWebHook},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/prototypes/:prototype_name/check", Method: "POST", Name: CheckPrototype},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/cache", Method: "DELETE", Name: ClearResourceCache},
@@ -194,10 +235,13 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/workers", Method: "GET", Name: ListWorkers},
 	{Path: "/api/v1/workers", Method: "POST", Name: RegisterWorker},
 	{Path: "/api/v1/workers/:worker_name/land", Method: "PUT", Name: LandWorker},
+	{Path: "/api/v1/workers/:worker_name/drain", Method: "PUT", Name: DrainWorker},
 	{Path: "/api/v1/workers/:worker_name/retire", Method: "PUT", Name: RetireWorker},
 	{Path: "/api/v1/workers/:worker_name/prune", Method: "PUT", Name: PruneWorker},
 	{Path: "/api/v1/workers/:worker_name/heartbeat", Method: "PUT", Name: HeartbeatWorker},
 	{Path: "/api/v1/workers/:worker_name", Method: "DELETE", Name: DeleteWorker},
+	{Path: "/api/v1/workers/:worker_name/scale-down", Method: "PUT", Name: ScaleDownWorker},
+	{Path: "/api/v1/workers/capacity", Method: "GET", Name: GetWorkerCapacity},
 
 	{Path: "/api/v1/log-level", Method: "GET", Name: GetLogLevel},
 	{Path: "/api/v1/log-level", Method: "PUT", Name: SetLogLevel},
@@ -206,9 +250,24 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/info", Method: "GET", Name: GetInfo},
 	{Path: "/api/v1/info/creds", Method: "GET", Name: GetInfoCreds},
 
+	{Path: "/api/v1/status/events", Method: "GET", Name: StatusEvents},
+
 	{Path: "/api/v1/user", Method: "GET", Name: GetUser},
 	{Path: "/api/v1/users", Method: "GET", Name: ListActiveUsersSince},
 
+	{Path: "/api/v1/sessions", Method: "GET", Name: ListSessions},
+	{Path: "/api/v1/sessions", Method: "DELETE", Name: RevokeUserSessions},
+	{Path: "/api/v1/sessions/:session_id", Method: "DELETE", Name: RevokeSession},
+
+	{Path: "/api/v1/user/tokens", Method: "GET", Name: ListUserTokens},
+	{Path: "/api/v1/user/tokens", Method: "POST", Name: CreateUserToken},
+	{Path: "/api/v1/user/tokens/:token_id", Method: "DELETE", Name: RevokeUserToken},
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "GET", Name: ListTeamTokens},
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "POST", Name: CreateTeamToken},
+	{Path: "/api/v1/teams/:team_name/tokens/:token_id", Method: "DELETE", Name: RevokeTeamToken},
+
+	{Path: "/api/v1/audit-events", Method: "GET", Name: ListAuditEvents},
+
 	{Path: "/api/v1/containers/destroying", Method: "GET", Name: ListDestroyingContainers},
 	{Path: "/api/v1/containers/report", Method: "PUT", Name: ReportWorkerContainers},
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
@@ -225,6 +284,15 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/gitops", Method: "GET", Name: GetTeamGitOps},
+	{Path: "/api/v1/teams/:team_name/gitops", Method: "PUT", Name: SetTeamGitOps},
+	{Path: "/api/v1/teams/:team_name/gitops", Method: "DELETE", Name: DeleteTeamGitOps},
+	{Path: "/api/v1/teams/:team_name/notifications", Method: "GET", Name: GetTeamNotifications},
+	{Path: "/api/v1/teams/:team_name/notifications", Method: "PUT", Name: SetTeamNotifications},
+	{Path: "/api/v1/teams/:team_name/notifications/deliveries", Method: "GET", Name: ListTeamNotificationDeliveries},
+	{Path: "/api/v1/teams/:team_name/shared-resources", Method: "GET", Name: GetTeamResources},
+	{Path: "/api/v1/teams/:team_name/shared-resources", Method: "PUT", Name: SetTeamResources},
+	{Path: "/api/v1/teams/:team_name/members", Method: "GET", Name: ListTeamMembers},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
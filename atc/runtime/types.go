@@ -90,4 +90,13 @@ type ProcessSpec struct {
 	User         string
 	StdoutWriter io.Writer
 	StderrWriter io.Writer
+
+	// Env is given to the process only, so that it is not kept in the
+	// container's spec, e.g. for credentials which are only valid for the
+	// process's lifetime.
+	Env []string
+
+	// Files are written into the container, keyed by their absolute path,
+	// before the process is started.
+	Files map[string][]byte
 }
@@ -0,0 +1,94 @@
+package algorithm
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type passedPipelineResolver struct {
+	vdb         db.VersionsDB
+	inputConfig db.InputConfig
+}
+
+func NewPassedPipelineResolver(vdb db.VersionsDB, inputConfig db.InputConfig) Resolver {
+	return &passedPipelineResolver{
+		vdb:         vdb,
+		inputConfig: inputConfig,
+	}
+}
+
+func (r *passedPipelineResolver) InputConfigs() db.InputConfigs {
+	return db.InputConfigs{r.inputConfig}
+}
+
+// Handles a resource with a passed_pipeline constraint: the latest version
+// which went through a successful build of the job in the other pipeline, the
+// next of them with every, or the pinned version if it did.
+func (r *passedPipelineResolver) Resolve(ctx context.Context) (map[string]*versionCandidate, db.ResolutionFailure, error) {
+	ctx, span := tracing.StartSpan(ctx, "passedPipelineResolver.Resolve", tracing.Attrs{
+		"input":          r.inputConfig.Name,
+		"passedPipeline": r.inputConfig.PassedPipelineJob.String(),
+	})
+	defer span.End()
+
+	if r.inputConfig.PassedPipelineJobID == 0 {
+		span.AddEvent("passed pipeline job not found")
+		span.SetStatus(codes.Error, "passed pipeline job not found")
+		return nil, db.PassedPipelineJobNotFound{PassedPipelineJob: *r.inputConfig.PassedPipelineJob}.String(), nil
+	}
+
+	var pinned db.ResourceVersion
+	if r.inputConfig.PinnedVersion != nil {
+		var found bool
+		var err error
+		pinned, found, err = r.vdb.FindVersionOfResource(ctx, r.inputConfig.ResourceID, r.inputConfig.PinnedVersion)
+		if err != nil {
+			tracing.End(span, err)
+			return nil, "", err
+		}
+
+		if !found {
+			span.AddEvent("pinned version not found")
+			span.SetStatus(codes.Error, "pinned version not found")
+			return nil, db.PinnedVersionNotFound{PinnedVersion: r.inputConfig.PinnedVersion}.String(), nil
+		}
+	}
+
+	var version db.ResourceVersion
+	var hasNext, found bool
+	var err error
+	if r.inputConfig.UseEveryVersion && pinned == "" {
+		version, hasNext, found, err = r.vdb.NextEveryVersionPassedPipelineJob(ctx, r.inputConfig.JobID, r.inputConfig.ResourceID, r.inputConfig.PassedPipelineJobID)
+	} else {
+		version, found, err = r.vdb.LatestVersionPassedPipelineJob(ctx, r.inputConfig.ResourceID, r.inputConfig.PassedPipelineJobID, pinned)
+	}
+	if err != nil {
+		tracing.End(span, err)
+		return nil, "", err
+	}
+
+	if !found {
+		span.AddEvent("no version passed the pipeline job")
+		span.SetStatus(codes.Error, "no version passed the pipeline job")
+		return nil, db.NoVersionsPassedPipelineJob, nil
+	}
+
+	span.AddEvent("found via passed pipeline", trace.WithAttributes(
+		attribute.String("version", string(version)),
+	))
+
+	candidate := newCandidateVersion(version)
+	candidate.HasNextEveryVersion = hasNext
+
+	versionCandidates := map[string]*versionCandidate{
+		r.inputConfig.Name: candidate,
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return versionCandidates, "", nil
+}
@@ -211,6 +211,21 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
+	// builds triggered by pipeline outputs use the builds which triggered
+	// them; manually triggered builds use the latest ones
+	if !nextPendingBuild.IsManuallyTriggered() {
+		outputBuilds, err := job.PipelineOutputBuilds()
+		if err != nil {
+			return startResults{}, fmt.Errorf("get pipeline output builds: %w", err)
+		}
+
+		plan.Each(func(p *atc.Plan) {
+			if p.PipelineOutput != nil {
+				p.PipelineOutput.BuildID = outputBuilds[p.PipelineOutput.Name]
+			}
+		})
+	}
+
 	started, err := nextPendingBuild.Start(plan)
 	if err != nil {
 		logger.Error("failed-to-mark-build-as-started", err)
@@ -780,6 +780,40 @@ var _ = Describe("BuildStarter", func() {
 											Expect(rerunBuild.StartCallCount()).To(Equal(1))
 											Expect(rerunBuild.StartArgsForCall(0)).To(Equal(plannedPlan))
 										})
+
+										Context("when the job consumes pipeline outputs", func() {
+											BeforeEach(func() {
+												fakePlanner.CreateStub = func(atc.StepConfig, db.SchedulerResources, atc.VersionedResourceTypes, atc.Prototypes, []db.BuildInput) (atc.Plan, error) {
+													return atc.Plan{
+														InParallel: &atc.InParallelPlan{
+															Steps: []atc.Plan{
+																{PipelineOutput: &atc.PipelineOutputPlan{Name: "triggering-output"}},
+																{PipelineOutput: &atc.PipelineOutputPlan{Name: "other-output"}},
+															},
+														},
+													}, nil
+												}
+
+												job.PipelineOutputBuildsReturns(map[string]int{"triggering-output": 42}, nil)
+											})
+
+											It("starts the build with the builds which triggered the job", func() {
+												plan := pendingBuild1.StartArgsForCall(0)
+												Expect(plan.InParallel.Steps[0].PipelineOutput.BuildID).To(Equal(42))
+												Expect(plan.InParallel.Steps[1].PipelineOutput.BuildID).To(BeZero())
+											})
+
+											Context("when getting the builds fails", func() {
+												BeforeEach(func() {
+													job.PipelineOutputBuildsReturns(nil, disaster)
+												})
+
+												It("returns the error without starting the build", func() {
+													Expect(tryStartErr).To(HaveOccurred())
+													Expect(pendingBuild1.StartCallCount()).To(BeZero())
+												})
+											})
+										})
 									})
 								})
 							})
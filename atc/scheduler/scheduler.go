@@ -58,6 +58,11 @@ func (s *Scheduler) Schedule(
 		return false, err
 	}
 
+	err = s.ensurePendingBuildForPipelineOutputs(ctx, logger, job)
+	if err != nil {
+		return false, err
+	}
+
 	return s.BuildStarter.TryStartPendingBuildsForJob(logger, job, jobInputs)
 }
 
@@ -121,3 +126,34 @@ func (s *Scheduler) ensurePendingBuildExists(
 
 	return nil
 }
+
+// ensurePendingBuildForPipelineOutputs triggers the job for new successful
+// builds of the jobs it consumes through triggering pipeline outputs.
+func (s *Scheduler) ensurePendingBuildForPipelineOutputs(
+	ctx context.Context,
+	logger lager.Logger,
+	job db.SchedulerJob,
+) error {
+	triggers, err := job.PipelineOutputTriggers()
+	if err != nil {
+		return fmt.Errorf("get pipeline output triggers: %w", err)
+	}
+
+	if len(triggers) == 0 {
+		return nil
+	}
+
+	logger.Debug("triggered-by-pipeline-outputs", lager.Data{"triggers": triggers})
+
+	err = job.EnsurePendingBuildExists(ctx)
+	if err != nil {
+		return fmt.Errorf("ensure pending build exists: %w", err)
+	}
+
+	err = job.SavePipelineOutputTriggers(triggers)
+	if err != nil {
+		return fmt.Errorf("save pipeline output triggers: %w", err)
+	}
+
+	return nil
+}
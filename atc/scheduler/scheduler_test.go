@@ -466,6 +466,62 @@ var _ = Describe("Scheduler", func() {
 			})
 		})
 
+		Context("when the job consumes pipeline outputs", func() {
+			BeforeEach(func() {
+				fakeJob.NameReturns("some-job")
+				fakeJob.AlgorithmInputsReturns(nil, nil)
+				fakeAlgorithm.ComputeReturns(db.InputMapping{}, true, false, nil)
+				fakeJob.GetFullNextBuildInputsReturns([]db.BuildInput{}, true, nil)
+			})
+
+			Context("when a triggering pipeline output has a new build", func() {
+				BeforeEach(func() {
+					fakeJob.PipelineOutputTriggersReturns(map[string]int{"some-output": 42}, nil)
+				})
+
+				It("creates a pending build", func() {
+					Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(Equal(1))
+				})
+
+				It("records the build which triggered the job", func() {
+					Expect(fakeJob.SavePipelineOutputTriggersCallCount()).To(Equal(1))
+					Expect(fakeJob.SavePipelineOutputTriggersArgsForCall(0)).To(Equal(map[string]int{"some-output": 42}))
+				})
+
+				Context("when creating a pending build fails", func() {
+					BeforeEach(func() {
+						fakeJob.EnsurePendingBuildExistsReturns(disaster)
+					})
+
+					It("returns the error without recording the trigger", func() {
+						Expect(scheduleErr).To(Equal(fmt.Errorf("ensure pending build exists: %w", disaster)))
+						Expect(fakeJob.SavePipelineOutputTriggersCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when no pipeline output has a new build", func() {
+				BeforeEach(func() {
+					fakeJob.PipelineOutputTriggersReturns(map[string]int{}, nil)
+				})
+
+				It("does not create a pending build", func() {
+					Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(BeZero())
+					Expect(fakeJob.SavePipelineOutputTriggersCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the pipeline output triggers fails", func() {
+				BeforeEach(func() {
+					fakeJob.PipelineOutputTriggersReturns(nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(scheduleErr).To(Equal(fmt.Errorf("get pipeline output triggers: %w", disaster)))
+				})
+			})
+		})
+
 		Context("when the job inputs fail to fetch", func() {
 			BeforeEach(func() {
 				fakeJob.AlgorithmInputsReturns(nil, disaster)
@@ -0,0 +1,41 @@
+package scim
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+var eqFilter = regexp.MustCompile(`^\s*(\w+)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// parseFilter parses the 'filter' query param. Only equality on the name
+// attribute of the resource, given by nameAttribute, and on 'externalId' is
+// supported, which is what identity providers use to look up resources before
+// provisioning them.
+func parseFilter(raw string, nameAttribute string) (db.SCIMFilter, error) {
+	if raw == "" {
+		return db.SCIMFilter{}, nil
+	}
+
+	match := eqFilter.FindStringSubmatch(raw)
+	if match == nil {
+		return db.SCIMFilter{}, fmt.Errorf("unsupported filter: %s", raw)
+	}
+
+	value, err := strconv.Unquote(match[2])
+	if err != nil {
+		return db.SCIMFilter{}, fmt.Errorf("invalid filter value: %s", match[2])
+	}
+
+	switch {
+	case strings.EqualFold(match[1], nameAttribute):
+		return db.SCIMFilter{Name: value}, nil
+	case strings.EqualFold(match[1], "externalId"):
+		return db.SCIMFilter{ExternalID: value}, nil
+	default:
+		return db.SCIMFilter{}, fmt.Errorf("unsupported filter attribute: %s", match[1])
+	}
+}
@@ -0,0 +1,343 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-groups")
+
+	filter, err := parseFilter(r.URL.Query().Get("filter"), "displayName")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	groups, err := s.repository.SCIMGroups(filter)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	excludeMembers := strings.EqualFold(r.URL.Query().Get("excludedAttributes"), "members")
+
+	s.writeList(w, r, len(groups), func(start, end int) interface{} {
+		presented := []Group{}
+		for _, group := range groups[start:end] {
+			if excludeMembers {
+				group.Members = nil
+			}
+
+			presented = append(presented, s.presentGroup(group))
+		}
+
+		return presented
+	})
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-group")
+
+	var request Group
+	if !s.readJSON(w, r, &request) {
+		return
+	}
+
+	group, err := groupFromRequest(request)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	group, err = s.repository.CreateSCIMGroup(group)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	logger.Info("created", lager.Data{"id": group.ID, "display-name": group.DisplayName})
+
+	s.writeJSON(w, http.StatusCreated, s.presentGroup(group))
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request, id string) {
+	logger := s.logger.Session("get-group")
+
+	group, found, err := s.repository.FindSCIMGroup(id)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	if !found {
+		s.writeError(w, http.StatusNotFound, "", "group not found")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, s.presentGroup(group))
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request, id string) {
+	logger := s.logger.Session("replace-group")
+
+	var request Group
+	if !s.readJSON(w, r, &request) {
+		return
+	}
+
+	group, err := groupFromRequest(request)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	group.ID = id
+
+	s.updateGroup(w, logger, group)
+}
+
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request, id string) {
+	logger := s.logger.Session("patch-group")
+
+	var request PatchRequest
+	if !s.readJSON(w, r, &request) {
+		return
+	}
+
+	group, found, err := s.repository.FindSCIMGroup(id)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	if !found {
+		s.writeError(w, http.StatusNotFound, "", "group not found")
+		return
+	}
+
+	for _, op := range request.Operations {
+		err = applyGroupOperation(&group, op)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+			return
+		}
+	}
+
+	if group.DisplayName == "" {
+		s.writeError(w, http.StatusBadRequest, "invalidValue", "displayName must not be empty")
+		return
+	}
+
+	s.updateGroup(w, logger, group)
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, logger lager.Logger, group db.SCIMGroup) {
+	group, found, err := s.repository.UpdateSCIMGroup(group)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	if !found {
+		s.writeError(w, http.StatusNotFound, "", "group not found")
+		return
+	}
+
+	logger.Info("updated", lager.Data{"id": group.ID, "display-name": group.DisplayName, "members": len(group.Members)})
+
+	s.writeJSON(w, http.StatusOK, s.presentGroup(group))
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request, id string) {
+	logger := s.logger.Session("delete-group")
+
+	deleted, err := s.repository.DeleteSCIMGroup(id)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	if !deleted {
+		s.writeError(w, http.StatusNotFound, "", "group not found")
+		return
+	}
+
+	logger.Info("deleted", lager.Data{"id": id})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func groupFromRequest(request Group) (db.SCIMGroup, error) {
+	if request.DisplayName == "" {
+		return db.SCIMGroup{}, errors.New("displayName must not be empty")
+	}
+
+	group := db.SCIMGroup{
+		ExternalID:  request.ExternalID,
+		DisplayName: request.DisplayName,
+	}
+
+	for _, member := range request.Members {
+		group.Members = append(group.Members, db.SCIMMember{UserID: member.Value})
+	}
+
+	return group, nil
+}
+
+var memberFilterPath = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*\]$`)
+
+// applyGroupOperation applies a PATCH operation to the group. Members are
+// added and removed either by the 'members' path with a list of members as
+// the value, or removed by a path filtering them by value, e.g.
+// 'members[value eq "2819c223"]'.
+func applyGroupOperation(group *db.SCIMGroup, op PatchOperation) error {
+	if match := memberFilterPath.FindStringSubmatch(op.Path); match != nil {
+		if !strings.EqualFold(op.Op, "remove") {
+			return fmt.Errorf("unsupported operation on %s: %s", op.Path, op.Op)
+		}
+
+		id, err := strconv.Unquote(match[1])
+		if err != nil {
+			return fmt.Errorf("invalid path: %s", op.Path)
+		}
+
+		removeMembers(group, []string{id})
+		return nil
+	}
+
+	if op.Path == "" {
+		if strings.EqualFold(op.Op, "remove") {
+			return errors.New("remove operation must have a path")
+		}
+
+		attributes, ok := op.Value.(map[string]interface{})
+		if !ok {
+			return errors.New("operation without a path must have an object value")
+		}
+
+		for path, value := range attributes {
+			err := applyGroupOperation(group, PatchOperation{Op: op.Op, Path: path, Value: value})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	switch strings.ToLower(op.Path) {
+	case "id":
+		// sent along with the other attributes by some identity providers,
+		// but cannot be changed
+		return nil
+
+	case "displayname":
+		if strings.EqualFold(op.Op, "remove") {
+			return errors.New("displayName cannot be removed")
+		}
+
+		return setString(&group.DisplayName, op.Path, op.Value)
+
+	case "externalid":
+		if strings.EqualFold(op.Op, "remove") {
+			group.ExternalID = ""
+			return nil
+		}
+
+		return setString(&group.ExternalID, op.Path, op.Value)
+
+	case "members":
+		var ids []string
+		if op.Value != nil {
+			var err error
+			ids, err = parseMemberIDs(op.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		switch strings.ToLower(op.Op) {
+		case "add":
+			addMembers(group, ids)
+		case "remove":
+			if op.Value == nil {
+				group.Members = nil
+			} else {
+				removeMembers(group, ids)
+			}
+		case "replace":
+			group.Members = nil
+			addMembers(group, ids)
+		default:
+			return fmt.Errorf("unsupported operation: %s", op.Op)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported path: %s", op.Path)
+	}
+}
+
+func parseMemberIDs(value interface{}) ([]string, error) {
+	rawMembers, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("members must be a list")
+	}
+
+	var ids []string
+	for _, rawMember := range rawMembers {
+		attributes, ok := rawMember.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("members must be objects")
+		}
+
+		var id string
+		err := setString(&id, "members.value", attributes["value"])
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func addMembers(group *db.SCIMGroup, ids []string) {
+	existing := map[string]bool{}
+	for _, member := range group.Members {
+		existing[member.UserID] = true
+	}
+
+	for _, id := range ids {
+		if !existing[id] {
+			group.Members = append(group.Members, db.SCIMMember{UserID: id})
+			existing[id] = true
+		}
+	}
+}
+
+func removeMembers(group *db.SCIMGroup, ids []string) {
+	removed := map[string]bool{}
+	for _, id := range ids {
+		removed[id] = true
+	}
+
+	var members []db.SCIMMember
+	for _, member := range group.Members {
+		if !removed[member.UserID] {
+			members = append(members, member)
+		}
+	}
+
+	group.Members = members
+}
@@ -0,0 +1,234 @@
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Path is where the SCIM 2.0 endpoint is served, relative to the external
+// URL.
+const Path = "/scim/v2"
+
+const contentType = "application/scim+json"
+
+// Server implements the subset of SCIM 2.0 needed for identity providers to
+// provision users and groups: creating, reading, replacing, patching and
+// deleting them, and listing them with 'eq' filters.
+//
+// Requests are authenticated with a static bearer token. If no token is
+// configured the endpoint is disabled.
+type Server struct {
+	logger      lager.Logger
+	repository  db.SCIMRepository
+	token       string
+	externalURL string
+}
+
+func NewServer(logger lager.Logger, repository db.SCIMRepository, token string, externalURL string) *Server {
+	return &Server{
+		logger:      logger,
+		repository:  repository,
+		token:       token,
+		externalURL: strings.TrimRight(externalURL, "/"),
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.token == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	if !s.authenticated(r) {
+		s.writeError(w, http.StatusUnauthorized, "", "invalid bearer token")
+		return
+	}
+
+	resource := strings.TrimPrefix(r.URL.Path, Path+"/")
+	id := ""
+	if i := strings.Index(resource, "/"); i != -1 {
+		resource, id = resource[:i], resource[i+1:]
+	}
+
+	switch {
+	case resource == "Users" && id == "":
+		s.route(w, r, map[string]http.HandlerFunc{
+			"GET":  s.listUsers,
+			"POST": s.createUser,
+		})
+	case resource == "Users":
+		s.route(w, r, map[string]http.HandlerFunc{
+			"GET":    func(w http.ResponseWriter, r *http.Request) { s.getUser(w, r, id) },
+			"PUT":    func(w http.ResponseWriter, r *http.Request) { s.replaceUser(w, r, id) },
+			"PATCH":  func(w http.ResponseWriter, r *http.Request) { s.patchUser(w, r, id) },
+			"DELETE": func(w http.ResponseWriter, r *http.Request) { s.deleteUser(w, r, id) },
+		})
+	case resource == "Groups" && id == "":
+		s.route(w, r, map[string]http.HandlerFunc{
+			"GET":  s.listGroups,
+			"POST": s.createGroup,
+		})
+	case resource == "Groups":
+		s.route(w, r, map[string]http.HandlerFunc{
+			"GET":    func(w http.ResponseWriter, r *http.Request) { s.getGroup(w, r, id) },
+			"PUT":    func(w http.ResponseWriter, r *http.Request) { s.replaceGroup(w, r, id) },
+			"PATCH":  func(w http.ResponseWriter, r *http.Request) { s.patchGroup(w, r, id) },
+			"DELETE": func(w http.ResponseWriter, r *http.Request) { s.deleteGroup(w, r, id) },
+		})
+	case resource == "ServiceProviderConfig" && id == "":
+		s.route(w, r, map[string]http.HandlerFunc{
+			"GET": s.serviceProviderConfig,
+		})
+	default:
+		s.writeError(w, http.StatusNotFound, "", "unknown resource")
+	}
+}
+
+func (s *Server) authenticated(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return false
+	}
+
+	token := strings.TrimPrefix(header, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request, handlers map[string]http.HandlerFunc) {
+	handler, found := handlers[r.Method]
+	if !found {
+		s.writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+		return
+	}
+
+	handler(w, r)
+}
+
+func (s *Server) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	s.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{ServiceProviderConfigSchema},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxResults},
+		"changePassword": map[string]bool{"supported": false},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]string{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the token configured on the web node",
+		}},
+	})
+}
+
+const maxResults = 1000
+
+// paginate returns the page of the resources asked for by the 'startIndex'
+// and 'count' query params, which are 1-based as in SCIM.
+func paginate(r *http.Request, total int) (int, int, error) {
+	startIndex := 1
+	if raw := r.URL.Query().Get("startIndex"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return 0, 0, errors.New("invalid startIndex")
+		}
+
+		if parsed > 1 {
+			startIndex = parsed
+		}
+	}
+
+	count := maxResults
+	if raw := r.URL.Query().Get("count"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return 0, 0, errors.New("invalid count")
+		}
+
+		if parsed < 0 {
+			parsed = 0
+		}
+
+		if parsed < count {
+			count = parsed
+		}
+	}
+
+	start := startIndex - 1
+	if start > total {
+		start = total
+	}
+
+	end := start + count
+	if end > total {
+		end = total
+	}
+
+	return start, end, nil
+}
+
+func (s *Server) writeList(w http.ResponseWriter, r *http.Request, total int, page func(start, end int) interface{}) {
+	start, end, err := paginate(r, total)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: total,
+		StartIndex:   start + 1,
+		ItemsPerPage: end - start,
+		Resources:    page(start, end),
+	})
+}
+
+func (s *Server) readJSON(w http.ResponseWriter, r *http.Request, dest interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(dest)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalidSyntax", "malformed request body: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		s.logger.Error("failed-to-encode-response", err)
+	}
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, scimType string, detail string) {
+	s.writeJSON(w, status, Error{
+		Schemas:  []string{ErrorSchema},
+		Status:   strconv.Itoa(status),
+		SCIMType: scimType,
+		Detail:   detail,
+	})
+}
+
+// writeRepositoryError responds to an error from the repository, which is
+// the client's fault for conflicting names and unknown group members.
+func (s *Server) writeRepositoryError(w http.ResponseWriter, logger lager.Logger, err error) {
+	switch err {
+	case db.ErrSCIMConflict:
+		s.writeError(w, http.StatusConflict, "uniqueness", err.Error())
+	case db.ErrSCIMUnknownMember:
+		s.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+	default:
+		logger.Error("failed-to-access-repository", err)
+		s.writeError(w, http.StatusInternalServerError, "", "internal error")
+	}
+}
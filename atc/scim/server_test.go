@@ -0,0 +1,347 @@
+package scim_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/scim"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		fakeRepository *dbfakes.FakeSCIMRepository
+		token          string
+		now            time.Time
+
+		alice db.SCIMUser
+		group db.SCIMGroup
+	)
+
+	BeforeEach(func() {
+		fakeRepository = new(dbfakes.FakeSCIMRepository)
+		token = "some-token"
+		now = time.Date(2021, 6, 9, 12, 0, 0, 0, time.UTC)
+
+		alice = db.SCIMUser{
+			ID:          "alice-id",
+			ExternalID:  "00u1",
+			UserName:    "alice@example.com",
+			DisplayName: "Alice",
+			Email:       "alice@example.com",
+			Active:      true,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+
+		group = db.SCIMGroup{
+			ID:          "group-id",
+			DisplayName: "platform",
+			Members:     []db.SCIMMember{{UserID: "alice-id", UserName: "alice@example.com"}},
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+	})
+
+	request := func(method string, path string, body string) *httptest.ResponseRecorder {
+		server := scim.NewServer(lagertest.NewTestLogger("test"), fakeRepository, token, "https://ci.example.com/")
+
+		var reader io.Reader
+		if body != "" {
+			reader = strings.NewReader(body)
+		}
+
+		req := httptest.NewRequest(method, path, reader)
+		req.Header.Set("Authorization", "Bearer some-token")
+
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	Describe("authentication", func() {
+		It("is disabled without a token", func() {
+			token = ""
+			Expect(request("GET", "/scim/v2/Users", "").Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("rejects other tokens", func() {
+			token = "other-token"
+			response := request("GET", "/scim/v2/Users", "")
+			Expect(response.Code).To(Equal(http.StatusUnauthorized))
+			Expect(response.Body.String()).To(MatchJSON(`{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:Error"],
+				"status": "401",
+				"detail": "invalid bearer token"
+			}`))
+		})
+	})
+
+	Describe("Users", func() {
+		It("lists them with a filter", func() {
+			fakeRepository.SCIMUsersReturns([]db.SCIMUser{alice}, nil)
+
+			response := request("GET", `/scim/v2/Users?filter=userName+eq+"alice@example.com"`, "")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Header().Get("Content-Type")).To(Equal("application/scim+json"))
+			Expect(response.Body.String()).To(MatchJSON(`{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+				"totalResults": 1,
+				"startIndex": 1,
+				"itemsPerPage": 1,
+				"Resources": [{
+					"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+					"id": "alice-id",
+					"externalId": "00u1",
+					"userName": "alice@example.com",
+					"displayName": "Alice",
+					"emails": [{"value": "alice@example.com", "primary": true}],
+					"active": true,
+					"meta": {
+						"resourceType": "User",
+						"created": "2021-06-09T12:00:00Z",
+						"lastModified": "2021-06-09T12:00:00Z",
+						"location": "https://ci.example.com/scim/v2/Users/alice-id"
+					}
+				}]
+			}`))
+
+			Expect(fakeRepository.SCIMUsersArgsForCall(0)).To(Equal(db.SCIMFilter{Name: "alice@example.com"}))
+		})
+
+		It("paginates them", func() {
+			fakeRepository.SCIMUsersReturns([]db.SCIMUser{alice, alice, alice}, nil)
+
+			response := request("GET", "/scim/v2/Users?startIndex=2&count=1", "")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(ContainSubstring(`"totalResults":3,"startIndex":2,"itemsPerPage":1`))
+		})
+
+		It("rejects unsupported filters", func() {
+			response := request("GET", `/scim/v2/Users?filter=name.familyName+co+"a"`, "")
+			Expect(response.Code).To(Equal(http.StatusBadRequest))
+			Expect(response.Body.String()).To(ContainSubstring(`"scimType":"invalidFilter"`))
+		})
+
+		It("creates them", func() {
+			fakeRepository.CreateSCIMUserStub = func(user db.SCIMUser) (db.SCIMUser, error) {
+				user.ID = "alice-id"
+				return user, nil
+			}
+
+			response := request("POST", "/scim/v2/Users", `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+				"userName": "alice@example.com",
+				"externalId": "00u1",
+				"emails": [{"value": "alice@work.example.com"}, {"value": "alice@example.com", "primary": true}]
+			}`)
+			Expect(response.Code).To(Equal(http.StatusCreated))
+			Expect(fakeRepository.CreateSCIMUserArgsForCall(0)).To(Equal(db.SCIMUser{
+				ExternalID: "00u1",
+				UserName:   "alice@example.com",
+				Email:      "alice@example.com",
+				Active:     true,
+			}))
+		})
+
+		It("rejects users without a user name", func() {
+			response := request("POST", "/scim/v2/Users", `{"displayName": "Alice"}`)
+			Expect(response.Code).To(Equal(http.StatusBadRequest))
+			Expect(fakeRepository.CreateSCIMUserCallCount()).To(BeZero())
+		})
+
+		It("responds with a conflict for duplicate users", func() {
+			fakeRepository.CreateSCIMUserReturns(db.SCIMUser{}, db.ErrSCIMConflict)
+
+			response := request("POST", "/scim/v2/Users", `{"userName": "alice@example.com"}`)
+			Expect(response.Code).To(Equal(http.StatusConflict))
+			Expect(response.Body.String()).To(ContainSubstring(`"scimType":"uniqueness"`))
+		})
+
+		It("gets them", func() {
+			fakeRepository.FindSCIMUserReturns(alice, true, nil)
+
+			response := request("GET", "/scim/v2/Users/alice-id", "")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(fakeRepository.FindSCIMUserArgsForCall(0)).To(Equal("alice-id"))
+		})
+
+		It("responds with not found for unknown users", func() {
+			response := request("GET", "/scim/v2/Users/bogus", "")
+			Expect(response.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("replaces them", func() {
+			fakeRepository.UpdateSCIMUserStub = func(user db.SCIMUser) (db.SCIMUser, bool, error) {
+				return user, true, nil
+			}
+
+			response := request("PUT", "/scim/v2/Users/alice-id", `{"userName": "alice", "active": false}`)
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(fakeRepository.UpdateSCIMUserArgsForCall(0)).To(Equal(db.SCIMUser{
+				ID:       "alice-id",
+				UserName: "alice",
+				Active:   false,
+			}))
+		})
+
+		It("patches them", func() {
+			fakeRepository.FindSCIMUserReturns(alice, true, nil)
+			fakeRepository.UpdateSCIMUserStub = func(user db.SCIMUser) (db.SCIMUser, bool, error) {
+				return user, true, nil
+			}
+
+			response := request("PATCH", "/scim/v2/Users/alice-id", `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [
+					{"op": "Replace", "value": {"active": "False", "displayName": "Alice L."}},
+					{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "al@example.com"},
+					{"op": "add", "path": "name.givenName", "value": "Alice"}
+				]
+			}`)
+			Expect(response.Code).To(Equal(http.StatusOK))
+
+			updated := fakeRepository.UpdateSCIMUserArgsForCall(0)
+			Expect(updated.Active).To(BeFalse())
+			Expect(updated.DisplayName).To(Equal("Alice L."))
+			Expect(updated.Email).To(Equal("al@example.com"))
+			Expect(updated.UserName).To(Equal("alice@example.com"))
+		})
+
+		It("deletes them", func() {
+			fakeRepository.DeleteSCIMUserReturns(true, nil)
+
+			response := request("DELETE", "/scim/v2/Users/alice-id", "")
+			Expect(response.Code).To(Equal(http.StatusNoContent))
+			Expect(fakeRepository.DeleteSCIMUserArgsForCall(0)).To(Equal("alice-id"))
+		})
+
+		It("responds with an internal error when the repository fails", func() {
+			fakeRepository.SCIMUsersReturns(nil, errors.New("nope"))
+
+			response := request("GET", "/scim/v2/Users", "")
+			Expect(response.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("Groups", func() {
+		BeforeEach(func() {
+			fakeRepository.FindSCIMGroupReturns(group, true, nil)
+			fakeRepository.UpdateSCIMGroupStub = func(group db.SCIMGroup) (db.SCIMGroup, bool, error) {
+				return group, true, nil
+			}
+		})
+
+		It("creates them with their members", func() {
+			fakeRepository.CreateSCIMGroupReturns(group, nil)
+
+			response := request("POST", "/scim/v2/Groups", `{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"],
+				"displayName": "platform",
+				"members": [{"value": "alice-id"}]
+			}`)
+			Expect(response.Code).To(Equal(http.StatusCreated))
+			Expect(response.Body.String()).To(MatchJSON(`{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"],
+				"id": "group-id",
+				"displayName": "platform",
+				"members": [{"value": "alice-id", "display": "alice@example.com"}],
+				"meta": {
+					"resourceType": "Group",
+					"created": "2021-06-09T12:00:00Z",
+					"lastModified": "2021-06-09T12:00:00Z",
+					"location": "https://ci.example.com/scim/v2/Groups/group-id"
+				}
+			}`))
+
+			Expect(fakeRepository.CreateSCIMGroupArgsForCall(0)).To(Equal(db.SCIMGroup{
+				DisplayName: "platform",
+				Members:     []db.SCIMMember{{UserID: "alice-id"}},
+			}))
+		})
+
+		It("rejects unknown members", func() {
+			fakeRepository.CreateSCIMGroupReturns(db.SCIMGroup{}, db.ErrSCIMUnknownMember)
+
+			response := request("POST", "/scim/v2/Groups", `{"displayName": "platform", "members": [{"value": "bogus"}]}`)
+			Expect(response.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("lists them with a filter", func() {
+			fakeRepository.SCIMGroupsReturns([]db.SCIMGroup{group}, nil)
+
+			response := request("GET", `/scim/v2/Groups?filter=displayName+eq+"platform"&excludedAttributes=members`, "")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(ContainSubstring(`"members":[]`))
+			Expect(fakeRepository.SCIMGroupsArgsForCall(0)).To(Equal(db.SCIMFilter{Name: "platform"}))
+		})
+
+		It("adds and removes members by patching them", func() {
+			response := request("PATCH", "/scim/v2/Groups/group-id", `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations": [
+					{"op": "add", "path": "members", "value": [{"value": "bob-id"}, {"value": "carol-id"}]},
+					{"op": "remove", "path": "members[value eq \"alice-id\"]"},
+					{"op": "Remove", "path": "members", "value": [{"value": "carol-id"}]}
+				]
+			}`)
+			Expect(response.Code).To(Equal(http.StatusOK))
+
+			updated := fakeRepository.UpdateSCIMGroupArgsForCall(0)
+			Expect(updated.Members).To(Equal([]db.SCIMMember{{UserID: "bob-id"}}))
+		})
+
+		It("renames them by patching them", func() {
+			response := request("PATCH", "/scim/v2/Groups/group-id", `{
+				"Operations": [{"op": "replace", "value": {"id": "group-id", "displayName": "infra"}}]
+			}`)
+			Expect(response.Code).To(Equal(http.StatusOK))
+
+			updated := fakeRepository.UpdateSCIMGroupArgsForCall(0)
+			Expect(updated.DisplayName).To(Equal("infra"))
+			Expect(updated.Members).To(Equal(group.Members))
+		})
+
+		It("replaces them", func() {
+			response := request("PUT", "/scim/v2/Groups/group-id", `{"displayName": "platform", "members": []}`)
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(fakeRepository.UpdateSCIMGroupArgsForCall(0)).To(Equal(db.SCIMGroup{
+				ID:          "group-id",
+				DisplayName: "platform",
+			}))
+		})
+
+		It("rejects patches of unknown attributes", func() {
+			response := request("PATCH", "/scim/v2/Groups/group-id", `{
+				"Operations": [{"op": "replace", "path": "owner", "value": "alice"}]
+			}`)
+			Expect(response.Code).To(Equal(http.StatusBadRequest))
+			Expect(fakeRepository.UpdateSCIMGroupCallCount()).To(BeZero())
+		})
+
+		It("responds with not found when deleting unknown groups", func() {
+			fakeRepository.DeleteSCIMGroupReturns(false, nil)
+
+			response := request("DELETE", "/scim/v2/Groups/group-id", "")
+			Expect(response.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	It("serves the service provider config", func() {
+		response := request("GET", "/scim/v2/ServiceProviderConfig", "")
+		Expect(response.Code).To(Equal(http.StatusOK))
+		Expect(response.Body.String()).To(ContainSubstring(`"patch":{"supported":true}`))
+	})
+
+	It("rejects unsupported methods", func() {
+		Expect(request("DELETE", "/scim/v2/Users", "").Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
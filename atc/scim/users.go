@@ -0,0 +1,334 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-users")
+
+	filter, err := parseFilter(r.URL.Query().Get("filter"), "userName")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	users, err := s.repository.SCIMUsers(filter)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	s.writeList(w, r, len(users), func(start, end int) interface{} {
+		presented := []User{}
+		for _, user := range users[start:end] {
+			presented = append(presented, s.presentUser(user))
+		}
+
+		return presented
+	})
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-user")
+
+	var request User
+	if !s.readJSON(w, r, &request) {
+		return
+	}
+
+	user, err := userFromRequest(request)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	user, err = s.repository.CreateSCIMUser(user)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	logger.Info("created", lager.Data{"id": user.ID, "user-name": user.UserName})
+
+	s.writeJSON(w, http.StatusCreated, s.presentUser(user))
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request, id string) {
+	logger := s.logger.Session("get-user")
+
+	user, found, err := s.repository.FindSCIMUser(id)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	if !found {
+		s.writeError(w, http.StatusNotFound, "", "user not found")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, s.presentUser(user))
+}
+
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request, id string) {
+	logger := s.logger.Session("replace-user")
+
+	var request User
+	if !s.readJSON(w, r, &request) {
+		return
+	}
+
+	user, err := userFromRequest(request)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	user.ID = id
+
+	s.updateUser(w, logger, user)
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request, id string) {
+	logger := s.logger.Session("patch-user")
+
+	var request PatchRequest
+	if !s.readJSON(w, r, &request) {
+		return
+	}
+
+	user, found, err := s.repository.FindSCIMUser(id)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	if !found {
+		s.writeError(w, http.StatusNotFound, "", "user not found")
+		return
+	}
+
+	for _, op := range request.Operations {
+		err = applyUserOperation(&user, op)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+			return
+		}
+	}
+
+	if user.UserName == "" {
+		s.writeError(w, http.StatusBadRequest, "invalidValue", "userName must not be empty")
+		return
+	}
+
+	s.updateUser(w, logger, user)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, logger lager.Logger, user db.SCIMUser) {
+	user, found, err := s.repository.UpdateSCIMUser(user)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	if !found {
+		s.writeError(w, http.StatusNotFound, "", "user not found")
+		return
+	}
+
+	logger.Info("updated", lager.Data{"id": user.ID, "user-name": user.UserName, "active": user.Active})
+
+	s.writeJSON(w, http.StatusOK, s.presentUser(user))
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request, id string) {
+	logger := s.logger.Session("delete-user")
+
+	deleted, err := s.repository.DeleteSCIMUser(id)
+	if err != nil {
+		s.writeRepositoryError(w, logger, err)
+		return
+	}
+
+	if !deleted {
+		s.writeError(w, http.StatusNotFound, "", "user not found")
+		return
+	}
+
+	logger.Info("deleted", lager.Data{"id": id})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func userFromRequest(request User) (db.SCIMUser, error) {
+	if request.UserName == "" {
+		return db.SCIMUser{}, errors.New("userName must not be empty")
+	}
+
+	user := db.SCIMUser{
+		ExternalID:  request.ExternalID,
+		UserName:    request.UserName,
+		DisplayName: request.DisplayName,
+		Email:       primaryEmail(request.Emails),
+		Active:      true,
+	}
+
+	if request.Active != nil {
+		user.Active = *request.Active
+	}
+
+	return user, nil
+}
+
+// applyUserOperation applies a PATCH operation to the user. Operations either
+// set an attribute by its path or, without a path, set the attributes in
+// their value.
+func applyUserOperation(user *db.SCIMUser, op PatchOperation) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+	case "remove":
+		return removeUserAttribute(user, op.Path)
+	default:
+		return fmt.Errorf("unsupported operation: %s", op.Op)
+	}
+
+	if op.Path == "" {
+		attributes, ok := op.Value.(map[string]interface{})
+		if !ok {
+			return errors.New("operation without a path must have an object value")
+		}
+
+		for path, value := range attributes {
+			err := setUserAttribute(user, path, value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return setUserAttribute(user, op.Path, op.Value)
+}
+
+func setUserAttribute(user *db.SCIMUser, path string, value interface{}) error {
+	switch strings.ToLower(path) {
+	case "username":
+		return setString(&user.UserName, path, value)
+	case "displayname":
+		return setString(&user.DisplayName, path, value)
+	case "externalid":
+		return setString(&user.ExternalID, path, value)
+	case "active":
+		return setBool(&user.Active, path, value)
+	case "emails":
+		emails, err := parseEmails(value)
+		if err != nil {
+			return err
+		}
+
+		user.Email = primaryEmail(emails)
+		return nil
+	default:
+		if isEmailValuePath(path) {
+			return setString(&user.Email, path, value)
+		}
+
+		// attributes which are not stored, e.g. 'name.givenName', are
+		// ignored rather than failing the whole request
+		return nil
+	}
+}
+
+func removeUserAttribute(user *db.SCIMUser, path string) error {
+	switch {
+	case strings.EqualFold(path, "displayName"):
+		user.DisplayName = ""
+	case strings.EqualFold(path, "externalId"):
+		user.ExternalID = ""
+	case strings.EqualFold(path, "emails"), isEmailValuePath(path):
+		user.Email = ""
+	case strings.EqualFold(path, "userName"), strings.EqualFold(path, "active"):
+		return fmt.Errorf("%s cannot be removed", path)
+	}
+
+	return nil
+}
+
+// isEmailValuePath returns whether the path is of an email's value, e.g.
+// 'emails[type eq "work"].value' as sent by some identity providers.
+func isEmailValuePath(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasPrefix(lower, "emails[") && strings.HasSuffix(lower, "].value")
+}
+
+func parseEmails(value interface{}) ([]Email, error) {
+	rawEmails, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("emails must be a list")
+	}
+
+	var emails []Email
+	for _, rawEmail := range rawEmails {
+		attributes, ok := rawEmail.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("emails must be objects")
+		}
+
+		var email Email
+		err := setString(&email.Value, "emails.value", attributes["value"])
+		if err != nil {
+			return nil, err
+		}
+
+		if primary, found := attributes["primary"]; found {
+			err = setBool(&email.Primary, "emails.primary", primary)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		emails = append(emails, email)
+	}
+
+	return emails, nil
+}
+
+func setString(dest *string, path string, value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("%s must be a string", path)
+	}
+
+	*dest = str
+	return nil
+}
+
+// setBool also accepts "True" and "False" strings, as sent by some identity
+// providers.
+func setBool(dest *bool, path string, value interface{}) error {
+	switch v := value.(type) {
+	case bool:
+		*dest = v
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			*dest = true
+		case "false":
+			*dest = false
+		default:
+			return fmt.Errorf("%s must be a boolean", path)
+		}
+	default:
+		return fmt.Errorf("%s must be a boolean", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package atc
+
+// Session is an access token issued to a user on login which has not yet
+// expired.
+type Session struct {
+	ID        int    `json:"id"`
+	Sub       string `json:"sub"`
+	Username  string `json:"username,omitempty"`
+	Connector string `json:"connector,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// RevokedSessions is the response to revoking all of a user's sessions, which
+// revokes their personal API tokens too.
+type RevokedSessions struct {
+	Revoked   int `json:"revoked"`
+	APITokens int `json:"api_tokens"`
+}
@@ -0,0 +1,31 @@
+package atc
+
+type StatusEventType string
+
+const (
+	// StatusEventBuild is emitted when a build is created, starts, or finishes.
+	StatusEventBuild StatusEventType = "build"
+
+	// StatusEventJob is emitted when a job is paused or unpaused.
+	StatusEventJob StatusEventType = "job"
+)
+
+// StatusEvent is a change to the status of a build or job, as streamed
+// cluster-wide from the status events endpoint.
+type StatusEvent struct {
+	ID   int             `json:"id"`
+	Type StatusEventType `json:"type"`
+	Time int64           `json:"time"`
+
+	TeamName             string       `json:"team_name"`
+	PipelineID           int          `json:"pipeline_id,omitempty"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name,omitempty"`
+
+	BuildID   int         `json:"build_id,omitempty"`
+	BuildName string      `json:"build_name,omitempty"`
+	Status    BuildStatus `json:"status,omitempty"`
+
+	Paused *bool `json:"paused,omitempty"`
+}
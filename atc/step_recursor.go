@@ -25,6 +25,10 @@ type StepRecursor struct {
 
 	// OnLoadVar will be invoked for any *LoadVarStep present in the StepConfig.
 	OnLoadVar func(*LoadVarStep) error
+
+	// OnPipelineOutput will be invoked for any *PipelineOutputStep present in
+	// the StepConfig.
+	OnPipelineOutput func(*PipelineOutputStep) error
 }
 
 // VisitTask calls the OnTask hook if configured.
@@ -81,6 +85,15 @@ func (recursor StepRecursor) VisitLoadVar(step *LoadVarStep) error {
 	return nil
 }
 
+// VisitPipelineOutput calls the OnPipelineOutput hook if configured.
+func (recursor StepRecursor) VisitPipelineOutput(step *PipelineOutputStep) error {
+	if recursor.OnPipelineOutput != nil {
+		return recursor.OnPipelineOutput(step)
+	}
+
+	return nil
+}
+
 // VisitTry recurses through to the wrapped step.
 func (recursor StepRecursor) VisitTry(step *TryStep) error {
 	return step.Step.Config.Visit(recursor)
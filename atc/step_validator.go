@@ -103,6 +103,16 @@ func (validator *StepValidator) VisitTask(plan *TaskStep) error {
 		validator.popContext()
 	}
 
+	if plan.OIDCToken != nil {
+		validator.pushContext(".oidc_token")
+
+		for _, msg := range plan.OIDCToken.Validate() {
+			validator.recordError(msg)
+		}
+
+		validator.popContext()
+	}
+
 	return nil
 }
 
@@ -164,6 +174,21 @@ func (validator *StepValidator) VisitGet(step *GetStep) error {
 
 	validator.popContext()
 
+	if step.PassedPipeline != "" {
+		validator.pushContext(".passed_pipeline")
+
+		_, err := ParsePassedPipelineJob(step.PassedPipeline)
+		if err != nil {
+			validator.recordError(err.Error())
+		}
+
+		if len(step.Passed) != 0 {
+			validator.recordError("cannot be combined with passed")
+		}
+
+		validator.popContext()
+	}
+
 	return nil
 }
 
@@ -252,6 +277,31 @@ func (validator *StepValidator) VisitLoadVar(step *LoadVarStep) error {
 	return nil
 }
 
+func (validator *StepValidator) VisitPipelineOutput(step *PipelineOutputStep) error {
+	validator.pushContext(".pipeline_output(%s)", step.Name)
+	defer validator.popContext()
+
+	warning, err := ValidateIdentifier(step.Name, validator.context...)
+	if err != nil {
+		validator.recordError(err.Error())
+	}
+	if warning != nil {
+		validator.recordWarning(*warning)
+	}
+
+	validator.declareLocalVar(step.Name)
+
+	if step.Pipeline == "" {
+		validator.recordError("no pipeline specified")
+	}
+
+	if step.Job == "" {
+		validator.recordError("no job specified")
+	}
+
+	return nil
+}
+
 func (validator *StepValidator) VisitTry(step *TryStep) error {
 	validator.pushContext(".try")
 	defer validator.popContext()
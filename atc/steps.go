@@ -194,6 +194,7 @@ type StepVisitor interface {
 	VisitRun(*RunStep) error
 	VisitSetPipeline(*SetPipelineStep) error
 	VisitLoadVar(*LoadVarStep) error
+	VisitPipelineOutput(*PipelineOutputStep) error
 	VisitTry(*TryStep) error
 	VisitDo(*DoStep) error
 	VisitInParallel(*InParallelStep) error
@@ -278,6 +279,10 @@ var StepPrecedence = []StepDetector{
 		Key: "load_var",
 		New: func() StepConfig { return &LoadVarStep{} },
 	},
+	{
+		Key: "pipeline_output",
+		New: func() StepConfig { return &PipelineOutputStep{} },
+	},
 	{
 		Key: "try",
 		New: func() StepConfig { return &TryStep{} },
@@ -293,14 +298,17 @@ var StepPrecedence = []StepDetector{
 }
 
 type GetStep struct {
-	Name     string         `json:"get"`
-	Resource string         `json:"resource,omitempty"`
-	Version  *VersionConfig `json:"version,omitempty"`
-	Params   Params         `json:"params,omitempty"`
-	Passed   []string       `json:"passed,omitempty"`
-	Trigger  bool           `json:"trigger,omitempty"`
-	Tags     Tags           `json:"tags,omitempty"`
-	Timeout  string         `json:"timeout,omitempty"`
+	Name      string         `json:"get"`
+	Resource  string         `json:"resource,omitempty"`
+	Version   *VersionConfig `json:"version,omitempty"`
+	Params    Params         `json:"params,omitempty"`
+	Passed    []string       `json:"passed,omitempty"`
+	Trigger   bool           `json:"trigger,omitempty"`
+	Tags      Tags           `json:"tags,omitempty"`
+	Timeout   string         `json:"timeout,omitempty"`
+	Retryable bool           `json:"retryable,omitempty"`
+
+	PassedPipeline string `json:"passed_pipeline,omitempty"`
 }
 
 func (step *GetStep) ResourceName() string {
@@ -315,6 +323,32 @@ func (step *GetStep) Visit(v StepVisitor) error {
 	return v.VisitGet(step)
 }
 
+// PassedPipelineJob identifies a job of another pipeline, configured on a get
+// step as `passed_pipeline: team/pipeline/job`. Only the versions which went
+// through successful builds of the job are fetched.
+type PassedPipelineJob struct {
+	Team     string
+	Pipeline string
+	Job      string
+}
+
+func ParsePassedPipelineJob(ref string) (PassedPipelineJob, error) {
+	parts := strings.Split(ref, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return PassedPipelineJob{}, fmt.Errorf("invalid passed pipeline job '%s': expected team/pipeline/job", ref)
+	}
+
+	return PassedPipelineJob{
+		Team:     parts[0],
+		Pipeline: parts[1],
+		Job:      parts[2],
+	}, nil
+}
+
+func (job PassedPipelineJob) String() string {
+	return job.Team + "/" + job.Pipeline + "/" + job.Job
+}
+
 type PutStep struct {
 	Name      string        `json:"put"`
 	Resource  string        `json:"resource,omitempty"`
@@ -323,6 +357,7 @@ type PutStep struct {
 	Tags      Tags          `json:"tags,omitempty"`
 	GetParams Params        `json:"get_params,omitempty"`
 	Timeout   string        `json:"timeout,omitempty"`
+	Retryable bool          `json:"retryable,omitempty"`
 }
 
 func (step *PutStep) ResourceName() string {
@@ -350,6 +385,8 @@ type TaskStep struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 	Timeout           string            `json:"timeout,omitempty"`
+	Retryable         bool              `json:"retryable,omitempty"`
+	OIDCToken         *OIDCTokenConfig  `json:"oidc_token,omitempty"`
 }
 
 func (step *TaskStep) Visit(v StepVisitor) error {
@@ -402,6 +439,21 @@ func (step *LoadVarStep) Visit(v StepVisitor) error {
 	return v.VisitLoadVar(step)
 }
 
+// PipelineOutputStep consumes the latest successful build of a job in another
+// pipeline, which must belong to the same team or be shared with it. The
+// build is exposed as a local var rather than fetching any artifact.
+type PipelineOutputStep struct {
+	Name     string `json:"pipeline_output"`
+	Team     string `json:"team,omitempty"`
+	Pipeline string `json:"pipeline"`
+	Job      string `json:"job"`
+	Trigger  bool   `json:"trigger,omitempty"`
+}
+
+func (step *PipelineOutputStep) Visit(v StepVisitor) error {
+	return v.VisitPipelineOutput(step)
+}
+
 type TryStep struct {
 	Step Step `json:"try"`
 }
@@ -45,6 +45,19 @@ var factoryTests = []StepTest{
 			Timeout:  "1h",
 		},
 	},
+	{
+		Title: "get step with passed_pipeline",
+		ConfigYAML: `
+			get: some-name
+			passed_pipeline: some-team/some-pipeline/some-job
+			trigger: true
+		`,
+		StepConfig: &atc.GetStep{
+			Name:           "some-name",
+			PassedPipeline: "some-team/some-pipeline/some-job",
+			Trigger:        true,
+		},
+	},
 	{
 		Title: "put step",
 
@@ -141,6 +154,28 @@ var factoryTests = []StepTest{
 			Timeout:           "1h",
 		},
 	},
+	{
+		Title: "task step with an oidc token",
+
+		ConfigYAML: `
+			task: some-task
+			file: some-task-file
+			oidc_token:
+			  audience: [sts.amazonaws.com]
+			  env: AWS_WEB_IDENTITY_TOKEN
+			  file: oidc/token
+		`,
+
+		StepConfig: &atc.TaskStep{
+			Name:       "some-task",
+			ConfigPath: "some-task-file",
+			OIDCToken: &atc.OIDCTokenConfig{
+				Audience: []string{"sts.amazonaws.com"},
+				Env:      "AWS_WEB_IDENTITY_TOKEN",
+				File:     "oidc/token",
+			},
+		},
+	},
 	{
 		Title: "task step with non-string params",
 
@@ -231,6 +266,25 @@ var factoryTests = []StepTest{
 			Reveal: true,
 		},
 	},
+	{
+		Title: "pipeline_output step",
+
+		ConfigYAML: `
+			pipeline_output: some-output
+			team: some-team
+			pipeline: some-pipeline
+			job: some-job
+			trigger: true
+		`,
+
+		StepConfig: &atc.PipelineOutputStep{
+			Name:     "some-output",
+			Team:     "some-team",
+			Pipeline: "some-pipeline",
+			Job:      "some-job",
+			Trigger:  true,
+		},
+	},
 	{
 		Title: "try step",
 
@@ -2,6 +2,7 @@ package atc
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
@@ -19,21 +20,155 @@ func (team Team) Validate() error {
 	return team.Auth.Validate()
 }
 
+// TeamAuth maps the name of each role to who is granted it, under the
+// "users" and "groups" keys.
+//
+// A role may also set "pipelines" to only be granted for those pipelines,
+// given either by name (covering every instance of an instance group) or in
+// the 'name/key:value' form of a single instance.
+//
+// A role which sets "actions" is a custom role granting exactly those
+// actions, named as in Routes, rather than one of the built-in roles, whose
+// names it may not take.
 type TeamAuth map[string]map[string][]string
 
+const (
+	TeamAuthUsers     = "users"
+	TeamAuthGroups    = "groups"
+	TeamAuthPipelines = "pipelines"
+	TeamAuthActions   = "actions"
+)
+
+// builtinRoles are the roles whose actions are fixed, which custom roles may
+// not be named after.
+var builtinRoles = []string{"owner", "member", "pipeline-operator", "viewer"}
+
+// ungrantableActions may not be granted by custom roles, as they either
+// require an admin or would let whoever has them grant themselves more.
+var ungrantableActions = []string{
+	SetTeam,
+	RenameTeam,
+	DestroyTeam,
+	CreateTeamToken,
+	GetLogLevel,
+	SetLogLevel,
+	ListActiveUsersSince,
+	ListSessions,
+	RevokeSession,
+	RevokeUserSessions,
+	ListAuditEvents,
+	GetInfoCreds,
+	SetWall,
+	ClearWall,
+	GetWorkerCapacity,
+	ListWorkers,
+	RegisterWorker,
+	HeartbeatWorker,
+	DeleteWorker,
+}
+
+// IsGrantableAction returns whether a custom role may grant the action.
+func IsGrantableAction(action string) bool {
+	for _, ungrantable := range ungrantableActions {
+		if action == ungrantable {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SCIMConnector is the connector of the groups provisioned through SCIM, so
+// that "scim:<group>" under "groups" grants the role to the group's members.
+const SCIMConnector = "scim"
+
+// TeamMember is a user provisioned through SCIM who is granted roles on a
+// team by the groups they are in, whether or not they have logged in.
+type TeamMember struct {
+	UserName    string   `json:"user_name"`
+	DisplayName string   `json:"display_name,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Roles       []string `json:"roles"`
+	Groups      []string `json:"groups"`
+}
+
 func (auth TeamAuth) Validate() error {
 	if len(auth) == 0 {
 		return ErrAuthConfigEmpty
 	}
 
-	for _, config := range auth {
-		users := config["users"]
-		groups := config["groups"]
+	for role, config := range auth {
+		users := config[TeamAuthUsers]
+		groups := config[TeamAuthGroups]
 
 		if len(users) == 0 && len(groups) == 0 {
 			return ErrAuthConfigInvalid
 		}
+
+		for _, pipeline := range config[TeamAuthPipelines] {
+			if pipeline == "" {
+				return fmt.Errorf("role '%s' has an empty pipeline", role)
+			}
+		}
+
+		actions, custom := config[TeamAuthActions]
+		if custom && isBuiltinRole(role) {
+			return fmt.Errorf("built-in role '%s' may not specify actions", role)
+		}
+
+		if custom && len(actions) == 0 {
+			return fmt.Errorf("custom role '%s' must specify at least one action", role)
+		}
+
+		for _, action := range actions {
+			if _, found := Routes.FindRouteByName(action); !found {
+				return fmt.Errorf("custom role '%s' has unknown action '%s'", role, action)
+			}
+
+			if !IsGrantableAction(action) {
+				return fmt.Errorf("custom role '%s' may not grant action '%s'", role, action)
+			}
+		}
 	}
 
 	return nil
 }
+
+func isBuiltinRole(role string) bool {
+	for _, builtin := range builtinRoles {
+		if role == builtin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AppliesTo returns whether the role binding grants access to the pipeline,
+// i.e. it is not scoped to pipelines or the pipeline is one of them.
+func (auth TeamAuth) AppliesTo(role string, ref PipelineRef) bool {
+	pipelines := auth[role][TeamAuthPipelines]
+	if len(pipelines) == 0 {
+		return true
+	}
+
+	for _, pipeline := range pipelines {
+		if pipeline == ref.Name || pipeline == ref.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsCustom returns whether the role grants the actions it lists rather than
+// those of a built-in role.
+func (auth TeamAuth) IsCustom(role string) bool {
+	_, custom := auth[role][TeamAuthActions]
+	return custom
+}
+
+// IsScoped returns whether the role is only granted for some pipelines.
+func (auth TeamAuth) IsScoped(role string) bool {
+	return len(auth[role][TeamAuthPipelines]) != 0
+}
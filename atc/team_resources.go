@@ -0,0 +1,103 @@
+package atc
+
+// TeamResources are resources and resource types declared once for a whole
+// team. A pipeline which uses a resource or resource type without declaring
+// it gets the team's definition, so every pipeline of the team shares it.
+type TeamResources struct {
+	Resources     ResourceConfigs `json:"resources,omitempty"`
+	ResourceTypes ResourceTypes   `json:"resource_types,omitempty"`
+}
+
+func (shared TeamResources) IsEmpty() bool {
+	return len(shared.Resources) == 0 && len(shared.ResourceTypes) == 0
+}
+
+// ApplyTo adds the shared resources used by the config's jobs, and the shared
+// resource types used by its resources, resource types and tasks, to the
+// config. Declarations of the config take precedence over shared ones of the
+// same name.
+//
+// The config's slices are not modified. The shared definitions which were
+// added are returned alongside the resulting config.
+func (shared TeamResources) ApplyTo(config Config) (Config, TeamResources) {
+	var used TeamResources
+	if shared.IsEmpty() {
+		return config, used
+	}
+
+	resources := append(ResourceConfigs{}, config.Resources...)
+	resourceTypes := append(ResourceTypes{}, config.ResourceTypes...)
+
+	addResource := func(name string) {
+		if _, found := resources.Lookup(name); found {
+			return
+		}
+
+		resource, found := shared.Resources.Lookup(name)
+		if !found {
+			return
+		}
+
+		resources = append(resources, resource)
+		used.Resources = append(used.Resources, resource)
+	}
+
+	var typeNames []string
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(StepRecursor{
+			OnGet: func(step *GetStep) error {
+				addResource(step.ResourceName())
+				return nil
+			},
+			OnPut: func(step *PutStep) error {
+				addResource(step.ResourceName())
+				return nil
+			},
+			OnTask: func(step *TaskStep) error {
+				if step.Config != nil && step.Config.ImageResource != nil {
+					typeNames = append(typeNames, step.Config.ImageResource.Type)
+				}
+				return nil
+			},
+		})
+	}
+
+	for _, resource := range resources {
+		typeNames = append(typeNames, resource.Type)
+	}
+
+	for _, resourceType := range resourceTypes {
+		typeNames = append(typeNames, resourceType.Type)
+	}
+
+	// shared resource types may themselves be of a shared resource type, so
+	// keep going until no new ones are found
+	for len(typeNames) > 0 {
+		name := typeNames[0]
+		typeNames = typeNames[1:]
+
+		if _, found := resourceTypes.Lookup(name); found {
+			continue
+		}
+
+		resourceType, found := shared.ResourceTypes.Lookup(name)
+		if !found {
+			continue
+		}
+
+		resourceTypes = append(resourceTypes, resourceType)
+		used.ResourceTypes = append(used.ResourceTypes, resourceType)
+
+		typeNames = append(typeNames, resourceType.Type)
+	}
+
+	if len(used.Resources) > 0 {
+		config.Resources = resources
+	}
+
+	if len(used.ResourceTypes) > 0 {
+		config.ResourceTypes = resourceTypes
+	}
+
+	return config, used
+}
@@ -0,0 +1,112 @@
+package atc_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("TeamResources", func() {
+	var (
+		shared atc.TeamResources
+		config atc.Config
+	)
+
+	BeforeEach(func() {
+		shared = atc.TeamResources{
+			Resources: atc.ResourceConfigs{
+				{Name: "repo", Type: "git", Source: atc.Source{"uri": "https://example.com/repo.git"}},
+				{Name: "image", Type: "registry-image", Source: atc.Source{"repository": "busybox"}},
+				{Name: "chat", Type: "slack", Source: atc.Source{"url": "https://example.com/hook"}},
+			},
+			ResourceTypes: atc.ResourceTypes{
+				{Name: "slack", Type: "slack-image"},
+				{Name: "slack-image", Type: "registry-image", Source: atc.Source{"repository": "slack"}},
+				{Name: "unused", Type: "registry-image"},
+			},
+		}
+
+		config = atc.Config{
+			Jobs: atc.JobConfigs{
+				{
+					Name: "some-job",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo"}},
+						{Config: &atc.PutStep{Name: "notify", Resource: "chat"}},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("ApplyTo", func() {
+		It("adds the shared resources used by the jobs and the resource types they need", func() {
+			applied, used := shared.ApplyTo(config)
+
+			Expect(applied.Resources).To(Equal(atc.ResourceConfigs{shared.Resources[0], shared.Resources[2]}))
+			Expect(applied.ResourceTypes).To(Equal(atc.ResourceTypes{shared.ResourceTypes[0], shared.ResourceTypes[1]}))
+
+			Expect(used.Resources).To(Equal(applied.Resources))
+			Expect(used.ResourceTypes).To(Equal(applied.ResourceTypes))
+		})
+
+		It("does not modify the given config", func() {
+			shared.ApplyTo(config)
+			Expect(config.Resources).To(BeNil())
+			Expect(config.ResourceTypes).To(BeNil())
+		})
+
+		Context("when the pipeline declares a resource of the same name", func() {
+			BeforeEach(func() {
+				config.Resources = atc.ResourceConfigs{
+					{Name: "repo", Type: "git", Source: atc.Source{"uri": "https://example.com/fork.git"}},
+				}
+			})
+
+			It("keeps the pipeline's declaration", func() {
+				applied, used := shared.ApplyTo(config)
+
+				Expect(applied.Resources).To(Equal(atc.ResourceConfigs{config.Resources[0], shared.Resources[2]}))
+				Expect(used.Resources).To(Equal(atc.ResourceConfigs{shared.Resources[2]}))
+			})
+		})
+
+		Context("when a task's image resource uses a shared resource type", func() {
+			BeforeEach(func() {
+				config.Jobs[0].PlanSequence = []atc.Step{
+					{
+						Config: &atc.TaskStep{
+							Name: "some-task",
+							Config: &atc.TaskConfig{
+								Platform:      "linux",
+								ImageResource: &atc.ImageResource{Type: "unused"},
+								Run:           atc.TaskRunConfig{Path: "true"},
+							},
+						},
+					},
+				}
+			})
+
+			It("adds the resource type", func() {
+				applied, used := shared.ApplyTo(config)
+
+				Expect(applied.Resources).To(BeNil())
+				Expect(used.ResourceTypes).To(Equal(atc.ResourceTypes{shared.ResourceTypes[2]}))
+			})
+		})
+
+		Context("when nothing shared is used", func() {
+			BeforeEach(func() {
+				config.Jobs[0].PlanSequence = nil
+			})
+
+			It("returns the config as is", func() {
+				applied, used := shared.ApplyTo(config)
+
+				Expect(applied).To(Equal(config))
+				Expect(used.IsEmpty()).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package atc_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("TeamAuth", func() {
+	var auth atc.TeamAuth
+
+	BeforeEach(func() {
+		auth = atc.TeamAuth{
+			"owner": {
+				"users": {"local:some-admin"},
+			},
+			"member": {
+				"groups":    {"github:org:contractors"},
+				"pipelines": {"some-pipeline", "other-pipeline/branch:main"},
+			},
+			"deployer": {
+				"users":   {"local:some-deployer"},
+				"actions": {atc.GetPipeline, atc.CreateJobBuild},
+			},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("accepts scoped and custom roles", func() {
+			Expect(auth.Validate()).To(Succeed())
+		})
+
+		It("requires users or groups", func() {
+			auth["member"] = map[string][]string{"pipelines": {"some-pipeline"}}
+			Expect(auth.Validate()).To(Equal(atc.ErrAuthConfigInvalid))
+		})
+
+		It("rejects empty pipelines", func() {
+			auth["member"]["pipelines"] = []string{""}
+			Expect(auth.Validate()).To(MatchError("role 'member' has an empty pipeline"))
+		})
+
+		It("requires custom roles to have actions", func() {
+			auth["deployer"]["actions"] = []string{}
+			Expect(auth.Validate()).To(MatchError("custom role 'deployer' must specify at least one action"))
+		})
+
+		It("rejects unknown actions", func() {
+			auth["deployer"]["actions"] = []string{"DoAnything"}
+			Expect(auth.Validate()).To(MatchError("custom role 'deployer' has unknown action 'DoAnything'"))
+		})
+
+		It("rejects actions on built-in roles", func() {
+			auth["owner"] = map[string][]string{
+				"users":   {"local:admin"},
+				"actions": {atc.GetPipeline},
+			}
+			Expect(auth.Validate()).To(MatchError("built-in role 'owner' may not specify actions"))
+		})
+
+		It("rejects actions which require an admin or would grant more", func() {
+			auth["deployer"]["actions"] = []string{atc.GetPipeline, atc.SetTeam}
+			Expect(auth.Validate()).To(MatchError("custom role 'deployer' may not grant action 'SetTeam'"))
+
+			auth["deployer"]["actions"] = []string{atc.SetLogLevel}
+			Expect(auth.Validate()).To(MatchError("custom role 'deployer' may not grant action 'SetLogLevel'"))
+		})
+	})
+
+	Describe("AppliesTo", func() {
+		It("applies roles which are not scoped to every pipeline", func() {
+			Expect(auth.IsScoped("owner")).To(BeFalse())
+			Expect(auth.AppliesTo("owner", atc.PipelineRef{Name: "anything"})).To(BeTrue())
+		})
+
+		It("applies scoped roles to every instance of a named pipeline", func() {
+			Expect(auth.IsScoped("member")).To(BeTrue())
+			Expect(auth.AppliesTo("member", atc.PipelineRef{Name: "some-pipeline"})).To(BeTrue())
+			Expect(auth.AppliesTo("member", atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature"},
+			})).To(BeTrue())
+		})
+
+		It("applies scoped roles to a single instance", func() {
+			Expect(auth.AppliesTo("member", atc.PipelineRef{
+				Name:         "other-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "main"},
+			})).To(BeTrue())
+			Expect(auth.AppliesTo("member", atc.PipelineRef{
+				Name:         "other-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature"},
+			})).To(BeFalse())
+		})
+
+		It("does not apply scoped roles to other pipelines", func() {
+			Expect(auth.AppliesTo("member", atc.PipelineRef{Name: "secret-pipeline"})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,429 @@
+// Package webhooks understands the payloads code hosts send when a repository
+// changes, so that a single team-level endpoint can work out which resources
+// they affect.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type Provider string
+
+const (
+	ProviderGitHub    Provider = "github"
+	ProviderGitLab    Provider = "gitlab"
+	ProviderBitbucket Provider = "bitbucket"
+	ProviderGeneric   Provider = "generic"
+)
+
+const (
+	GitHubEventHeader     = "X-GitHub-Event"
+	GitHubSignatureHeader = "X-Hub-Signature-256"
+
+	GitLabEventHeader = "X-Gitlab-Event"
+	GitLabTokenHeader = "X-Gitlab-Token"
+
+	BitbucketEventHeader     = "X-Event-Key"
+	BitbucketSignatureHeader = "X-Hub-Signature"
+
+	GenericSignatureHeader = "X-Concourse-Signature"
+
+	// TokenParam may be given instead of a signature by generic senders which
+	// are unable to sign their payloads, as with the per-resource webhook.
+	TokenParam = "webhook_token"
+)
+
+// repositoryKeys are the source fields which identify the repository a
+// resource watches, e.g. the git resource's 'uri' or the pull request
+// resource's 'owner/repo' style 'repository'.
+var repositoryKeys = []string{"uri", "repository"}
+
+// branchKey is the source field naming the branch a resource watches.
+const branchKey = "branch"
+
+// Event is a webhook payload reduced to what is needed to match it against
+// resource sources.
+type Event struct {
+	Provider Provider
+	Type     string
+
+	// Repositories holds every normalized identifier of the repository the
+	// event happened in, e.g. both its clone URL and its full name.
+	Repositories []string
+
+	// Branches are the branches which were pushed to, if any.
+	Branches      []string
+	DefaultBranch string
+
+	// Source is the partial source sent in a generic payload.
+	Source atc.Source
+
+	body      []byte
+	signature string
+	token     string
+}
+
+// Parse detects which provider sent the request from its headers and
+// extracts the event from its body.
+func Parse(r *http.Request, body []byte) (Event, error) {
+	var (
+		event Event
+		err   error
+	)
+
+	switch {
+	case r.Header.Get(GitHubEventHeader) != "":
+		event, err = parseGitHub(r.Header.Get(GitHubEventHeader), body)
+		event.signature = r.Header.Get(GitHubSignatureHeader)
+	case r.Header.Get(GitLabEventHeader) != "":
+		event, err = parseGitLab(r.Header.Get(GitLabEventHeader), body)
+		event.token = r.Header.Get(GitLabTokenHeader)
+	case r.Header.Get(BitbucketEventHeader) != "":
+		event, err = parseBitbucket(r.Header.Get(BitbucketEventHeader), body)
+		event.signature = r.Header.Get(BitbucketSignatureHeader)
+	default:
+		event, err = parseGeneric(body)
+		event.signature = r.Header.Get(GenericSignatureHeader)
+		event.token = r.URL.Query().Get(TokenParam)
+	}
+
+	if err != nil {
+		return Event{}, fmt.Errorf("malformed %s payload: %w", event.Provider, err)
+	}
+
+	event.body = body
+
+	return event, nil
+}
+
+// Verify reports whether the event was sent by someone who knows the secret,
+// either by signing the payload with it or by presenting it as a token.
+func (e Event) Verify(secret string) bool {
+	if secret == "" {
+		return false
+	}
+
+	if e.signature != "" {
+		return hmac.Equal([]byte(e.signature), []byte(Sign(secret, e.body)))
+	}
+
+	if e.token != "" {
+		return hmac.Equal([]byte(e.token), []byte(secret))
+	}
+
+	return false
+}
+
+// Affects reports whether a resource with the given (evaluated) source
+// should be checked because of the event.
+func (e Event) Affects(source atc.Source) bool {
+	if e.Provider == ProviderGeneric {
+		return e.affectsGeneric(source)
+	}
+
+	if !e.affectsRepository(source) {
+		return false
+	}
+
+	if len(e.Branches) == 0 {
+		return true
+	}
+
+	branch, _ := source[branchKey].(string)
+	if branch == "" {
+		branch = e.DefaultBranch
+	}
+
+	if branch == "" {
+		return true
+	}
+
+	for _, pushed := range e.Branches {
+		if pushed == branch {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e Event) affectsRepository(source atc.Source) bool {
+	for _, key := range repositoryKeys {
+		value, ok := source[key].(string)
+		if !ok {
+			continue
+		}
+
+		normalized := NormalizeRepository(value)
+		for _, repository := range e.Repositories {
+			if repository == normalized {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (e Event) affectsGeneric(source atc.Source) bool {
+	if len(e.Source) == 0 {
+		return false
+	}
+
+	for key, expected := range e.Source {
+		actual, found := source[key]
+		if !found {
+			return false
+		}
+
+		if key != branchKey {
+			expectedRepository, ok := expected.(string)
+			actualRepository, ok2 := actual.(string)
+			if !ok || !ok2 || NormalizeRepository(expectedRepository) != NormalizeRepository(actualRepository) {
+				return false
+			}
+
+			continue
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isRepositoryKey(key string) bool {
+	for _, k := range repositoryKeys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NormalizeRepository reduces the many ways of referring to a repository to
+// one, so that e.g. 'git@github.com:owner/repo.git' and
+// 'https://github.com/owner/repo' compare equal.
+func NormalizeRepository(repository string) string {
+	normalized := strings.ToLower(strings.TrimSpace(repository))
+
+	hasScheme := false
+	if i := strings.Index(normalized, "://"); i != -1 {
+		normalized = normalized[i+3:]
+		hasScheme = true
+	}
+
+	slash := strings.Index(normalized, "/")
+	if at := strings.Index(normalized, "@"); at != -1 && (slash == -1 || at < slash) {
+		normalized = normalized[at+1:]
+		slash = strings.Index(normalized, "/")
+	}
+
+	if colon := strings.Index(normalized, ":"); colon != -1 && (slash == -1 || colon < slash) {
+		if hasScheme {
+			// host:port/path
+			path := ""
+			if slash != -1 {
+				path = normalized[slash:]
+			}
+
+			normalized = normalized[:colon] + path
+		} else {
+			// scp-like host:path
+			normalized = normalized[:colon] + "/" + normalized[colon+1:]
+		}
+	}
+
+	normalized = strings.TrimSuffix(normalized, "/")
+	normalized = strings.TrimSuffix(normalized, ".git")
+
+	return normalized
+}
+
+// Sign returns the signature of the payload in the 'sha256=<hex>' format
+// used by GitHub, Bitbucket and generic senders. The webhooks Concourse sends
+// are signed the same way.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func repositories(identifiers ...string) []string {
+	var normalized []string
+	for _, identifier := range identifiers {
+		if identifier != "" {
+			normalized = append(normalized, NormalizeRepository(identifier))
+		}
+	}
+
+	return normalized
+}
+
+func branchFromRef(ref string) []string {
+	if !strings.HasPrefix(ref, "refs/heads/") {
+		return nil
+	}
+
+	return []string{strings.TrimPrefix(ref, "refs/heads/")}
+}
+
+func parseGitHub(eventType string, body []byte) (Event, error) {
+	event := Event{Provider: ProviderGitHub, Type: eventType}
+
+	var payload struct {
+		Ref        string `json:"ref"`
+		Repository struct {
+			FullName      string `json:"full_name"`
+			HTMLURL       string `json:"html_url"`
+			CloneURL      string `json:"clone_url"`
+			SSHURL        string `json:"ssh_url"`
+			DefaultBranch string `json:"default_branch"`
+		} `json:"repository"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return event, err
+	}
+
+	if eventType == "ping" {
+		return event, nil
+	}
+
+	event.Repositories = repositories(
+		payload.Repository.FullName,
+		payload.Repository.HTMLURL,
+		payload.Repository.CloneURL,
+		payload.Repository.SSHURL,
+	)
+	event.Branches = branchFromRef(payload.Ref)
+	event.DefaultBranch = payload.Repository.DefaultBranch
+
+	return event, nil
+}
+
+func parseGitLab(eventType string, body []byte) (Event, error) {
+	event := Event{Provider: ProviderGitLab, Type: eventType}
+
+	var payload struct {
+		Ref     string `json:"ref"`
+		Project struct {
+			PathWithNamespace string `json:"path_with_namespace"`
+			WebURL            string `json:"web_url"`
+			GitHTTPURL        string `json:"git_http_url"`
+			GitSSHURL         string `json:"git_ssh_url"`
+			DefaultBranch     string `json:"default_branch"`
+		} `json:"project"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return event, err
+	}
+
+	event.Repositories = repositories(
+		payload.Project.PathWithNamespace,
+		payload.Project.WebURL,
+		payload.Project.GitHTTPURL,
+		payload.Project.GitSSHURL,
+	)
+	event.Branches = branchFromRef(payload.Ref)
+	event.DefaultBranch = payload.Project.DefaultBranch
+
+	return event, nil
+}
+
+// parseBitbucket understands Bitbucket Cloud payloads.
+func parseBitbucket(eventType string, body []byte) (Event, error) {
+	event := Event{Provider: ProviderBitbucket, Type: eventType}
+
+	var payload struct {
+		Repository struct {
+			FullName string `json:"full_name"`
+			Links    struct {
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+			MainBranch struct {
+				Name string `json:"name"`
+			} `json:"mainbranch"`
+		} `json:"repository"`
+		Push struct {
+			Changes []struct {
+				New *struct {
+					Type string `json:"type"`
+					Name string `json:"name"`
+				} `json:"new"`
+			} `json:"changes"`
+		} `json:"push"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return event, err
+	}
+
+	if eventType == "diagnostics:ping" {
+		return event, nil
+	}
+
+	event.Repositories = repositories(
+		payload.Repository.FullName,
+		payload.Repository.Links.HTML.Href,
+	)
+	event.DefaultBranch = payload.Repository.MainBranch.Name
+
+	for _, change := range payload.Push.Changes {
+		if change.New != nil && change.New.Type == "branch" {
+			event.Branches = append(event.Branches, change.New.Name)
+		}
+	}
+
+	return event, nil
+}
+
+// parseGeneric understands payloads of the form {"source": {...}}, which
+// affect every resource whose source contains the given fields. Only the
+// fields identifying a repository and branch may be given, as matching on
+// any other would let senders probe for the values of e.g. credentials.
+func parseGeneric(body []byte) (Event, error) {
+	event := Event{Provider: ProviderGeneric}
+
+	var payload struct {
+		Source atc.Source `json:"source"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return event, err
+	}
+
+	if len(payload.Source) == 0 {
+		return event, errors.New("must specify a source")
+	}
+
+	for key := range payload.Source {
+		if key != branchKey && !isRepositoryKey(key) {
+			return event, fmt.Errorf("source may only contain %s and %s, not '%s'", strings.Join(repositoryKeys, ", "), branchKey, key)
+		}
+	}
+
+	event.Source = payload.Source
+
+	return event, nil
+}
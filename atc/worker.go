@@ -5,6 +5,10 @@ import (
 	"regexp"
 )
 
+// WorkerDrainingChannel is notified when a worker is drained, so that the
+// steps running on it can be moved to other workers.
+const WorkerDrainingChannel = "worker_draining"
+
 type Worker struct {
 	// not garden_addr, for backwards-compatibility
 	GardenAddr      string `json:"addr"`
@@ -30,6 +34,9 @@ type Worker struct {
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
 	State     string   `json:"state"`
+
+	ScaleDownCandidate bool `json:"scale_down_candidate,omitempty"`
+	Draining           bool `json:"draining,omitempty"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -56,6 +63,31 @@ type WorkerResourceType struct {
 	UniqueVersionHistory bool   `json:"unique_version_history"`
 }
 
+// WorkerCapacity is the number of workers the cluster needs, as computed from
+// the steps waiting for a worker and the load on the running workers.
+type WorkerCapacity struct {
+	RunningWorkers int `json:"running_workers"`
+	DesiredWorkers int `json:"desired_workers"`
+
+	ActiveTasks             int `json:"active_tasks"`
+	MaxActiveTasksPerWorker int `json:"max_active_tasks_per_worker,omitempty"`
+
+	WaitingSteps int            `json:"waiting_steps"`
+	Demand       []WorkerDemand `json:"demand,omitempty"`
+
+	ScaleDownCandidates []string `json:"scale_down_candidates,omitempty"`
+}
+
+// WorkerDemand is the number of steps waiting for a worker with the given
+// platform, tags and team, and how many more workers would satisfy them.
+type WorkerDemand struct {
+	Platform          string   `json:"platform,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+	Team              string   `json:"team,omitempty"`
+	WaitingSteps      int      `json:"waiting_steps"`
+	AdditionalWorkers int      `json:"additional_workers"`
+}
+
 type PruneWorkerResponseBody struct {
 	Stderr string `json:"stderr"`
 }
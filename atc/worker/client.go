@@ -1,11 +1,14 @@
 package worker
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"fmt"
 	"path"
 	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -66,14 +69,19 @@ type Client interface {
 	) (GetResult, error)
 }
 
-func NewClient(worker Worker) *client {
+// NewClient returns a client running steps on the worker. The warm
+// containers are optional; when given, task steps try to claim one before
+// creating a container of their own.
+func NewClient(worker Worker, warmContainers WarmContainers) *client {
 	return &client{
-		worker: worker,
+		worker:         worker,
+		warmContainers: warmContainers,
 	}
 }
 
 type client struct {
-	worker Worker
+	worker         Worker
+	warmContainers WarmContainers
 }
 
 type TaskResult struct {
@@ -151,6 +159,16 @@ func (client *client) RunTaskStep(
 ) (TaskResult, error) {
 	logger := lagerctx.FromContext(ctx)
 
+	var claimedWarmContainer bool
+	if client.warmContainers != nil {
+		claimed, err := client.warmContainers.Claim(ctx, client.worker, owner, metadata, containerSpec)
+		if err != nil {
+			logger.Error("failed-to-claim-warm-container", err)
+		}
+
+		claimedWarmContainer = claimed
+	}
+
 	container, err := client.worker.FindOrCreateContainer(
 		ctx,
 		logger,
@@ -163,6 +181,15 @@ func (client *client) RunTaskStep(
 		return TaskResult{}, err
 	}
 
+	// warm containers are created without the task's env, so it has to be
+	// given to the process instead
+	var processEnv []string
+	if claimedWarmContainer {
+		processEnv = append(processEnv, containerSpec.Env...)
+	}
+
+	processEnv = append(processEnv, processSpec.Env...)
+
 	// container already exited
 	exitStatusProp, _ := container.Properties()
 	code := exitStatusProp[taskExitStatusPropertyName]
@@ -193,6 +220,11 @@ func (client *client) RunTaskStep(
 		eventDelegate.Starting(logger)
 		logger.Info("spawning")
 
+		err = streamInFiles(container, containerSpec.User, processSpec.Files)
+		if err != nil {
+			return TaskResult{}, err
+		}
+
 		process, err = container.Run(
 			context.Background(),
 			garden.ProcessSpec{
@@ -202,6 +234,7 @@ func (client *client) RunTaskStep(
 				Args: processSpec.Args,
 
 				Dir: path.Join(metadata.WorkingDirectory, processSpec.Dir),
+				Env: processEnv,
 
 				// Guardian sets the default TTY window size to width: 80, height: 24,
 				// which creates ANSI control sequences that do not work with other window sizes
@@ -358,3 +391,42 @@ func lockName(resourceJSON []byte, workerName string) string {
 	jsonRes := append(resourceJSON, []byte(workerName)...)
 	return fmt.Sprintf("%x", sha256.Sum256(jsonRes))
 }
+
+// streamInFiles writes the files into the container, owned by the user the
+// process runs as so that they can be private to it.
+func streamInFiles(container Container, user string, files map[string][]byte) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	tarWriter := tar.NewWriter(buf)
+
+	for filePath, content := range files {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name:     strings.TrimPrefix(path.Clean(filePath), "/"),
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tarWriter.Write(content)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return container.StreamIn(garden.StreamInSpec{
+		Path:      "/",
+		User:      user,
+		TarStream: buf,
+	})
+}
@@ -1,10 +1,12 @@
 package worker_test
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"path"
 
 	"code.cloudfoundry.org/garden"
@@ -27,7 +29,8 @@ import (
 
 var _ = Describe("Client", func() {
 	var (
-		fakeWorker *workerfakes.FakeWorker
+		fakeWorker         *workerfakes.FakeWorker
+		fakeWarmContainers *workerfakes.FakeWarmContainers
 
 		metadata db.ContainerMetadata
 		client   worker.Client
@@ -36,8 +39,9 @@ var _ = Describe("Client", func() {
 	BeforeEach(func() {
 		fakeWorker = new(workerfakes.FakeWorker)
 		fakeWorker.NameReturns("some-worker")
+		fakeWarmContainers = new(workerfakes.FakeWarmContainers)
 
-		client = worker.NewClient(fakeWorker)
+		client = worker.NewClient(fakeWorker, fakeWarmContainers)
 	})
 
 	Describe("RunCheckStep", func() {
@@ -345,6 +349,26 @@ var _ = Describe("Client", func() {
 			}))
 		})
 
+		It("tries to claim a warm container before finding or creating one", func() {
+			Expect(fakeWarmContainers.ClaimCallCount()).To(Equal(1))
+			_, actualWorker, owner, claimMetadata, containerSpec := fakeWarmContainers.ClaimArgsForCall(0)
+			Expect(actualWorker).To(Equal(fakeWorker))
+			Expect(owner).To(Equal(fakeContainerOwner))
+			Expect(claimMetadata).To(Equal(fakeMetadata))
+			Expect(containerSpec).To(Equal(fakeContainerSpec))
+		})
+
+		Context("when claiming a warm container fails", func() {
+			BeforeEach(func() {
+				fakeWarmContainers.ClaimReturns(false, errors.New("nope"))
+			})
+
+			It("still finds or creates a container", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+			})
+		})
+
 		Context("found a container that has already exited", func() {
 			BeforeEach(func() {
 				fakeContainer.PropertiesReturns(garden.Properties{"concourse:exit-status": "8"}, nil)
@@ -599,6 +623,98 @@ var _ = Describe("Client", func() {
 					Expect(actualProcessIO.Stderr).To(Equal(stderrBuf))
 				})
 
+				It("leaves the env to the container", func() {
+					Eventually(fakeContainer.RunCallCount()).Should(Equal(1))
+
+					_, gardenProcessSpec, _ := fakeContainer.RunArgsForCall(0)
+					Expect(gardenProcessSpec.Env).To(BeEmpty())
+				})
+
+				Context("when a warm container was claimed", func() {
+					BeforeEach(func() {
+						fakeWarmContainers.ClaimReturns(true, nil)
+					})
+
+					It("gives the env to the process", func() {
+						Eventually(fakeContainer.RunCallCount()).Should(Equal(1))
+
+						_, gardenProcessSpec, _ := fakeContainer.RunArgsForCall(0)
+						Expect(gardenProcessSpec.Env).To(Equal([]string{"SECURE=secret-task-param"}))
+					})
+
+					Context("when the process has env", func() {
+						BeforeEach(func() {
+							fakeTaskProcessSpec.Env = []string{"CONCOURSE_OIDC_TOKEN=some-token"}
+						})
+
+						It("gives both to the process", func() {
+							Eventually(fakeContainer.RunCallCount()).Should(Equal(1))
+
+							_, gardenProcessSpec, _ := fakeContainer.RunArgsForCall(0)
+							Expect(gardenProcessSpec.Env).To(Equal([]string{"SECURE=secret-task-param", "CONCOURSE_OIDC_TOKEN=some-token"}))
+						})
+					})
+				})
+
+				Context("when the process has env", func() {
+					BeforeEach(func() {
+						fakeTaskProcessSpec.Env = []string{"CONCOURSE_OIDC_TOKEN=some-token"}
+					})
+
+					It("gives it to the process only", func() {
+						Eventually(fakeContainer.RunCallCount()).Should(Equal(1))
+
+						_, gardenProcessSpec, _ := fakeContainer.RunArgsForCall(0)
+						Expect(gardenProcessSpec.Env).To(Equal([]string{"CONCOURSE_OIDC_TOKEN=some-token"}))
+
+						_, _, _, _, actualContainerSpec := fakeWorker.FindOrCreateContainerArgsForCall(0)
+						Expect(actualContainerSpec.Env).ToNot(ContainElement("CONCOURSE_OIDC_TOKEN=some-token"))
+					})
+				})
+
+				It("does not stream any files into the container", func() {
+					Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+				})
+
+				Context("when the process has files", func() {
+					BeforeEach(func() {
+						fakeContainerSpec.User = "some-user"
+						fakeTaskProcessSpec.Files = map[string][]byte{
+							"/some-artifact-root/oidc/token": []byte("some-token"),
+						}
+					})
+
+					It("streams them into the container before running the process", func() {
+						Expect(fakeContainer.StreamInCallCount()).To(Equal(1))
+						Expect(fakeContainer.RunCallCount()).To(Equal(1))
+
+						spec := fakeContainer.StreamInArgsForCall(0)
+						Expect(spec.Path).To(Equal("/"))
+						Expect(spec.User).To(Equal("some-user"))
+
+						tarReader := tar.NewReader(spec.TarStream)
+						header, err := tarReader.Next()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(header.Name).To(Equal("some-artifact-root/oidc/token"))
+						Expect(header.Mode).To(Equal(int64(0600)))
+
+						content, err := ioutil.ReadAll(tarReader)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(content)).To(Equal("some-token"))
+					})
+
+					Context("when streaming them in fails", func() {
+						BeforeEach(func() {
+							fakeContainer.StreamInReturns(errors.New("nope"))
+						})
+
+						It("does not run the process", func() {
+							Expect(err).To(MatchError("nope"))
+							Expect(fakeContainer.RunCallCount()).To(BeZero())
+						})
+					})
+				})
+
 				It("invokes the Starting Event on the delegate", func() {
 					Expect(fakeEventDelegate.StartingCallCount()).Should((Equal(1)))
 				})
@@ -14,6 +14,11 @@ type WorkerSpec struct {
 	ResourceType string
 	Tags         []string
 	TeamID       int
+
+	// BuildID is the build of the step, which a worker provisioned for the
+	// step is kept for until it finishes. It is not set for checks, as no
+	// other step uses what they leave on the worker.
+	BuildID int
 }
 
 type ContainerSpec struct {
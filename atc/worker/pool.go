@@ -66,15 +66,49 @@ type VolumeFinder interface {
 	FindVolume(lager.Logger, int, string) (Volume, bool, error)
 }
 
+// ProvisionedWorkerTimeoutError is returned when a worker provisioned for a
+// step does not become available in time.
+type ProvisionedWorkerTimeoutError struct {
+	Worker  string
+	Timeout time.Duration
+}
+
+func (err ProvisionedWorkerTimeoutError) Error() string {
+	return fmt.Sprintf("provisioned worker %s did not become available within %s", err.Worker, err.Timeout)
+}
+
+// Provisioning configures the workers provisioned for steps. Workers are
+// reserved for the step they were provisioned for in the database, so that
+// steps run by other web nodes are not placed on them.
+type Provisioning struct {
+	Provisioner  Provisioner
+	Reservations db.ProvisionedWorkerRepository
+
+	// Timeout is how long a step waits for its worker to register before
+	// failing.
+	Timeout time.Duration
+}
+
 type pool struct {
-	provider WorkerProvider
-	waker    chan bool
+	provider     WorkerProvider
+	waitingSteps db.WaitingStepRepository
+	provisioning Provisioning
+	warm         WarmContainers
+	waker        chan bool
 }
 
-func NewPool(provider WorkerProvider) Pool {
+// NewPool returns a pool selecting workers from the provider. Provisioning is
+// optional; when it has a provisioner, steps it provisions for get a worker
+// of their own instead of waiting for one to become available. The warm
+// containers are optional too, and are handed to the clients of the selected
+// workers.
+func NewPool(provider WorkerProvider, waitingSteps db.WaitingStepRepository, provisioning Provisioning, warm WarmContainers) Pool {
 	return &pool{
-		provider: provider,
-		waker:    make(chan bool),
+		provider:     provider,
+		waitingSteps: waitingSteps,
+		provisioning: provisioning,
+		warm:         warm,
+		waker:        make(chan bool),
 	}
 }
 
@@ -114,6 +148,36 @@ func (pool *pool) compatibleWorkers(logger lager.Logger, candidateWorkers []Work
 	return compatibleGeneralWorkers, nil
 }
 
+// claimableWorkers leaves out workers provisioned for other steps. If the step
+// has a provisioned worker, only that worker is claimable.
+func (pool *pool) claimableWorkers(candidateWorkers []Worker, provisionedWorker string) ([]Worker, error) {
+	if pool.provisioning.Provisioner == nil {
+		return candidateWorkers, nil
+	}
+
+	reserved, err := pool.provisioning.Reservations.ReservedWorkers()
+	if err != nil {
+		return nil, err
+	}
+
+	claimable := []Worker{}
+	for _, worker := range candidateWorkers {
+		if provisionedWorker != "" {
+			if worker.Name() == provisionedWorker {
+				claimable = append(claimable, worker)
+			}
+
+			continue
+		}
+
+		if _, found := reserved[worker.Name()]; !found {
+			claimable = append(claimable, worker)
+		}
+	}
+
+	return claimable, nil
+}
+
 func (pool *pool) findWorkerWithContainer(
 	logger lager.Logger,
 	compatible []Worker,
@@ -175,6 +239,7 @@ func (pool *pool) findWorker(
 	containerSpec ContainerSpec,
 	workerSpec WorkerSpec,
 	strategy ContainerPlacementStrategy,
+	provisionedWorker string,
 ) (Client, error) {
 	logger := lagerctx.FromContext(ctx)
 
@@ -183,6 +248,11 @@ func (pool *pool) findWorker(
 		return nil, err
 	}
 
+	compatibleWorkers, err = pool.claimableWorkers(compatibleWorkers, provisionedWorker)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(compatibleWorkers) == 0 {
 		return nil, nil
 	}
@@ -212,7 +282,7 @@ func (pool *pool) findWorker(
 		return nil, nil
 	}
 
-	return NewClient(worker), nil
+	return NewClient(worker, pool.warm), nil
 }
 
 func (pool *pool) FindContainer(logger lager.Logger, teamID int, handle string) (Container, bool, error) {
@@ -287,9 +357,12 @@ func (pool *pool) SelectWorker(
 
 	var worker Client
 	var pollingTicker *time.Ticker
+	var waitingStep db.WaitingStep
+	var provisionedWorker string
+	var provisionTimeout <-chan time.Time
 	for {
 		var err error
-		worker, err = pool.findWorker(ctx, owner, containerSpec, workerSpec, strategy)
+		worker, err = pool.findWorker(ctx, owner, containerSpec, workerSpec, strategy, provisionedWorker)
 
 		if err != nil {
 			return nil, 0, err
@@ -313,16 +386,68 @@ func (pool *pool) SelectWorker(
 			metric.Metrics.StepsWaiting[labels].Inc()
 			defer metric.Metrics.StepsWaiting[labels].Dec()
 
+			// record the waiting step so that the worker capacity can be
+			// computed across all web nodes; failing to do so only affects
+			// autoscaling, so the step keeps waiting regardless
+			waitingStep, err = pool.waitingSteps.StartWaiting(db.WaitingStepSpec{
+				TeamID:   workerSpec.TeamID,
+				Platform: workerSpec.Platform,
+				Tags:     workerSpec.Tags,
+				Type:     string(containerSpec.Type),
+			})
+			if err != nil {
+				logger.Error("failed-to-record-waiting-step", err)
+			} else {
+				defer func() {
+					err := waitingStep.Done()
+					if err != nil {
+						logger.Error("failed-to-remove-waiting-step", err)
+					}
+				}()
+			}
+
 			if callbacks != nil {
 				callbacks.WaitingForWorker(logger)
 			}
+
+			if pool.provisioning.Provisioner != nil && pool.provisioning.Provisioner.Provisions(workerSpec) {
+				provisionedWorker, err = pool.provision(ctx, workerSpec)
+				if err != nil {
+					return nil, 0, err
+				}
+
+				timer := time.NewTimer(pool.provisioning.Timeout)
+				defer timer.Stop()
+
+				provisionTimeout = timer.C
+
+				// once the step got the worker, it is only torn down on release,
+				// or when its build finishes
+				defer func() {
+					if worker == nil {
+						pool.destroyProvisionedWorker(logger, provisionedWorker)
+					}
+				}()
+			}
 		}
 
 		select {
 		case <-ctx.Done():
 			logger.Info("aborted-waiting-for-worker")
 			return nil, 0, ctx.Err()
+		case <-provisionTimeout:
+			logger.Info("timed-out-waiting-for-provisioned-worker", lager.Data{"worker": provisionedWorker})
+			return nil, 0, ProvisionedWorkerTimeoutError{
+				Worker:  provisionedWorker,
+				Timeout: pool.provisioning.Timeout,
+			}
 		case <-pollingTicker.C:
+			if waitingStep != nil {
+				err := waitingStep.Refresh()
+				if err != nil {
+					logger.Error("failed-to-refresh-waiting-step", err)
+				}
+			}
 		case <-pool.waker:
 		}
 	}
@@ -345,6 +470,18 @@ func (pool *pool) ReleaseWorker(
 	logger := lagerctx.FromContext(ctx)
 	strategy.Release(logger, client.Worker(), containerSpec)
 
+	// workers provisioned for a step of a build are kept until the build
+	// finishes, as its later steps may use the volumes the step left on the
+	// worker; they are torn down by the provisioned worker collector
+	if pool.provisioning.Provisioner != nil {
+		reserved, err := pool.provisioning.Reservations.ReservedWorkers()
+		if err != nil {
+			logger.Error("failed-to-get-provisioned-workers", err)
+		} else if buildID, found := reserved[client.Name()]; found && buildID == 0 {
+			pool.destroyProvisionedWorker(logger, client.Name())
+		}
+	}
+
 	// Attempt to wake a random waiting step to see if it can be
 	// scheduled on the recently released worker.
 	select {
@@ -354,6 +491,43 @@ func (pool *pool) ReleaseWorker(
 	}
 }
 
+func (pool *pool) provision(ctx context.Context, workerSpec WorkerSpec) (string, error) {
+	logger := lagerctx.FromContext(ctx).Session("provision-worker")
+
+	name, err := pool.provisioning.Provisioner.Provision(ctx, workerSpec)
+	if err != nil {
+		logger.Error("failed-to-provision-worker", err)
+		return "", err
+	}
+
+	logger.Info("provisioned-worker", lager.Data{"worker": name})
+
+	err = pool.provisioning.Reservations.Reserve(name, workerSpec.BuildID)
+	if err != nil {
+		logger.Error("failed-to-reserve-provisioned-worker", err)
+		pool.destroyProvisionedWorker(logger, name)
+		return "", err
+	}
+
+	return name, nil
+}
+
+func (pool *pool) destroyProvisionedWorker(logger lager.Logger, name string) {
+	logger = logger.Session("destroy-provisioned-worker", lager.Data{"worker": name})
+
+	// the step may have been aborted, which must not stop the worker from
+	// being torn down
+	err := pool.provisioning.Provisioner.Destroy(lagerctx.NewContext(context.Background(), logger), name)
+	if err != nil {
+		logger.Error("failed-to-destroy-provisioned-worker", err)
+	}
+
+	err = pool.provisioning.Reservations.Release(name)
+	if err != nil {
+		logger.Error("failed-to-release-provisioned-worker", err)
+	}
+}
+
 func (pool *pool) chooseRandomWorkerForVolume(
 	logger lager.Logger,
 	workerSpec WorkerSpec,
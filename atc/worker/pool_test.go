@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/lager/lagertest"
 	"context"
 	"errors"
+	"time"
 
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
@@ -21,8 +22,9 @@ import (
 
 var _ = Describe("Pool", func() {
 	var (
-		logger       *lagertest.TestLogger
-		fakeProvider *workerfakes.FakeWorkerProvider
+		logger           *lagertest.TestLogger
+		fakeProvider     *workerfakes.FakeWorkerProvider
+		fakeWaitingSteps *dbfakes.FakeWaitingStepRepository
 
 		pool Pool
 	)
@@ -30,8 +32,10 @@ var _ = Describe("Pool", func() {
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("test")
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
+		fakeWaitingSteps = new(dbfakes.FakeWaitingStepRepository)
+		fakeWaitingSteps.StartWaitingReturns(new(dbfakes.FakeWaitingStep), nil)
 
-		pool = NewPool(fakeProvider)
+		pool = NewPool(fakeProvider, fakeWaitingSteps, Provisioning{}, nil)
 	})
 
 	Describe("FindContainer", func() {
@@ -562,6 +566,226 @@ var _ = Describe("Pool", func() {
 				})
 			})
 
+			Context("when the step has to wait", func() {
+				var fakeWaitingStep *dbfakes.FakeWaitingStep
+
+				BeforeEach(func() {
+					fakeProvider.RunningWorkersReturns([]Worker{}, nil)
+
+					containerSpec.Type = db.ContainerTypeTask
+					workerSpec.Platform = "some-platform"
+
+					fakeWaitingStep = new(dbfakes.FakeWaitingStep)
+					fakeWaitingSteps.StartWaitingReturns(fakeWaitingStep, nil)
+				})
+
+				It("records the waiting step", func() {
+					Expect(fakeWaitingSteps.StartWaitingCallCount()).To(Equal(1))
+					Expect(fakeWaitingSteps.StartWaitingArgsForCall(0)).To(Equal(db.WaitingStepSpec{
+						TeamID:   4567,
+						Platform: "some-platform",
+						Tags:     []string{"some-tag"},
+						Type:     "task",
+					}))
+				})
+
+				It("refreshes the waiting step on every poll", func() {
+					Expect(fakeWaitingStep.RefreshCallCount()).To(Equal(1))
+				})
+
+				It("removes the waiting step once it stops waiting", func() {
+					Expect(fakeWaitingStep.DoneCallCount()).To(Equal(1))
+				})
+
+				Context("when recording the waiting step fails", func() {
+					BeforeEach(func() {
+						fakeWaitingSteps.StartWaitingReturns(nil, errors.New("nope"))
+					})
+
+					It("keeps waiting for a worker", func() {
+						Expect(selectErr).To(Equal(selectCtx.Err()))
+						Expect(fakeProvider.RunningWorkersCallCount()).To(Equal(2))
+					})
+				})
+			})
+
+			Context("when a provisioner provisions for the step", func() {
+				var (
+					fakeProvisioner  *workerfakes.FakeProvisioner
+					fakeReservations *dbfakes.FakeProvisionedWorkerRepository
+					provisioning     Provisioning
+				)
+
+				BeforeEach(func() {
+					fakeProvisioner = new(workerfakes.FakeProvisioner)
+					fakeProvisioner.ProvisionsReturns(true)
+					fakeProvisioner.ProvisionReturns("worker-1", nil)
+
+					reserved := map[string]int{}
+					fakeReservations = new(dbfakes.FakeProvisionedWorkerRepository)
+					fakeReservations.ReserveStub = func(name string, buildID int) error {
+						reserved[name] = buildID
+						return nil
+					}
+					fakeReservations.ReleaseStub = func(name string) error {
+						delete(reserved, name)
+						return nil
+					}
+					fakeReservations.ReservedWorkersStub = func() (map[string]int, error) {
+						copied := map[string]int{}
+						for name, buildID := range reserved {
+							copied[name] = buildID
+						}
+
+						return copied, nil
+					}
+
+					provisioning = Provisioning{
+						Provisioner:  fakeProvisioner,
+						Reservations: fakeReservations,
+						Timeout:      time.Hour,
+					}
+
+					pool = NewPool(fakeProvider, fakeWaitingSteps, provisioning, nil)
+
+					for _, worker := range workerFakes {
+						worker.SatisfiesReturns(true)
+					}
+
+					fakeProvider.RunningWorkersReturnsOnCall(0, []Worker{}, nil)
+					fakeProvider.RunningWorkersReturns(workers, nil)
+				})
+
+				It("provisions a worker for the step", func() {
+					Expect(fakeProvisioner.ProvisionsCallCount()).To(Equal(1))
+					Expect(fakeProvisioner.ProvisionsArgsForCall(0)).To(Equal(workerSpec))
+
+					Expect(fakeProvisioner.ProvisionCallCount()).To(Equal(1))
+					_, spec := fakeProvisioner.ProvisionArgsForCall(0)
+					Expect(spec).To(Equal(workerSpec))
+				})
+
+				It("selects the provisioned worker", func() {
+					Expect(selectErr).ToNot(HaveOccurred())
+					Expect(selectedWorker.Name()).To(Equal("worker-1"))
+				})
+
+				It("reserves the worker for the step", func() {
+					Expect(fakeReservations.ReserveCallCount()).To(Equal(1))
+					name, buildID := fakeReservations.ReserveArgsForCall(0)
+					Expect(name).To(Equal("worker-1"))
+					Expect(buildID).To(BeZero())
+				})
+
+				It("destroys the worker once it is released", func() {
+					Expect(fakeProvisioner.DestroyCallCount()).To(Equal(0))
+
+					pool.ReleaseWorker(selectCtx, containerSpec, selectedWorker, fakeStrategy)
+
+					Expect(fakeProvisioner.DestroyCallCount()).To(Equal(1))
+					_, name := fakeProvisioner.DestroyArgsForCall(0)
+					Expect(name).To(Equal("worker-1"))
+
+					Expect(fakeReservations.ReleaseCallCount()).To(Equal(1))
+					Expect(fakeReservations.ReleaseArgsForCall(0)).To(Equal("worker-1"))
+				})
+
+				Context("when the step belongs to a build", func() {
+					BeforeEach(func() {
+						workerSpec.BuildID = 42
+					})
+
+					It("reserves the worker for the build", func() {
+						Expect(fakeReservations.ReserveCallCount()).To(Equal(1))
+						name, buildID := fakeReservations.ReserveArgsForCall(0)
+						Expect(name).To(Equal("worker-1"))
+						Expect(buildID).To(Equal(42))
+					})
+
+					It("keeps the worker once it is released", func() {
+						pool.ReleaseWorker(selectCtx, containerSpec, selectedWorker, fakeStrategy)
+
+						Expect(fakeProvisioner.DestroyCallCount()).To(Equal(0))
+						Expect(fakeReservations.ReleaseCallCount()).To(Equal(0))
+					})
+				})
+
+				It("does not let other steps select the provisioned worker, even on other web nodes", func() {
+					fakeProvisioner.ProvisionsReturns(false)
+
+					otherPool := NewPool(fakeProvider, fakeWaitingSteps, provisioning, nil)
+
+					otherWorker, _, err := otherPool.SelectWorker(
+						lagerctx.NewContext(context.Background(), logger),
+						fakeOwner,
+						containerSpec,
+						workerSpec,
+						fakeStrategy,
+						fakeCallbacks,
+					)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(otherWorker.Name()).ToNot(Equal("worker-1"))
+				})
+
+				Context("when provisioning fails", func() {
+					BeforeEach(func() {
+						fakeProvisioner.ProvisionReturns("", errors.New("nope"))
+					})
+
+					It("returns the error", func() {
+						Expect(selectErr).To(MatchError("nope"))
+					})
+				})
+
+				Context("when reserving the worker fails", func() {
+					BeforeEach(func() {
+						fakeReservations.ReserveStub = nil
+						fakeReservations.ReserveReturns(errors.New("nope"))
+					})
+
+					It("destroys the worker and returns the error", func() {
+						Expect(selectErr).To(MatchError("nope"))
+
+						Expect(fakeProvisioner.DestroyCallCount()).To(Equal(1))
+						_, name := fakeProvisioner.DestroyArgsForCall(0)
+						Expect(name).To(Equal("worker-1"))
+					})
+				})
+
+				Context("when the provisioned worker never shows up", func() {
+					BeforeEach(func() {
+						fakeProvider.RunningWorkersReturns([]Worker{}, nil)
+					})
+
+					It("destroys the worker", func() {
+						Expect(selectErr).To(Equal(selectCtx.Err()))
+
+						Expect(fakeProvisioner.DestroyCallCount()).To(Equal(1))
+						_, name := fakeProvisioner.DestroyArgsForCall(0)
+						Expect(name).To(Equal("worker-1"))
+
+						Expect(fakeReservations.ReleaseCallCount()).To(Equal(1))
+					})
+
+					Context("when the timeout passes first", func() {
+						BeforeEach(func() {
+							provisioning.Timeout = time.Millisecond
+							pool = NewPool(fakeProvider, fakeWaitingSteps, provisioning, nil)
+						})
+
+						It("fails the step and destroys the worker", func() {
+							Expect(selectErr).To(Equal(ProvisionedWorkerTimeoutError{
+								Worker:  "worker-1",
+								Timeout: time.Millisecond,
+							}))
+
+							Expect(fakeProvisioner.DestroyCallCount()).To(Equal(1))
+							Expect(fakeReservations.ReleaseCallCount()).To(Equal(1))
+						})
+					})
+				})
+			})
+
 			Context("with no compatible workers available", func() {
 				BeforeEach(func() {
 					workerFakes = workerFakes[:1]
@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+)
+
+// Provisioner creates workers on demand for steps that would otherwise have
+// to wait for one, e.g. by starting a VM or a pod. Provisioned workers are
+// expected to register themselves through the same path as any other worker,
+// i.e. the TSA and the RegisterWorker endpoint, with Ephemeral set so that
+// they are removed as soon as they stall.
+//
+//counterfeiter:generate . Provisioner
+type Provisioner interface {
+	// Provisions returns whether steps with the given spec should get a
+	// worker of their own.
+	Provisions(WorkerSpec) bool
+
+	// Provision starts a worker satisfying the spec and returns the name it
+	// will register with.
+	Provision(context.Context, WorkerSpec) (string, error)
+
+	// Destroy tears down a worker returned by Provision once the step that
+	// asked for it, or the build of the step, is done with it. It may be
+	// called by another web node than the one which provisioned the worker,
+	// or after the web node restarted.
+	Destroy(context.Context, string) error
+}
@@ -0,0 +1,234 @@
+package provisioner
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/worker"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// StopTimeout is how long a local worker process is given to exit after being
+// asked to stop before it is killed.
+const StopTimeout = 30 * time.Second
+
+// Local provisions workers by running a worker process on the same host as
+// the web node. It is a reference implementation which allows provisioning
+// to be used without any cloud; the command is expected to accept the same
+// flags as 'concourse worker'.
+//
+// The processes are only known to the web node which started them, so Local
+// is meant for deployments with a single web node. They outlive restarts of
+// the web node, after which they are found again through the names they were
+// reserved with.
+type Local struct {
+	tags    []string
+	command string
+	args    []string
+
+	processesL sync.Mutex
+	processes  map[string]*process
+}
+
+type process struct {
+	process *os.Process
+	exited  chan struct{}
+}
+
+func NewLocal(tags []string, command string, args []string) *Local {
+	return &Local{
+		tags:    tags,
+		command: command,
+		args:    args,
+
+		processes: map[string]*process{},
+	}
+}
+
+func (local *Local) Provisions(spec worker.WorkerSpec) bool {
+	if spec.Platform != "" && spec.Platform != runtime.GOOS {
+		return false
+	}
+
+	for _, tag := range spec.Tags {
+		for _, provisionedTag := range local.tags {
+			if tag == provisionedTag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (local *Local) Provision(ctx context.Context, spec worker.WorkerSpec) (string, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+
+	name := "provisioned-" + guid.String()
+
+	args := append([]string{}, local.args...)
+	args = append(args, "--name", name, "--ephemeral")
+	for _, tag := range spec.Tags {
+		args = append(args, "--tag", tag)
+	}
+
+	// the process outlives the step's context; it is stopped by Destroy
+	cmd := exec.Command(local.command, args...)
+
+	err = cmd.Start()
+	if err != nil {
+		return "", err
+	}
+
+	logger.Info("started-worker-process", lager.Data{"worker": name, "pid": cmd.Process.Pid})
+
+	proc := &process{
+		process: cmd.Process,
+		exited:  make(chan struct{}),
+	}
+
+	go func() {
+		_ = cmd.Wait()
+		close(proc.exited)
+	}()
+
+	local.processesL.Lock()
+	local.processes[name] = proc
+	local.processesL.Unlock()
+
+	return name, nil
+}
+
+func (local *Local) Destroy(ctx context.Context, name string) error {
+	logger := lagerctx.FromContext(ctx)
+
+	local.processesL.Lock()
+	proc, found := local.processes[name]
+	delete(local.processes, name)
+	local.processesL.Unlock()
+
+	if !found {
+		var err error
+		proc, found, err = adoptProcess(name)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			logger.Info("worker-process-not-found", lager.Data{"worker": name})
+			return nil
+		}
+
+		logger.Info("adopted-worker-process", lager.Data{"worker": name, "pid": proc.process.Pid})
+	}
+
+	err := proc.process.Signal(syscall.SIGTERM)
+	if err != nil {
+		select {
+		case <-proc.exited:
+			return nil
+		default:
+			return err
+		}
+	}
+
+	select {
+	case <-proc.exited:
+	case <-time.After(StopTimeout):
+		logger.Info("killing-worker-process", lager.Data{"worker": name})
+
+		err = proc.process.Kill()
+		if err != nil {
+			return err
+		}
+
+		<-proc.exited
+	}
+
+	return nil
+}
+
+// adoptProcess finds the process of a worker started by a previous run of
+// the web node, which is no longer its parent and so can only poll whether it
+// has exited.
+func adoptProcess(name string) (*process, bool, error) {
+	pid, found, err := findWorkerProcess(name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	osProcess, err := os.FindProcess(pid)
+	if err != nil {
+		return nil, false, err
+	}
+
+	proc := &process{
+		process: osProcess,
+		exited:  make(chan struct{}),
+	}
+
+	go func() {
+		for osProcess.Signal(syscall.Signal(0)) == nil {
+			time.Sleep(time.Second)
+		}
+
+		close(proc.exited)
+	}()
+
+	return proc, true, nil
+}
+
+// findWorkerProcess looks for the process started with the worker's name
+// through /proc, so workers are only found again on Linux.
+func findWorkerProcess(name string) (int, bool, error) {
+	entries, err := ioutil.ReadDir("/proc")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		// the process may have exited in the meantime
+		cmdline, err := ioutil.ReadFile(filepath.Join("/proc", entry.Name(), "cmdline"))
+		if err != nil {
+			continue
+		}
+
+		args := strings.Split(string(cmdline), "\x00")
+		for i := 0; i+1 < len(args); i++ {
+			if args[i] == "--name" && args[i+1] == name {
+				return pid, true, nil
+			}
+		}
+	}
+
+	return 0, false, nil
+}
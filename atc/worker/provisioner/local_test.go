@@ -0,0 +1,149 @@
+package provisioner_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/provisioner"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Local", func() {
+	var (
+		tmpDir  string
+		argsLog string
+		pidFile string
+		ctx     context.Context
+
+		local *provisioner.Local
+	)
+
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("the fake worker command is a shell script")
+		}
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "local-provisioner")
+		Expect(err).ToNot(HaveOccurred())
+
+		argsLog = filepath.Join(tmpDir, "args")
+		pidFile = filepath.Join(tmpDir, "pid")
+
+		// the script keeps running with its args, like a worker would
+		command := filepath.Join(tmpDir, "worker")
+		err = ioutil.WriteFile(command, []byte("#!/bin/sh\n"+
+			"echo $$ > "+pidFile+"\n"+
+			"echo \"$@\" > "+argsLog+"\n"+
+			"sleep 60 &\n"+
+			"trap 'kill $!; exit' TERM\n"+
+			"wait\n",
+		), 0755)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+
+		local = provisioner.NewLocal([]string{"ephemeral"}, command, []string{"worker", "--tsa-host", "127.0.0.1:2222"})
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	Describe("Provisions", func() {
+		It("provisions for steps with one of the configured tags", func() {
+			Expect(local.Provisions(worker.WorkerSpec{Tags: atc.Tags{"other", "ephemeral"}})).To(BeTrue())
+		})
+
+		It("does not provision for steps without the configured tags", func() {
+			Expect(local.Provisions(worker.WorkerSpec{Tags: atc.Tags{"other"}})).To(BeFalse())
+			Expect(local.Provisions(worker.WorkerSpec{})).To(BeFalse())
+		})
+
+		It("does not provision for steps needing another platform", func() {
+			Expect(local.Provisions(worker.WorkerSpec{
+				Platform: "some-other-platform",
+				Tags:     atc.Tags{"ephemeral"},
+			})).To(BeFalse())
+		})
+	})
+
+	Describe("Provision", func() {
+		var (
+			name string
+			err  error
+		)
+
+		JustBeforeEach(func() {
+			name, err = local.Provision(ctx, worker.WorkerSpec{Tags: atc.Tags{"ephemeral", "gpu"}})
+		})
+
+		AfterEach(func() {
+			Expect(local.Destroy(ctx, name)).To(Succeed())
+		})
+
+		It("starts an ephemeral worker process with the step's tags", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(HavePrefix("provisioned-"))
+
+			Eventually(func() string {
+				args, _ := ioutil.ReadFile(argsLog)
+				return strings.TrimSpace(string(args))
+			}).Should(Equal("worker --tsa-host 127.0.0.1:2222 --name " + name + " --ephemeral --tag ephemeral --tag gpu"))
+		})
+	})
+
+	Describe("Destroy", func() {
+		It("stops the worker process", func() {
+			name, err := local.Provision(ctx, worker.WorkerSpec{Tags: atc.Tags{"ephemeral"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(argsLog).Should(BeAnExistingFile())
+
+			Expect(local.Destroy(ctx, name)).To(Succeed())
+		})
+
+		It("does nothing for unknown workers", func() {
+			Expect(local.Destroy(ctx, "some-worker")).To(Succeed())
+		})
+
+		Context("when the worker was provisioned before the web node restarted", func() {
+			BeforeEach(func() {
+				if runtime.GOOS != "linux" {
+					Skip("worker processes are only found again on Linux")
+				}
+			})
+
+			It("finds and stops the worker process", func() {
+				name, err := local.Provision(ctx, worker.WorkerSpec{Tags: atc.Tags{"ephemeral"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(argsLog).Should(BeAnExistingFile())
+
+				pidContent, err := ioutil.ReadFile(pidFile)
+				Expect(err).ToNot(HaveOccurred())
+
+				pid, err := strconv.Atoi(strings.TrimSpace(string(pidContent)))
+				Expect(err).ToNot(HaveOccurred())
+
+				restarted := provisioner.NewLocal([]string{"ephemeral"}, "unused", nil)
+				Expect(restarted.Destroy(ctx, name)).To(Succeed())
+
+				Eventually(func() error {
+					return syscall.Kill(pid, 0)
+				}).Should(HaveOccurred())
+			})
+		})
+	})
+})
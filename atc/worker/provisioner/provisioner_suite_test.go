@@ -0,0 +1,13 @@
+package provisioner_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestProvisioner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Provisioner Suite")
+}
@@ -0,0 +1,285 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// WarmContainers hands out containers which were created ahead of time for
+// task images that are used often, so that tasks don't have to wait for the
+// container to be created.
+//
+//counterfeiter:generate . WarmContainers
+type WarmContainers interface {
+	// Claim attempts to hand a warm container matching the spec over to the
+	// owner. It returns false if the spec can't be served by a warm container
+	// or if none is available on the worker.
+	Claim(context.Context, Worker, db.ContainerOwner, db.ContainerMetadata, ContainerSpec) (bool, error)
+}
+
+type WarmPoolConfig struct {
+	Size        int           `long:"size" default:"0" description:"Number of warm containers to keep per image on each worker. Only unprivileged tasks without inputs, outputs or caches can use them. 0 disables the warm container pool."`
+	Images      int           `long:"images" default:"3" description:"Number of most-used task images to keep warm containers for on each worker."`
+	IdleTimeout time.Duration `long:"idle-timeout" default:"30m" description:"Period after which unclaimed warm containers are garbage collected, and images stop being kept warm when no task has used them."`
+}
+
+type warmDemand struct {
+	spec     ContainerSpec
+	uses     int
+	lastUsed time.Time
+}
+
+type WarmPool struct {
+	provider      WorkerProvider
+	workerFactory db.WorkerFactory
+	config        WarmPoolConfig
+
+	demandL sync.Mutex
+	demand  map[string]map[string]*warmDemand
+}
+
+// NewWarmPool returns a component which keeps warm containers for the most
+// used task images on each running worker, and which can be used to claim
+// them. Usage is tracked in memory, so each ATC warms the images of the tasks
+// it has placed itself.
+func NewWarmPool(
+	provider WorkerProvider,
+	workerFactory db.WorkerFactory,
+	config WarmPoolConfig,
+) *WarmPool {
+	return &WarmPool{
+		provider:      provider,
+		workerFactory: workerFactory,
+		config:        config,
+		demand:        map[string]map[string]*warmDemand{},
+	}
+}
+
+func (p *WarmPool) Claim(
+	ctx context.Context,
+	worker Worker,
+	owner db.ContainerOwner,
+	metadata db.ContainerMetadata,
+	spec ContainerSpec,
+) (bool, error) {
+	logger := lagerctx.FromContext(ctx).Session("claim-warm-container")
+
+	if !WarmEligible(spec) {
+		metric.Metrics.WarmContainerIneligible.Inc()
+		return false, nil
+	}
+
+	key, ok, err := warmKey(logger, worker, spec)
+	if err != nil {
+		return false, err
+	}
+
+	if !ok {
+		logger.Debug("image-not-on-worker", lager.Data{"worker": worker.Name()})
+		metric.Metrics.WarmContainerMisses.Inc()
+		return false, nil
+	}
+
+	p.recordUse(worker.Name(), key, spec)
+
+	dbWorker, found, err := p.workerFactory.GetWorker(worker.Name())
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	claimed, err := dbWorker.ClaimWarmContainer(key, spec.TeamID, owner, metadata)
+	if err != nil {
+		return false, err
+	}
+
+	if claimed {
+		logger.Debug("claimed", lager.Data{"worker": worker.Name(), "key": key})
+		metric.Metrics.WarmContainerHits.Inc()
+	} else {
+		metric.Metrics.WarmContainerMisses.Inc()
+	}
+
+	return claimed, nil
+}
+
+func (p *WarmPool) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("warm-containers")
+
+	workers, err := p.provider.RunningWorkers(logger)
+	if err != nil {
+		logger.Error("failed-to-get-running-workers", err)
+		return err
+	}
+
+	running := map[string]bool{}
+	for _, worker := range workers {
+		running[worker.Name()] = true
+
+		for key, spec := range p.mostUsed(worker.Name()) {
+			for slot := 0; slot < p.config.Size; slot++ {
+				_, err := worker.FindOrCreateContainer(
+					ctx,
+					logger,
+					db.NewWarmContainerOwner(key, slot, spec.TeamID),
+					db.ContainerMetadata{Type: db.ContainerTypeTask},
+					spec,
+				)
+				if err != nil {
+					logger.Error("failed-to-create-warm-container", err, lager.Data{
+						"worker": worker.Name(),
+						"key":    key,
+						"slot":   slot,
+					})
+					break
+				}
+			}
+		}
+	}
+
+	p.demandL.Lock()
+	for name := range p.demand {
+		if !running[name] {
+			delete(p.demand, name)
+		}
+	}
+	p.demandL.Unlock()
+
+	return nil
+}
+
+func (p *WarmPool) recordUse(workerName string, key string, spec ContainerSpec) {
+	p.demandL.Lock()
+	defer p.demandL.Unlock()
+
+	keys, found := p.demand[workerName]
+	if !found {
+		keys = map[string]*warmDemand{}
+		p.demand[workerName] = keys
+	}
+
+	demand, found := keys[key]
+	if !found {
+		demand = &warmDemand{}
+		keys[key] = demand
+	}
+
+	demand.spec = warmSpec(spec)
+	demand.uses++
+	demand.lastUsed = time.Now()
+}
+
+// mostUsed returns the specs of the images used most on the worker within the
+// idle timeout, keyed by their warm key. Images which haven't been used since
+// are forgotten.
+func (p *WarmPool) mostUsed(workerName string) map[string]ContainerSpec {
+	p.demandL.Lock()
+	defer p.demandL.Unlock()
+
+	type candidate struct {
+		key  string
+		uses int
+	}
+
+	var candidates []candidate
+	for key, demand := range p.demand[workerName] {
+		if time.Since(demand.lastUsed) > p.config.IdleTimeout {
+			delete(p.demand[workerName], key)
+			continue
+		}
+
+		candidates = append(candidates, candidate{key, demand.uses})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].uses == candidates[j].uses {
+			return candidates[i].key < candidates[j].key
+		}
+
+		return candidates[i].uses > candidates[j].uses
+	})
+
+	specs := map[string]ContainerSpec{}
+	for i, c := range candidates {
+		if i >= p.config.Images {
+			break
+		}
+
+		specs[c.key] = p.demand[workerName][c.key].spec
+	}
+
+	return specs
+}
+
+// WarmEligible returns whether a task container spec can be served by a warm
+// container. Only unprivileged task containers without any mounts qualify, as
+// those are fixed when the container is created, so tasks with inputs,
+// outputs or caches never use warm containers.
+func WarmEligible(spec ContainerSpec) bool {
+	return spec.Type == db.ContainerTypeTask &&
+		!spec.ImageSpec.Privileged &&
+		spec.ImageSpec.ImageArtifactSource != nil &&
+		len(spec.Inputs) == 0 &&
+		len(spec.Outputs) == 0 &&
+		len(spec.BindMounts) == 0
+}
+
+// warmKey identifies the containers that an eligible task container spec can
+// be served by, which is only possible when the image is already present on
+// the worker.
+func warmKey(logger lager.Logger, worker Worker, spec ContainerSpec) (string, bool, error) {
+	volume, found, err := spec.ImageSpec.ImageArtifactSource.ExistsOn(logger, worker)
+	if err != nil {
+		return "", false, err
+	}
+
+	if !found {
+		return "", false, nil
+	}
+
+	payload, err := json.Marshal(struct {
+		Image  string          `json:"image"`
+		TeamID int             `json:"team_id"`
+		Limits ContainerLimits `json:"limits"`
+		User   string          `json:"user"`
+		Dir    string          `json:"dir"`
+	}{
+		Image:  volume.Handle(),
+		TeamID: spec.TeamID,
+		Limits: spec.Limits,
+		User:   spec.User,
+		Dir:    spec.Dir,
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), true, nil
+}
+
+// warmSpec strips everything from the spec that is specific to a single
+// task, leaving what is needed to create a container any matching task can
+// claim.
+func warmSpec(spec ContainerSpec) ContainerSpec {
+	return ContainerSpec{
+		TeamID:    spec.TeamID,
+		ImageSpec: spec.ImageSpec,
+		Type:      db.ContainerTypeTask,
+		Limits:    spec.Limits,
+		User:      spec.User,
+		Dir:       spec.Dir,
+	}
+}
@@ -0,0 +1,306 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/metric"
+	. "github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WarmPool", func() {
+	var (
+		ctx               context.Context
+		fakeProvider      *workerfakes.FakeWorkerProvider
+		fakeWorkerFactory *dbfakes.FakeWorkerFactory
+		fakeDBWorker      *dbfakes.FakeWorker
+		fakeWorker        *workerfakes.FakeWorker
+		fakeImageSource   *workerfakes.FakeStreamableArtifactSource
+		fakeImageVolume   *workerfakes.FakeVolume
+
+		config   WarmPoolConfig
+		warmPool *WarmPool
+
+		owner    db.ContainerOwner
+		metadata db.ContainerMetadata
+		spec     ContainerSpec
+	)
+
+	BeforeEach(func() {
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+
+		fakeProvider = new(workerfakes.FakeWorkerProvider)
+		fakeWorkerFactory = new(dbfakes.FakeWorkerFactory)
+
+		fakeDBWorker = new(dbfakes.FakeWorker)
+		fakeWorkerFactory.GetWorkerReturns(fakeDBWorker, true, nil)
+
+		fakeWorker = new(workerfakes.FakeWorker)
+		fakeWorker.NameReturns("some-worker")
+		fakeProvider.RunningWorkersReturns([]Worker{fakeWorker}, nil)
+
+		fakeImageVolume = new(workerfakes.FakeVolume)
+		fakeImageVolume.HandleReturns("some-image-volume")
+
+		fakeImageSource = new(workerfakes.FakeStreamableArtifactSource)
+		fakeImageSource.ExistsOnReturns(fakeImageVolume, true, nil)
+
+		config = WarmPoolConfig{
+			Size:        2,
+			Images:      1,
+			IdleTimeout: time.Hour,
+		}
+
+		owner = db.NewBuildStepContainerOwner(1, "some-plan", 42)
+		metadata = db.ContainerMetadata{
+			Type:             db.ContainerTypeTask,
+			WorkingDirectory: "/tmp/build/some-dir",
+		}
+		spec = ContainerSpec{
+			TeamID: 42,
+			Type:   db.ContainerTypeTask,
+			ImageSpec: ImageSpec{
+				ImageArtifactSource: fakeImageSource,
+			},
+			Dir:     "/tmp/build/some-dir",
+			Env:     []string{"SOME=env"},
+			Outputs: OutputPaths{},
+		}
+	})
+
+	JustBeforeEach(func() {
+		warmPool = NewWarmPool(fakeProvider, fakeWorkerFactory, config)
+	})
+
+	Describe("Claim", func() {
+		var (
+			claimed  bool
+			claimErr error
+		)
+
+		JustBeforeEach(func() {
+			claimed, claimErr = warmPool.Claim(ctx, fakeWorker, owner, metadata, spec)
+		})
+
+		Context("when a warm container is available", func() {
+			BeforeEach(func() {
+				fakeDBWorker.ClaimWarmContainerReturns(true, nil)
+				metric.Metrics.WarmContainerHits.Delta()
+			})
+
+			It("claims it for the owner", func() {
+				Expect(claimErr).ToNot(HaveOccurred())
+				Expect(claimed).To(BeTrue())
+
+				Expect(fakeWorkerFactory.GetWorkerArgsForCall(0)).To(Equal("some-worker"))
+				Expect(fakeDBWorker.ClaimWarmContainerCallCount()).To(Equal(1))
+				key, teamID, actualOwner, actualMetadata := fakeDBWorker.ClaimWarmContainerArgsForCall(0)
+				Expect(key).ToNot(BeEmpty())
+				Expect(teamID).To(Equal(42))
+				Expect(actualOwner).To(Equal(owner))
+				Expect(actualMetadata).To(Equal(metadata))
+			})
+
+			It("counts a hit", func() {
+				Expect(metric.Metrics.WarmContainerHits.Delta()).To(Equal(float64(1)))
+			})
+		})
+
+		Context("when no warm container is available", func() {
+			BeforeEach(func() {
+				fakeDBWorker.ClaimWarmContainerReturns(false, nil)
+				metric.Metrics.WarmContainerMisses.Delta()
+			})
+
+			It("does not claim one", func() {
+				Expect(claimErr).ToNot(HaveOccurred())
+				Expect(claimed).To(BeFalse())
+			})
+
+			It("counts a miss", func() {
+				Expect(metric.Metrics.WarmContainerMisses.Delta()).To(Equal(float64(1)))
+			})
+		})
+
+		Context("when claiming fails", func() {
+			BeforeEach(func() {
+				fakeDBWorker.ClaimWarmContainerReturns(false, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				Expect(claimErr).To(MatchError("disaster"))
+			})
+		})
+
+		Context("when the task runs in another directory", func() {
+			var firstKey string
+
+			BeforeEach(func() {
+				_, err := NewWarmPool(fakeProvider, fakeWorkerFactory, config).Claim(ctx, fakeWorker, owner, metadata, spec)
+				Expect(err).ToNot(HaveOccurred())
+
+				firstKey, _, _, _ = fakeDBWorker.ClaimWarmContainerArgsForCall(0)
+
+				spec.Dir = "/tmp/build/other-dir"
+			})
+
+			It("claims a container for that directory", func() {
+				key, _, _, _ := fakeDBWorker.ClaimWarmContainerArgsForCall(1)
+				Expect(key).ToNot(Equal(firstKey))
+			})
+		})
+
+		Context("when the task has inputs", func() {
+			BeforeEach(func() {
+				spec.Inputs = []InputSource{new(workerfakes.FakeInputSource)}
+				metric.Metrics.WarmContainerIneligible.Delta()
+			})
+
+			It("does not try to claim a warm container", func() {
+				Expect(WarmEligible(spec)).To(BeFalse())
+				Expect(claimed).To(BeFalse())
+				Expect(fakeDBWorker.ClaimWarmContainerCallCount()).To(BeZero())
+			})
+
+			It("counts it as ineligible", func() {
+				Expect(metric.Metrics.WarmContainerIneligible.Delta()).To(Equal(float64(1)))
+			})
+		})
+
+		Context("when the task has outputs", func() {
+			BeforeEach(func() {
+				spec.Outputs = OutputPaths{"some-output": "/tmp/build/some-dir/some-output"}
+			})
+
+			It("does not try to claim a warm container", func() {
+				Expect(WarmEligible(spec)).To(BeFalse())
+				Expect(claimed).To(BeFalse())
+				Expect(fakeDBWorker.ClaimWarmContainerCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the task is privileged", func() {
+			BeforeEach(func() {
+				spec.ImageSpec.Privileged = true
+			})
+
+			It("does not try to claim a warm container", func() {
+				Expect(claimed).To(BeFalse())
+				Expect(fakeDBWorker.ClaimWarmContainerCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the image is not on the worker", func() {
+			BeforeEach(func() {
+				fakeImageSource.ExistsOnReturns(nil, false, nil)
+				metric.Metrics.WarmContainerMisses.Delta()
+			})
+
+			It("does not try to claim a warm container", func() {
+				Expect(WarmEligible(spec)).To(BeTrue())
+				Expect(claimed).To(BeFalse())
+				Expect(fakeDBWorker.ClaimWarmContainerCallCount()).To(BeZero())
+			})
+
+			It("counts a miss", func() {
+				Expect(metric.Metrics.WarmContainerMisses.Delta()).To(Equal(float64(1)))
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var runErr error
+
+		JustBeforeEach(func() {
+			runErr = warmPool.Run(ctx)
+		})
+
+		Context("when no tasks have been placed", func() {
+			It("does not create any containers", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when tasks have been placed", func() {
+			var otherSpec ContainerSpec
+
+			BeforeEach(func() {
+				otherVolume := new(workerfakes.FakeVolume)
+				otherVolume.HandleReturns("some-other-image-volume")
+
+				otherImageSource := new(workerfakes.FakeStreamableArtifactSource)
+				otherImageSource.ExistsOnReturns(otherVolume, true, nil)
+
+				otherSpec = spec
+				otherSpec.ImageSpec = ImageSpec{ImageArtifactSource: otherImageSource}
+			})
+
+			JustBeforeEach(func() {
+				_, err := warmPool.Claim(ctx, fakeWorker, owner, metadata, spec)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = warmPool.Claim(ctx, fakeWorker, owner, metadata, spec)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = warmPool.Claim(ctx, fakeWorker, owner, metadata, otherSpec)
+				Expect(err).ToNot(HaveOccurred())
+
+				runErr = warmPool.Run(ctx)
+			})
+
+			It("fills the slots for the most used image", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(2))
+
+				_, _, _, warmMetadata, warmSpec := fakeWorker.FindOrCreateContainerArgsForCall(0)
+				Expect(warmMetadata).To(Equal(db.ContainerMetadata{Type: db.ContainerTypeTask}))
+				Expect(warmSpec.ImageSpec.ImageArtifactSource).To(Equal(fakeImageSource))
+				Expect(warmSpec.TeamID).To(Equal(42))
+				Expect(warmSpec.Env).To(BeEmpty())
+				Expect(warmSpec.Dir).To(Equal("/tmp/build/some-dir"))
+
+				_, _, firstOwner, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
+				_, _, secondOwner, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(1)
+				Expect(firstOwner).ToNot(Equal(secondOwner))
+			})
+
+			Context("when the images have not been used within the idle timeout", func() {
+				BeforeEach(func() {
+					config.IdleTimeout = 0
+				})
+
+				It("does not create any containers", func() {
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when creating a container fails", func() {
+				BeforeEach(func() {
+					fakeWorker.FindOrCreateContainerReturns(nil, errors.New("disaster"))
+				})
+
+				It("skips the remaining slots of the image", func() {
+					Expect(runErr).ToNot(HaveOccurred())
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when getting the running workers fails", func() {
+			BeforeEach(func() {
+				fakeProvider.RunningWorkersReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("disaster"))
+			})
+		})
+	})
+})
@@ -57,7 +57,9 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// requester is system, admin team, or worker owning team
 		case atc.PruneWorker,
 			atc.LandWorker,
+			atc.DrainWorker,
 			atc.RetireWorker,
+			atc.ScaleDownWorker,
 			atc.ListDestroyingVolumes,
 			atc.ListDestroyingContainers,
 			atc.ReportWorkerContainers,
@@ -89,12 +91,17 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.HeartbeatWorker,
 			atc.DeleteWorker,
 			atc.ListTeamBuilds,
-			atc.GetUser:
+			atc.GetUser,
+			atc.ListUserTokens,
+			atc.CreateUserToken,
+			atc.RevokeUserToken,
+			atc.StatusEvents:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
 
 		// unauthenticated / delegating to handler (validate token if provided)
 		case atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.CheckTeamWebHook,
 			atc.GetInfo,
 			atc.ListTeams,
 			atc.ListAllPipelines,
@@ -110,16 +117,33 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetLogLevel,
 			atc.DestroyTeam,
 			atc.ListActiveUsersSince,
+			atc.ListSessions,
+			atc.RevokeSession,
+			atc.RevokeUserSessions,
+			atc.ListAuditEvents,
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
-			atc.ClearWall:
+			atc.ClearWall,
+			atc.GetWorkerCapacity:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team and has required role, or is admin)
 		case atc.GetTeam,
 			atc.SetTeam,
 			atc.RenameTeam,
+			atc.GetTeamGitOps,
+			atc.SetTeamGitOps,
+			atc.DeleteTeamGitOps,
+			atc.GetTeamNotifications,
+			atc.SetTeamNotifications,
+			atc.ListTeamNotificationDeliveries,
+			atc.GetTeamResources,
+			atc.SetTeamResources,
+			atc.ListTeamMembers,
+			atc.ListTeamTokens,
+			atc.CreateTeamToken,
+			atc.RevokeTeamToken,
 			atc.ListContainers,
 			atc.GetContainer,
 			atc.HijackContainer,
@@ -138,6 +162,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
+			atc.ListPipelineConfigVersions,
+			atc.GetPipelineConfigVersion,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
@@ -150,6 +176,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpausePipeline,
 			atc.ExposePipeline,
 			atc.HidePipeline,
+			atc.SharePipeline,
 			atc.SaveConfig,
 			atc.ArchivePipeline,
 			atc.ClearTaskCache,
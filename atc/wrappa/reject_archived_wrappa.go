@@ -43,6 +43,8 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			// leave the handler as-is
 		case
 			atc.GetConfig,
+			atc.ListPipelineConfigVersions,
+			atc.GetPipelineConfigVersion,
 			atc.GetBuild,
 			atc.BuildResources,
 			atc.BuildEvents,
@@ -52,9 +54,12 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.AbortBuild,
 			atc.PruneWorker,
 			atc.LandWorker,
+			atc.DrainWorker,
 			atc.ReportWorkerContainers,
 			atc.ReportWorkerVolumes,
 			atc.RetireWorker,
+			atc.ScaleDownWorker,
+			atc.GetWorkerCapacity,
 			atc.ListDestroyingContainers,
 			atc.ListDestroyingVolumes,
 			atc.GetPipeline,
@@ -87,10 +92,26 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetTeam,
 			atc.RenameTeam,
 			atc.DestroyTeam,
+			atc.GetTeamGitOps,
+			atc.SetTeamGitOps,
+			atc.DeleteTeamGitOps,
+			atc.GetTeamNotifications,
+			atc.SetTeamNotifications,
+			atc.ListTeamNotificationDeliveries,
+			atc.GetTeamResources,
+			atc.SetTeamResources,
+			atc.ListTeamMembers,
+			atc.ListTeamTokens,
+			atc.CreateTeamToken,
+			atc.RevokeTeamToken,
 			atc.GetUser,
+			atc.ListUserTokens,
+			atc.CreateUserToken,
+			atc.RevokeUserToken,
 			atc.GetInfo,
 			atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.CheckTeamWebHook,
 			atc.ListAllPipelines,
 			atc.ListBuilds,
 			atc.ListPipelines,
@@ -102,7 +123,12 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetLogLevel,
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
+			atc.StatusEvents,
 			atc.ListActiveUsersSince,
+			atc.ListSessions,
+			atc.RevokeSession,
+			atc.RevokeUserSessions,
+			atc.ListAuditEvents,
 			atc.SetWall,
 			atc.ClearWall,
 			atc.DeletePipeline,
@@ -118,6 +144,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpauseJob,
 			atc.ExposePipeline,
 			atc.HidePipeline,
+			atc.SharePipeline,
 			atc.CreatePipelineBuild,
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
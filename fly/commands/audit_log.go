@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type AuditLogCommand struct {
+	Since  string `long:"since" description:"Only show events at or after this time, as yyyy-mm-dd or RFC3339"`
+	Until  string `long:"until" description:"Only show events before this time, as yyyy-mm-dd or RFC3339"`
+	Actor  string `long:"actor" description:"Only show events of this user"`
+	Action string `long:"action" description:"Only show events of this API action, e.g. SaveConfig"`
+	Team   string `long:"team" description:"Only show events of this team"`
+	Count  int    `short:"c" long:"count" default:"100" description:"Number of events you want to limit the return to"`
+	Json   bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *AuditLogCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	filter := concourse.AuditEventFilter{
+		Actor:    command.Actor,
+		Action:   command.Action,
+		TeamName: command.Team,
+		Limit:    command.Count,
+	}
+
+	filter.Since, err = parseAuditTime("since", command.Since)
+	if err != nil {
+		return err
+	}
+
+	filter.Until, err = parseAuditTime("until", command.Until)
+	if err != nil {
+		return err
+	}
+
+	events, err := target.Client().AuditEvents(filter)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(events)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "time", Color: color.New(color.Bold)},
+		{Contents: "actor", Color: color.New(color.Bold)},
+		{Contents: "team", Color: color.New(color.Bold)},
+		{Contents: "action", Color: color.New(color.Bold)},
+		{Contents: "target", Color: color.New(color.Bold)},
+		{Contents: "remote address", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, event := range events {
+		row := ui.TableRow{
+			{Contents: time.Unix(event.Time, 0).Format(timeDateLayout)},
+			{Contents: event.Actor},
+			stringOrDefault(event.TeamName),
+			{Contents: event.Action},
+			stringOrDefault(event.Target),
+			stringOrDefault(event.RemoteAddr),
+		}
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func parseAuditTime(flag string, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.ParseInLocation(inputDateLayout, value, time.Now().Location())
+	if err == nil {
+		return parsed, nil
+	}
+
+	parsed, err = time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s time should be in the format yyyy-mm-dd or RFC3339", flag)
+	}
+
+	return parsed, nil
+}
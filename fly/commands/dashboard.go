@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/dashboard"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/pty"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+const (
+	enterAlternateScreen = "\x1b[?1049h\x1b[?25l"
+	leaveAlternateScreen = "\x1b[?25h\x1b[?1049l"
+)
+
+type DashboardCommand struct {
+	Pipeline *flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Only show the jobs of this pipeline"`
+	Interval time.Duration             `long:"interval" default:"5s" description:"How often to refresh the jobs"`
+}
+
+func (command *DashboardCommand) Execute([]string) error {
+	var pipelineRef *atc.PipelineRef
+	if command.Pipeline != nil {
+		_, err := command.Pipeline.Validate()
+		if err != nil {
+			return err
+		}
+
+		ref := command.Pipeline.Ref()
+		pipelineRef = &ref
+	}
+
+	if command.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if !pty.IsTerminal() {
+		return errors.New("the dashboard can only be shown in a terminal")
+	}
+
+	rows, _, err := pty.Getsize(os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	term, err := pty.OpenCBreakTerm()
+	if err != nil {
+		return err
+	}
+
+	restore := func() {
+		fmt.Print(leaveAlternateScreen)
+		_ = term.Restore()
+	}
+
+	defer restore()
+
+	fmt.Print(enterAlternateScreen)
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-interrupts
+		restore()
+		os.Exit(1)
+	}()
+
+	keys := make(chan string)
+	go dashboard.ReadKeys(term, keys)
+
+	resizes := make(chan int)
+	go func() {
+		for range pty.ResizeNotifier() {
+			rows, _, err := pty.Getsize(os.Stdout)
+			if err == nil {
+				resizes <- rows
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(command.Interval)
+	defer ticker.Stop()
+
+	return dashboard.New(target.Client(), os.Stdout, pipelineRef, rows).Run(keys, ticker.C, resizes)
+}
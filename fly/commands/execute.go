@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -24,7 +25,9 @@ import (
 )
 
 type ExecuteCommand struct {
-	TaskConfig     atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute"`
+	TaskConfig     atc.PathFlag                       `short:"c" long:"config"                                description:"The task config to execute"`
+	Job            flaghelpers.JobFlag                `          long:"job"         value-name:"PIPELINE/JOB" description:"Execute the plan of a job instead of a task config, replacing the job's get steps with --input directories"`
+	IncludePuts    bool                               `          long:"include-puts"                          description:"Run the job's put and set_pipeline steps, which are skipped by default"`
 	Privileged     bool                               `short:"p" long:"privileged"                            description:"Run the task with full privileges"`
 	IncludeIgnored bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
 	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
@@ -49,56 +52,34 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		return err
 	}
 
-	taskConfig, err := command.CreateTaskConfig(args)
-	if err != nil {
-		return err
-	}
-
 	planFactory := atc.NewPlanFactory(time.Now().Unix())
 
-	inputs, inputMappings, imageResource, resourceTypes, err := executehelpers.DetermineInputs(
-		planFactory,
-		target.Team(),
-		taskConfig.Inputs,
-		command.Inputs,
-		command.InputMappings,
-		command.Image,
-		command.InputsFrom,
-		command.IncludeIgnored,
-		taskConfig.Platform,
-		command.Tags,
-	)
-	if err != nil {
-		return err
-	}
+	var plan atc.Plan
+	var outputs []executehelpers.Output
+	var pipelineRef atc.PipelineRef
 
-	if imageResource != nil {
-		taskConfig.ImageResource = imageResource
-	}
+	if command.Job.JobName != "" {
+		if command.TaskConfig != "" {
+			return errors.New("--config and --job cannot be used together")
+		}
 
-	outputs, err := executehelpers.DetermineOutputs(
-		planFactory,
-		taskConfig.Outputs,
-		command.Outputs,
-	)
-	if err != nil {
-		return err
-	}
+		plan, err = command.createJobBuildPlan(planFactory, target)
+		if err != nil {
+			return err
+		}
 
-	plan, err := executehelpers.CreateBuildPlan(
-		planFactory,
-		target,
-		command.Privileged,
-		inputs,
-		inputMappings,
-		resourceTypes,
-		outputs,
-		taskConfig,
-		command.Tags,
-	)
+		pipelineRef = command.Job.PipelineRef
+	} else {
+		if command.TaskConfig == "" {
+			return errors.New("either --config or --job must be specified")
+		}
 
-	if err != nil {
-		return err
+		plan, outputs, err = command.createTaskBuildPlan(planFactory, target, args)
+		if err != nil {
+			return err
+		}
+
+		pipelineRef = command.InputsFrom.PipelineRef
 	}
 
 	client := target.Client()
@@ -110,8 +91,8 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	var build atc.Build
 	var buildURL *url.URL
 
-	if command.InputsFrom.PipelineRef.Name != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineRef, plan)
+	if pipelineRef.Name != "" {
+		build, err = target.Team().CreatePipelineBuild(pipelineRef, plan)
 		if err != nil {
 			return err
 		}
@@ -183,6 +164,127 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	return nil
 }
 
+func (command *ExecuteCommand) createTaskBuildPlan(
+	planFactory atc.PlanFactory,
+	target rc.Target,
+	args []string,
+) (atc.Plan, []executehelpers.Output, error) {
+	taskConfig, err := command.CreateTaskConfig(args)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	inputs, inputMappings, imageResource, resourceTypes, err := executehelpers.DetermineInputs(
+		planFactory,
+		target.Team(),
+		taskConfig.Inputs,
+		command.Inputs,
+		command.InputMappings,
+		command.Image,
+		command.InputsFrom,
+		command.IncludeIgnored,
+		taskConfig.Platform,
+		command.Tags,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	if imageResource != nil {
+		taskConfig.ImageResource = imageResource
+	}
+
+	outputs, err := executehelpers.DetermineOutputs(
+		planFactory,
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	plan, err := executehelpers.CreateBuildPlan(
+		planFactory,
+		target,
+		command.Privileged,
+		inputs,
+		inputMappings,
+		resourceTypes,
+		outputs,
+		taskConfig,
+		command.Tags,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	return plan, outputs, nil
+}
+
+// createJobBuildPlan plans the job from the pipeline's saved config, using
+// the versions its next build would use for the inputs which weren't
+// provided locally.
+func (command *ExecuteCommand) createJobBuildPlan(
+	planFactory atc.PlanFactory,
+	target rc.Target,
+) (atc.Plan, error) {
+	if len(command.Outputs) > 0 {
+		return atc.Plan{}, errors.New("--output cannot be used with --job")
+	}
+
+	team := target.Team()
+	pipelineRef := command.Job.PipelineRef
+
+	config, _, found, err := team.PipelineConfig(pipelineRef)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	if !found {
+		return atc.Plan{}, fmt.Errorf("pipeline '%s' not found", pipelineRef.String())
+	}
+
+	job, found := config.Jobs.Lookup(command.Job.JobName)
+	if !found {
+		return atc.Plan{}, fmt.Errorf("job '%s' not found in pipeline '%s'", command.Job.JobName, pipelineRef.String())
+	}
+
+	err = executehelpers.CheckForUnknownJobInputs(command.Inputs, job)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	err = executehelpers.CheckForInputType(command.Inputs)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	buildInputs, _, err := team.BuildInputsForJob(pipelineRef, command.Job.JobName)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	resourceTypes, _, err := team.VersionedResourceTypes(pipelineRef)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	localInputs, err := executehelpers.GenerateLocalInputs(planFactory, team, command.Inputs, command.IncludeIgnored, "", command.Tags)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	return executehelpers.CreateJobBuildPlan(
+		planFactory,
+		config,
+		job,
+		localInputs,
+		buildInputs,
+		resourceTypes,
+		command.IncludePuts,
+	)
+}
+
 func (command *ExecuteCommand) CreateTaskConfig(args []string) (atc.TaskConfig, error) {
 
 	taskTemplate := templatehelpers.NewYamlTemplateWithParams(
@@ -25,17 +25,33 @@ type FlyCommand struct {
 
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
+	AuditLog    AuditLogCommand    `command:"audit-log" description:"List the audited API requests, most recent first"`
+	Sessions    SessionsCommand    `command:"sessions" description:"List and revoke the sessions of users who logged in"`
 
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	GetTeam     GetTeamCommand     `command:"get-team"  alias:"gt" description:"Show team configuration"`
 	SetTeam     SetTeamCommand     `command:"set-team"  alias:"st" description:"Create or modify a team to have the given credentials"`
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
+	TeamMembers TeamMembersCommand `command:"team-members" description:"List the users provisioned through SCIM who have access to a team"`
+
+	SetGitOps    SetGitOpsCommand    `command:"set-gitops"    description:"Reconcile all of a team's pipelines from a repository, or stop doing so"`
+	GitOpsStatus GitOpsStatusCommand `command:"gitops-status" description:"Show a team's GitOps configuration and the result of its last sync"`
+
+	SetNotifications SetNotificationsCommand `command:"set-notifications" description:"Subscribe a team to webhooks sent when the builds of its jobs change status"`
+	Notifications    NotificationsCommand    `command:"notifications"     description:"List a team's notifications and their recent deliveries"`
+
+	SetTeamResources SetTeamResourcesCommand `command:"set-team-resources" description:"Declare resources and resource types that all of a team's pipelines can use by name"`
+
+	Tokens TokensCommand `command:"tokens" description:"Create, list and revoke long-lived API tokens for automation"`
 
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
-	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
-	Watch   WatchCommand   `command:"watch"   alias:"w" description:"Stream a build's output"`
+	Execute  ExecuteCommand  `command:"execute"   alias:"e"  description:"Execute a one-off build using local bits"`
+	RunLocal RunLocalCommand `command:"run-local" alias:"rl" description:"Run a task or a job on the local machine, without a Concourse"`
+	Watch    WatchCommand    `command:"watch"     alias:"w"  description:"Stream a build's output"`
+
+	Dashboard DashboardCommand `command:"dashboard" alias:"db" description:"Show live job statuses full-screen and act on them with keybindings"`
 
 	Containers ContainersCommand `command:"containers" alias:"cs" description:"Print the active containers"`
 	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
@@ -54,8 +70,12 @@ type FlyCommand struct {
 	UnpausePipeline           UnpausePipelineCommand         `command:"unpause-pipeline"          alias:"up"   description:"Un-pause a pipeline"`
 	ExposePipeline            ExposePipelineCommand          `command:"expose-pipeline"           alias:"ep"   description:"Make a pipeline publicly viewable"`
 	HidePipeline              HidePipelineCommand            `command:"hide-pipeline"             alias:"hp"   description:"Hide a pipeline from the public"`
+	SharePipeline             SharePipelineCommand           `command:"share-pipeline"            alias:"shp"  description:"Share a pipeline read-only with other teams"`
 	RenamePipeline            RenamePipelineCommand          `command:"rename-pipeline"           alias:"rp"   description:"Rename a pipeline"`
+	PipelineHistory           PipelineHistoryCommand         `command:"pipeline-history"          alias:"ph"   description:"List the versions of a pipeline's config and what changed in each"`
+	RollbackPipeline          RollbackPipelineCommand        `command:"rollback-pipeline"         alias:"rbp"  description:"Restore a previous version of a pipeline's config"`
 	ValidatePipeline          ValidatePipelineCommand        `command:"validate-pipeline"         alias:"vp"   description:"Validate a pipeline config"`
+	TestPipeline              TestPipelineCommand            `command:"test-pipeline"             alias:"tp"   description:"Simulate builds of a pipeline's jobs and check which steps run"`
 	FormatPipeline            FormatPipelineCommand          `command:"format-pipeline"           alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines            OrderPipelinesCommand          `command:"order-pipelines"           alias:"op"   description:"Orders pipelines"`
 	OrderPipelinesWithinGroup OrderInstancedPipelinesCommand `command:"order-instanced-pipelines" alias:"oip"  description:"Orders instanced pipelines within an instance group"`
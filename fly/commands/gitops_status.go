@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type GitOpsStatusCommand struct {
+	Json bool                 `long:"json" description:"Print command result as JSON"`
+	Team flaghelpers.TeamFlag `long:"team" description:"Name of the team, if different from the target default"`
+}
+
+func (command *GitOpsStatusCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team.Name())
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	gitOps, found, err := team.GitOps()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("gitops is not enabled for team '%s'", team.Name())
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(gitOps)
+	}
+
+	fmt.Printf("type:     %s\n", gitOps.Config.Type)
+	fmt.Printf("manifest: %s\n", gitOps.Config.Path)
+	fmt.Printf("interval: %s\n", gitOps.Config.SyncInterval())
+
+	status := gitOps.Status
+	if status == nil {
+		fmt.Println("\nnot synced yet")
+		return nil
+	}
+
+	fmt.Println()
+
+	result := status.Result
+	switch result {
+	case atc.GitOpsSucceeded:
+		result = ui.SucceededColor.Sprint(result)
+	case atc.GitOpsFailed:
+		result = ui.FailedColor.Sprint(result)
+	case atc.GitOpsErrored:
+		result = ui.ErroredColor.Sprint(result)
+	case atc.GitOpsStarted:
+		result = ui.StartedColor.Sprint(result)
+	}
+
+	fmt.Printf("last sync: %s (build %d)\n", result, status.BuildID)
+
+	if status.FinishedAt != 0 {
+		fmt.Printf("finished:  %s\n", time.Unix(status.FinishedAt, 0).Format(time.RFC3339))
+	}
+
+	if len(status.Version) != 0 {
+		var version []string
+		for k, v := range status.Version {
+			version = append(version, k+":"+v)
+		}
+
+		sort.Strings(version)
+
+		fmt.Printf("version:   %s\n", strings.Join(version, ", "))
+	}
+
+	if status.Error != "" {
+		fmt.Printf("error:     %s\n", ui.FailedColor.Sprint(status.Error))
+	}
+
+	printGitOpsPipelines("created", status.Created)
+	printGitOpsPipelines("updated", status.Updated)
+	printGitOpsPipelines("archived", status.Archived)
+	printGitOpsPipelines("drifted", status.Drifted)
+
+	return nil
+}
+
+func printGitOpsPipelines(label string, pipelines []string) {
+	if len(pipelines) == 0 {
+		return
+	}
+
+	fmt.Printf("%-10s %s\n", label+":", strings.Join(pipelines, ", "))
+}
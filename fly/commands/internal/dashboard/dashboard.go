@@ -0,0 +1,437 @@
+// Package dashboard implements a full-screen terminal view of jobs and their
+// builds which updates live and lets the user act on them with keybindings.
+package dashboard
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+
+	help = "j/k: move  enter: logs  t: trigger  a: abort  p: pause/unpause  r: refresh  q: quit"
+
+	// lines taken up by everything but the jobs
+	chromeLines = 5
+)
+
+type Dashboard struct {
+	client   concourse.Client
+	out      io.Writer
+	pipeline *atc.PipelineRef
+	rows     int
+
+	jobs     []atc.Job
+	selected int
+	offset   int
+	status   string
+
+	log *buildLog
+}
+
+type buildLog struct {
+	events concourse.Events
+	writer *detachableWriter
+}
+
+func New(client concourse.Client, out io.Writer, pipeline *atc.PipelineRef, rows int) *Dashboard {
+	return &Dashboard{
+		client:   client,
+		out:      out,
+		pipeline: pipeline,
+		rows:     rows,
+	}
+}
+
+// Run refreshes the jobs on every tick and handles keys until the user quits
+// or the keys run out.
+func (d *Dashboard) Run(keys <-chan string, ticks <-chan time.Time, resizes <-chan int) error {
+	d.Refresh()
+
+	err := d.Render()
+	if err != nil {
+		return err
+	}
+
+	defer d.closeLog()
+
+	for {
+		select {
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+
+			quit, err := d.HandleKey(key)
+			if err != nil {
+				return err
+			}
+
+			if quit {
+				return nil
+			}
+
+		case <-ticks:
+			if d.log != nil {
+				continue
+			}
+
+			d.Refresh()
+
+			err := d.Render()
+			if err != nil {
+				return err
+			}
+
+		case rows := <-resizes:
+			d.rows = rows
+
+			if d.log == nil {
+				err := d.Render()
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// Refresh fetches the jobs, keeping the same job selected. Failures are shown
+// in the status line rather than ending the dashboard.
+func (d *Dashboard) Refresh() {
+	jobs, err := d.client.ListAllJobs()
+	if err != nil {
+		d.status = fmt.Sprintf("failed to fetch jobs: %s", err)
+		return
+	}
+
+	selectedID := 0
+	if job, ok := d.Selected(); ok {
+		selectedID = job.ID
+	}
+
+	d.jobs = nil
+	d.selected = 0
+
+	for _, job := range jobs {
+		if d.pipeline != nil && pipelineRef(job).String() != d.pipeline.String() {
+			continue
+		}
+
+		if job.ID == selectedID {
+			d.selected = len(d.jobs)
+		}
+
+		d.jobs = append(d.jobs, job)
+	}
+}
+
+func (d *Dashboard) Selected() (atc.Job, bool) {
+	if d.selected >= len(d.jobs) {
+		return atc.Job{}, false
+	}
+
+	return d.jobs[d.selected], true
+}
+
+// HandleKey performs the action bound to the key, returning true when the
+// user wants to quit.
+func (d *Dashboard) HandleKey(key string) (bool, error) {
+	if d.log != nil {
+		switch key {
+		case "q", "h", KeyEsc:
+			d.closeLog()
+			d.Refresh()
+			return false, d.Render()
+		}
+
+		return false, nil
+	}
+
+	switch key {
+	case "q":
+		return true, nil
+	case "j", KeyDown:
+		d.move(1)
+	case "k", KeyUp:
+		d.move(-1)
+	case "r":
+		d.status = ""
+		d.Refresh()
+	case "t":
+		d.trigger()
+	case "a":
+		d.abort()
+	case "p":
+		d.togglePause()
+	case "l", KeyEnter:
+		if d.openLog() {
+			return false, nil
+		}
+	}
+
+	return false, d.Render()
+}
+
+// Render draws the jobs, scrolled so that the selected job is visible.
+func (d *Dashboard) Render() error {
+	bold := color.New(color.Bold)
+
+	fmt.Fprint(d.out, clearScreen)
+	fmt.Fprintln(d.out, bold.Sprintf("%d jobs", len(d.jobs)))
+	fmt.Fprintln(d.out)
+
+	visible := d.rows - chromeLines
+	if visible < 1 {
+		visible = 1
+	}
+
+	if d.selected < d.offset {
+		d.offset = d.selected
+	} else if d.selected >= d.offset+visible {
+		d.offset = d.selected - visible + 1
+	}
+
+	end := d.offset + visible
+	if end > len(d.jobs) {
+		end = len(d.jobs)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: " ", Color: bold},
+			{Contents: "team", Color: bold},
+			{Contents: "name", Color: bold},
+			{Contents: "paused", Color: bold},
+			{Contents: "status", Color: bold},
+			{Contents: "next", Color: bold},
+		},
+	}
+
+	for i := d.offset; i < end; i++ {
+		job := d.jobs[i]
+
+		cursor := ui.TableCell{Contents: " "}
+		if i == d.selected {
+			cursor = ui.TableCell{Contents: ">", Color: bold}
+		}
+
+		pausedCell := ui.TableCell{Contents: "no"}
+		if job.Paused {
+			pausedCell = ui.TableCell{Contents: "yes", Color: ui.OnColor}
+		}
+
+		statusCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if job.FinishedBuild != nil {
+			statusCell = ui.BuildStatusCell(job.FinishedBuild.Status)
+		}
+
+		nextCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if job.NextBuild != nil {
+			nextCell = ui.BuildStatusCell(job.NextBuild.Status)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			cursor,
+			{Contents: job.TeamName},
+			{Contents: jobName(job)},
+			pausedCell,
+			statusCell,
+			nextCell,
+		})
+	}
+
+	err := table.Render(d.out, true)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(d.out)
+	fmt.Fprintln(d.out, d.status)
+	fmt.Fprint(d.out, ui.OffColor.Sprint(help))
+
+	return nil
+}
+
+func (d *Dashboard) move(delta int) {
+	d.selected += delta
+
+	if d.selected >= len(d.jobs) {
+		d.selected = len(d.jobs) - 1
+	}
+
+	if d.selected < 0 {
+		d.selected = 0
+	}
+}
+
+func (d *Dashboard) trigger() {
+	job, ok := d.Selected()
+	if !ok {
+		return
+	}
+
+	if job.DisableManualTrigger {
+		d.status = fmt.Sprintf("manual triggering is disabled for %s", jobName(job))
+		return
+	}
+
+	build, err := d.client.Team(job.TeamName).CreateJobBuild(pipelineRef(job), job.Name)
+	if err != nil {
+		d.status = fmt.Sprintf("failed to trigger %s: %s", jobName(job), err)
+		return
+	}
+
+	d.status = fmt.Sprintf("started %s #%s", jobName(job), build.Name)
+	d.Refresh()
+}
+
+func (d *Dashboard) abort() {
+	job, ok := d.Selected()
+	if !ok {
+		return
+	}
+
+	if job.NextBuild == nil {
+		d.status = fmt.Sprintf("%s has no running build", jobName(job))
+		return
+	}
+
+	err := d.client.AbortBuild(strconv.Itoa(job.NextBuild.ID))
+	if err != nil {
+		d.status = fmt.Sprintf("failed to abort %s #%s: %s", jobName(job), job.NextBuild.Name, err)
+		return
+	}
+
+	d.status = fmt.Sprintf("aborted %s #%s", jobName(job), job.NextBuild.Name)
+	d.Refresh()
+}
+
+func (d *Dashboard) togglePause() {
+	job, ok := d.Selected()
+	if !ok {
+		return
+	}
+
+	team := d.client.Team(job.TeamName)
+
+	var found bool
+	var err error
+	var action string
+	if job.Paused {
+		action = "unpause"
+		found, err = team.UnpauseJob(pipelineRef(job), job.Name)
+	} else {
+		action = "pause"
+		found, err = team.PauseJob(pipelineRef(job), job.Name)
+	}
+
+	switch {
+	case err != nil:
+		d.status = fmt.Sprintf("failed to %s %s: %s", action, jobName(job), err)
+	case !found:
+		d.status = fmt.Sprintf("%s not found", jobName(job))
+	default:
+		d.status = fmt.Sprintf("%sd %s", action, jobName(job))
+		d.Refresh()
+	}
+}
+
+// openLog streams the log of the selected job's current build, or its latest
+// build if none is running. It returns false if there is no log to show.
+func (d *Dashboard) openLog() bool {
+	job, ok := d.Selected()
+	if !ok {
+		return false
+	}
+
+	build := job.NextBuild
+	if build == nil {
+		build = job.FinishedBuild
+	}
+
+	if build == nil {
+		d.status = fmt.Sprintf("%s has no builds", jobName(job))
+		return false
+	}
+
+	events, err := d.client.BuildEvents(strconv.Itoa(build.ID))
+	if err != nil {
+		d.status = fmt.Sprintf("failed to stream the log of %s #%s: %s", jobName(job), build.Name, err)
+		return false
+	}
+
+	writer := &detachableWriter{w: d.out}
+	d.log = &buildLog{events: events, writer: writer}
+
+	fmt.Fprint(d.out, clearScreen)
+	fmt.Fprintln(d.out, color.New(color.Bold).Sprintf("%s #%s", jobName(job), build.Name)+ui.OffColor.Sprint("  (q: back)"))
+	fmt.Fprintln(d.out)
+
+	// the shared ui colors are toggled whenever a table is rendered, so the
+	// log has its own
+	faint := color.New(color.Faint)
+
+	go func() {
+		eventstream.Render(writer, events, eventstream.RenderOptions{})
+		fmt.Fprintln(writer, faint.Sprint("\nend of log (q: back)"))
+	}()
+
+	return true
+}
+
+func (d *Dashboard) closeLog() {
+	if d.log == nil {
+		return
+	}
+
+	d.log.writer.Detach()
+	_ = d.log.events.Close()
+	d.log = nil
+}
+
+func pipelineRef(job atc.Job) atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         job.PipelineName,
+		InstanceVars: job.PipelineInstanceVars,
+	}
+}
+
+func jobName(job atc.Job) string {
+	return pipelineRef(job).String() + "/" + job.Name
+}
+
+// detachableWriter stops writing to the terminal once the user leaves the
+// log, while the build's events may still be streaming.
+type detachableWriter struct {
+	w        io.Writer
+	detached bool
+	lock     sync.Mutex
+}
+
+func (w *detachableWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.detached {
+		return len(p), nil
+	}
+
+	return w.w.Write(p)
+}
+
+func (w *detachableWriter) Detach() {
+	w.lock.Lock()
+	w.detached = true
+	w.lock.Unlock()
+}
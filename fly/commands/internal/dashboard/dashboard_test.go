@@ -0,0 +1,275 @@
+package dashboard_test
+
+import (
+	"errors"
+	"io"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/fly/commands/internal/dashboard"
+	"github.com/concourse/concourse/go-concourse/concourse/concoursefakes"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeEvents struct {
+	events chan atc.Event
+	closed bool
+}
+
+func (e *fakeEvents) NextEvent() (atc.Event, error) {
+	ev, ok := <-e.events
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return ev, nil
+}
+
+func (e *fakeEvents) Close() error {
+	e.closed = true
+	return nil
+}
+
+var _ = Describe("Dashboard", func() {
+	var (
+		fakeClient *concoursefakes.FakeClient
+		fakeTeam   *concoursefakes.FakeTeam
+		out        *gbytes.Buffer
+		pipeline   *atc.PipelineRef
+
+		jobs []atc.Job
+
+		dash *dashboard.Dashboard
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(concoursefakes.FakeClient)
+		fakeTeam = new(concoursefakes.FakeTeam)
+		fakeClient.TeamReturns(fakeTeam)
+
+		out = gbytes.NewBuffer()
+		pipeline = nil
+
+		jobs = []atc.Job{
+			{
+				ID:            1,
+				Name:          "unit",
+				TeamName:      "main",
+				PipelineName:  "some-pipeline",
+				FinishedBuild: &atc.Build{ID: 10, Name: "3", Status: atc.StatusSucceeded},
+			},
+			{
+				ID:            2,
+				Name:          "deploy",
+				TeamName:      "main",
+				PipelineName:  "some-pipeline",
+				Paused:        true,
+				FinishedBuild: &atc.Build{ID: 11, Name: "1", Status: atc.StatusFailed},
+				NextBuild:     &atc.Build{ID: 12, Name: "2", Status: atc.StatusStarted},
+			},
+			{
+				ID:           3,
+				Name:         "other",
+				TeamName:     "other-team",
+				PipelineName: "other-pipeline",
+			},
+		}
+		fakeClient.ListAllJobsReturns(jobs, nil)
+	})
+
+	JustBeforeEach(func() {
+		dash = dashboard.New(fakeClient, out, pipeline, 20)
+		dash.Refresh()
+	})
+
+	Describe("Render", func() {
+		It("shows the jobs and their statuses", func() {
+			Expect(dash.Render()).To(Succeed())
+
+			Expect(out).To(gbytes.Say(`3 jobs`))
+			Expect(out).To(gbytes.Say(`>\s+main\s+some-pipeline/unit\s+no\s+succeeded\s+n/a`))
+			Expect(out).To(gbytes.Say(`\s+main\s+some-pipeline/deploy\s+yes\s+failed\s+started`))
+			Expect(out).To(gbytes.Say(`\s+other-team\s+other-pipeline/other\s+no\s+n/a\s+n/a`))
+			Expect(out).To(gbytes.Say(`q: quit`))
+		})
+
+		Context("when filtering by pipeline", func() {
+			BeforeEach(func() {
+				pipeline = &atc.PipelineRef{Name: "other-pipeline"}
+			})
+
+			It("only shows its jobs", func() {
+				Expect(dash.Render()).To(Succeed())
+
+				Expect(out).To(gbytes.Say(`1 jobs`))
+				Expect(out).ToNot(gbytes.Say(`some-pipeline`))
+			})
+		})
+
+		Context("when there are more jobs than fit on the screen", func() {
+			JustBeforeEach(func() {
+				dash = dashboard.New(fakeClient, out, pipeline, 6)
+				dash.Refresh()
+			})
+
+			It("scrolls to the selected job", func() {
+				_, err := dash.HandleKey("j")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = dash.HandleKey("j")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(out).To(gbytes.Say(`>\s+other-team`))
+				Expect(string(out.Contents()[len(out.Contents())-200:])).ToNot(ContainSubstring("some-pipeline/unit"))
+			})
+		})
+	})
+
+	Describe("moving the selection", func() {
+		It("moves down and up, staying within the jobs", func() {
+			for _, key := range []string{"j", dashboard.KeyDown, "j", "j"} {
+				_, err := dash.HandleKey(key)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			job, _ := dash.Selected()
+			Expect(job.Name).To(Equal("other"))
+
+			_, err := dash.HandleKey(dashboard.KeyUp)
+			Expect(err).ToNot(HaveOccurred())
+
+			job, _ = dash.Selected()
+			Expect(job.Name).To(Equal("deploy"))
+		})
+
+		It("keeps the selected job when refreshing", func() {
+			_, err := dash.HandleKey("j")
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClient.ListAllJobsReturns([]atc.Job{jobs[2], jobs[1], jobs[0]}, nil)
+			dash.Refresh()
+
+			job, _ := dash.Selected()
+			Expect(job.Name).To(Equal("deploy"))
+		})
+	})
+
+	Describe("triggering", func() {
+		It("creates a build of the selected job", func() {
+			fakeTeam.CreateJobBuildReturns(atc.Build{Name: "4"}, nil)
+
+			_, err := dash.HandleKey("t")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.TeamArgsForCall(0)).To(Equal("main"))
+			ref, name := fakeTeam.CreateJobBuildArgsForCall(0)
+			Expect(ref).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+			Expect(name).To(Equal("unit"))
+
+			Expect(out).To(gbytes.Say(`started some-pipeline/unit #4`))
+		})
+
+		It("shows failures in the status line", func() {
+			fakeTeam.CreateJobBuildReturns(atc.Build{}, errors.New("nope"))
+
+			quit, err := dash.HandleKey("t")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quit).To(BeFalse())
+
+			Expect(out).To(gbytes.Say(`failed to trigger some-pipeline/unit: nope`))
+		})
+	})
+
+	Describe("aborting", func() {
+		It("aborts the running build of the selected job", func() {
+			_, err := dash.HandleKey("j")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = dash.HandleKey("a")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.AbortBuildArgsForCall(0)).To(Equal("12"))
+			Expect(out).To(gbytes.Say(`aborted some-pipeline/deploy #2`))
+		})
+
+		It("does nothing when no build is running", func() {
+			_, err := dash.HandleKey("a")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.AbortBuildCallCount()).To(BeZero())
+			Expect(out).To(gbytes.Say(`some-pipeline/unit has no running build`))
+		})
+	})
+
+	Describe("pausing", func() {
+		It("pauses an unpaused job", func() {
+			fakeTeam.PauseJobReturns(true, nil)
+
+			_, err := dash.HandleKey("p")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeTeam.PauseJobCallCount()).To(Equal(1))
+			Expect(out).To(gbytes.Say(`paused some-pipeline/unit`))
+		})
+
+		It("unpauses a paused job", func() {
+			fakeTeam.UnpauseJobReturns(true, nil)
+
+			_, err := dash.HandleKey("j")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = dash.HandleKey("p")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeTeam.UnpauseJobCallCount()).To(Equal(1))
+			Expect(out).To(gbytes.Say(`unpaused some-pipeline/deploy`))
+		})
+	})
+
+	Describe("showing the log", func() {
+		var events *fakeEvents
+
+		BeforeEach(func() {
+			events = &fakeEvents{events: make(chan atc.Event, 1)}
+			fakeClient.BuildEventsReturns(events, nil)
+		})
+
+		It("streams the log of the running build until the user goes back", func() {
+			_, err := dash.HandleKey("j")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dash.HandleKey(dashboard.KeyEnter)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.BuildEventsArgsForCall(0)).To(Equal("12"))
+			Expect(out).To(gbytes.Say(`some-pipeline/deploy #2`))
+
+			events.events <- event.Log{Payload: "hello from the build\n"}
+			Eventually(out).Should(gbytes.Say(`hello from the build`))
+
+			quit, err := dash.HandleKey("q")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quit).To(BeFalse())
+			Expect(events.closed).To(BeTrue())
+			Expect(out).To(gbytes.Say(`3 jobs`))
+
+			close(events.events)
+		})
+
+		It("shows the latest build when none is running", func() {
+			_, err := dash.HandleKey("l")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.BuildEventsArgsForCall(0)).To(Equal("10"))
+			close(events.events)
+		})
+	})
+
+	Describe("quitting", func() {
+		It("quits on q", func() {
+			quit, err := dash.HandleKey("q")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quit).To(BeTrue())
+		})
+	})
+})
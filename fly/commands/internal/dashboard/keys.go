@@ -0,0 +1,60 @@
+package dashboard
+
+import (
+	"io"
+)
+
+const (
+	KeyUp    = "up"
+	KeyDown  = "down"
+	KeyEnter = "enter"
+	KeyEsc   = "esc"
+)
+
+// ParseKeys splits input read from a terminal into keys, translating the
+// escape sequences sent for arrow keys. Printable keys are returned as-is.
+func ParseKeys(input []byte) []string {
+	var keys []string
+
+	for i := 0; i < len(input); i++ {
+		switch b := input[i]; b {
+		case '\x1b':
+			if i+2 < len(input) && (input[i+1] == '[' || input[i+1] == 'O') {
+				switch input[i+2] {
+				case 'A':
+					keys = append(keys, KeyUp)
+				case 'B':
+					keys = append(keys, KeyDown)
+				}
+
+				i += 2
+				continue
+			}
+
+			keys = append(keys, KeyEsc)
+		case '\r', '\n':
+			keys = append(keys, KeyEnter)
+		default:
+			keys = append(keys, string(b))
+		}
+	}
+
+	return keys
+}
+
+// ReadKeys sends the keys read from the input until it is closed.
+func ReadKeys(input io.Reader, keys chan<- string) {
+	buf := make([]byte, 32)
+
+	for {
+		n, err := input.Read(buf)
+		for _, key := range ParseKeys(buf[:n]) {
+			keys <- key
+		}
+
+		if err != nil {
+			close(keys)
+			return
+		}
+	}
+}
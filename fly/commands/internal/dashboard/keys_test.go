@@ -0,0 +1,29 @@
+package dashboard_test
+
+import (
+	"github.com/concourse/concourse/fly/commands/internal/dashboard"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseKeys", func() {
+	It("returns printable keys as-is", func() {
+		Expect(dashboard.ParseKeys([]byte("jkq"))).To(Equal([]string{"j", "k", "q"}))
+	})
+
+	It("translates arrow keys", func() {
+		Expect(dashboard.ParseKeys([]byte("\x1b[A\x1b[Bj"))).To(Equal([]string{
+			dashboard.KeyUp,
+			dashboard.KeyDown,
+			"j",
+		}))
+	})
+
+	It("translates enter and escape", func() {
+		Expect(dashboard.ParseKeys([]byte("\r\x1b"))).To(Equal([]string{
+			dashboard.KeyEnter,
+			dashboard.KeyEsc,
+		}))
+	})
+})
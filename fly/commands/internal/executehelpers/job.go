@@ -0,0 +1,120 @@
+package executehelpers
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/builds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+)
+
+// CreateJobBuildPlan plans the job with the ATC's planner, then replaces get
+// steps which have a local input with the uploaded artifact and, unless
+// includePuts is set, skips put and set_pipeline steps. The remaining get
+// steps fetch the versions the job's next build would use.
+func CreateJobBuildPlan(
+	fact atc.PlanFactory,
+	config atc.Config,
+	job atc.JobConfig,
+	localInputs map[string]Input,
+	buildInputs []atc.BuildInput,
+	versionedResourceTypes atc.VersionedResourceTypes,
+	includePuts bool,
+) (atc.Plan, error) {
+	var resources db.SchedulerResources
+	for _, resource := range config.Resources {
+		resources = append(resources, db.SchedulerResource{
+			Name:                 resource.Name,
+			Type:                 resource.Type,
+			Source:               resource.Source,
+			ExposeBuildCreatedBy: resource.ExposeBuildCreatedBy,
+		})
+	}
+
+	var inputs []db.BuildInput
+	for _, jobInput := range job.Inputs() {
+		// replaced by the local input once planned
+		if _, found := localInputs[jobInput.Name]; found {
+			inputs = append(inputs, db.BuildInput{
+				Name:    jobInput.Name,
+				Version: atc.Version{},
+			})
+
+			continue
+		}
+
+		var version atc.Version
+		for _, input := range buildInputs {
+			if input.Name == jobInput.Name {
+				version = input.Version
+				break
+			}
+		}
+
+		if version == nil {
+			return atc.Plan{}, fmt.Errorf("no version available for `%s`; provide it with --input %s=PATH", jobInput.Name, jobInput.Name)
+		}
+
+		inputs = append(inputs, db.BuildInput{
+			Name:    jobInput.Name,
+			Version: version,
+		})
+	}
+
+	plan, err := builds.NewPlanner(fact).Create(
+		job.StepConfig(),
+		resources,
+		versionedResourceTypes,
+		config.Prototypes,
+		inputs,
+	)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	plan.Each(func(p *atc.Plan) {
+		switch {
+		case p.Get != nil && p.Get.VersionFrom == nil:
+			if input, found := localInputs[p.Get.Name]; found {
+				*p = atc.Plan{
+					ID: p.ID,
+					ArtifactInput: &atc.ArtifactInputPlan{
+						ArtifactID: input.Plan.ArtifactInput.ArtifactID,
+						Name:       p.Get.Name,
+					},
+				}
+			}
+
+		case includePuts:
+
+		// puts are planned along with a get of the version they created
+		case p.OnSuccess != nil && p.OnSuccess.Step.Put != nil,
+			p.Put != nil,
+			p.SetPipeline != nil:
+			*p = atc.Plan{ID: p.ID, Do: &atc.DoPlan{}}
+		}
+	})
+
+	return plan, nil
+}
+
+// CheckForUnknownJobInputs makes sure all local inputs replace a get step of
+// the job.
+func CheckForUnknownJobInputs(inputMappings []flaghelpers.InputPairFlag, job atc.JobConfig) error {
+	for _, input := range inputMappings {
+		found := false
+		for _, jobInput := range job.Inputs() {
+			if jobInput.Name == input.Name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("unknown input `%s`", input.Name)
+		}
+	}
+
+	return nil
+}
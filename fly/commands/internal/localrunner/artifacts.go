@@ -0,0 +1,89 @@
+package localrunner
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// Artifacts are local directories, identified by their path. The artifact
+// sourcer hands them to the worker client as they are, rather than finding or
+// streaming volumes.
+type artifactSourcer struct {
+	cachesDir string
+}
+
+func (sourcer artifactSourcer) SourceInputsAndCaches(logger lager.Logger, teamID int, inputMap map[string]runtime.Artifact) ([]worker.InputSource, error) {
+	var inputs []worker.InputSource
+	for path, artifact := range inputMap {
+		dir := artifact.ID()
+
+		if cache, ok := artifact.(*runtime.CacheArtifact); ok {
+			dir = filepath.Join(sourcer.cachesDir, fmt.Sprintf("%x", sha1.Sum([]byte(cache.ID()))))
+
+			err := os.MkdirAll(dir, 0755)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		inputs = append(inputs, inputSource{
+			source: artifactSource{dir: dir},
+			path:   path,
+		})
+	}
+
+	return inputs, nil
+}
+
+func (sourcer artifactSourcer) SourceImage(logger lager.Logger, imageArtifact runtime.Artifact) (worker.StreamableArtifactSource, error) {
+	return artifactSource{dir: imageArtifact.ID()}, nil
+}
+
+type artifactStreamer struct{}
+
+func (artifactStreamer) StreamFileFromArtifact(ctx context.Context, artifact runtime.Artifact, path string) (io.ReadCloser, error) {
+	return artifactSource{dir: artifact.ID()}.StreamFile(ctx, path)
+}
+
+type inputSource struct {
+	source artifactSource
+	path   string
+}
+
+func (src inputSource) Source() worker.ArtifactSource {
+	return src.source
+}
+
+func (src inputSource) DestinationPath() string {
+	return src.path
+}
+
+type artifactSource struct {
+	dir string
+}
+
+func (source artifactSource) ExistsOn(lager.Logger, worker.Worker) (worker.Volume, bool, error) {
+	return nil, false, nil
+}
+
+func (source artifactSource) StreamTo(context.Context, worker.ArtifactDestination) error {
+	return errors.New("local artifacts are not streamed")
+}
+
+func (source artifactSource) StreamFile(ctx context.Context, path string) (io.ReadCloser, error) {
+	file, err := os.Open(filepath.Join(source.dir, path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, runtime.FileNotFoundError{Path: path}
+	}
+
+	return file, err
+}
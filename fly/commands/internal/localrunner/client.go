@@ -0,0 +1,223 @@
+package localrunner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/image"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+const workerName = "local"
+
+// client is the worker every step runs on. Its volumes are directories
+// created in volumesDir.
+type client struct {
+	runtime    Runtime
+	volumesDir string
+}
+
+func (client *client) Name() string {
+	return workerName
+}
+
+func (client *client) Worker() worker.Worker {
+	return nil
+}
+
+func (client *client) RunCheckStep(context.Context, db.ContainerOwner, worker.ContainerSpec, db.ContainerMetadata, runtime.ProcessSpec, runtime.StartingEventDelegate, resource.Resource) (worker.CheckResult, error) {
+	return worker.CheckResult{}, UnsupportedStepError{"check"}
+}
+
+func (client *client) RunPutStep(context.Context, db.ContainerOwner, worker.ContainerSpec, db.ContainerMetadata, runtime.ProcessSpec, runtime.StartingEventDelegate, resource.Resource) (worker.PutResult, error) {
+	return worker.PutResult{}, UnsupportedStepError{"put"}
+}
+
+func (client *client) RunGetStep(context.Context, db.ContainerOwner, worker.ContainerSpec, db.ContainerMetadata, runtime.ProcessSpec, runtime.StartingEventDelegate, db.UsedResourceCache, resource.Resource) (worker.GetResult, error) {
+	return worker.GetResult{}, UnsupportedStepError{"get"}
+}
+
+func (client *client) RunTaskStep(
+	ctx context.Context,
+	owner db.ContainerOwner,
+	containerSpec worker.ContainerSpec,
+	metadata db.ContainerMetadata,
+	processSpec runtime.ProcessSpec,
+	eventDelegate runtime.StartingEventDelegate,
+) (worker.TaskResult, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	handle, err := uuid.NewV4()
+	if err != nil {
+		return worker.TaskResult{}, err
+	}
+
+	spec := ContainerSpec{
+		Handle:     handle.String(),
+		Privileged: containerSpec.ImageSpec.Privileged,
+		User:       containerSpec.User,
+	}
+
+	imageMetadata, err := client.image(ctx, containerSpec.ImageSpec, &spec)
+	if err != nil {
+		return worker.TaskResult{}, err
+	}
+
+	spec.Env = append(imageMetadata.Env, containerSpec.Env...)
+	if spec.User == "" {
+		spec.User = imageMetadata.User
+	}
+
+	inputs := map[string]string{}
+	for _, input := range containerSpec.Inputs {
+		source, ok := input.Source().(artifactSource)
+		if !ok {
+			return worker.TaskResult{}, fmt.Errorf("input %s is not a local directory", input.DestinationPath())
+		}
+
+		inputs[filepath.Clean(input.DestinationPath())] = source.dir
+
+		spec.Mounts = append(spec.Mounts, Mount{
+			Src: source.dir,
+			Dst: input.DestinationPath(),
+		})
+	}
+
+	var volumeMounts []worker.VolumeMount
+	for _, outputPath := range containerSpec.Outputs {
+		// outputs which are also inputs are written to the input's directory
+		dir, found := inputs[filepath.Clean(outputPath)]
+		if !found {
+			dir, err = client.createVolume()
+			if err != nil {
+				return worker.TaskResult{}, err
+			}
+
+			spec.Mounts = append(spec.Mounts, Mount{
+				Src: dir,
+				Dst: outputPath,
+			})
+		}
+
+		volume := new(workerfakes.FakeVolume)
+		volume.HandleReturns(dir)
+
+		volumeMounts = append(volumeMounts, worker.VolumeMount{
+			Volume:    volume,
+			MountPath: outputPath,
+		})
+	}
+
+	eventDelegate.Starting(logger)
+
+	exitStatus, err := client.runtime.Run(ctx, spec, ProcessSpec{
+		Path:   processSpec.Path,
+		Args:   processSpec.Args,
+		Dir:    path.Join(metadata.WorkingDirectory, processSpec.Dir),
+		Stdout: processSpec.StdoutWriter,
+		Stderr: processSpec.StderrWriter,
+	})
+	if err != nil {
+		return worker.TaskResult{}, err
+	}
+
+	return worker.TaskResult{
+		ExitStatus:   exitStatus,
+		VolumeMounts: volumeMounts,
+	}, nil
+}
+
+// image sets the root filesystem of the container from the image spec,
+// returning the image's metadata.
+func (client *client) image(ctx context.Context, imageSpec worker.ImageSpec, spec *ContainerSpec) (worker.ImageMetadata, error) {
+	var dir string
+
+	switch {
+	case imageSpec.ImageArtifactSource != nil:
+		source, ok := imageSpec.ImageArtifactSource.(artifactSource)
+		if !ok {
+			return worker.ImageMetadata{}, errors.New("image is not a local directory")
+		}
+
+		dir = source.dir
+
+	case strings.HasPrefix(imageSpec.ImageURL, "raw://"):
+		spec.RootFSPath = strings.TrimPrefix(imageSpec.ImageURL, "raw://")
+		return worker.ImageMetadata{}, nil
+
+	case strings.HasPrefix(imageSpec.ImageURL, "docker://"):
+		repository := strings.TrimPrefix(strings.TrimPrefix(imageSpec.ImageURL, "docker://"), "/")
+
+		source := atc.Source{"repository": repository}
+		if i := strings.LastIndex(repository, "#"); i != -1 {
+			source = atc.Source{"repository": repository[:i], "tag": repository[i+1:]}
+		}
+
+		var err error
+		dir, err = client.createVolume()
+		if err != nil {
+			return worker.ImageMetadata{}, err
+		}
+
+		err = client.runtime.FetchImage(ctx, ioutil.Discard, source, dir)
+		if err != nil {
+			return worker.ImageMetadata{}, err
+		}
+
+	case imageSpec.ImageURL != "":
+		return worker.ImageMetadata{}, fmt.Errorf("unsupported rootfs_uri: %s", imageSpec.ImageURL)
+
+	default:
+		return worker.ImageMetadata{}, nil
+	}
+
+	// images fetched by resources have their root filesystem in a rootfs
+	// directory, alongside their metadata; other artifacts are used as they
+	// are
+	rootfs := filepath.Join(dir, "rootfs")
+	if _, err := os.Stat(rootfs); err != nil {
+		spec.RootFSPath = dir
+		return worker.ImageMetadata{}, nil
+	}
+
+	spec.RootFSPath = rootfs
+
+	var metadata worker.ImageMetadata
+
+	payload, err := ioutil.ReadFile(filepath.Join(dir, image.ImageMetadataFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return metadata, nil
+	} else if err != nil {
+		return worker.ImageMetadata{}, err
+	}
+
+	err = json.Unmarshal(payload, &metadata)
+	if err != nil {
+		return worker.ImageMetadata{}, fmt.Errorf("malformed image metadata: %w", err)
+	}
+
+	return metadata, nil
+}
+
+func (client *client) createVolume() (string, error) {
+	err := os.MkdirAll(client.volumesDir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	return ioutil.TempDir(client.volumesDir, "volume-")
+}
@@ -0,0 +1,251 @@
+// +build linux
+
+package localrunner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/image"
+	"github.com/concourse/concourse/worker/runtime"
+	"github.com/concourse/concourse/worker/runtime/libcontainerd"
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/archive"
+	"github.com/containerd/containerd/archive/compression"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/reference/docker"
+	"github.com/containerd/containerd/remotes"
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const containerdNamespace = "fly"
+
+const containerdRequestTimeout = 5 * time.Minute
+
+// NewContainerdRuntime returns a Runtime which runs processes in containers
+// of the containerd daemon listening on address, like a worker using the
+// containerd runtime does.
+func NewContainerdRuntime(config ContainerdConfig) (Runtime, error) {
+	network, err := runtime.NewCNINetwork(runtime.WithCNIBinariesDir(config.CNIPluginsDir))
+	if err != nil {
+		return nil, fmt.Errorf("new cni network: %w", err)
+	}
+
+	backend, err := runtime.NewGardenBackend(
+		libcontainerd.New(config.Address, containerdNamespace, containerdRequestTimeout),
+		runtime.WithNetwork(network),
+		runtime.WithRequestTimeout(containerdRequestTimeout),
+		runtime.WithInitBinPath(config.InitBin),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("containerd backend: %w", err)
+	}
+
+	err = backend.Start()
+	if err != nil {
+		return nil, fmt.Errorf("start containerd backend: %w", err)
+	}
+
+	client, err := containerd.New(config.Address, containerd.WithDefaultNamespace(containerdNamespace))
+	if err != nil {
+		return nil, fmt.Errorf("containerd client: %w", err)
+	}
+
+	return &containerdRuntime{
+		backend: &backend,
+		client:  client,
+	}, nil
+}
+
+type containerdRuntime struct {
+	backend *runtime.GardenBackend
+	client  *containerd.Client
+}
+
+func (r *containerdRuntime) Run(ctx context.Context, spec ContainerSpec, process ProcessSpec) (int, error) {
+	if spec.RootFSPath == "" {
+		return 0, errors.New("containers need an image; configure the task with image_resource, rootfs_uri or an image artifact")
+	}
+
+	bindMounts := []garden.BindMount{}
+	for _, mount := range spec.Mounts {
+		bindMounts = append(bindMounts, garden.BindMount{
+			SrcPath: mount.Src,
+			DstPath: mount.Dst,
+			Mode:    garden.BindMountModeRW,
+			Origin:  garden.BindMountOriginHost,
+		})
+	}
+
+	container, err := r.backend.Create(garden.ContainerSpec{
+		Handle:     spec.Handle,
+		RootFSPath: "raw://" + spec.RootFSPath,
+		Env:        spec.Env,
+		Privileged: spec.Privileged,
+		BindMounts: bindMounts,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	defer r.backend.Destroy(spec.Handle)
+
+	proc, err := container.Run(garden.ProcessSpec{
+		Path: process.Path,
+		Args: process.Args,
+		Dir:  process.Dir,
+		User: spec.User,
+	}, garden.ProcessIO{
+		Stdout: process.Stdout,
+		Stderr: process.Stderr,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	type result struct {
+		status int
+		err    error
+	}
+
+	exited := make(chan result, 1)
+	go func() {
+		status, err := proc.Wait()
+		exited <- result{status, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		_ = container.Stop(false)
+		<-exited
+		return 0, ctx.Err()
+
+	case res := <-exited:
+		return res.status, res.err
+	}
+}
+
+// FetchImage pulls the image of the resource from its registry, extracting
+// its layers into the rootfs directory.
+func (r *containerdRuntime) FetchImage(ctx context.Context, stdout io.Writer, source atc.Source, dir string) error {
+	repository, _ := source["repository"].(string)
+	if repository == "" {
+		return errors.New("image source must specify a repository")
+	}
+
+	tag, _ := source["tag"].(string)
+	if tag == "" {
+		tag = "latest"
+	}
+
+	named, err := docker.ParseDockerRef(repository + ":" + tag)
+	if err != nil {
+		return err
+	}
+
+	ref := named.String()
+
+	fmt.Fprintf(stdout, "fetching %s\n", ref)
+
+	ctx = namespaces.WithNamespace(ctx, containerdNamespace)
+
+	img, err := r.client.Fetch(ctx, ref,
+		containerd.WithResolver(resolver(source)),
+		containerd.WithPlatformMatcher(platforms.Default()),
+	)
+	if err != nil {
+		return fmt.Errorf("fetch %s: %w", ref, err)
+	}
+
+	store := r.client.ContentStore()
+
+	manifest, err := images.Manifest(ctx, store, img.Target, platforms.Default())
+	if err != nil {
+		return err
+	}
+
+	rootfs := filepath.Join(dir, "rootfs")
+
+	err = os.MkdirAll(rootfs, 0755)
+	if err != nil {
+		return err
+	}
+
+	for _, layer := range manifest.Layers {
+		err := applyLayer(ctx, store, layer, rootfs)
+		if err != nil {
+			return fmt.Errorf("apply layer %s: %w", layer.Digest, err)
+		}
+	}
+
+	payload, err := content.ReadBlob(ctx, store, manifest.Config)
+	if err != nil {
+		return err
+	}
+
+	var config ocispec.Image
+	err = json.Unmarshal(payload, &config)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := json.Marshal(worker.ImageMetadata{
+		Env:  config.Config.Env,
+		User: config.Config.User,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, image.ImageMetadataFile), metadata, 0644)
+}
+
+func applyLayer(ctx context.Context, store content.Store, layer ocispec.Descriptor, rootfs string) error {
+	ra, err := store.ReaderAt(ctx, layer)
+	if err != nil {
+		return err
+	}
+
+	defer ra.Close()
+
+	stream, err := compression.DecompressStream(content.NewReader(ra))
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	_, err = archive.Apply(ctx, rootfs, stream)
+	return err
+}
+
+func resolver(source atc.Source) remotes.Resolver {
+	username, _ := source["username"].(string)
+	password, _ := source["password"].(string)
+
+	authorizer := dockerremote.NewDockerAuthorizer(
+		dockerremote.WithAuthCreds(func(string) (string, string, error) {
+			return username, password, nil
+		}),
+	)
+
+	return dockerremote.NewResolver(dockerremote.ResolverOptions{
+		Hosts: dockerremote.ConfigureDefaultRegistries(
+			dockerremote.WithAuthorizer(authorizer),
+		),
+	})
+}
@@ -0,0 +1,11 @@
+// +build !linux
+
+package localrunner
+
+import "errors"
+
+// NewContainerdRuntime returns an error, as containerd is only supported on
+// Linux.
+func NewContainerdRuntime(config ContainerdConfig) (Runtime, error) {
+	return nil, errors.New("the containerd runtime is only supported on linux")
+}
@@ -0,0 +1,13 @@
+package localrunner_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLocalRunner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Local Runner Suite")
+}
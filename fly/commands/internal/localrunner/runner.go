@@ -0,0 +1,303 @@
+// Package localrunner runs the steps of a job or a task on the local machine,
+// without an ATC or workers, for developing pipelines offline.
+//
+// Plans are run by the atc/exec engine, with in-memory stand-ins for the
+// build and the worker pool. Tasks are run by a Runtime: either as processes
+// on the host, or in containers of a local containerd daemon. Local
+// directories stand in for the resources of get steps, and steps which change
+// things outside of the build, such as puts, are skipped.
+package localrunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/builds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/vars"
+)
+
+const teamName = "main"
+
+// Plan plans the step as a build of the pipeline, with the resources and
+// resource types of its config.
+func Plan(step atc.StepConfig, config atc.Config) (atc.Plan, error) {
+	var resources db.SchedulerResources
+	for _, resource := range config.Resources {
+		resources = append(resources, db.SchedulerResource{
+			Name:                 resource.Name,
+			Type:                 resource.Type,
+			Source:               resource.Source,
+			ExposeBuildCreatedBy: resource.ExposeBuildCreatedBy,
+		})
+	}
+
+	var resourceTypes atc.VersionedResourceTypes
+	for _, resourceType := range config.ResourceTypes {
+		resourceTypes = append(resourceTypes, atc.VersionedResourceType{
+			ResourceType: resourceType,
+		})
+	}
+
+	var inputs []db.BuildInput
+	_ = step.Visit(atc.StepRecursor{
+		OnGet: func(step *atc.GetStep) error {
+			inputs = append(inputs, db.BuildInput{
+				Name:    step.Name,
+				Version: atc.Version{},
+			})
+
+			return nil
+		},
+	})
+
+	return builds.NewPlanner(atc.NewPlanFactory(time.Now().Unix())).Create(
+		step,
+		resources,
+		resourceTypes,
+		config.Prototypes,
+		inputs,
+	)
+}
+
+type Runner struct {
+	runtime   Runtime
+	workDir   string
+	variables vars.Variables
+	inputs    map[string]string
+	outputs   map[string]string
+
+	events chan atc.Event
+}
+
+// New returns a Runner which runs tasks with the runtime, keeping its
+// volumes in workDir. Variables stand in for the credentials of the build.
+// Inputs map the names of get steps or task inputs to local directories.
+// Outputs map the names of artifacts to the local directories they should be
+// copied to once the run has finished.
+func New(runtime Runtime, workDir string, variables vars.Variables, inputs map[string]string, outputs map[string]string) *Runner {
+	return &Runner{
+		runtime:   runtime,
+		workDir:   workDir,
+		variables: variables,
+		inputs:    inputs,
+		outputs:   outputs,
+		events:    make(chan atc.Event, 100),
+	}
+}
+
+// Events returns the stream of events emitted while running, in the same
+// form as the events of a build.
+func (r *Runner) Events() eventstream.EventStream {
+	return &eventStream{events: r.events}
+}
+
+// Run runs the plan, ending the event stream with the status of the run.
+func (r *Runner) Run(ctx context.Context, plan atc.Plan) {
+	defer close(r.events)
+
+	r.emit(event.Status{Status: atc.StatusStarted, Time: time.Now().Unix()})
+
+	status := atc.StatusSucceeded
+
+	ok, err := r.run(ctx, plan)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			status = atc.StatusAborted
+		} else {
+			status = atc.StatusErrored
+		}
+	} else if !ok {
+		status = atc.StatusFailed
+	}
+
+	r.emit(event.Status{Status: status, Time: time.Now().Unix()})
+}
+
+func (r *Runner) run(ctx context.Context, plan atc.Plan) (bool, error) {
+	inputs := map[string]string{}
+	for name, dir := range r.inputs {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return false, r.errored(err)
+		}
+
+		inputs[name] = abs
+	}
+
+	client := &client{
+		runtime:    r.runtime,
+		volumesDir: filepath.Join(r.workDir, "volumes"),
+	}
+
+	pool := new(workerfakes.FakePool)
+	pool.SelectWorkerReturns(client, 0, nil)
+
+	sourcer := artifactSourcer{
+		cachesDir: filepath.Join(r.workDir, "caches"),
+	}
+
+	factory := &stepFactory{
+		pool:             pool,
+		artifactStreamer: artifactStreamer{},
+		artifactSourcer:  sourcer,
+		teamFactory:      newTeamFactory(),
+		runtime:          r.runtime,
+		inputs:           inputs,
+		imagesDir:        filepath.Join(r.workDir, "images"),
+	}
+
+	stepper, err := engine.NewStepperFactory(
+		factory,
+		"",
+		nil,
+		policy.NoopChecker{},
+		sourcer,
+		nil,
+		nil,
+	).StepperForBuild(r.build())
+	if err != nil {
+		return false, r.errored(err)
+	}
+
+	state := exec.NewRunState(stepper, r.variables, false)
+
+	for name, dir := range inputs {
+		state.ArtifactRepository().RegisterArtifact(
+			build.ArtifactName(name),
+			runtime.GetArtifact{VolumeHandle: dir},
+		)
+	}
+
+	ok, err := state.Run(ctx, plan)
+
+	outputErr := r.writeOutputs(state.ArtifactRepository())
+	if err == nil && outputErr != nil {
+		return false, r.errored(outputErr)
+	}
+
+	return ok, err
+}
+
+// build is the in-memory build whose events are sent to the event stream.
+func (r *Runner) build() db.Build {
+	build := new(dbfakes.FakeBuild)
+	build.NameReturns("local")
+	build.TeamNameReturns(teamName)
+	build.SchemaReturns("exec.v2")
+	build.TracingAttrsStub = func() tracing.Attrs {
+		return tracing.Attrs{}
+	}
+	build.SaveEventStub = func(ev atc.Event) error {
+		r.emit(ev)
+		return nil
+	}
+
+	return build
+}
+
+// writeOutputs copies the artifacts given as outputs to their directories.
+func (r *Runner) writeOutputs(repository *build.Repository) error {
+	for name, dst := range r.outputs {
+		artifact, found := repository.ArtifactFor(build.ArtifactName(name))
+		if !found {
+			continue
+		}
+
+		abs, err := filepath.Abs(dst)
+		if err != nil {
+			return err
+		}
+
+		// inputs given as outputs are already in place
+		if abs == artifact.ID() {
+			continue
+		}
+
+		err = copyDir(artifact.ID(), abs)
+		if err != nil {
+			return fmt.Errorf("write output %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Runner) errored(err error) error {
+	r.emit(event.Error{Message: err.Error(), Time: time.Now().Unix()})
+	return err
+}
+
+func (r *Runner) emit(ev atc.Event) {
+	r.events <- ev
+}
+
+type eventStream struct {
+	events <-chan atc.Event
+}
+
+func (s *eventStream) NextEvent() (atc.Event, error) {
+	ev, ok := <-s.events
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return ev, nil
+}
+
+func (s *eventStream) Close() error {
+	return nil
+}
+
+func copyDir(src string, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+
+			return os.Symlink(link, target)
+
+		default:
+			payload, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			return ioutil.WriteFile(target, payload, info.Mode().Perm())
+		}
+	})
+}
@@ -0,0 +1,345 @@
+package localrunner_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/fly/commands/internal/localrunner"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Runner", func() {
+	var (
+		workDir  string
+		inputDir string
+		outputs  map[string]string
+
+		step   atc.StepConfig
+		config atc.Config
+		ctx    context.Context
+
+		events []atc.Event
+	)
+
+	BeforeEach(func() {
+		var err error
+		workDir, err = ioutil.TempDir("", "local-runner-work")
+		Expect(err).NotTo(HaveOccurred())
+
+		inputDir, err = ioutil.TempDir("", "local-runner-input")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(inputDir, "some-file"), []byte("some-content"), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		outputs = map[string]string{}
+		config = atc.Config{}
+		ctx = context.Background()
+
+		os.Setenv("SOME_HOST_SECRET", "some-secret")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+		os.RemoveAll(inputDir)
+		os.Unsetenv("SOME_HOST_SECRET")
+	})
+
+	JustBeforeEach(func() {
+		plan, err := localrunner.Plan(step, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		runner := localrunner.New(
+			localrunner.NewProcessRuntime(filepath.Join(workDir, "containers")),
+			workDir,
+			vars.StaticVariables{"some-var": "some-var-value"},
+			map[string]string{"some-input": inputDir},
+			outputs,
+		)
+
+		go runner.Run(ctx, plan)
+
+		stream := runner.Events()
+
+		events = nil
+		for {
+			ev, err := stream.NextEvent()
+			if err == io.EOF {
+				break
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			events = append(events, ev)
+		}
+	})
+
+	task := func(name string, script string) *atc.TaskStep {
+		return &atc.TaskStep{
+			Name: name,
+			Config: &atc.TaskConfig{
+				Platform: "linux",
+				Inputs:   []atc.TaskInputConfig{{Name: "some-input", Optional: true}},
+				Outputs:  []atc.TaskOutputConfig{{Name: "some-output"}},
+				Params:   atc.TaskEnv{"SOME_PARAM": "some-value"},
+				Run: atc.TaskRunConfig{
+					Path: "sh",
+					Args: []string{"-c", script},
+				},
+			},
+		}
+	}
+
+	logs := func() string {
+		var output string
+		for _, ev := range events {
+			if log, ok := ev.(event.Log); ok {
+				output += log.Payload
+			}
+		}
+
+		return output
+	}
+
+	errorMessages := func() []string {
+		var messages []string
+		for _, ev := range events {
+			if e, ok := ev.(event.Error); ok {
+				messages = append(messages, e.Message)
+			}
+		}
+
+		return messages
+	}
+
+	finalStatus := func() atc.BuildStatus {
+		Expect(events).ToNot(BeEmpty())
+		status, ok := events[len(events)-1].(event.Status)
+		Expect(ok).To(BeTrue())
+		return status.Status
+	}
+
+	Context("when running a task", func() {
+		BeforeEach(func() {
+			step = task("some-task", `cat some-input/some-file && echo " $SOME_PARAM" && echo hello > some-output/file`)
+			outputs["some-output"] = filepath.Join(workDir, "exported")
+		})
+
+		It("runs it with its inputs and params and succeeds", func() {
+			Expect(logs()).To(ContainSubstring("some-content some-value"))
+			Expect(finalStatus()).To(Equal(atc.StatusSucceeded))
+		})
+
+		It("writes the outputs to the given directories", func() {
+			Expect(ioutil.ReadFile(filepath.Join(workDir, "exported", "file"))).To(Equal([]byte("hello\n")))
+		})
+
+		It("emits the task lifecycle events", func() {
+			Expect(events[0]).To(Equal(event.Status{Status: atc.StatusStarted, Time: events[0].(event.Status).Time}))
+			Expect(events).To(ContainElement(BeAssignableToTypeOf(event.InitializeTask{})))
+			Expect(events).To(ContainElement(BeAssignableToTypeOf(event.SelectedWorker{})))
+			Expect(events).To(ContainElement(BeAssignableToTypeOf(event.StartTask{})))
+
+			var finish event.FinishTask
+			for _, ev := range events {
+				if f, ok := ev.(event.FinishTask); ok {
+					finish = f
+				}
+			}
+
+			Expect(finish.ExitStatus).To(Equal(0))
+		})
+	})
+
+	Context("when the host has environment variables", func() {
+		BeforeEach(func() {
+			step = task("some-task", `echo "secret: ${SOME_HOST_SECRET:-unset}"`)
+		})
+
+		It("does not give them to the task", func() {
+			Expect(logs()).To(ContainSubstring("secret: unset"))
+			Expect(finalStatus()).To(Equal(atc.StatusSucceeded))
+		})
+	})
+
+	Context("when the task has an image", func() {
+		BeforeEach(func() {
+			taskStep := task("some-task", "echo ran")
+			taskStep.Config.ImageResource = &atc.ImageResource{
+				Type:   "registry-image",
+				Source: atc.Source{"repository": "busybox"},
+			}
+
+			step = taskStep
+		})
+
+		It("runs it on the host, noting that the image is ignored", func() {
+			Expect(logs()).To(ContainSubstring("the task's image is ignored"))
+			Expect(logs()).To(ContainSubstring("ran\n"))
+			Expect(finalStatus()).To(Equal(atc.StatusSucceeded))
+		})
+	})
+
+	Context("when the task config uses vars", func() {
+		BeforeEach(func() {
+			taskStep := task("some-task", "echo $SOME_PARAM")
+			taskStep.Config.Params = atc.TaskEnv{"SOME_PARAM": "((some-var))"}
+
+			step = taskStep
+		})
+
+		It("interpolates the given vars", func() {
+			Expect(logs()).To(ContainSubstring("some-var-value\n"))
+			Expect(finalStatus()).To(Equal(atc.StatusSucceeded))
+		})
+	})
+
+	Context("when the task exits nonzero", func() {
+		BeforeEach(func() {
+			step = task("some-task", "exit 3")
+		})
+
+		It("fails", func() {
+			Expect(finalStatus()).To(Equal(atc.StatusFailed))
+		})
+	})
+
+	Context("when running the steps of a job", func() {
+		BeforeEach(func() {
+			config = atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "some-input", Type: "git"},
+					{Name: "some-resource", Type: "git"},
+				},
+			}
+
+			step = &atc.OnFailureStep{
+				Step: &atc.DoStep{
+					Steps: []atc.Step{
+						{Config: &atc.GetStep{Name: "some-input"}},
+						{Config: task("producer", "echo produced > some-output/file")},
+						{Config: &atc.TaskStep{
+							Name: "consumer",
+							Config: &atc.TaskConfig{
+								Platform: "linux",
+								Inputs:   []atc.TaskInputConfig{{Name: "produced"}},
+								Run:      atc.TaskRunConfig{Path: "sh", Args: []string{"-c", "cat produced/file && exit 1"}},
+							},
+							InputMapping: map[string]string{"produced": "some-output"},
+						}},
+						{Config: &atc.PutStep{Name: "some-resource"}},
+						{Config: &atc.GetStep{Name: "some-resource"}},
+					},
+				},
+				Hook: atc.Step{Config: task("notify", "echo notified")},
+			}
+		})
+
+		It("passes outputs between tasks and runs the hooks", func() {
+			Expect(logs()).To(ContainSubstring("produced\n"))
+			Expect(logs()).To(ContainSubstring("notified\n"))
+			Expect(logs()).ToNot(ContainSubstring("skipping put"))
+			Expect(finalStatus()).To(Equal(atc.StatusFailed))
+		})
+	})
+
+	Context("when a put step succeeds", func() {
+		BeforeEach(func() {
+			config = atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "some-resource", Type: "git"},
+				},
+			}
+
+			step = &atc.PutStep{Name: "some-resource"}
+		})
+
+		It("skips it and the get after it", func() {
+			Expect(logs()).To(ContainSubstring("skipping put some-resource\n"))
+			Expect(logs()).To(ContainSubstring("skipping get some-resource of the skipped put\n"))
+			Expect(finalStatus()).To(Equal(atc.StatusSucceeded))
+		})
+	})
+
+	Context("when a get step has no local directory", func() {
+		BeforeEach(func() {
+			config = atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "some-other-input", Type: "git"},
+				},
+			}
+
+			step = &atc.GetStep{Name: "some-other-input"}
+		})
+
+		It("errors asking for an input", func() {
+			Expect(events).To(ContainElement(BeAssignableToTypeOf(event.Error{})))
+			Expect(finalStatus()).To(Equal(atc.StatusErrored))
+		})
+	})
+
+	Context("when a step sets a pipeline", func() {
+		BeforeEach(func() {
+			step = &atc.SetPipelineStep{Name: "some-pipeline", File: "some-input/pipeline.yml"}
+		})
+
+		It("skips it", func() {
+			Expect(logs()).To(ContainSubstring("skipping set_pipeline some-pipeline\n"))
+			Expect(finalStatus()).To(Equal(atc.StatusSucceeded))
+		})
+	})
+
+	Context("when loading vars and running across them", func() {
+		BeforeEach(func() {
+			step = &atc.DoStep{
+				Steps: []atc.Step{
+					{Config: &atc.LoadVarStep{Name: "loaded", File: "some-input/some-file"}},
+					{Config: &atc.AcrossStep{
+						Step: task("some-task", "echo loaded ((.:loaded)) for ((.:value))"),
+						Vars: []atc.AcrossVarConfig{
+							{Var: "value", Values: []interface{}{"one", "two"}},
+						},
+					}},
+				},
+			}
+		})
+
+		It("runs the steps with the loaded vars", func() {
+			Expect(logs()).To(ContainSubstring("loaded some-content for one\n"))
+			Expect(logs()).To(ContainSubstring("loaded some-content for two\n"))
+			Expect(finalStatus()).To(Equal(atc.StatusSucceeded))
+		})
+	})
+
+	Context("when the run is canceled", func() {
+		BeforeEach(func() {
+			canceled, cancel := context.WithCancel(context.Background())
+			cancel()
+			ctx = canceled
+
+			step = task("some-task", "true")
+		})
+
+		It("aborts", func() {
+			Expect(finalStatus()).To(Equal(atc.StatusAborted))
+		})
+	})
+
+	Context("when a step times out", func() {
+		BeforeEach(func() {
+			step = &atc.TimeoutStep{Step: task("some-task", "exec sleep 5"), Duration: "100ms"}
+		})
+
+		It("fails", func() {
+			Expect(events).To(ContainElement(BeAssignableToTypeOf(event.Error{})))
+			Expect(errorMessages()).To(ContainElement("timeout exceeded"))
+			Expect(finalStatus()).To(Equal(atc.StatusFailed))
+		})
+	})
+})
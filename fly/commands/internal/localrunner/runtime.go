@@ -0,0 +1,155 @@
+package localrunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// Runtime runs the processes of tasks.
+type Runtime interface {
+	// Run runs the process in a container built from the spec, returning the
+	// exit status of the process once it has exited.
+	Run(context.Context, ContainerSpec, ProcessSpec) (int, error)
+
+	// FetchImage fetches the image of a registry-image or docker-image
+	// resource into dir, as its rootfs directory and metadata.json.
+	FetchImage(ctx context.Context, stdout io.Writer, source atc.Source, dir string) error
+}
+
+type ContainerSpec struct {
+	Handle string
+
+	// Directory containing the root filesystem of the container's image, if
+	// it has one.
+	RootFSPath string
+
+	Env        []string
+	User       string
+	Privileged bool
+
+	Mounts []Mount
+}
+
+// Mount makes a local directory available in the container.
+type Mount struct {
+	Src string
+	Dst string
+}
+
+type ProcessSpec struct {
+	Path string
+	Args []string
+	Dir  string
+
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// NewProcessRuntime returns a Runtime which runs processes directly on the
+// host, like a Houdini worker does, keeping the directories of its containers
+// in dir.
+//
+// Images are ignored, and the paths of the container are created under its
+// directory. Processes only see the environment of the task, with the PATH of
+// the host.
+func NewProcessRuntime(dir string) Runtime {
+	return processRuntime{dir: dir}
+}
+
+type processRuntime struct {
+	dir string
+}
+
+func (r processRuntime) Run(ctx context.Context, spec ContainerSpec, process ProcessSpec) (int, error) {
+	if spec.RootFSPath != "" {
+		fmt.Fprintln(process.Stderr, "\x1b[1;33mrunning on the host; the task's image is ignored\x1b[0m")
+	}
+
+	root := filepath.Join(r.dir, spec.Handle)
+	defer os.RemoveAll(root)
+
+	for _, mount := range spec.Mounts {
+		dst := filepath.Join(root, mount.Dst)
+
+		err := os.MkdirAll(filepath.Dir(dst), 0755)
+		if err != nil {
+			return 0, err
+		}
+
+		err = os.Symlink(mount.Src, dst)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	dir := filepath.Join(root, process.Dir)
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return 0, err
+	}
+
+	path := process.Path
+	if !filepath.IsAbs(path) && strings.ContainsRune(path, '/') {
+		path = filepath.Join(dir, path)
+	}
+
+	cmd := exec.CommandContext(ctx, path, process.Args...)
+	cmd.Dir = dir
+	cmd.Env = processEnv(spec.Env)
+	cmd.Stdout = process.Stdout
+	cmd.Stderr = process.Stderr
+
+	err = cmd.Run()
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+
+	if err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return 0, err
+		}
+
+		return exitErr.ExitCode(), nil
+	}
+
+	return 0, nil
+}
+
+func (r processRuntime) FetchImage(ctx context.Context, stdout io.Writer, source atc.Source, dir string) error {
+	fmt.Fprintln(stdout, "running on the host; skipping image fetch")
+	return os.MkdirAll(dir, 0755)
+}
+
+// processEnv gives the process the host's PATH, so that it can find the
+// programs of the host, unless the task sets its own.
+func processEnv(env []string) []string {
+	for _, e := range env {
+		if strings.HasPrefix(e, "PATH=") {
+			return env
+		}
+	}
+
+	return append([]string{"PATH=" + os.Getenv("PATH")}, env...)
+}
+
+// ContainerdConfig configures the containerd runtime.
+type ContainerdConfig struct {
+	// Address of the containerd daemon's socket.
+	Address string
+
+	// Path to the init executable run as the first process of containers.
+	InitBin string
+
+	// Directory containing the CNI plugins used for the containers' network.
+	CNIPluginsDir string
+}
@@ -0,0 +1,182 @@
+package localrunner
+
+import (
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// stepFactory builds the steps of the atc/exec engine, running tasks on the
+// local worker client and standing in for the steps which need an ATC.
+type stepFactory struct {
+	pool             worker.Pool
+	artifactStreamer worker.ArtifactStreamer
+	artifactSourcer  worker.ArtifactSourcer
+	teamFactory      db.TeamFactory
+	runtime          Runtime
+	inputs           map[string]string
+	imagesDir        string
+}
+
+func (factory *stepFactory) GetStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(getStep{
+		planID:          plan.ID,
+		plan:            *plan.Get,
+		delegateFactory: delegateFactory,
+		runtime:         factory.runtime,
+		inputs:          factory.inputs,
+		imagesDir:       factory.imagesDir,
+	}, delegateFactory)
+}
+
+func (factory *stepFactory) PutStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(skipStep{
+		step:            "put",
+		name:            plan.Put.Name,
+		delegateFactory: delegateFactory,
+	}, delegateFactory)
+}
+
+func (factory *stepFactory) TaskStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	sum := sha1.Sum([]byte(plan.Task.Name))
+	containerMetadata.WorkingDirectory = filepath.Join("/tmp", "build", fmt.Sprintf("%x", sum[:4]))
+
+	taskStep := exec.NewTaskStep(
+		plan.ID,
+		*plan.Task,
+		atc.ContainerLimits{},
+		stepMetadata,
+		containerMetadata,
+		worker.NewRandomPlacementStrategy(),
+		factory.pool,
+		factory.artifactStreamer,
+		factory.artifactSourcer,
+		oidcTokenIssuer{},
+		delegateFactory,
+	)
+
+	return exec.LogError(taskStep, delegateFactory)
+}
+
+func (factory *stepFactory) RunStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(exec.NewRunStep(plan.ID, *plan.Run, delegateFactory), delegateFactory)
+}
+
+func (factory *stepFactory) CheckStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(checkStep{planID: plan.ID}, delegateFactory)
+}
+
+func (factory *stepFactory) SetPipelineStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(skipStep{
+		step:            "set_pipeline",
+		name:            plan.SetPipeline.Name,
+		delegateFactory: delegateFactory,
+	}, delegateFactory)
+}
+
+func (factory *stepFactory) SyncPipelinesStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	return exec.LogError(skipStep{
+		step:            "sync_pipelines",
+		name:            plan.SyncPipelines.Name,
+		delegateFactory: delegateFactory,
+	}, delegateFactory)
+}
+
+func (factory *stepFactory) LoadVarStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	loadVarStep := exec.NewLoadVarStep(
+		plan.ID,
+		*plan.LoadVar,
+		stepMetadata,
+		delegateFactory,
+		factory.artifactStreamer,
+	)
+
+	return exec.LogError(loadVarStep, delegateFactory)
+}
+
+func (factory *stepFactory) PipelineOutputStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory engine.DelegateFactory,
+) exec.Step {
+	pipelineOutputStep := exec.NewPipelineOutputStep(
+		plan.ID,
+		*plan.PipelineOutput,
+		stepMetadata,
+		delegateFactory,
+		factory.teamFactory,
+	)
+
+	return exec.LogError(pipelineOutputStep, delegateFactory)
+}
+
+func (factory *stepFactory) ArtifactInputStep(plan atc.Plan, build db.Build) exec.Step {
+	return unsupportedStep{"artifact input"}
+}
+
+func (factory *stepFactory) ArtifactOutputStep(plan atc.Plan, build db.Build) exec.Step {
+	return unsupportedStep{"artifact output"}
+}
+
+// newTeamFactory returns a team factory whose team has no pipelines, so
+// pipeline_output steps find no upstream builds.
+func newTeamFactory() db.TeamFactory {
+	team := new(dbfakes.FakeTeam)
+	team.NameReturns(teamName)
+
+	teamFactory := new(dbfakes.FakeTeamFactory)
+	teamFactory.GetByIDReturns(team)
+
+	return teamFactory
+}
+
+type oidcTokenIssuer struct{}
+
+func (oidcTokenIssuer) IssueOIDCToken(atc.WorkloadIdentity, []string) (string, error) {
+	return "", errors.New("oidc tokens can only be issued by an ATC")
+}
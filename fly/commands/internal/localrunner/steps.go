@@ -0,0 +1,144 @@
+package localrunner
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// UnsupportedStepError is returned for steps which need an ATC to run.
+type UnsupportedStepError struct {
+	Step string
+}
+
+func (err UnsupportedStepError) Error() string {
+	return fmt.Sprintf("%s steps cannot be run locally", err.Step)
+}
+
+// MissingInputError is returned when a get step has no local directory
+// standing in for its resource.
+type MissingInputError struct {
+	Name string
+}
+
+func (err MissingInputError) Error() string {
+	return fmt.Sprintf("no local directory for `%s`; provide it with --input %s=PATH", err.Name, err.Name)
+}
+
+// getStep stands in for a get step, using the local directory given for the
+// resource. Images of registry-image and docker-image resources are fetched by
+// the runtime when no directory is given.
+type getStep struct {
+	planID          atc.PlanID
+	plan            atc.GetPlan
+	delegateFactory exec.GetDelegateFactory
+	runtime         Runtime
+	inputs          map[string]string
+	imagesDir       string
+}
+
+func (step getStep) Run(ctx context.Context, state exec.RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	delegate := step.delegateFactory.GetDelegate(state)
+	delegate.Initializing(logger)
+
+	stdout := delegate.Stdout()
+
+	// the put this get follows was skipped, so there is nothing to fetch
+	if step.plan.VersionFrom != nil {
+		delegate.Starting(logger)
+		fmt.Fprintf(stdout, "skipping get %s of the skipped put\n", step.plan.Name)
+		delegate.Finished(logger, 0, runtime.VersionResult{})
+		return true, nil
+	}
+
+	dir, found := step.inputs[step.plan.Name]
+	if !found {
+		dir, found = step.inputs[step.plan.Resource]
+	}
+
+	delegate.Starting(logger)
+
+	if found {
+		fmt.Fprintf(stdout, "using %s for get %s\n", dir, step.plan.Name)
+	} else {
+		if step.plan.Type != "registry-image" && step.plan.Type != "docker-image" {
+			return false, MissingInputError{step.plan.Name}
+		}
+
+		source, err := creds.NewSource(state, step.plan.Source).Evaluate()
+		if err != nil {
+			return false, err
+		}
+
+		dir = filepath.Join(step.imagesDir, string(step.planID))
+
+		err = step.runtime.FetchImage(ctx, stdout, source, dir)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	state.ArtifactRepository().RegisterArtifact(
+		build.ArtifactName(step.plan.Name),
+		runtime.GetArtifact{VolumeHandle: dir},
+	)
+
+	// fetching an image expects the cache of the get as its result
+	state.StoreResult(step.planID, db.UsedResourceCache(new(dbfakes.FakeUsedResourceCache)))
+
+	delegate.Finished(logger, 0, runtime.VersionResult{})
+
+	return true, nil
+}
+
+// checkStep stands in for the checks of images, which are fetched as they are
+// by the get step that follows.
+type checkStep struct {
+	planID atc.PlanID
+}
+
+func (step checkStep) Run(ctx context.Context, state exec.RunState) (bool, error) {
+	state.StoreResult(step.planID, atc.Version{})
+	return true, nil
+}
+
+// skipStep stands in for steps which change things outside of the build, such
+// as puts and set_pipeline steps.
+type skipStep struct {
+	step            string
+	name            string
+	delegateFactory exec.BuildStepDelegateFactory
+}
+
+func (step skipStep) Run(ctx context.Context, state exec.RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	delegate := step.delegateFactory.BuildStepDelegate(state)
+	delegate.Initializing(logger)
+	delegate.Starting(logger)
+
+	fmt.Fprintf(delegate.Stdout(), "skipping %s %s\n", step.step, step.name)
+
+	delegate.Finished(logger, true)
+
+	return true, nil
+}
+
+type unsupportedStep struct {
+	step string
+}
+
+func (step unsupportedStep) Run(context.Context, exec.RunState) (bool, error) {
+	return false, UnsupportedStepError{step.step}
+}
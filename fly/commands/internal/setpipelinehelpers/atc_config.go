@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/vars"
 )
 
 type ATCConfig struct {
@@ -29,6 +31,21 @@ type ATCConfig struct {
 	CheckCredentials bool
 	CommandWarnings  []concourse.ConfigWarning
 	GivenTeamName    string
+	DryRun           bool
+	OutputJSON       bool
+}
+
+// Preview is the structured result of a dry run, for consumption by tools.
+type Preview struct {
+	Pipeline     string                    `json:"pipeline"`
+	InstanceVars atc.InstanceVars          `json:"instance_vars,omitempty"`
+	Created      bool                      `json:"created"`
+	Changes      atc.ConfigChanges         `json:"changes"`
+	Warnings     []concourse.ConfigWarning `json:"warnings,omitempty"`
+
+	// UnresolvedVars are left in the config to be resolved by var sources or
+	// the credential manager at runtime.
+	UnresolvedVars []string `json:"unresolved_vars,omitempty"`
 }
 
 func (atcConfig ATCConfig) ApplyConfigInteraction() bool {
@@ -51,7 +68,7 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
-	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
+	existingConfig, existingConfigVersion, existing, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
 	if err != nil {
 		return err
 	}
@@ -70,6 +87,17 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		})
 	}
 
+	if atcConfig.OutputJSON {
+		return displayhelpers.JsonPrint(Preview{
+			Pipeline:       atcConfig.PipelineRef.Name,
+			InstanceVars:   atcConfig.PipelineRef.InstanceVars,
+			Created:        !existing,
+			Changes:        existingConfig.Changes(newConfig),
+			Warnings:       atcConfig.CommandWarnings,
+			UnresolvedVars: UnresolvedVars(evaluatedTemplate),
+		})
+	}
+
 	diffExists := diff(existingConfig, newConfig)
 
 	if len(atcConfig.CommandWarnings) > 0 {
@@ -81,6 +109,20 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return nil
 	}
 
+	if atcConfig.DryRun {
+		unresolved := UnresolvedVars(evaluatedTemplate)
+		if len(unresolved) > 0 {
+			fmt.Println(bold("unresolved vars:"))
+			for _, name := range unresolved {
+				fmt.Println("  " + name)
+			}
+			fmt.Println()
+		}
+
+		fmt.Println("dry run: not applying configuration")
+		return nil
+	}
+
 	fmt.Println(bold("pipeline name: ") + atcConfig.PipelineRef.Name)
 	if len(atcConfig.PipelineRef.InstanceVars) != 0 {
 		fmt.Println(bold("pipeline instance vars:"))
@@ -180,6 +222,23 @@ func diff(existingConfig atc.Config, newConfig atc.Config) bool {
 	return existingConfig.Diff(stdout, newConfig)
 }
 
+// UnresolvedVars returns the names of the vars which remain in an evaluated
+// config template.
+func UnresolvedVars(evaluatedTemplate []byte) []string {
+	seen := map[string]bool{}
+	names := []string{}
+	for _, name := range vars.NewTemplate(evaluatedTemplate).ExtraVarNames() {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
 func indent(text, indent string) string {
 	text = strings.TrimRight(text, "\n")
 	var result strings.Builder
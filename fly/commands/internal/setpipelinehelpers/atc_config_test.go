@@ -70,3 +70,20 @@ var _ = Describe("UnpausePipelineCommand", func() {
 		})
 	})
 })
+
+var _ = Describe("UnresolvedVars", func() {
+	It("returns the sorted names of the vars left in the template", func() {
+		Expect(UnresolvedVars([]byte(`
+resources:
+- name: repo
+  source:
+    uri: ((uri))
+    private_key: ((vault:deploy.key))
+    other: ((uri))
+`))).To(Equal([]string{"uri", "vault:deploy.key"}))
+	})
+
+	It("returns nothing when all vars are resolved", func() {
+		Expect(UnresolvedVars([]byte(`resources: []`))).To(BeEmpty())
+	})
+})
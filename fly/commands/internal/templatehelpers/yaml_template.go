@@ -89,7 +89,16 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 		params = append(params, staticVars)
 	}
 
-	evaluatedConfig, err := vars.NewTemplateResolver(config, params).Resolve(false, allowEmpty)
+	resolver := vars.NewTemplateResolver(config, params)
+
+	if !allowEmpty {
+		err = resolver.ValidateVars()
+		if err != nil {
+			return nil, fmt.Errorf("invalid vars: %w", err)
+		}
+	}
+
+	evaluatedConfig, err := resolver.Resolve(false, allowEmpty)
 	if err != nil {
 		return nil, err
 	}
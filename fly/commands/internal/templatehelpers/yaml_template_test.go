@@ -75,5 +75,66 @@ var _ = Describe("YAML Template With Params", func() {
     nested: ((param3))
 `))
 		})
+
+		Context("when the template declares vars", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(
+					filepath.Join(tmpdir, "declared.yml"),
+					[]byte(`vars:
+- name: branch
+  type: string
+  required: true
+- name: replicas
+  type: number
+  default: 1
+section:
+- branch: ((branch))
+  replicas: ((replicas))
+`),
+					0644,
+				)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fills in the defaults of the vars which are not provided", func() {
+				instanceVars := atc.InstanceVars{"branch": "main"}
+				sampleYaml := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(filepath.Join(tmpdir, "declared.yml")), nil, nil, nil, instanceVars)
+				result, err := sampleYaml.Evaluate(false, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(MatchYAML(`vars:
+- name: branch
+  type: string
+  required: true
+- name: replicas
+  type: number
+  default: 1
+section:
+- branch: main
+  replicas: 1
+`))
+			})
+
+			It("fails when a required var is not provided", func() {
+				sampleYaml := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(filepath.Join(tmpdir, "declared.yml")), nil, nil, nil, nil)
+				_, err := sampleYaml.Evaluate(false, false)
+				Expect(err).To(MatchError(ContainSubstring("required var 'branch' is not provided")))
+			})
+
+			It("fails when a var is provided with a value of another type", func() {
+				variables := []flaghelpers.VariablePairFlag{
+					{Ref: vars.Reference{Path: "branch"}, Value: "main"},
+					{Ref: vars.Reference{Path: "replicas"}, Value: "two"},
+				}
+				sampleYaml := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(filepath.Join(tmpdir, "declared.yml")), nil, variables, nil, nil)
+				_, err := sampleYaml.Evaluate(false, false)
+				Expect(err).To(MatchError(ContainSubstring("var 'replicas' must be of type number, got string")))
+			})
+
+			It("does not require the vars when empty vars are allowed", func() {
+				sampleYaml := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(filepath.Join(tmpdir, "declared.yml")), nil, nil, nil, nil)
+				_, err := sampleYaml.Evaluate(true, false)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
 	})
 })
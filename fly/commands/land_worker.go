@@ -9,6 +9,7 @@ import (
 
 type LandWorkerCommand struct {
 	Worker flaghelpers.WorkerFlag `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+	Drain  bool                   `long:"drain" description:"Move steps of interruptible jobs and retryable steps to other workers instead of waiting for them to finish"`
 }
 
 func (command *LandWorkerCommand) Execute(args []string) error {
@@ -24,6 +25,17 @@ func (command *LandWorkerCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Drain {
+		err = target.Client().DrainWorker(workerName)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("draining '%s'\n", workerName)
+
+		return nil
+	}
+
 	err = target.Client().LandWorker(workerName)
 	if err != nil {
 		return err
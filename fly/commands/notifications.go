@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type NotificationsCommand struct {
+	Deliveries bool                 `short:"d" long:"deliveries" description:"List the most recent deliveries instead of the subscriptions"`
+	Count      int                  `short:"c" long:"count" default:"50" description:"Number of deliveries to list"`
+	Json       bool                 `long:"json" description:"Print command result as JSON"`
+	Team       flaghelpers.TeamFlag `long:"team" description:"Name of the team, if different from the target default"`
+}
+
+func (command *NotificationsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team.Name())
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	if command.Deliveries {
+		return command.showDeliveries(team)
+	}
+
+	configs, err := team.Notifications()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(configs)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "url", Color: color.New(color.Bold)},
+			{Contents: "pipelines", Color: color.New(color.Bold)},
+			{Contents: "statuses", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, config := range configs {
+		pipelinesCell := ui.TableCell{Contents: strings.Join(config.Pipelines, ",")}
+		if len(config.Pipelines) == 0 {
+			pipelinesCell = ui.TableCell{Contents: "all", Color: ui.OffColor}
+		}
+
+		statuses := config.Statuses
+		if len(statuses) == 0 {
+			statuses = atc.DefaultNotificationStatuses
+		}
+
+		var names []string
+		for _, status := range statuses {
+			names = append(names, string(status))
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: config.Name},
+			{Contents: config.URL},
+			pipelinesCell,
+			{Contents: strings.Join(names, ",")},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *NotificationsCommand) showDeliveries(team concourse.Team) error {
+	deliveries, err := team.NotificationDeliveries(command.Count)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(deliveries)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "notification", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "state", Color: color.New(color.Bold)},
+			{Contents: "attempts", Color: color.New(color.Bold)},
+			{Contents: "last attempt", Color: color.New(color.Bold)},
+			{Contents: "response", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		build := delivery.PipelineName + "/" + delivery.JobName + " #" + delivery.BuildName
+
+		lastAttemptCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if delivery.LastAttemptAt != 0 {
+			lastAttemptCell = ui.TableCell{Contents: time.Unix(delivery.LastAttemptAt, 0).Local().Format(timeDateLayout)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: delivery.Notification},
+			{Contents: build},
+			ui.BuildStatusCell(delivery.BuildStatus),
+			notificationDeliveryStateCell(delivery.State),
+			{Contents: strconv.Itoa(delivery.Attempts)},
+			lastAttemptCell,
+			notificationDeliveryResponseCell(delivery),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func notificationDeliveryStateCell(state atc.NotificationDeliveryState) ui.TableCell {
+	cell := ui.TableCell{Contents: string(state)}
+
+	switch state {
+	case atc.NotificationDeliveryPending:
+		cell.Color = ui.PendingColor
+	case atc.NotificationDeliverySucceeded:
+		cell.Color = ui.SucceededColor
+	case atc.NotificationDeliveryFailed:
+		cell.Color = ui.FailedColor
+	}
+
+	return cell
+}
+
+func notificationDeliveryResponseCell(delivery atc.NotificationDelivery) ui.TableCell {
+	switch {
+	case delivery.Error != "":
+		return ui.TableCell{Contents: delivery.Error, Color: ui.FailedColor}
+	case delivery.ResponseStatus != 0:
+		return ui.TableCell{Contents: strconv.Itoa(delivery.ResponseStatus)}
+	default:
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+}
@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type PipelineHistoryCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to show the config history of"`
+	Version  int                      `short:"v" long:"version" value-name:"VERSION" description:"Show what changed in this version compared to the one before it"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Team     flaghelpers.TeamFlag     `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *PipelineHistoryCommand) Validate() error {
+	_, err := command.Pipeline.Validate()
+	return err
+}
+
+func (command *PipelineHistoryCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team.Name())
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	versions, found, err := team.PipelineConfigVersions(command.Pipeline.Ref())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline not found")
+	}
+
+	if command.Version != 0 {
+		return command.showVersion(team, versions)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(versions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "created at", Color: color.New(color.Bold)},
+			{Contents: "created by", Color: color.New(color.Bold)},
+			{Contents: "changes", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, version := range versions {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(version.Version)},
+			{Contents: time.Unix(version.CreatedAt, 0).Local().Format(timeDateLayout)},
+			configVersionAuthorCell(version),
+			{Contents: summarizeConfigChanges(version.Changes)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *PipelineHistoryCommand) showVersion(team concourse.Team, versions []atc.PipelineConfigVersion) error {
+	var previous int
+	for i, version := range versions {
+		if version.Version == command.Version && i+1 < len(versions) {
+			previous = versions[i+1].Version
+		}
+	}
+
+	version, found, err := team.PipelineConfigVersion(command.Pipeline.Ref(), command.Version)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("version %d of pipeline '%s' not found", command.Version, command.Pipeline.Ref())
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(version)
+	}
+
+	var before atc.Config
+	if previous != 0 {
+		previousVersion, found, err := team.PipelineConfigVersion(command.Pipeline.Ref(), previous)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			before = *previousVersion.Config
+		}
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	if !before.Diff(stdout, *version.Config) {
+		fmt.Println("no changes")
+	}
+
+	return nil
+}
+
+func configVersionAuthorCell(version atc.PipelineConfigVersion) ui.TableCell {
+	switch {
+	case version.CreatedBy != "":
+		return ui.TableCell{Contents: version.CreatedBy}
+	case version.BuildID != 0:
+		return ui.TableCell{Contents: fmt.Sprintf("build %d", version.BuildID)}
+	default:
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+}
+
+func summarizeConfigChanges(changes *atc.ConfigChanges) string {
+	if changes == nil {
+		return "initial version"
+	}
+
+	var summary []string
+	summarize := func(kind string, objectChanges []atc.ObjectChange) {
+		counts := map[string]int{}
+		for _, change := range objectChanges {
+			counts[change.Action]++
+		}
+
+		var actions []string
+		for _, action := range []string{atc.DiffAdded, atc.DiffChanged, atc.DiffRenamed, atc.DiffRemoved} {
+			if counts[action] > 0 {
+				actions = append(actions, fmt.Sprintf("%d %s", counts[action], action))
+			}
+		}
+
+		if len(actions) > 0 {
+			summary = append(summary, kind+": "+strings.Join(actions, ", "))
+		}
+	}
+
+	summarize("groups", changes.Groups)
+	summarize("var sources", changes.VarSources)
+	summarize("resource types", changes.ResourceTypes)
+	summarize("resources", changes.Resources)
+	summarize("jobs", changes.Jobs)
+
+	if changes.Display != nil {
+		summary = append(summary, "display: "+changes.Display.Action)
+	}
+
+	if len(summary) == 0 {
+		return "no changes"
+	}
+
+	return strings.Join(summary, "; ")
+}
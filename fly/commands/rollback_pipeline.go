@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/vito/go-interact/interact"
+	"sigs.k8s.io/yaml"
+)
+
+type RollbackPipelineCommand struct {
+	Pipeline        flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to roll back"`
+	To              int                      `long:"to" required:"true" value-name:"VERSION" description:"Version of the config to roll back to, as listed by pipeline-history"`
+	SkipInteractive bool                     `short:"n" long:"non-interactive" description:"Roll back the pipeline without confirmation"`
+	Team            flaghelpers.TeamFlag     `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *RollbackPipelineCommand) Validate() error {
+	_, err := command.Pipeline.Validate()
+	return err
+}
+
+func (command *RollbackPipelineCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team.Name())
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	version, found, err := team.PipelineConfigVersion(pipelineRef, command.To)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("version %d of pipeline '%s' not found", command.To, pipelineRef)
+	}
+
+	existingConfig, existingVersion, found, err := team.PipelineConfig(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline not found")
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	if !existingConfig.Diff(stdout, *version.Config) {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction(fmt.Sprintf("roll back to version %d?", command.To)).Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	payload, err := yaml.Marshal(version.Config)
+	if err != nil {
+		return err
+	}
+
+	_, _, warnings, err := team.CreateOrUpdatePipelineConfig(pipelineRef, existingVersion, payload, false)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	fmt.Printf("rolled back pipeline '%s' to version %d\n", pipelineRef, command.To)
+
+	return nil
+}
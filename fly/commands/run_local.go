@@ -0,0 +1,200 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/localrunner"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/config"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/vars"
+	"sigs.k8s.io/yaml"
+)
+
+type RunLocalCommand struct {
+	TaskConfig atc.PathFlag                       `short:"c" long:"config"                            description:"The task config to run"`
+	Pipeline   atc.PathFlag                       `short:"p" long:"pipeline"                          description:"Pipeline configuration file containing the job to run"`
+	Job        string                             `short:"j" long:"job"                               description:"Name of the job to run from the --pipeline config"`
+	Inputs     []flaghelpers.InputPairFlag        `short:"i" long:"input"   value-name:"NAME=PATH"    description:"A local directory standing in for a get step or task input (can be specified multiple times)"`
+	Outputs    []flaghelpers.OutputPairFlag       `short:"o" long:"output"  value-name:"NAME=PATH"    description:"A local directory to write a task output to (can be specified multiple times)"`
+	WorkDir    string                             `          long:"work-dir"                          description:"Directory to keep the volumes and caches of the run in (default: a temporary directory, removed afterwards)"`
+	Runtime    string                             `          long:"runtime"   choice:"process" choice:"containerd" default:"process" description:"Run tasks as processes on the host, ignoring their images, or in containers of a local containerd daemon"`
+	Var        []flaghelpers.VariablePairFlag     `short:"v" long:"var"       value-name:"[NAME=STRING]"  unquote:"false"  description:"Specify a string value to set for a variable in the config"`
+	YAMLVar    []flaghelpers.YAMLVariablePairFlag `short:"y" long:"yaml-var"  value-name:"[NAME=YAML]"    unquote:"false"  description:"Specify a YAML value to set for a variable in the config"`
+	VarsFrom   []atc.PathFlag                     `short:"l" long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Containerd struct {
+		Address       string `long:"containerd-address"         default:"/run/containerd/containerd.sock" description:"Address of the containerd daemon's socket"`
+		InitBin       string `long:"containerd-init-bin"        default:"/usr/local/concourse/bin/init"   description:"Path to an init executable for the containers"`
+		CNIPluginsDir string `long:"containerd-cni-plugins-dir" default:"/usr/local/concourse/bin"        description:"Path to the CNI network plugins"`
+	} `group:"Containerd runtime"`
+}
+
+func (command *RunLocalCommand) Execute(args []string) error {
+	step, pipelineConfig, err := command.step(args)
+	if err != nil {
+		return err
+	}
+
+	plan, err := localrunner.Plan(step, pipelineConfig)
+	if err != nil {
+		return err
+	}
+
+	workDir := command.WorkDir
+	if workDir == "" {
+		workDir, err = ioutil.TempDir("", "fly-run-local")
+		if err != nil {
+			return err
+		}
+	} else {
+		err = os.MkdirAll(workDir, 0755)
+		if err != nil {
+			return err
+		}
+	}
+
+	inputs := map[string]string{}
+	for _, input := range command.Inputs {
+		inputs[input.Name] = input.Path
+	}
+
+	outputs := map[string]string{}
+	for _, output := range command.Outputs {
+		outputs[output.Name] = output.Path
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	terminate := make(chan os.Signal, 1)
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-terminate
+		fmt.Fprintf(ui.Stderr, "\naborting...\n")
+		cancel()
+	}()
+
+	var runtime localrunner.Runtime
+	if command.Runtime == "containerd" {
+		runtime, err = localrunner.NewContainerdRuntime(localrunner.ContainerdConfig{
+			Address:       command.Containerd.Address,
+			InitBin:       command.Containerd.InitBin,
+			CNIPluginsDir: command.Containerd.CNIPluginsDir,
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		runtime = localrunner.NewProcessRuntime(filepath.Join(workDir, "containers"))
+	}
+
+	variables, err := command.variables()
+	if err != nil {
+		return err
+	}
+
+	runner := localrunner.New(runtime, workDir, variables, inputs, outputs)
+
+	go runner.Run(ctx, plan)
+
+	exitCode := eventstream.Render(os.Stdout, runner.Events(), eventstream.RenderOptions{})
+
+	// os.Exit skips deferred calls
+	if command.WorkDir == "" {
+		os.RemoveAll(workDir)
+	}
+
+	os.Exit(exitCode)
+
+	return nil
+}
+
+// variables gives the vars of the command to the build, for the configs of
+// tasks loaded while running.
+func (command *RunLocalCommand) variables() (vars.Variables, error) {
+	var pairs vars.KVPairs
+	for _, f := range command.Var {
+		pairs = append(pairs, vars.KVPair(f))
+	}
+	for _, f := range command.YAMLVar {
+		pairs = append(pairs, vars.KVPair(f))
+	}
+
+	variables := []vars.Variables{pairs.Expand()}
+
+	// files specified later take precedence
+	for i := len(command.VarsFrom) - 1; i >= 0; i-- {
+		payload, err := ioutil.ReadFile(string(command.VarsFrom[i]))
+		if err != nil {
+			return nil, err
+		}
+
+		var staticVars vars.StaticVariables
+		err = yaml.Unmarshal(payload, &staticVars)
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal template variables (%s): %s", command.VarsFrom[i], err)
+		}
+
+		variables = append(variables, staticVars)
+	}
+
+	return vars.NewMultiVars(variables), nil
+}
+
+func (command *RunLocalCommand) step(args []string) (atc.StepConfig, atc.Config, error) {
+	if command.TaskConfig != "" {
+		if command.Pipeline != "" || command.Job != "" {
+			return nil, atc.Config{}, errors.New("--config cannot be used with --pipeline or --job")
+		}
+
+		taskTemplate := templatehelpers.NewYamlTemplateWithParams(command.TaskConfig, command.VarsFrom, command.Var, command.YAMLVar, nil)
+
+		evaluated, err := taskTemplate.Evaluate(false, false)
+		if err != nil {
+			return nil, atc.Config{}, err
+		}
+
+		taskConfig, err := config.OverrideTaskParams(evaluated, args)
+		if err != nil {
+			return nil, atc.Config{}, err
+		}
+
+		return &atc.TaskStep{Name: "one-off", Config: &taskConfig}, atc.Config{}, nil
+	}
+
+	if command.Pipeline == "" || command.Job == "" {
+		return nil, atc.Config{}, errors.New("either --config or both --pipeline and --job must be specified")
+	}
+
+	pipelineTemplate := templatehelpers.NewYamlTemplateWithParams(command.Pipeline, command.VarsFrom, command.Var, command.YAMLVar, nil)
+
+	evaluated, err := pipelineTemplate.Evaluate(false, false)
+	if err != nil {
+		return nil, atc.Config{}, err
+	}
+
+	var pipelineConfig atc.Config
+	err = yaml.Unmarshal(evaluated, &pipelineConfig)
+	if err != nil {
+		return nil, atc.Config{}, err
+	}
+
+	job, found := pipelineConfig.Jobs.Lookup(command.Job)
+	if !found {
+		return nil, atc.Config{}, fmt.Errorf("job '%s' not found in pipeline config", command.Job)
+	}
+
+	return job.StepConfig(), pipelineConfig, nil
+}
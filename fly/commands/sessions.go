@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type SessionsCommand struct {
+	List   SessionsListCommand   `command:"list"   description:"List the active sessions of users who logged in"`
+	Revoke SessionsRevokeCommand `command:"revoke" description:"Revoke a session, or every session of a user, logging them out"`
+}
+
+func loadSessionsClient() (concourse.Client, error) {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return nil, err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return target.Client(), nil
+}
+
+type SessionsListCommand struct {
+	User      string `short:"u" long:"user" description:"Only list the sessions of the user with this username"`
+	Connector string `long:"connector" description:"Only list the sessions of users who logged in with this connector"`
+	Json      bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *SessionsListCommand) Execute([]string) error {
+	client, err := loadSessionsClient()
+	if err != nil {
+		return err
+	}
+
+	sessions, err := client.ListSessions(concourse.SessionFilter{
+		Username:  command.User,
+		Connector: command.Connector,
+	})
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(sessions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "username", Color: color.New(color.Bold)},
+			{Contents: "connector", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "expires", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, session := range sessions {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(session.ID)},
+			stringOrDefault(session.Username),
+			stringOrDefault(session.Connector),
+			{Contents: time.Unix(session.CreatedAt, 0).Local().Format(timeDateLayout)},
+			{Contents: time.Unix(session.ExpiresAt, 0).Local().Format(timeDateLayout)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type SessionsRevokeCommand struct {
+	ID        int    `long:"id" description:"ID of the session to revoke"`
+	User      string `short:"u" long:"user" description:"Revoke every session of the user with this username"`
+	Connector string `long:"connector" description:"Only revoke the sessions of the user which were created with this connector"`
+}
+
+func (command *SessionsRevokeCommand) Execute([]string) error {
+	if (command.ID == 0) == (command.User == "") {
+		return errors.New("either --id or --user must be specified")
+	}
+
+	if command.Connector != "" && command.User == "" {
+		return errors.New("--connector can only be specified with --user")
+	}
+
+	client, err := loadSessionsClient()
+	if err != nil {
+		return err
+	}
+
+	if command.ID != 0 {
+		revoked, err := client.RevokeSession(command.ID)
+		if err != nil {
+			return err
+		}
+
+		if !revoked {
+			return fmt.Errorf("session %d not found", command.ID)
+		}
+
+		fmt.Printf("revoked session %d\n", command.ID)
+
+		return nil
+	}
+
+	revoked, err := client.RevokeUserSessions(concourse.SessionFilter{
+		Username:  command.User,
+		Connector: command.Connector,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("revoked %d session(s) and %d API token(s) of user %s\n", revoked.Revoked, revoked.APITokens, ui.Embolden("%s", command.User))
+
+	return nil
+}
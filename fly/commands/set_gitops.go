@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"sigs.k8s.io/yaml"
+)
+
+type SetGitOpsCommand struct {
+	Config  atc.PathFlag `short:"c" long:"config"  description:"GitOps configuration file declaring the type, source and manifest path of the repository"`
+	Disable bool         `long:"disable"           description:"Stop reconciling the team's pipelines from a repository"`
+
+	Var      []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       unquote:"false"  value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the configuration"`
+	YAMLVar  []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  unquote:"false"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the configuration"`
+	VarsFrom []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Team flaghelpers.TeamFlag `long:"team" description:"Name of the team to configure, if different from the target default"`
+}
+
+func (command *SetGitOpsCommand) Execute([]string) error {
+	if command.Disable == (command.Config != "") {
+		return errors.New("either --config or --disable must be specified")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team.Name())
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	if command.Disable {
+		err = team.DisableGitOps()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("gitops disabled for team '%s'\n", team.Name())
+		return nil
+	}
+
+	evaluated, err := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar, nil).Evaluate(false, false)
+	if err != nil {
+		return err
+	}
+
+	var config atc.GitOpsConfig
+	err = yaml.UnmarshalStrict(evaluated, &config)
+	if err != nil {
+		return fmt.Errorf("malformed gitops config: %w", err)
+	}
+
+	err = config.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = team.SetGitOps(config)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("team '%s' now syncs its pipelines from %s every %s\n", team.Name(), config.Path, config.SyncInterval())
+
+	return nil
+}
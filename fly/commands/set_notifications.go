@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"sigs.k8s.io/yaml"
+)
+
+type SetNotificationsCommand struct {
+	Config atc.PathFlag `short:"c" long:"config"  description:"Configuration file declaring the team's notifications under a top-level 'notifications' key"`
+	Clear  bool         `long:"clear"             description:"Remove all of the team's notifications"`
+
+	Var      []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       unquote:"false"  value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the configuration"`
+	YAMLVar  []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  unquote:"false"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the configuration"`
+	VarsFrom []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Team flaghelpers.TeamFlag `long:"team" description:"Name of the team to configure, if different from the target default"`
+}
+
+type notificationsFile struct {
+	Notifications atc.NotificationConfigs `json:"notifications"`
+}
+
+func (command *SetNotificationsCommand) Execute([]string) error {
+	if command.Clear == (command.Config != "") {
+		return errors.New("either --config or --clear must be specified")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team.Name())
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	if command.Clear {
+		err = team.SetNotifications(nil)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("notifications cleared for team '%s'\n", team.Name())
+		return nil
+	}
+
+	evaluated, err := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar, nil).Evaluate(false, false)
+	if err != nil {
+		return err
+	}
+
+	var config notificationsFile
+	err = yaml.UnmarshalStrict(evaluated, &config)
+	if err != nil {
+		return fmt.Errorf("malformed notifications config: %w", err)
+	}
+
+	err = config.Notifications.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = team.SetNotifications(config.Notifications)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("team '%s' now has %d notification(s)\n", team.Name(), len(config.Notifications))
+
+	return nil
+}
@@ -21,6 +21,9 @@ type SetPipelineCommand struct {
 
 	CheckCredentials bool `long:"check-creds"  description:"Validate credential variables against credential manager"`
 
+	DryRun bool   `long:"dry-run"                                   description:"Show the changes without applying them"`
+	Output string `short:"o"  long:"output"  choice:"text" choice:"json"  default:"text"  description:"Format of the dry run; json prints the structured changes and their consequences"`
+
 	PipelineName string       `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to configure"`
 	Config       atc.PathFlag `short:"c"  long:"config"    required:"true"  description:"Pipeline configuration file, \"-\" stands for stdin"`
 
@@ -56,6 +59,10 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
+
+	if command.Output == "json" && !command.DryRun {
+		return errors.New("--output json can only be used with --dry-run")
+	}
 	configPath := command.Config
 	templateVariablesFiles := command.VarsFrom
 	pipelineName := command.PipelineName
@@ -104,6 +111,8 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 		CheckCredentials: command.CheckCredentials,
 		CommandWarnings:  warnings,
 		GivenTeamName:    command.Team,
+		DryRun:           command.DryRun,
+		OutputJSON:       command.Output == "json",
 	}
 
 	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar, instanceVars)
@@ -101,6 +101,22 @@ func (command *SetTeamCommand) Execute([]string) error {
 		} else {
 			fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
 		}
+
+		if pipelines := authRoles[role][atc.TeamAuthPipelines]; len(pipelines) > 0 {
+			fmt.Println()
+			fmt.Printf("  pipelines:\n")
+			for _, pipeline := range pipelines {
+				fmt.Printf("  - %s\n", pipeline)
+			}
+		}
+
+		if actions := authRoles[role][atc.TeamAuthActions]; len(actions) > 0 {
+			fmt.Println()
+			fmt.Printf("  actions:\n")
+			for _, action := range actions {
+				fmt.Printf("  - %s\n", action)
+			}
+		}
 	}
 
 	if len(warnings) > 0 {
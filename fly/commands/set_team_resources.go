@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"sigs.k8s.io/yaml"
+)
+
+type SetTeamResourcesCommand struct {
+	Config atc.PathFlag `short:"c" long:"config"  description:"Configuration file declaring the team's shared 'resources' and 'resource_types'"`
+	Clear  bool         `long:"clear"             description:"Remove all of the team's shared resources and resource types"`
+
+	Var      []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       unquote:"false"  value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the configuration"`
+	YAMLVar  []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  unquote:"false"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the configuration"`
+	VarsFrom []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Team flaghelpers.TeamFlag `long:"team" description:"Name of the team to configure, if different from the target default"`
+}
+
+func (command *SetTeamResourcesCommand) Execute([]string) error {
+	if command.Clear == (command.Config != "") {
+		return errors.New("either --config or --clear must be specified")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team.Name())
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	var shared atc.TeamResources
+	if !command.Clear {
+		evaluated, err := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar, nil).Evaluate(false, false)
+		if err != nil {
+			return err
+		}
+
+		err = yaml.UnmarshalStrict(evaluated, &shared)
+		if err != nil {
+			return fmt.Errorf("malformed shared resources config: %w", err)
+		}
+	}
+
+	warnings, err := team.SetTeamResources(shared)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	if command.Clear {
+		fmt.Printf("shared resources cleared for team '%s'\n", team.Name())
+		return nil
+	}
+
+	fmt.Printf("team '%s' now shares %d resource(s) and %d resource type(s)\n", team.Name(), len(shared.Resources), len(shared.ResourceTypes))
+
+	return nil
+}
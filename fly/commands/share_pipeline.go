@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type SharePipelineCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to share"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+	WithTeam []string                 `short:"w" long:"with-team" value-name:"TEAM" description:"Team to share the pipeline with read-only. Can be specified multiple times"`
+	None     bool                     `long:"none" description:"Stop sharing the pipeline with any team"`
+}
+
+func (command *SharePipelineCommand) Validate() error {
+	_, err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	if len(command.WithTeam) == 0 && !command.None {
+		return errors.New("either --with-team or --none must be specified")
+	}
+
+	if len(command.WithTeam) != 0 && command.None {
+		return errors.New("--with-team and --none cannot be specified together")
+	}
+
+	return nil
+}
+
+func (command *SharePipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+	found, err := team.SharePipeline(pipelineRef, command.WithTeam)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
+	}
+
+	if command.None {
+		fmt.Printf("stopped sharing '%s'\n", pipelineRef.String())
+	} else {
+		fmt.Printf("shared '%s' with %s\n", pipelineRef.String(), strings.Join(command.WithTeam, ", "))
+	}
+
+	return nil
+}
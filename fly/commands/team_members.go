@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type TeamMembersCommand struct {
+	Json bool                 `long:"json" description:"Print command result as JSON"`
+	Team flaghelpers.TeamFlag `long:"team" description:"Name of the team, if different from the target default"`
+}
+
+func (command *TeamMembersCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team.Name())
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	members, err := team.ListMembers()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(members)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "username", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "email", Color: color.New(color.Bold)},
+			{Contents: "roles", Color: color.New(color.Bold)},
+			{Contents: "groups", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, member := range members {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: member.UserName},
+			stringOrDefault(member.DisplayName),
+			stringOrDefault(member.Email),
+			{Contents: strings.Join(member.Roles, ",")},
+			{Contents: strings.Join(member.Groups, ",")},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
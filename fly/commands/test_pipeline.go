@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/pipelinetest"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/ui"
+	"sigs.k8s.io/yaml"
+)
+
+type TestPipelineCommand struct {
+	Config atc.PathFlag `short:"c" long:"config" required:"true" description:"Pipeline configuration file"`
+	Suite  atc.PathFlag `short:"s" long:"suite"  required:"true" description:"Test suite declaring the step results and expectations of each test"`
+	Json   bool         `long:"json" description:"Print command result as JSON"`
+
+	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       unquote:"false"  value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
+	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  unquote:"false"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
+
+	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+}
+
+func (command *TestPipelineCommand) Execute(args []string) error {
+	yamlTemplate := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar, nil)
+
+	evaluated, err := yamlTemplate.Evaluate(false, false)
+	if err != nil {
+		return err
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(evaluated, &config)
+	if err != nil {
+		return fmt.Errorf("malformed pipeline config: %w", err)
+	}
+
+	payload, err := ioutil.ReadFile(string(command.Suite))
+	if err != nil {
+		return err
+	}
+
+	suite, err := pipelinetest.LoadSuite(payload)
+	if err != nil {
+		return err
+	}
+
+	var results []pipelinetest.Result
+	for _, test := range suite.Tests {
+		result, err := pipelinetest.Run(config, test)
+		if err != nil {
+			return fmt.Errorf("test '%s': %w", test.Name, err)
+		}
+
+		results = append(results, result)
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(results)
+		if err != nil {
+			return err
+		}
+	} else {
+		command.print(results)
+	}
+
+	for _, result := range results {
+		if !result.Passed() {
+			return errors.New("some tests failed")
+		}
+	}
+
+	return nil
+}
+
+func (command *TestPipelineCommand) print(results []pipelinetest.Result) {
+	passed := 0
+
+	for _, result := range results {
+		if result.Passed() {
+			passed++
+			fmt.Printf("%s %s (%s)\n", ui.SucceededColor.Sprint("PASS"), result.Name, result.Job)
+		} else {
+			fmt.Printf("%s %s (%s)\n", ui.FailedColor.Sprint("FAIL"), result.Name, result.Job)
+		}
+
+		fmt.Printf("  build %s\n", result.Status)
+
+		for _, step := range result.Steps {
+			line := fmt.Sprintf("    %s %s", step.ID, step.Status)
+
+			if len(step.Inputs) > 0 {
+				line += " inputs: " + strings.Join(step.Inputs, ", ")
+			}
+
+			if len(step.Params) > 0 {
+				var keys []string
+				for k := range step.Params {
+					keys = append(keys, k)
+				}
+
+				sort.Strings(keys)
+
+				var params []string
+				for _, k := range keys {
+					params = append(params, fmt.Sprintf("%s=%v", k, step.Params[k]))
+				}
+
+				line += " params: " + strings.Join(params, ", ")
+			}
+
+			fmt.Println(line)
+		}
+
+		for _, failure := range result.Failures {
+			fmt.Printf("  %s\n", ui.FailedColor.Sprint(failure))
+		}
+	}
+
+	fmt.Printf("\n%d of %d tests passed\n", passed, len(results))
+}
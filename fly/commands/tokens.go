@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type TokensCommand struct {
+	Create TokensCreateCommand `command:"create" description:"Create an API token, printing it once"`
+	List   TokensListCommand   `command:"list"   description:"List API tokens"`
+	Revoke TokensRevokeCommand `command:"revoke" description:"Revoke an API token"`
+}
+
+// tokenOwner is implemented by both the client, for the user's own tokens,
+// and by teams, for the tokens of their service accounts.
+type tokenOwner interface {
+	ListTokens() ([]atc.APIToken, error)
+	CreateToken(atc.APITokenRequest) (atc.APIToken, error)
+	RevokeToken(int) (bool, error)
+}
+
+func loadTokenOwner(team flaghelpers.TeamFlag) (tokenOwner, error) {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return nil, err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if team == "" {
+		return target.Client(), nil
+	}
+
+	return target.FindTeam(team.Name())
+}
+
+type TokensCreateCommand struct {
+	Name           string               `short:"n" long:"name" required:"true" description:"Name of the token"`
+	Role           string               `short:"r" long:"role" required:"true" description:"Role granted to the token (owner, member, pipeline-operator or viewer)"`
+	Team           flaghelpers.TeamFlag `long:"team" description:"Create the token for a service account of this team, rather than for yourself"`
+	ServiceAccount string               `long:"service-account" description:"Name of the team's service account the token authenticates as"`
+	ExpiresIn      time.Duration        `long:"expires-in" description:"Duration after which the token expires; it never expires if omitted"`
+	Json           bool                 `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *TokensCreateCommand) Execute([]string) error {
+	if (command.Team == "") != (command.ServiceAccount == "") {
+		return errors.New("--team and --service-account must be given together")
+	}
+
+	if command.ExpiresIn < 0 {
+		return errors.New("--expires-in must be positive")
+	}
+
+	owner, err := loadTokenOwner(command.Team)
+	if err != nil {
+		return err
+	}
+
+	request := atc.APITokenRequest{
+		Name:           command.Name,
+		Role:           command.Role,
+		ServiceAccount: command.ServiceAccount,
+	}
+
+	if command.ExpiresIn != 0 {
+		request.ExpiresAt = time.Now().Add(command.ExpiresIn).Unix()
+	}
+
+	token, err := owner.CreateToken(request)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(token)
+	}
+
+	fmt.Printf("created token %s (id %d)\n\n", ui.Embolden("%s", token.Name), token.ID)
+	fmt.Println(token.Token)
+	fmt.Println()
+	fmt.Fprintln(ui.Stderr, "store the token now; it cannot be shown again")
+
+	return nil
+}
+
+type TokensListCommand struct {
+	Team flaghelpers.TeamFlag `long:"team" description:"List the tokens of this team's service accounts, rather than your own"`
+	Json bool                 `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *TokensListCommand) Execute([]string) error {
+	owner, err := loadTokenOwner(command.Team)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := owner.ListTokens()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(tokens)
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "name", Color: color.New(color.Bold)},
+		{Contents: "role", Color: color.New(color.Bold)},
+	}
+
+	if command.Team != "" {
+		headers = append(headers, ui.TableCell{Contents: "service account", Color: color.New(color.Bold)})
+	}
+
+	headers = append(headers,
+		ui.TableCell{Contents: "created", Color: color.New(color.Bold)},
+		ui.TableCell{Contents: "expires", Color: color.New(color.Bold)},
+	)
+
+	table := ui.Table{Headers: headers}
+
+	for _, token := range tokens {
+		row := ui.TableRow{
+			{Contents: strconv.Itoa(token.ID)},
+			{Contents: token.Name},
+			{Contents: token.Role},
+		}
+
+		if command.Team != "" {
+			row = append(row, ui.TableCell{Contents: token.ServiceAccount})
+		}
+
+		expiresCell := ui.TableCell{Contents: "never", Color: ui.OffColor}
+		if token.ExpiresAt != 0 {
+			expiresCell = ui.TableCell{Contents: time.Unix(token.ExpiresAt, 0).Local().Format(timeDateLayout)}
+		}
+
+		row = append(row,
+			ui.TableCell{Contents: time.Unix(token.CreatedAt, 0).Local().Format(timeDateLayout)},
+			expiresCell,
+		)
+
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type TokensRevokeCommand struct {
+	ID   int                  `long:"id" required:"true" description:"ID of the token to revoke"`
+	Team flaghelpers.TeamFlag `long:"team" description:"Revoke a token of this team's service accounts, rather than your own"`
+}
+
+func (command *TokensRevokeCommand) Execute([]string) error {
+	owner, err := loadTokenOwner(command.Team)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := owner.RevokeToken(command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return fmt.Errorf("token %d not found", command.ID)
+	}
+
+	fmt.Printf("revoked token %d\n", command.ID)
+
+	return nil
+}
@@ -1,15 +1,20 @@
 package commands
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type WatchCommand struct {
@@ -18,6 +23,8 @@ type WatchCommand struct {
 	Url                      string              `short:"u" long:"url"                                    description:"URL for the build or job to watch"`
 	Timestamp                bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
 	IgnoreEventParsingErrors bool                `long:"ignore-event-parsing-errors"                      description:"Ignore event parsing errors"`
+	All                      bool                `short:"a" long:"all"                                    description:"Watches status changes of builds and jobs in every team you can see"`
+	Json                     bool                `long:"json"                                             description:"Print each status change as a line of JSON (with --all)"`
 }
 
 func getBuildIDFromURL(target rc.Target, urlParam string) (int, error) {
@@ -83,8 +90,17 @@ func (command *WatchCommand) Execute(args []string) error {
 		return err
 	}
 
-	var buildId int
 	client := target.Client()
+
+	if command.All {
+		if command.Job.JobName != "" || command.Build != "" || command.Url != "" {
+			return errors.New("--all cannot be combined with --job, --build or --url")
+		}
+
+		return command.watchAll(client)
+	}
+
+	var buildId int
 	if command.Job.JobName != "" || command.Build == "" && command.Url == "" {
 		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineRef)
 		if err != nil {
@@ -123,3 +139,63 @@ func (command *WatchCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (command *WatchCommand) watchAll(client concourse.Client) error {
+	events, err := client.StatusEvents()
+	if err != nil {
+		return err
+	}
+
+	defer events.Close()
+
+	encoder := json.NewEncoder(os.Stdout)
+
+	for {
+		ev, err := events.NextEvent()
+		if err != nil {
+			return err
+		}
+
+		if command.Json {
+			err = encoder.Encode(ev)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		fmt.Println(formatStatusEvent(ev))
+	}
+}
+
+func formatStatusEvent(ev atc.StatusEvent) string {
+	name := ev.TeamName
+	if ev.PipelineName != "" {
+		pipelineRef := atc.PipelineRef{Name: ev.PipelineName, InstanceVars: ev.PipelineInstanceVars}
+		name += "/" + pipelineRef.String()
+	}
+
+	if ev.JobName != "" {
+		name += "/" + ev.JobName
+	}
+
+	var status string
+	switch ev.Type {
+	case atc.StatusEventBuild:
+		name += " #" + ev.BuildName
+
+		cell := ui.BuildStatusCell(ev.Status)
+		status = cell.Color.Sprint(cell.Contents)
+	case atc.StatusEventJob:
+		if ev.Paused != nil && *ev.Paused {
+			status = ui.OnColor.Sprint("paused")
+		} else {
+			status = "unpaused"
+		}
+	}
+
+	timestamp := time.Unix(ev.Time, 0).Format("15:04:05")
+
+	return fmt.Sprintf("%s  %s  %s", ui.OffColor.Sprint(timestamp), name, status)
+}
@@ -0,0 +1,191 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("audit-log", func() {
+		var (
+			flyCmd *exec.Cmd
+			events []atc.AuditEvent
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "audit-log")
+
+			events = []atc.AuditEvent{
+				{
+					ID:         2,
+					Time:       time.Date(2021, 6, 8, 12, 0, 0, 0, time.UTC).Unix(),
+					Actor:      "alice",
+					TeamName:   "main",
+					Action:     atc.SaveConfig,
+					Target:     "pipeline_name=deploy",
+					Method:     "PUT",
+					Path:       "/api/v1/teams/main/pipelines/deploy/config",
+					RemoteAddr: "10.0.0.1",
+				},
+				{
+					ID:     1,
+					Time:   time.Date(2021, 6, 8, 11, 0, 0, 0, time.UTC).Unix(),
+					Actor:  "bob",
+					Action: atc.SetLogLevel,
+					Method: "PUT",
+					Path:   "/api/v1/log-level",
+				},
+			}
+		})
+
+		Context("when the events are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", "limit=100"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, events),
+					),
+				)
+			})
+
+			It("lists the events", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "time", Color: color.New(color.Bold)},
+						{Contents: "actor", Color: color.New(color.Bold)},
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "action", Color: color.New(color.Bold)},
+						{Contents: "target", Color: color.New(color.Bold)},
+						{Contents: "remote address", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: time.Unix(events[0].Time, 0).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "alice"},
+							{Contents: "main"},
+							{Contents: "SaveConfig"},
+							{Contents: "pipeline_name=deploy"},
+							{Contents: "10.0.0.1"},
+						},
+						{
+							{Contents: time.Unix(events[1].Time, 0).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "bob"},
+							{Contents: "none", Color: color.New(color.Faint)},
+							{Contents: "SetLogLevel"},
+							{Contents: "none", Color: color.New(color.Faint)},
+							{Contents: "none", Color: color.New(color.Faint)},
+						},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the events as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 2,
+							"time": 1623153600,
+							"actor": "alice",
+							"team_name": "main",
+							"action": "SaveConfig",
+							"target": "pipeline_name=deploy",
+							"method": "PUT",
+							"path": "/api/v1/teams/main/pipelines/deploy/config",
+							"remote_addr": "10.0.0.1"
+						},
+						{
+							"id": 1,
+							"time": 1623150000,
+							"actor": "bob",
+							"action": "SetLogLevel",
+							"method": "PUT",
+							"path": "/api/v1/log-level"
+						}
+					]`))
+				})
+			})
+		})
+
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args,
+					"--since", "2021-06-08T00:00:00Z",
+					"--until", "2021-06-09T00:00:00Z",
+					"--actor", "alice",
+					"--action", "SaveConfig",
+					"--team", "main",
+					"--count", "5",
+				)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", "action=SaveConfig&actor=alice&limit=5&since=1623110400&team=main&until=1623196800"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, events[:1]),
+					),
+				)
+			})
+
+			It("sends them to the API", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("alice"))
+			})
+		})
+
+		Context("when the time is malformed", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--since", "last week")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("since time should be in the format yyyy-mm-dd or RFC3339"))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("dashboard", func() {
+		It("requires a terminal", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "dashboard")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("the dashboard can only be shown in a terminal"))
+		})
+
+		It("validates the interval", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "dashboard", "--interval", "0s")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("interval must be positive"))
+		})
+	})
+})
@@ -0,0 +1,247 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --job", func() {
+		var (
+			inputDir string
+
+			streaming chan struct{}
+			events    chan atc.Event
+			uploading chan struct{}
+
+			taskConfig   *atc.TaskConfig
+			pipelineConf atc.Config
+			expectedPlan atc.Plan
+
+			args []string
+			sess *gexec.Session
+		)
+
+		BeforeEach(func() {
+			var err error
+			inputDir, err = ioutil.TempDir("", "fly-job-input")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(inputDir, "some-file"), []byte("blob"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			streaming = make(chan struct{})
+			events = make(chan atc.Event)
+			uploading = make(chan struct{})
+
+			taskConfig = &atc.TaskConfig{
+				Platform: "linux",
+				ImageResource: &atc.ImageResource{
+					Type:   "registry-image",
+					Source: atc.Source{"repository": "busybox"},
+				},
+				Inputs: []atc.TaskInputConfig{
+					{Name: "some-input"},
+					{Name: "other-input"},
+				},
+				Run: atc.TaskRunConfig{Path: "true"},
+			}
+
+			pipelineConf = atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "some-input", Type: "git", Source: atc.Source{"uri": "some-uri"}},
+					{Name: "other-resource", Type: "git", Source: atc.Source{"uri": "other-uri"}},
+					{Name: "some-output", Type: "s3", Source: atc.Source{"bucket": "some-bucket"}},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						PlanSequence: []atc.Step{
+							{
+								Config: &atc.InParallelStep{
+									Config: atc.InParallelConfig{
+										Steps: []atc.Step{
+											{Config: &atc.GetStep{Name: "some-input"}},
+											{Config: &atc.GetStep{Name: "other-input", Resource: "other-resource"}},
+										},
+									},
+								},
+							},
+							{Config: &atc.TaskStep{Name: "unit", Config: taskConfig}},
+							{Config: &atc.PutStep{Name: "some-output"}},
+						},
+						OnFailure: &atc.Step{
+							Config: &atc.TaskStep{Name: "notify", Config: taskConfig},
+						},
+					},
+				},
+			}
+
+			planFactory := atc.NewPlanFactory(0)
+
+			expectedPlan = planFactory.NewPlan(atc.OnFailurePlan{
+				Step: planFactory.NewPlan(atc.DoPlan{
+					planFactory.NewPlan(atc.InParallelPlan{
+						Steps: []atc.Plan{
+							planFactory.NewPlan(atc.ArtifactInputPlan{
+								ArtifactID: 125,
+								Name:       "some-input",
+							}),
+							planFactory.NewPlan(atc.GetPlan{
+								Name:     "other-input",
+								Type:     "git",
+								Resource: "other-resource",
+								Source:   atc.Source{"uri": "other-uri"},
+								Version:  &atc.Version{"ref": "abc"},
+							}),
+						},
+					}),
+					planFactory.NewPlan(atc.TaskPlan{
+						Name:   "unit",
+						Config: taskConfig,
+					}),
+					planFactory.NewPlan(atc.DoPlan{}),
+				}),
+				Next: planFactory.NewPlan(atc.TaskPlan{
+					Name:   "notify",
+					Config: taskConfig,
+				}),
+			})
+
+			args = []string{
+				"--job", "some-pipeline/some-job",
+				"--input", fmt.Sprintf("some-input=%s", inputDir),
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(inputDir)
+		})
+
+		JustBeforeEach(func() {
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/config",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: pipelineConf}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/inputs",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.BuildInput{
+					{Name: "some-input", Version: atc.Version{"ref": "def"}},
+					{Name: "other-input", Version: atc.Version{"ref": "abc"}},
+				}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/resource-types",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.VersionedResourceTypes{}),
+			)
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, req *http.Request) {
+						close(uploading)
+					},
+					ghttp.RespondWithJSONEncoded(201, atc.WorkerArtifact{ID: 125, Name: "some-input"}),
+				),
+			)
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds",
+				ghttp.CombineHandlers(
+					VerifyPlan(expectedPlan),
+					ghttp.RespondWith(201, `{"id":128}`),
+				),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/events",
+				func(w http.ResponseWriter, r *http.Request) {
+					flusher := w.(http.Flusher)
+
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+					flusher.Flush()
+
+					close(streaming)
+
+					for e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						err = sse.Event{Name: "event", Data: payload}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+
+						flusher.Flush()
+					}
+
+					err := sse.Event{Name: "end"}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/artifacts",
+				ghttp.RespondWithJSONEncoded(200, []atc.WorkerArtifact{}),
+			)
+
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "execute"}, args...)...)
+
+			var err error
+			sess, err = gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("runs the job's plan with the local inputs and without puts", func() {
+			Eventually(uploading).Should(BeClosed())
+			Eventually(streaming).Should(BeClosed())
+
+			events <- event.Log{Payload: "sup"}
+			close(events)
+
+			Eventually(sess.Out).Should(gbytes.Say("executing build 128"))
+			Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(0))
+		})
+
+		Context("when an input is not an input of the job", func() {
+			BeforeEach(func() {
+				args = append(args, "--input", fmt.Sprintf("bogus=%s", inputDir))
+			})
+
+			It("errors", func() {
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("unknown input `bogus`"))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				args = []string{"--job", "some-pipeline/bogus-job"}
+			})
+
+			It("errors", func() {
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("job 'bogus-job' not found in pipeline 'some-pipeline'"))
+			})
+		})
+
+		Context("when a task config is also given", func() {
+			BeforeEach(func() {
+				args = append(args, "--config", filepath.Join(inputDir, "some-file"))
+			})
+
+			It("errors", func() {
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--config and --job cannot be used together"))
+			})
+		})
+	})
+})
@@ -0,0 +1,143 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("set-gitops", func() {
+		var configFile string
+
+		BeforeEach(func() {
+			dir, err := ioutil.TempDir("", "fly-gitops")
+			Expect(err).NotTo(HaveOccurred())
+
+			configFile = filepath.Join(dir, "gitops.yml")
+			err = ioutil.WriteFile(configFile, []byte(`
+type: git
+source: {uri: ((uri))}
+path: ci/pipelines.yml
+interval: 5m
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(filepath.Dir(configFile))
+		})
+
+		It("sends the config to the team", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/gitops"),
+					ghttp.VerifyJSONRepresenting(atc.GitOpsConfig{
+						Type:     "git",
+						Source:   atc.Source{"uri": "https://example.com/ci.git"},
+						Path:     "ci/pipelines.yml",
+						Interval: "5m",
+					}),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-gitops", "-c", configFile, "-v", "uri=https://example.com/ci.git")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("team 'main' now syncs its pipelines from ci/pipelines.yml every 5m0s"))
+		})
+
+		It("disables gitops", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/gitops"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-gitops", "--disable")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("gitops disabled for team 'main'"))
+		})
+
+		It("requires either --config or --disable", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-gitops")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("either --config or --disable must be specified"))
+		})
+	})
+
+	Describe("gitops-status", func() {
+		It("shows the result of the last sync", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/gitops"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.GitOps{
+						Config: &atc.GitOpsConfig{Type: "git", Path: "ci/pipelines.yml"},
+						Status: &atc.GitOpsStatus{
+							BuildID:  42,
+							Result:   atc.GitOpsSucceeded,
+							Version:  atc.Version{"ref": "abc"},
+							Created:  []string{"new-pipeline"},
+							Archived: []string{"old-pipeline"},
+							Drifted:  []string{"edited-pipeline"},
+						},
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "gitops-status")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`manifest: ci/pipelines.yml`))
+			Expect(sess.Out).To(gbytes.Say(`interval: 1m0s`))
+			Expect(sess.Out).To(gbytes.Say(`last sync: succeeded \(build 42\)`))
+			Expect(sess.Out).To(gbytes.Say(`version:   ref:abc`))
+			Expect(sess.Out).To(gbytes.Say(`created:   new-pipeline`))
+			Expect(sess.Out).To(gbytes.Say(`archived:  old-pipeline`))
+			Expect(sess.Out).To(gbytes.Say(`drifted:   edited-pipeline`))
+		})
+
+		It("fails when gitops is not enabled", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/gitops"),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "gitops-status")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("gitops is not enabled for team 'main'"))
+		})
+	})
+})
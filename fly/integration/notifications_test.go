@@ -0,0 +1,194 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("set-notifications", func() {
+		var configFile string
+
+		BeforeEach(func() {
+			dir, err := ioutil.TempDir("", "fly-notifications")
+			Expect(err).NotTo(HaveOccurred())
+
+			configFile = filepath.Join(dir, "notifications.yml")
+			err = ioutil.WriteFile(configFile, []byte(`
+notifications:
+- name: slack
+  url: https://hooks.slack.com/services/((hook))
+  pipelines: [some-pipeline]
+  statuses: [failed, errored]
+  template: '{"text": {{json .JobName}}}'
+- name: audit
+  url: https://audit.example.com/concourse
+  secret: ((secret))
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(filepath.Dir(configFile))
+		})
+
+		It("sends the notifications to the team", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/notifications"),
+					ghttp.VerifyJSONRepresenting(atc.NotificationConfigs{
+						{
+							Name:      "slack",
+							URL:       "https://hooks.slack.com/services/T000/B000",
+							Pipelines: []string{"some-pipeline"},
+							Statuses:  []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+							Template:  `{"text": {{json .JobName}}}`,
+						},
+						{
+							Name:   "audit",
+							URL:    "https://audit.example.com/concourse",
+							Secret: "shh",
+						},
+					}),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-notifications", "-c", configFile, "-v", "hook=T000/B000", "-v", "secret=shh")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("team 'main' now has 2 notification\\(s\\)"))
+		})
+
+		It("validates the notifications before sending them", func() {
+			err := ioutil.WriteFile(configFile, []byte(`
+notifications:
+- name: slack
+  url: ftp://example.com
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-notifications", "-c", configFile)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("invalid notification 'slack': url must be http or https"))
+		})
+
+		It("clears the notifications", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/notifications"),
+					ghttp.VerifyJSON(`[]`),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-notifications", "--clear")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("notifications cleared for team 'main'"))
+		})
+
+		It("requires either --config or --clear", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-notifications")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("either --config or --clear must be specified"))
+		})
+	})
+
+	Describe("notifications", func() {
+		It("lists the team's notifications", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/notifications"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.NotificationConfigs{
+						{
+							Name:      "slack",
+							URL:       "https://hooks.slack.com",
+							Pipelines: []string{"some-pipeline"},
+							Statuses:  []atc.BuildStatus{atc.StatusFailed},
+						},
+						{
+							Name: "audit",
+							URL:  "https://audit.example.com",
+						},
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "notifications")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`slack\s+https://hooks.slack.com\s+some-pipeline\s+failed`))
+			Expect(sess.Out).To(gbytes.Say(`audit\s+https://audit.example.com\s+all\s+succeeded,failed,errored,aborted`))
+		})
+
+		It("lists the recent deliveries", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/notifications/deliveries", "limit=50"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.NotificationDelivery{
+						{
+							ID:           4,
+							Notification: "slack",
+							State:        atc.NotificationDeliveryPending,
+							Attempts:     2,
+							PipelineName: "some-pipeline",
+							JobName:      "some-job",
+							BuildName:    "3",
+							BuildStatus:  atc.StatusFailed,
+							Error:        "unexpected response: 502 Bad Gateway",
+						},
+						{
+							ID:             3,
+							Notification:   "audit",
+							State:          atc.NotificationDeliverySucceeded,
+							Attempts:       1,
+							PipelineName:   "some-pipeline",
+							JobName:        "some-job",
+							BuildName:      "2",
+							BuildStatus:    atc.StatusSucceeded,
+							ResponseStatus: 204,
+						},
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "notifications", "--deliveries")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`4\s+slack\s+some-pipeline/some-job #3\s+failed\s+pending\s+2\s+n/a\s+unexpected response: 502 Bad Gateway`))
+			Expect(sess.Out).To(gbytes.Say(`3\s+audit\s+some-pipeline/some-job #2\s+succeeded\s+succeeded\s+1\s+n/a\s+204`))
+		})
+	})
+})
@@ -0,0 +1,188 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		oldConfig atc.Config
+		newConfig atc.Config
+	)
+
+	BeforeEach(func() {
+		oldConfig = atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "some-job", PlanSequence: []atc.Step{{Config: &atc.GetStep{Name: "some-resource"}}}},
+			},
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git"},
+			},
+		}
+
+		newConfig = atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "some-job", PlanSequence: []atc.Step{{Config: &atc.GetStep{Name: "some-resource"}}}},
+				{Name: "new-job", PlanSequence: []atc.Step{{Config: &atc.GetStep{Name: "some-resource"}}}},
+			},
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git"},
+			},
+		}
+	})
+
+	Describe("pipeline-history", func() {
+		It("lists the versions of the pipeline's config", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.PipelineConfigVersion{
+						{
+							Version:   5,
+							CreatedBy: "some-user",
+							Changes: &atc.ConfigChanges{
+								Jobs: []atc.ObjectChange{{Name: "new-job", Action: atc.DiffAdded}},
+							},
+						},
+						{Version: 2, BuildID: 42},
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`5\s+\S+\s+some-user\s+jobs: 1 added`))
+			Expect(sess.Out).To(gbytes.Say(`2\s+\S+\s+build 42\s+initial version`))
+		})
+
+		It("shows what changed in a version", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.PipelineConfigVersion{
+						{Version: 5},
+						{Version: 2},
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/5"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{Version: 5, Config: &newConfig}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/2"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{Version: 2, Config: &oldConfig}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline", "-v", "5")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("job new-job has been added"))
+		})
+
+		It("fails when the pipeline does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("pipeline not found"))
+		})
+	})
+
+	Describe("rollback-pipeline", func() {
+		It("saves the config of the old version over the current one", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/2"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{Version: 2, Config: &oldConfig}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: newConfig}, http.Header{atc.ConfigVersionHeader: {"5"}}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+					ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "5"),
+					func(w http.ResponseWriter, r *http.Request) {
+						var config atc.Config
+						Expect(atc.UnmarshalConfig(getConfig(r), &config)).To(Succeed())
+						Expect(config).To(Equal(oldConfig))
+					},
+					ghttp.RespondWith(http.StatusOK, "{}"),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--to", "2", "-n")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("job new-job has been removed"))
+			Expect(sess.Out).To(gbytes.Say("rolled back pipeline 'some-pipeline' to version 2"))
+		})
+
+		It("does nothing when the config is unchanged", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/5"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{Version: 5, Config: &newConfig}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: newConfig}, http.Header{atc.ConfigVersionHeader: {"5"}}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--to", "5", "-n")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("no changes to apply"))
+		})
+
+		It("fails when the version does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/3"),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--to", "3", "-n")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("version 3 of pipeline 'some-pipeline' not found"))
+		})
+	})
+})
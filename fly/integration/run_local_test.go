@@ -0,0 +1,126 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("run-local", func() {
+		var (
+			tmpdir   string
+			inputDir string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-run-local-test")
+			Expect(err).NotTo(HaveOccurred())
+
+			inputDir = filepath.Join(tmpdir, "some-input")
+			err = os.Mkdir(inputDir, 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(inputDir, "some-file"), []byte("hello from the input"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(tmpdir, "task.yml"), []byte(`---
+platform: linux
+
+inputs:
+- name: some-input
+
+params:
+  GREETING: ((greeting))
+
+run:
+  path: sh
+  args: [-c, 'cat some-input/some-file && echo " $GREETING"']
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(tmpdir, "pipeline.yml"), []byte(`---
+resources:
+- name: some-input
+  type: git
+  source: {uri: some-uri}
+
+jobs:
+- name: some-job
+  plan:
+  - get: some-input
+  - task: unit
+    file: some-input/task.yml
+  - put: some-input
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		It("runs a task without a target", func() {
+			flyCmd := exec.Command(
+				flyPath, "run-local",
+				"-c", filepath.Join(tmpdir, "task.yml"),
+				"-i", "some-input="+inputDir,
+				"-v", "greeting=hi",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out).Should(gbytes.Say("hello from the input hi"))
+			Eventually(sess.Out).Should(gbytes.Say("succeeded"))
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(0))
+		})
+
+		It("runs a job from a pipeline config", func() {
+			err := exec.Command("cp", filepath.Join(tmpdir, "task.yml"), inputDir).Run()
+			Expect(err).NotTo(HaveOccurred())
+
+			flyCmd := exec.Command(
+				flyPath, "run-local",
+				"-p", filepath.Join(tmpdir, "pipeline.yml"),
+				"-j", "some-job",
+				"-i", "some-input="+inputDir,
+				"-v", "greeting=hi",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out).Should(gbytes.Say("hello from the input hi"))
+			Eventually(sess.Out).Should(gbytes.Say("skipping put some-input"))
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(0))
+		})
+
+		It("errors when the job does not exist", func() {
+			flyCmd := exec.Command(
+				flyPath, "run-local",
+				"-p", filepath.Join(tmpdir, "pipeline.yml"),
+				"-j", "bogus-job",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("job 'bogus-job' not found in pipeline config"))
+		})
+	})
+})
@@ -0,0 +1,139 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"regexp"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("sessions list", func() {
+		var created, expires time.Time
+
+		BeforeEach(func() {
+			created = time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+			expires = created.Add(24 * time.Hour)
+		})
+
+		It("lists the sessions", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/sessions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Session{
+						{
+							ID:        2,
+							Sub:       "bob-sub",
+							Username:  "bob",
+							Connector: "github",
+							CreatedAt: created.Unix(),
+							ExpiresAt: expires.Unix(),
+						},
+						{
+							ID:        1,
+							Sub:       "unknown-sub",
+							CreatedAt: created.Unix(),
+							ExpiresAt: expires.Unix(),
+						},
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "sessions", "list")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`2\s+bob\s+github\s+` + regexp.QuoteMeta(created.Local().Format("2006-01-02@15:04:05-0700"))))
+			Expect(sess.Out).To(gbytes.Say(`1\s+none\s+none`))
+		})
+
+		It("filters the sessions by user", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/sessions", "username=bob&connector=github"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Session{}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "sessions", "list", "-u", "bob", "--connector", "github")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+		})
+	})
+
+	Describe("sessions revoke", func() {
+		It("revokes a session", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/sessions/2"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "sessions", "revoke", "--id", "2")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("revoked session 2"))
+		})
+
+		It("fails when the session does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/sessions/2"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "sessions", "revoke", "--id", "2")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("session 2 not found"))
+		})
+
+		It("revokes every session of a user", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/sessions", "username=bob"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.RevokedSessions{Revoked: 3, APITokens: 1}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "sessions", "revoke", "-u", "bob")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("revoked 3 session\\(s\\) and 1 API token\\(s\\) of user bob"))
+		})
+
+		It("requires either --id or --user", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "sessions", "revoke")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("either --id or --user must be specified"))
+		})
+	})
+})
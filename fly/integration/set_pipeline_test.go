@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -970,6 +971,76 @@ this is super secure
 					)
 				})
 
+				Context("when --dry-run is given", func() {
+					It("shows the diff without applying it", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "--dry-run")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gbytes.Say("resource some-resource has changed"))
+						Eventually(sess).Should(gbytes.Say("dry run: not applying configuration"))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+
+						for _, req := range atcServer.ReceivedRequests() {
+							Expect(req.Method).ToNot(Equal("PUT"))
+						}
+					})
+
+					Context("with --output json", func() {
+						It("prints the structured changes and their consequences", func() {
+							flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "--dry-run", "-o", "json")
+
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(0))
+
+							var preview struct {
+								Pipeline string            `json:"pipeline"`
+								Created  bool              `json:"created"`
+								Changes  atc.ConfigChanges `json:"changes"`
+							}
+							err = json.Unmarshal(sess.Out.Contents(), &preview)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(preview.Pipeline).To(Equal("awesome-pipeline"))
+							Expect(preview.Created).To(BeFalse())
+							Expect(preview.Changes.Resources).To(ContainElement(atc.ObjectChange{
+								Name:   "some-resource",
+								Action: atc.DiffChanged,
+								Fields: []atc.FieldChange{{Path: "$.type", Before: "some-type", After: "some-new-type"}},
+							}))
+							Expect(preview.Changes.Resources).To(ContainElement(atc.ObjectChange{
+								Name:   "some-other-resource",
+								Action: atc.DiffRemoved,
+							}))
+							Expect(preview.Changes.NewCheckConfigs).To(ContainElement("some-resource"))
+							Expect(preview.Changes.OrphanedJobs).ToNot(BeEmpty())
+
+							for _, req := range atcServer.ReceivedRequests() {
+								Expect(req.Method).ToNot(Equal("PUT"))
+							}
+						})
+					})
+				})
+
+				Context("when --output json is given without --dry-run", func() {
+					It("errors", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "-o", "json")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(1))
+						Expect(sess.Err).To(gbytes.Say("--output json can only be used with --dry-run"))
+					})
+				})
+
 				It("parses the config file and sends it to the ATC", func() {
 					Expect(func() {
 						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name())
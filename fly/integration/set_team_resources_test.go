@@ -0,0 +1,117 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("set-team-resources", func() {
+		var configFile string
+
+		BeforeEach(func() {
+			dir, err := ioutil.TempDir("", "fly-team-resources")
+			Expect(err).NotTo(HaveOccurred())
+
+			configFile = filepath.Join(dir, "shared.yml")
+			err = ioutil.WriteFile(configFile, []byte(`
+resources:
+- name: repo
+  type: git
+  source:
+    uri: ((uri))
+resource_types:
+- name: slack
+  type: registry-image
+  source:
+    repository: cfcommunity/slack-notification-resource
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(filepath.Dir(configFile))
+		})
+
+		It("sends the shared resources to the team", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/shared-resources"),
+					ghttp.VerifyJSONRepresenting(atc.TeamResources{
+						Resources: atc.ResourceConfigs{
+							{Name: "repo", Type: "git", Source: atc.Source{"uri": "https://example.com/repo.git"}},
+						},
+						ResourceTypes: atc.ResourceTypes{
+							{Name: "slack", Type: "registry-image", Source: atc.Source{"repository": "cfcommunity/slack-notification-resource"}},
+						},
+					}),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-resources", "-c", configFile, "-v", "uri=https://example.com/repo.git")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`team 'main' now shares 1 resource\(s\) and 1 resource type\(s\)`))
+		})
+
+		It("shows why the shared resources were rejected", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/shared-resources"),
+					ghttp.RespondWith(http.StatusConflict, `{"errors":["shared resource 'chat' is still used by pipeline 'some-pipeline'"]}`),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-resources", "-c", configFile, "-v", "uri=https://example.com/repo.git")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("shared resource 'chat' is still used by pipeline 'some-pipeline'"))
+		})
+
+		It("clears the shared resources", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/shared-resources"),
+					ghttp.VerifyJSON(`{}`),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-resources", "--clear")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("shared resources cleared for team 'main'"))
+		})
+
+		It("requires either --config or --clear", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-resources")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("either --config or --clear must be specified"))
+		})
+	})
+})
@@ -122,6 +122,33 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("Setting scim auth", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_with_scim_auth.yml"}
+				})
+
+				It("shows the groups configured for scim for a given role", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("setting team: venture"))
+
+					Eventually(sess.Out).Should(gbytes.Say("role member:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("none"))
+					Eventually(sess.Out).Should(gbytes.Say("groups:"))
+					Eventually(sess.Out).Should(gbytes.Say("- scim:developers"))
+
+					Eventually(sess.Out).Should(gbytes.Say("role owner:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-owner"))
+					Eventually(sess.Out).Should(gbytes.Say("groups:"))
+					Eventually(sess.Out).Should(gbytes.Say("- scim:admins"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
 			Context("Setting github auth", func() {
 				BeforeEach(func() {
 					cmdParams = []string{"-c", "fixtures/team_config_with_github_auth.yml"}
@@ -291,6 +318,48 @@ var _ = Describe("Fly CLI", func() {
 					Eventually(sess).Should(gexec.Exit(1))
 				})
 			})
+
+			Context("Setting custom roles scoped to pipelines", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_scoped_roles.yml"}
+				})
+
+				It("shows the pipelines and actions configured for a given role", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("setting team: venture"))
+
+					Eventually(sess.Out).Should(gbytes.Say("role contractor:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-contractor"))
+					Eventually(sess.Out).Should(gbytes.Say("pipelines:"))
+					Eventually(sess.Out).Should(gbytes.Say("- some-pipeline"))
+					Eventually(sess.Out).Should(gbytes.Say("- other-pipeline/branch:main"))
+					Eventually(sess.Out).Should(gbytes.Say("actions:"))
+					Eventually(sess.Out).Should(gbytes.Say("- GetPipeline"))
+					Eventually(sess.Out).Should(gbytes.Say("- ListJobs"))
+					Eventually(sess.Out).Should(gbytes.Say("- CreateJobBuild"))
+
+					Eventually(sess.Out).Should(gbytes.Say("role owner:"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
+			Context("Setting a custom role with an unknown action", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_unknown_action.yml"}
+				})
+
+				It("errors", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("custom role 'contractor' has unknown action 'DoAnything'"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
 		})
 
 		Describe("confirmation", func() {
@@ -526,6 +595,26 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("Setting scim auth", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"--scim-group", "Developers"}
+				})
+
+				It("shows the groups configured for scim auth", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("setting team: venture"))
+					Eventually(sess.Out).Should(gbytes.Say("role owner:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("none"))
+					Eventually(sess.Out).Should(gbytes.Say("groups:"))
+					Eventually(sess.Out).Should(gbytes.Say("- scim:developers"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
 			Context("Setting cf auth", func() {
 				BeforeEach(func() {
 					cmdParams = []string{"--cf-org", "myorg-1", "--cf-space", "myorg-2:myspace", "--cf-user", "my-username", "--cf-space-guid", "myspace-guid"}
@@ -0,0 +1,78 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("team-members", func() {
+		var members []atc.TeamMember
+
+		BeforeEach(func() {
+			members = []atc.TeamMember{
+				{
+					UserName: "alice",
+					Email:    "alice@example.com",
+					Roles:    []string{"member"},
+					Groups:   []string{"developers"},
+				},
+				{
+					UserName:    "bob",
+					DisplayName: "Bob",
+					Roles:       []string{"member", "owner"},
+					Groups:      []string{"admins", "developers"},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/members"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, members),
+				),
+			)
+		})
+
+		It("lists the team's members", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "team-members")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`alice\s+none\s+alice@example.com\s+member\s+developers`))
+			Expect(sess.Out).To(gbytes.Say(`bob\s+Bob\s+none\s+member,owner\s+admins,developers`))
+		})
+
+		It("prints the members as JSON", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "team-members", "--json")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out.Contents()).To(MatchJSON(`[
+				{
+					"user_name": "alice",
+					"email": "alice@example.com",
+					"roles": ["member"],
+					"groups": ["developers"]
+				},
+				{
+					"user_name": "bob",
+					"display_name": "Bob",
+					"roles": ["member", "owner"],
+					"groups": ["admins", "developers"]
+				}
+			]`))
+		})
+	})
+})
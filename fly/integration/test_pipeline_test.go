@@ -0,0 +1,84 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("test-pipeline", func() {
+		It("reports the steps of each test", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"test-pipeline",
+				"-c", "fixtures/test-pipeline.yml",
+				"-s", "fixtures/test-pipeline-suite.yml",
+				"-v", "uri=https://example.com/repo.git",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gbytes.Say(`PASS notifies slack on failure \(unit\)`))
+			Eventually(sess).Should(gbytes.Say(`task:test failed`))
+			Eventually(sess).Should(gbytes.Say(`put:slack succeeded inputs: repo params: text=unit failed`))
+			Eventually(sess).Should(gbytes.Say(`PASS does not notify on success \(unit\)`))
+			Eventually(sess).Should(gbytes.Say(`2 of 2 tests passed`))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+
+		Context("when an expectation is not met", func() {
+			var suitePath string
+
+			BeforeEach(func() {
+				dir, err := ioutil.TempDir("", "fly-test-pipeline")
+				Expect(err).NotTo(HaveOccurred())
+
+				suitePath = filepath.Join(dir, "suite.yml")
+				err = ioutil.WriteFile(suitePath, []byte(`
+tests:
+- name: expects a notification
+  job: unit
+  expect:
+    ran: [put:slack]
+`), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(filepath.Dir(suitePath))
+			})
+
+			It("reports the failure and exits nonzero", func() {
+				flyCmd := exec.Command(
+					flyPath,
+					"test-pipeline",
+					"-c", "fixtures/test-pipeline.yml",
+					"-s", suitePath,
+					"-v", "uri=https://example.com/repo.git",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say(`FAIL expects a notification \(unit\)`))
+				Eventually(sess).Should(gbytes.Say(`expected put:slack to run`))
+				Eventually(sess).Should(gbytes.Say(`0 of 1 tests passed`))
+				Eventually(sess.Err).Should(gbytes.Say(`some tests failed`))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,158 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("tokens create", func() {
+		It("creates a token for the user and prints it", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/user/tokens"),
+					ghttp.VerifyJSONRepresenting(atc.APITokenRequest{
+						Name: "ci",
+						Role: "member",
+					}),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+						ID:    1,
+						Name:  "ci",
+						Role:  "member",
+						Token: "cpat_some-token",
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "create", "-n", "ci", "-r", "member")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("created token ci \\(id 1\\)"))
+			Expect(sess.Out).To(gbytes.Say("cpat_some-token"))
+			Expect(sess.Err).To(gbytes.Say("cannot be shown again"))
+		})
+
+		It("creates a token for a service account of a team", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/other-team"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{Name: "other-team"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/other-team/tokens"),
+					func(w http.ResponseWriter, r *http.Request) {
+						defer GinkgoRecover()
+
+						var request atc.APITokenRequest
+						Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+						Expect(request.Name).To(Equal("deploy"))
+						Expect(request.ServiceAccount).To(Equal("deployer"))
+						Expect(request.ExpiresAt).To(BeNumerically("~", time.Now().Add(time.Hour).Unix(), 60))
+					},
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+						ID:             2,
+						Name:           "deploy",
+						Role:           "pipeline-operator",
+						TeamName:       "other-team",
+						ServiceAccount: "deployer",
+						Token:          "cpat_other-token",
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "create",
+				"-n", "deploy",
+				"-r", "pipeline-operator",
+				"--team", "other-team",
+				"--service-account", "deployer",
+				"--expires-in", "1h",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("cpat_other-token"))
+		})
+
+		It("requires --team and --service-account together", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "create", "-n", "ci", "-r", "member", "--service-account", "deployer")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("--team and --service-account must be given together"))
+		})
+	})
+
+	Describe("tokens list", func() {
+		It("lists the user's tokens", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/user/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.APIToken{
+						{ID: 1, Name: "ci", Role: "member", CreatedAt: time.Now().Unix()},
+					}),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "list")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("1\\s+ci\\s+member\\s+.+\\s+never"))
+		})
+	})
+
+	Describe("tokens revoke", func() {
+		It("revokes the token", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/user/tokens/1"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "revoke", "--id", "1")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("revoked token 1"))
+		})
+
+		It("fails when the token does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/user/tokens/1"),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens", "revoke", "--id", "1")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("token 1 not found"))
+		})
+	})
+})
@@ -241,4 +241,100 @@ var _ = Describe("Watching", func() {
 			})
 		})
 	})
+
+	Context("with --all", func() {
+		var done chan struct{}
+
+		BeforeEach(func() {
+			done = make(chan struct{})
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/status/events"),
+					func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+						w.WriteHeader(http.StatusOK)
+
+						paused := true
+						for i, e := range []atc.StatusEvent{
+							{
+								Type:                 atc.StatusEventBuild,
+								Time:                 100,
+								TeamName:             "main",
+								PipelineName:         "some-pipeline",
+								PipelineInstanceVars: atc.InstanceVars{"branch": "master"},
+								JobName:              "some-job",
+								BuildName:            "3",
+								Status:               atc.StatusSucceeded,
+							},
+							{
+								Type:         atc.StatusEventJob,
+								Time:         200,
+								TeamName:     "main",
+								PipelineName: "some-pipeline",
+								JobName:      "other-job",
+								Paused:       &paused,
+							},
+						} {
+							e.ID = i + 1
+
+							payload, err := json.Marshal(e)
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{
+								ID:   fmt.Sprintf("%d", e.ID),
+								Name: "event",
+								Data: payload,
+							}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+						}
+
+						w.(http.Flusher).Flush()
+
+						<-done
+					},
+				),
+			)
+		})
+
+		AfterEach(func() {
+			close(done)
+		})
+
+		It("prints the status changes as they happen", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--all")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out).Should(gbytes.Say(`main/some-pipeline/branch:master/some-job #3  succeeded`))
+			Eventually(sess.Out).Should(gbytes.Say(`main/some-pipeline/other-job  paused`))
+
+			sess.Interrupt()
+			<-sess.Exited
+		})
+
+		It("prints the status changes as JSON with --json", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--all", "--json")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out).Should(gbytes.Say(`\{"id":2,"type":"job","time":200,"team_name":"main","pipeline_name":"some-pipeline","job_name":"other-job","paused":true\}`))
+
+			sess.Interrupt()
+			<-sess.Exited
+		})
+
+		It("cannot be combined with a build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--all", "--build", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("--all cannot be combined with --job, --build or --url"))
+		})
+	})
 })
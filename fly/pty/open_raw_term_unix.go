@@ -22,3 +22,14 @@ func OpenRawTerm() (Term, error) {
 
 	return t, nil
 }
+
+// OpenCBreakTerm reads input a key at a time without echoing it, while still
+// translating output newlines and generating signals, unlike OpenRawTerm.
+func OpenCBreakTerm() (Term, error) {
+	t, err := term.Open(os.Stdin.Name(), term.CBreakMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -20,6 +20,10 @@ func OpenRawTerm() (Term, error) {
 	}, nil
 }
 
+func OpenCBreakTerm() (Term, error) {
+	return OpenRawTerm()
+}
+
 type noopRestoreTerm struct {
 	io.Reader
 	io.Writer
@@ -0,0 +1,68 @@
+package concourse
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+// AuditEventFilter narrows down the audit events returned. Zero values match
+// every event.
+type AuditEventFilter struct {
+	Since time.Time
+	Until time.Time
+
+	Actor    string
+	Action   string
+	TeamName string
+
+	Limit int
+}
+
+func (client *client) AuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error) {
+	queryParams := url.Values{}
+
+	if !filter.Since.IsZero() {
+		queryParams.Add("since", strconv.FormatInt(filter.Since.Unix(), 10))
+	}
+
+	if !filter.Until.IsZero() {
+		queryParams.Add("until", strconv.FormatInt(filter.Until.Unix(), 10))
+	}
+
+	if filter.Actor != "" {
+		queryParams.Add("actor", filter.Actor)
+	}
+
+	if filter.Action != "" {
+		queryParams.Add("action", filter.Action)
+	}
+
+	if filter.TeamName != "" {
+		queryParams.Add("team", filter.TeamName)
+	}
+
+	if filter.Limit > 0 {
+		queryParams.Add("limit", strconv.Itoa(filter.Limit))
+	}
+
+	var events []atc.AuditEvent
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListAuditEvents,
+		Query:       queryParams,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &events,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
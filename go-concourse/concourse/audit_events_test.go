@@ -0,0 +1,77 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Audit Events Handler", func() {
+	Describe("AuditEvents", func() {
+		expectedEvents := []atc.AuditEvent{
+			{ID: 2, Time: 100, Actor: "alice", TeamName: "main", Action: atc.SaveConfig, Method: "PUT", Path: "/api/v1/teams/main/pipelines/deploy/config"},
+		}
+
+		Context("without a filter", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents),
+					),
+				)
+			})
+
+			It("returns the events", func() {
+				events, err := client.AuditEvents(concourse.AuditEventFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(Equal(expectedEvents))
+			})
+		})
+
+		Context("with a filter", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", "action=SaveConfig&actor=alice&limit=5&since=10&team=main&until=20"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents),
+					),
+				)
+			})
+
+			It("sends the filter in the query", func() {
+				events, err := client.AuditEvents(concourse.AuditEventFilter{
+					Since:    time.Unix(10, 0),
+					Until:    time.Unix(20, 0),
+					Actor:    "alice",
+					Action:   atc.SaveConfig,
+					TeamName: "main",
+					Limit:    5,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(Equal(expectedEvents))
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := client.AuditEvents(concourse.AuditEventFilter{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -18,6 +18,7 @@ type Client interface {
 	Builds(Page) ([]atc.Build, Pagination, error)
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
+	StatusEvents() (StatusEvents, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
@@ -26,6 +27,9 @@ type Client interface {
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	DrainWorker(workerName string) error
+	ScaleDownWorker(workerName string) error
+	WorkerCapacity() (atc.WorkerCapacity, error)
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
@@ -35,6 +39,16 @@ type Client interface {
 	Team(teamName string) Team
 	UserInfo() (atc.UserInfo, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+
+	ListTokens() ([]atc.APIToken, error)
+	CreateToken(request atc.APITokenRequest) (atc.APIToken, error)
+	RevokeToken(id int) (bool, error)
+
+	ListSessions(filter SessionFilter) ([]atc.Session, error)
+	RevokeSession(id int) (bool, error)
+	RevokeUserSessions(filter SessionFilter) (atc.RevokedSessions, error)
+
+	AuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error)
 }
 
 type client struct {
@@ -23,6 +23,19 @@ type FakeClient struct {
 	abortBuildReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuditEventsStub        func(concourse.AuditEventFilter) ([]atc.AuditEvent, error)
+	auditEventsMutex       sync.RWMutex
+	auditEventsArgsForCall []struct {
+		arg1 concourse.AuditEventFilter
+	}
+	auditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}
+	auditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}
 	BuildStub        func(string) (atc.Build, bool, error)
 	buildMutex       sync.RWMutex
 	buildArgsForCall []struct {
@@ -96,6 +109,30 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	CreateTokenStub        func(atc.APITokenRequest) (atc.APIToken, error)
+	createTokenMutex       sync.RWMutex
+	createTokenArgsForCall []struct {
+		arg1 atc.APITokenRequest
+	}
+	createTokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createTokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	DrainWorkerStub        func(string) error
+	drainWorkerMutex       sync.RWMutex
+	drainWorkerArgsForCall []struct {
+		arg1 string
+	}
+	drainWorkerReturns struct {
+		result1 error
+	}
+	drainWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -208,6 +245,19 @@ type FakeClient struct {
 		result1 []atc.Pipeline
 		result2 error
 	}
+	ListSessionsStub        func(concourse.SessionFilter) ([]atc.Session, error)
+	listSessionsMutex       sync.RWMutex
+	listSessionsArgsForCall []struct {
+		arg1 concourse.SessionFilter
+	}
+	listSessionsReturns struct {
+		result1 []atc.Session
+		result2 error
+	}
+	listSessionsReturnsOnCall map[int]struct {
+		result1 []atc.Session
+		result2 error
+	}
 	ListTeamsStub        func() ([]atc.Team, error)
 	listTeamsMutex       sync.RWMutex
 	listTeamsArgsForCall []struct {
@@ -220,6 +270,18 @@ type FakeClient struct {
 		result1 []atc.Team
 		result2 error
 	}
+	ListTokensStub        func() ([]atc.APIToken, error)
+	listTokensMutex       sync.RWMutex
+	listTokensArgsForCall []struct {
+	}
+	listTokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	listTokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
 	ListWorkersStub        func() ([]atc.Worker, error)
 	listWorkersMutex       sync.RWMutex
 	listWorkersArgsForCall []struct {
@@ -243,6 +305,45 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RevokeSessionStub        func(int) (bool, error)
+	revokeSessionMutex       sync.RWMutex
+	revokeSessionArgsForCall []struct {
+		arg1 int
+	}
+	revokeSessionReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeSessionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeTokenStub        func(int) (bool, error)
+	revokeTokenMutex       sync.RWMutex
+	revokeTokenArgsForCall []struct {
+		arg1 int
+	}
+	revokeTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeUserSessionsStub        func(concourse.SessionFilter) (atc.RevokedSessions, error)
+	revokeUserSessionsMutex       sync.RWMutex
+	revokeUserSessionsArgsForCall []struct {
+		arg1 concourse.SessionFilter
+	}
+	revokeUserSessionsReturns struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}
+	revokeUserSessionsReturnsOnCall map[int]struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}
 	SaveWorkerStub        func(atc.Worker, *time.Duration) (*atc.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -257,6 +358,29 @@ type FakeClient struct {
 		result1 *atc.Worker
 		result2 error
 	}
+	ScaleDownWorkerStub        func(string) error
+	scaleDownWorkerMutex       sync.RWMutex
+	scaleDownWorkerArgsForCall []struct {
+		arg1 string
+	}
+	scaleDownWorkerReturns struct {
+		result1 error
+	}
+	scaleDownWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StatusEventsStub        func() (concourse.StatusEvents, error)
+	statusEventsMutex       sync.RWMutex
+	statusEventsArgsForCall []struct {
+	}
+	statusEventsReturns struct {
+		result1 concourse.StatusEvents
+		result2 error
+	}
+	statusEventsReturnsOnCall map[int]struct {
+		result1 concourse.StatusEvents
+		result2 error
+	}
 	TeamStub        func(string) concourse.Team
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -290,6 +414,18 @@ type FakeClient struct {
 		result1 atc.UserInfo
 		result2 error
 	}
+	WorkerCapacityStub        func() (atc.WorkerCapacity, error)
+	workerCapacityMutex       sync.RWMutex
+	workerCapacityArgsForCall []struct {
+	}
+	workerCapacityReturns struct {
+		result1 atc.WorkerCapacity
+		result2 error
+	}
+	workerCapacityReturnsOnCall map[int]struct {
+		result1 atc.WorkerCapacity
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -355,6 +491,70 @@ func (fake *FakeClient) AbortBuildReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) AuditEvents(arg1 concourse.AuditEventFilter) ([]atc.AuditEvent, error) {
+	fake.auditEventsMutex.Lock()
+	ret, specificReturn := fake.auditEventsReturnsOnCall[len(fake.auditEventsArgsForCall)]
+	fake.auditEventsArgsForCall = append(fake.auditEventsArgsForCall, struct {
+		arg1 concourse.AuditEventFilter
+	}{arg1})
+	stub := fake.AuditEventsStub
+	fakeReturns := fake.auditEventsReturns
+	fake.recordInvocation("AuditEvents", []interface{}{arg1})
+	fake.auditEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) AuditEventsCallCount() int {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return len(fake.auditEventsArgsForCall)
+}
+
+func (fake *FakeClient) AuditEventsCalls(stub func(concourse.AuditEventFilter) ([]atc.AuditEvent, error)) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = stub
+}
+
+func (fake *FakeClient) AuditEventsArgsForCall(i int) concourse.AuditEventFilter {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	argsForCall := fake.auditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) AuditEventsReturns(result1 []atc.AuditEvent, result2 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	fake.auditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	if fake.auditEventsReturnsOnCall == nil {
+		fake.auditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 error
+		})
+	}
+	fake.auditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Build(arg1 string) (atc.Build, bool, error) {
 	fake.buildMutex.Lock()
 	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
@@ -687,6 +887,131 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) CreateToken(arg1 atc.APITokenRequest) (atc.APIToken, error) {
+	fake.createTokenMutex.Lock()
+	ret, specificReturn := fake.createTokenReturnsOnCall[len(fake.createTokenArgsForCall)]
+	fake.createTokenArgsForCall = append(fake.createTokenArgsForCall, struct {
+		arg1 atc.APITokenRequest
+	}{arg1})
+	stub := fake.CreateTokenStub
+	fakeReturns := fake.createTokenReturns
+	fake.recordInvocation("CreateToken", []interface{}{arg1})
+	fake.createTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateTokenCallCount() int {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	return len(fake.createTokenArgsForCall)
+}
+
+func (fake *FakeClient) CreateTokenCalls(stub func(atc.APITokenRequest) (atc.APIToken, error)) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = stub
+}
+
+func (fake *FakeClient) CreateTokenArgsForCall(i int) atc.APITokenRequest {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	argsForCall := fake.createTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreateTokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	fake.createTokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateTokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	if fake.createTokenReturnsOnCall == nil {
+		fake.createTokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createTokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DrainWorker(arg1 string) error {
+	fake.drainWorkerMutex.Lock()
+	ret, specificReturn := fake.drainWorkerReturnsOnCall[len(fake.drainWorkerArgsForCall)]
+	fake.drainWorkerArgsForCall = append(fake.drainWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DrainWorkerStub
+	fakeReturns := fake.drainWorkerReturns
+	fake.recordInvocation("DrainWorker", []interface{}{arg1})
+	fake.drainWorkerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DrainWorkerCallCount() int {
+	fake.drainWorkerMutex.RLock()
+	defer fake.drainWorkerMutex.RUnlock()
+	return len(fake.drainWorkerArgsForCall)
+}
+
+func (fake *FakeClient) DrainWorkerCalls(stub func(string) error) {
+	fake.drainWorkerMutex.Lock()
+	defer fake.drainWorkerMutex.Unlock()
+	fake.DrainWorkerStub = stub
+}
+
+func (fake *FakeClient) DrainWorkerArgsForCall(i int) string {
+	fake.drainWorkerMutex.RLock()
+	defer fake.drainWorkerMutex.RUnlock()
+	argsForCall := fake.drainWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DrainWorkerReturns(result1 error) {
+	fake.drainWorkerMutex.Lock()
+	defer fake.drainWorkerMutex.Unlock()
+	fake.DrainWorkerStub = nil
+	fake.drainWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DrainWorkerReturnsOnCall(i int, result1 error) {
+	fake.drainWorkerMutex.Lock()
+	defer fake.drainWorkerMutex.Unlock()
+	fake.DrainWorkerStub = nil
+	if fake.drainWorkerReturnsOnCall == nil {
+		fake.drainWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.drainWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -1229,6 +1554,70 @@ func (fake *FakeClient) ListPipelinesReturnsOnCall(i int, result1 []atc.Pipeline
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListSessions(arg1 concourse.SessionFilter) ([]atc.Session, error) {
+	fake.listSessionsMutex.Lock()
+	ret, specificReturn := fake.listSessionsReturnsOnCall[len(fake.listSessionsArgsForCall)]
+	fake.listSessionsArgsForCall = append(fake.listSessionsArgsForCall, struct {
+		arg1 concourse.SessionFilter
+	}{arg1})
+	stub := fake.ListSessionsStub
+	fakeReturns := fake.listSessionsReturns
+	fake.recordInvocation("ListSessions", []interface{}{arg1})
+	fake.listSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListSessionsCallCount() int {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	return len(fake.listSessionsArgsForCall)
+}
+
+func (fake *FakeClient) ListSessionsCalls(stub func(concourse.SessionFilter) ([]atc.Session, error)) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = stub
+}
+
+func (fake *FakeClient) ListSessionsArgsForCall(i int) concourse.SessionFilter {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	argsForCall := fake.listSessionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListSessionsReturns(result1 []atc.Session, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	fake.listSessionsReturns = struct {
+		result1 []atc.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListSessionsReturnsOnCall(i int, result1 []atc.Session, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	if fake.listSessionsReturnsOnCall == nil {
+		fake.listSessionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Session
+			result2 error
+		})
+	}
+	fake.listSessionsReturnsOnCall[i] = struct {
+		result1 []atc.Session
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListTeams() ([]atc.Team, error) {
 	fake.listTeamsMutex.Lock()
 	ret, specificReturn := fake.listTeamsReturnsOnCall[len(fake.listTeamsArgsForCall)]
@@ -1285,6 +1674,62 @@ func (fake *FakeClient) ListTeamsReturnsOnCall(i int, result1 []atc.Team, result
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListTokens() ([]atc.APIToken, error) {
+	fake.listTokensMutex.Lock()
+	ret, specificReturn := fake.listTokensReturnsOnCall[len(fake.listTokensArgsForCall)]
+	fake.listTokensArgsForCall = append(fake.listTokensArgsForCall, struct {
+	}{})
+	stub := fake.ListTokensStub
+	fakeReturns := fake.listTokensReturns
+	fake.recordInvocation("ListTokens", []interface{}{})
+	fake.listTokensMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListTokensCallCount() int {
+	fake.listTokensMutex.RLock()
+	defer fake.listTokensMutex.RUnlock()
+	return len(fake.listTokensArgsForCall)
+}
+
+func (fake *FakeClient) ListTokensCalls(stub func() ([]atc.APIToken, error)) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = stub
+}
+
+func (fake *FakeClient) ListTokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = nil
+	fake.listTokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListTokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = nil
+	if fake.listTokensReturnsOnCall == nil {
+		fake.listTokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.listTokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListWorkers() ([]atc.Worker, error) {
 	fake.listWorkersMutex.Lock()
 	ret, specificReturn := fake.listWorkersReturnsOnCall[len(fake.listWorkersArgsForCall)]
@@ -1402,6 +1847,198 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) RevokeSession(arg1 int) (bool, error) {
+	fake.revokeSessionMutex.Lock()
+	ret, specificReturn := fake.revokeSessionReturnsOnCall[len(fake.revokeSessionArgsForCall)]
+	fake.revokeSessionArgsForCall = append(fake.revokeSessionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.RevokeSessionStub
+	fakeReturns := fake.revokeSessionReturns
+	fake.recordInvocation("RevokeSession", []interface{}{arg1})
+	fake.revokeSessionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeSessionCallCount() int {
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	return len(fake.revokeSessionArgsForCall)
+}
+
+func (fake *FakeClient) RevokeSessionCalls(stub func(int) (bool, error)) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = stub
+}
+
+func (fake *FakeClient) RevokeSessionArgsForCall(i int) int {
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	argsForCall := fake.revokeSessionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RevokeSessionReturns(result1 bool, result2 error) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = nil
+	fake.revokeSessionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeSessionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = nil
+	if fake.revokeSessionReturnsOnCall == nil {
+		fake.revokeSessionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeSessionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeToken(arg1 int) (bool, error) {
+	fake.revokeTokenMutex.Lock()
+	ret, specificReturn := fake.revokeTokenReturnsOnCall[len(fake.revokeTokenArgsForCall)]
+	fake.revokeTokenArgsForCall = append(fake.revokeTokenArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.RevokeTokenStub
+	fakeReturns := fake.revokeTokenReturns
+	fake.recordInvocation("RevokeToken", []interface{}{arg1})
+	fake.revokeTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeTokenCallCount() int {
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	return len(fake.revokeTokenArgsForCall)
+}
+
+func (fake *FakeClient) RevokeTokenCalls(stub func(int) (bool, error)) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = stub
+}
+
+func (fake *FakeClient) RevokeTokenArgsForCall(i int) int {
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	argsForCall := fake.revokeTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RevokeTokenReturns(result1 bool, result2 error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = nil
+	fake.revokeTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = nil
+	if fake.revokeTokenReturnsOnCall == nil {
+		fake.revokeTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeUserSessions(arg1 concourse.SessionFilter) (atc.RevokedSessions, error) {
+	fake.revokeUserSessionsMutex.Lock()
+	ret, specificReturn := fake.revokeUserSessionsReturnsOnCall[len(fake.revokeUserSessionsArgsForCall)]
+	fake.revokeUserSessionsArgsForCall = append(fake.revokeUserSessionsArgsForCall, struct {
+		arg1 concourse.SessionFilter
+	}{arg1})
+	stub := fake.RevokeUserSessionsStub
+	fakeReturns := fake.revokeUserSessionsReturns
+	fake.recordInvocation("RevokeUserSessions", []interface{}{arg1})
+	fake.revokeUserSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeUserSessionsCallCount() int {
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
+	return len(fake.revokeUserSessionsArgsForCall)
+}
+
+func (fake *FakeClient) RevokeUserSessionsCalls(stub func(concourse.SessionFilter) (atc.RevokedSessions, error)) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = stub
+}
+
+func (fake *FakeClient) RevokeUserSessionsArgsForCall(i int) concourse.SessionFilter {
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
+	argsForCall := fake.revokeUserSessionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RevokeUserSessionsReturns(result1 atc.RevokedSessions, result2 error) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = nil
+	fake.revokeUserSessionsReturns = struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeUserSessionsReturnsOnCall(i int, result1 atc.RevokedSessions, result2 error) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = nil
+	if fake.revokeUserSessionsReturnsOnCall == nil {
+		fake.revokeUserSessionsReturnsOnCall = make(map[int]struct {
+			result1 atc.RevokedSessions
+			result2 error
+		})
+	}
+	fake.revokeUserSessionsReturnsOnCall[i] = struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) SaveWorker(arg1 atc.Worker, arg2 *time.Duration) (*atc.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1467,6 +2104,123 @@ func (fake *FakeClient) SaveWorkerReturnsOnCall(i int, result1 *atc.Worker, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ScaleDownWorker(arg1 string) error {
+	fake.scaleDownWorkerMutex.Lock()
+	ret, specificReturn := fake.scaleDownWorkerReturnsOnCall[len(fake.scaleDownWorkerArgsForCall)]
+	fake.scaleDownWorkerArgsForCall = append(fake.scaleDownWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ScaleDownWorkerStub
+	fakeReturns := fake.scaleDownWorkerReturns
+	fake.recordInvocation("ScaleDownWorker", []interface{}{arg1})
+	fake.scaleDownWorkerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ScaleDownWorkerCallCount() int {
+	fake.scaleDownWorkerMutex.RLock()
+	defer fake.scaleDownWorkerMutex.RUnlock()
+	return len(fake.scaleDownWorkerArgsForCall)
+}
+
+func (fake *FakeClient) ScaleDownWorkerCalls(stub func(string) error) {
+	fake.scaleDownWorkerMutex.Lock()
+	defer fake.scaleDownWorkerMutex.Unlock()
+	fake.ScaleDownWorkerStub = stub
+}
+
+func (fake *FakeClient) ScaleDownWorkerArgsForCall(i int) string {
+	fake.scaleDownWorkerMutex.RLock()
+	defer fake.scaleDownWorkerMutex.RUnlock()
+	argsForCall := fake.scaleDownWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ScaleDownWorkerReturns(result1 error) {
+	fake.scaleDownWorkerMutex.Lock()
+	defer fake.scaleDownWorkerMutex.Unlock()
+	fake.ScaleDownWorkerStub = nil
+	fake.scaleDownWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ScaleDownWorkerReturnsOnCall(i int, result1 error) {
+	fake.scaleDownWorkerMutex.Lock()
+	defer fake.scaleDownWorkerMutex.Unlock()
+	fake.ScaleDownWorkerStub = nil
+	if fake.scaleDownWorkerReturnsOnCall == nil {
+		fake.scaleDownWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scaleDownWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) StatusEvents() (concourse.StatusEvents, error) {
+	fake.statusEventsMutex.Lock()
+	ret, specificReturn := fake.statusEventsReturnsOnCall[len(fake.statusEventsArgsForCall)]
+	fake.statusEventsArgsForCall = append(fake.statusEventsArgsForCall, struct {
+	}{})
+	stub := fake.StatusEventsStub
+	fakeReturns := fake.statusEventsReturns
+	fake.recordInvocation("StatusEvents", []interface{}{})
+	fake.statusEventsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StatusEventsCallCount() int {
+	fake.statusEventsMutex.RLock()
+	defer fake.statusEventsMutex.RUnlock()
+	return len(fake.statusEventsArgsForCall)
+}
+
+func (fake *FakeClient) StatusEventsCalls(stub func() (concourse.StatusEvents, error)) {
+	fake.statusEventsMutex.Lock()
+	defer fake.statusEventsMutex.Unlock()
+	fake.StatusEventsStub = stub
+}
+
+func (fake *FakeClient) StatusEventsReturns(result1 concourse.StatusEvents, result2 error) {
+	fake.statusEventsMutex.Lock()
+	defer fake.statusEventsMutex.Unlock()
+	fake.StatusEventsStub = nil
+	fake.statusEventsReturns = struct {
+		result1 concourse.StatusEvents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StatusEventsReturnsOnCall(i int, result1 concourse.StatusEvents, result2 error) {
+	fake.statusEventsMutex.Lock()
+	defer fake.statusEventsMutex.Unlock()
+	fake.StatusEventsStub = nil
+	if fake.statusEventsReturnsOnCall == nil {
+		fake.statusEventsReturnsOnCall = make(map[int]struct {
+			result1 concourse.StatusEvents
+			result2 error
+		})
+	}
+	fake.statusEventsReturnsOnCall[i] = struct {
+		result1 concourse.StatusEvents
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Team(arg1 string) concourse.Team {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -1637,11 +2391,69 @@ func (fake *FakeClient) UserInfoReturnsOnCall(i int, result1 atc.UserInfo, resul
 	}{result1, result2}
 }
 
+func (fake *FakeClient) WorkerCapacity() (atc.WorkerCapacity, error) {
+	fake.workerCapacityMutex.Lock()
+	ret, specificReturn := fake.workerCapacityReturnsOnCall[len(fake.workerCapacityArgsForCall)]
+	fake.workerCapacityArgsForCall = append(fake.workerCapacityArgsForCall, struct {
+	}{})
+	stub := fake.WorkerCapacityStub
+	fakeReturns := fake.workerCapacityReturns
+	fake.recordInvocation("WorkerCapacity", []interface{}{})
+	fake.workerCapacityMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) WorkerCapacityCallCount() int {
+	fake.workerCapacityMutex.RLock()
+	defer fake.workerCapacityMutex.RUnlock()
+	return len(fake.workerCapacityArgsForCall)
+}
+
+func (fake *FakeClient) WorkerCapacityCalls(stub func() (atc.WorkerCapacity, error)) {
+	fake.workerCapacityMutex.Lock()
+	defer fake.workerCapacityMutex.Unlock()
+	fake.WorkerCapacityStub = stub
+}
+
+func (fake *FakeClient) WorkerCapacityReturns(result1 atc.WorkerCapacity, result2 error) {
+	fake.workerCapacityMutex.Lock()
+	defer fake.workerCapacityMutex.Unlock()
+	fake.WorkerCapacityStub = nil
+	fake.workerCapacityReturns = struct {
+		result1 atc.WorkerCapacity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) WorkerCapacityReturnsOnCall(i int, result1 atc.WorkerCapacity, result2 error) {
+	fake.workerCapacityMutex.Lock()
+	defer fake.workerCapacityMutex.Unlock()
+	fake.WorkerCapacityStub = nil
+	if fake.workerCapacityReturnsOnCall == nil {
+		fake.workerCapacityReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerCapacity
+			result2 error
+		})
+	}
+	fake.workerCapacityReturnsOnCall[i] = struct {
+		result1 atc.WorkerCapacity
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortBuildMutex.RLock()
 	defer fake.abortBuildMutex.RUnlock()
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
@@ -1652,6 +2464,10 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	fake.drainWorkerMutex.RLock()
+	defer fake.drainWorkerMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
@@ -1670,20 +2486,36 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listBuildArtifactsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
 	fake.listTeamsMutex.RLock()
 	defer fake.listTeamsMutex.RUnlock()
+	fake.listTokensMutex.RLock()
+	defer fake.listTokensMutex.RUnlock()
 	fake.listWorkersMutex.RLock()
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.scaleDownWorkerMutex.RLock()
+	defer fake.scaleDownWorkerMutex.RUnlock()
+	fake.statusEventsMutex.RLock()
+	defer fake.statusEventsMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
 	defer fake.uRLMutex.RUnlock()
 	fake.userInfoMutex.RLock()
 	defer fake.userInfoMutex.RUnlock()
+	fake.workerCapacityMutex.RLock()
+	defer fake.workerCapacityMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -285,6 +285,19 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	CreateTokenStub        func(atc.APITokenRequest) (atc.APIToken, error)
+	createTokenMutex       sync.RWMutex
+	createTokenArgsForCall []struct {
+		arg1 atc.APITokenRequest
+	}
+	createTokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createTokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
 	DeletePipelineStub        func(atc.PipelineRef) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
@@ -309,6 +322,16 @@ type FakeTeam struct {
 	destroyTeamReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisableGitOpsStub        func() error
+	disableGitOpsMutex       sync.RWMutex
+	disableGitOpsArgsForCall []struct {
+	}
+	disableGitOpsReturns struct {
+		result1 error
+	}
+	disableGitOpsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisableResourceVersionStub        func(atc.PipelineRef, string, int) (bool, error)
 	disableResourceVersionMutex       sync.RWMutex
 	disableResourceVersionArgsForCall []struct {
@@ -378,6 +401,20 @@ type FakeTeam struct {
 		result1 atc.Container
 		result2 error
 	}
+	GitOpsStub        func() (atc.GitOps, bool, error)
+	gitOpsMutex       sync.RWMutex
+	gitOpsArgsForCall []struct {
+	}
+	gitOpsReturns struct {
+		result1 atc.GitOps
+		result2 bool
+		result3 error
+	}
+	gitOpsReturnsOnCall map[int]struct {
+		result1 atc.GitOps
+		result2 bool
+		result3 error
+	}
 	HidePipelineStub        func(atc.PipelineRef) (bool, error)
 	hidePipelineMutex       sync.RWMutex
 	hidePipelineArgsForCall []struct {
@@ -479,6 +516,18 @@ type FakeTeam struct {
 		result1 []atc.Job
 		result2 error
 	}
+	ListMembersStub        func() ([]atc.TeamMember, error)
+	listMembersMutex       sync.RWMutex
+	listMembersArgsForCall []struct {
+	}
+	listMembersReturns struct {
+		result1 []atc.TeamMember
+		result2 error
+	}
+	listMembersReturnsOnCall map[int]struct {
+		result1 []atc.TeamMember
+		result2 error
+	}
 	ListPipelinesStub        func() ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
@@ -504,6 +553,18 @@ type FakeTeam struct {
 		result1 []atc.Resource
 		result2 error
 	}
+	ListTokensStub        func() ([]atc.APIToken, error)
+	listTokensMutex       sync.RWMutex
+	listTokensArgsForCall []struct {
+	}
+	listTokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	listTokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
 	ListVolumesStub        func() ([]atc.Volume, error)
 	listVolumesMutex       sync.RWMutex
 	listVolumesArgsForCall []struct {
@@ -526,6 +587,31 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(int) ([]atc.NotificationDelivery, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}
+	NotificationsStub        func() (atc.NotificationConfigs, error)
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct {
+	}
+	notificationsReturns struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
 	OrderingPipelinesStub        func([]string) error
 	orderingPipelinesMutex       sync.RWMutex
 	orderingPipelinesArgsForCall []struct {
@@ -641,6 +727,37 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineConfigVersionStub        func(atc.PipelineRef, int) (atc.PipelineConfigVersion, bool, error)
+	pipelineConfigVersionMutex       sync.RWMutex
+	pipelineConfigVersionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	pipelineConfigVersionReturns struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionReturnsOnCall map[int]struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	PipelineConfigVersionsStub        func(atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error)
+	pipelineConfigVersionsMutex       sync.RWMutex
+	pipelineConfigVersionsArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	pipelineConfigVersionsReturns struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionsReturnsOnCall map[int]struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
 	RenamePipelineStub        func(string, string) (bool, []concourse.ConfigWarning, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -724,6 +841,19 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	RevokeTokenStub        func(int) (bool, error)
+	revokeTokenMutex       sync.RWMutex
+	revokeTokenArgsForCall []struct {
+		arg1 int
+	}
+	revokeTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ScheduleJobStub        func(atc.PipelineRef, string) (bool, error)
 	scheduleJobMutex       sync.RWMutex
 	scheduleJobArgsForCall []struct {
@@ -738,6 +868,28 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetGitOpsStub        func(atc.GitOpsConfig) error
+	setGitOpsMutex       sync.RWMutex
+	setGitOpsArgsForCall []struct {
+		arg1 atc.GitOpsConfig
+	}
+	setGitOpsReturns struct {
+		result1 error
+	}
+	setGitOpsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetNotificationsStub        func(atc.NotificationConfigs) error
+	setNotificationsMutex       sync.RWMutex
+	setNotificationsArgsForCall []struct {
+		arg1 atc.NotificationConfigs
+	}
+	setNotificationsReturns struct {
+		result1 error
+	}
+	setNotificationsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetPinCommentStub        func(atc.PipelineRef, string, string) (bool, error)
 	setPinCommentMutex       sync.RWMutex
 	setPinCommentArgsForCall []struct {
@@ -753,6 +905,45 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetTeamResourcesStub        func(atc.TeamResources) ([]concourse.ConfigWarning, error)
+	setTeamResourcesMutex       sync.RWMutex
+	setTeamResourcesArgsForCall []struct {
+		arg1 atc.TeamResources
+	}
+	setTeamResourcesReturns struct {
+		result1 []concourse.ConfigWarning
+		result2 error
+	}
+	setTeamResourcesReturnsOnCall map[int]struct {
+		result1 []concourse.ConfigWarning
+		result2 error
+	}
+	SharePipelineStub        func(atc.PipelineRef, []string) (bool, error)
+	sharePipelineMutex       sync.RWMutex
+	sharePipelineArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 []string
+	}
+	sharePipelineReturns struct {
+		result1 bool
+		result2 error
+	}
+	sharePipelineReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	TeamResourcesStub        func() (atc.TeamResources, error)
+	teamResourcesMutex       sync.RWMutex
+	teamResourcesArgsForCall []struct {
+	}
+	teamResourcesReturns struct {
+		result1 atc.TeamResources
+		result2 error
+	}
+	teamResourcesReturnsOnCall map[int]struct {
+		result1 atc.TeamResources
+		result2 error
+	}
 	UnpauseJobStub        func(atc.PipelineRef, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -2012,6 +2203,70 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateToken(arg1 atc.APITokenRequest) (atc.APIToken, error) {
+	fake.createTokenMutex.Lock()
+	ret, specificReturn := fake.createTokenReturnsOnCall[len(fake.createTokenArgsForCall)]
+	fake.createTokenArgsForCall = append(fake.createTokenArgsForCall, struct {
+		arg1 atc.APITokenRequest
+	}{arg1})
+	stub := fake.CreateTokenStub
+	fakeReturns := fake.createTokenReturns
+	fake.recordInvocation("CreateToken", []interface{}{arg1})
+	fake.createTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateTokenCallCount() int {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	return len(fake.createTokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateTokenCalls(stub func(atc.APITokenRequest) (atc.APIToken, error)) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = stub
+}
+
+func (fake *FakeTeam) CreateTokenArgsForCall(i int) atc.APITokenRequest {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	argsForCall := fake.createTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateTokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	fake.createTokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateTokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	if fake.createTokenReturnsOnCall == nil {
+		fake.createTokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createTokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DeletePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
@@ -2137,6 +2392,59 @@ func (fake *FakeTeam) DestroyTeamReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DisableGitOps() error {
+	fake.disableGitOpsMutex.Lock()
+	ret, specificReturn := fake.disableGitOpsReturnsOnCall[len(fake.disableGitOpsArgsForCall)]
+	fake.disableGitOpsArgsForCall = append(fake.disableGitOpsArgsForCall, struct {
+	}{})
+	stub := fake.DisableGitOpsStub
+	fakeReturns := fake.disableGitOpsReturns
+	fake.recordInvocation("DisableGitOps", []interface{}{})
+	fake.disableGitOpsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) DisableGitOpsCallCount() int {
+	fake.disableGitOpsMutex.RLock()
+	defer fake.disableGitOpsMutex.RUnlock()
+	return len(fake.disableGitOpsArgsForCall)
+}
+
+func (fake *FakeTeam) DisableGitOpsCalls(stub func() error) {
+	fake.disableGitOpsMutex.Lock()
+	defer fake.disableGitOpsMutex.Unlock()
+	fake.DisableGitOpsStub = stub
+}
+
+func (fake *FakeTeam) DisableGitOpsReturns(result1 error) {
+	fake.disableGitOpsMutex.Lock()
+	defer fake.disableGitOpsMutex.Unlock()
+	fake.DisableGitOpsStub = nil
+	fake.disableGitOpsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) DisableGitOpsReturnsOnCall(i int, result1 error) {
+	fake.disableGitOpsMutex.Lock()
+	defer fake.disableGitOpsMutex.Unlock()
+	fake.DisableGitOpsStub = nil
+	if fake.disableGitOpsReturnsOnCall == nil {
+		fake.disableGitOpsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableGitOpsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) DisableResourceVersion(arg1 atc.PipelineRef, arg2 string, arg3 int) (bool, error) {
 	fake.disableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.disableResourceVersionReturnsOnCall[len(fake.disableResourceVersionArgsForCall)]
@@ -2461,6 +2769,65 @@ func (fake *FakeTeam) GetContainerReturnsOnCall(i int, result1 atc.Container, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) GitOps() (atc.GitOps, bool, error) {
+	fake.gitOpsMutex.Lock()
+	ret, specificReturn := fake.gitOpsReturnsOnCall[len(fake.gitOpsArgsForCall)]
+	fake.gitOpsArgsForCall = append(fake.gitOpsArgsForCall, struct {
+	}{})
+	stub := fake.GitOpsStub
+	fakeReturns := fake.gitOpsReturns
+	fake.recordInvocation("GitOps", []interface{}{})
+	fake.gitOpsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) GitOpsCallCount() int {
+	fake.gitOpsMutex.RLock()
+	defer fake.gitOpsMutex.RUnlock()
+	return len(fake.gitOpsArgsForCall)
+}
+
+func (fake *FakeTeam) GitOpsCalls(stub func() (atc.GitOps, bool, error)) {
+	fake.gitOpsMutex.Lock()
+	defer fake.gitOpsMutex.Unlock()
+	fake.GitOpsStub = stub
+}
+
+func (fake *FakeTeam) GitOpsReturns(result1 atc.GitOps, result2 bool, result3 error) {
+	fake.gitOpsMutex.Lock()
+	defer fake.gitOpsMutex.Unlock()
+	fake.GitOpsStub = nil
+	fake.gitOpsReturns = struct {
+		result1 atc.GitOps
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) GitOpsReturnsOnCall(i int, result1 atc.GitOps, result2 bool, result3 error) {
+	fake.gitOpsMutex.Lock()
+	defer fake.gitOpsMutex.Unlock()
+	fake.GitOpsStub = nil
+	if fake.gitOpsReturnsOnCall == nil {
+		fake.gitOpsReturnsOnCall = make(map[int]struct {
+			result1 atc.GitOps
+			result2 bool
+			result3 error
+		})
+	}
+	fake.gitOpsReturnsOnCall[i] = struct {
+		result1 atc.GitOps
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) HidePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.hidePipelineMutex.Lock()
 	ret, specificReturn := fake.hidePipelineReturnsOnCall[len(fake.hidePipelineArgsForCall)]
@@ -2915,6 +3282,62 @@ func (fake *FakeTeam) ListJobsReturnsOnCall(i int, result1 []atc.Job, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListMembers() ([]atc.TeamMember, error) {
+	fake.listMembersMutex.Lock()
+	ret, specificReturn := fake.listMembersReturnsOnCall[len(fake.listMembersArgsForCall)]
+	fake.listMembersArgsForCall = append(fake.listMembersArgsForCall, struct {
+	}{})
+	stub := fake.ListMembersStub
+	fakeReturns := fake.listMembersReturns
+	fake.recordInvocation("ListMembers", []interface{}{})
+	fake.listMembersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListMembersCallCount() int {
+	fake.listMembersMutex.RLock()
+	defer fake.listMembersMutex.RUnlock()
+	return len(fake.listMembersArgsForCall)
+}
+
+func (fake *FakeTeam) ListMembersCalls(stub func() ([]atc.TeamMember, error)) {
+	fake.listMembersMutex.Lock()
+	defer fake.listMembersMutex.Unlock()
+	fake.ListMembersStub = stub
+}
+
+func (fake *FakeTeam) ListMembersReturns(result1 []atc.TeamMember, result2 error) {
+	fake.listMembersMutex.Lock()
+	defer fake.listMembersMutex.Unlock()
+	fake.ListMembersStub = nil
+	fake.listMembersReturns = struct {
+		result1 []atc.TeamMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListMembersReturnsOnCall(i int, result1 []atc.TeamMember, result2 error) {
+	fake.listMembersMutex.Lock()
+	defer fake.listMembersMutex.Unlock()
+	fake.ListMembersStub = nil
+	if fake.listMembersReturnsOnCall == nil {
+		fake.listMembersReturnsOnCall = make(map[int]struct {
+			result1 []atc.TeamMember
+			result2 error
+		})
+	}
+	fake.listMembersReturnsOnCall[i] = struct {
+		result1 []atc.TeamMember
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListPipelines() ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
@@ -3035,6 +3458,62 @@ func (fake *FakeTeam) ListResourcesReturnsOnCall(i int, result1 []atc.Resource,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListTokens() ([]atc.APIToken, error) {
+	fake.listTokensMutex.Lock()
+	ret, specificReturn := fake.listTokensReturnsOnCall[len(fake.listTokensArgsForCall)]
+	fake.listTokensArgsForCall = append(fake.listTokensArgsForCall, struct {
+	}{})
+	stub := fake.ListTokensStub
+	fakeReturns := fake.listTokensReturns
+	fake.recordInvocation("ListTokens", []interface{}{})
+	fake.listTokensMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListTokensCallCount() int {
+	fake.listTokensMutex.RLock()
+	defer fake.listTokensMutex.RUnlock()
+	return len(fake.listTokensArgsForCall)
+}
+
+func (fake *FakeTeam) ListTokensCalls(stub func() ([]atc.APIToken, error)) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = stub
+}
+
+func (fake *FakeTeam) ListTokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = nil
+	fake.listTokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListTokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = nil
+	if fake.listTokensReturnsOnCall == nil {
+		fake.listTokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.listTokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListVolumes() ([]atc.Volume, error) {
 	fake.listVolumesMutex.Lock()
 	ret, specificReturn := fake.listVolumesReturnsOnCall[len(fake.listVolumesArgsForCall)]
@@ -3144,51 +3623,171 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeTeam) OrderingPipelines(arg1 []string) error {
-	var arg1Copy []string
-	if arg1 != nil {
-		arg1Copy = make([]string, len(arg1))
-		copy(arg1Copy, arg1)
-	}
-	fake.orderingPipelinesMutex.Lock()
-	ret, specificReturn := fake.orderingPipelinesReturnsOnCall[len(fake.orderingPipelinesArgsForCall)]
-	fake.orderingPipelinesArgsForCall = append(fake.orderingPipelinesArgsForCall, struct {
-		arg1 []string
-	}{arg1Copy})
-	stub := fake.OrderingPipelinesStub
-	fakeReturns := fake.orderingPipelinesReturns
-	fake.recordInvocation("OrderingPipelines", []interface{}{arg1Copy})
-	fake.orderingPipelinesMutex.Unlock()
+func (fake *FakeTeam) NotificationDeliveries(arg1 int) ([]atc.NotificationDelivery, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.NotificationDeliveriesStub
+	fakeReturns := fake.notificationDeliveriesReturns
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1})
+	fake.notificationDeliveriesMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeTeam) OrderingPipelinesCallCount() int {
-	fake.orderingPipelinesMutex.RLock()
-	defer fake.orderingPipelinesMutex.RUnlock()
-	return len(fake.orderingPipelinesArgsForCall)
+func (fake *FakeTeam) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
 }
 
-func (fake *FakeTeam) OrderingPipelinesCalls(stub func([]string) error) {
-	fake.orderingPipelinesMutex.Lock()
-	defer fake.orderingPipelinesMutex.Unlock()
-	fake.OrderingPipelinesStub = stub
+func (fake *FakeTeam) NotificationDeliveriesCalls(stub func(int) ([]atc.NotificationDelivery, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
 }
 
-func (fake *FakeTeam) OrderingPipelinesArgsForCall(i int) []string {
-	fake.orderingPipelinesMutex.RLock()
-	defer fake.orderingPipelinesMutex.RUnlock()
-	argsForCall := fake.orderingPipelinesArgsForCall[i]
+func (fake *FakeTeam) NotificationDeliveriesArgsForCall(i int) int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeTeam) OrderingPipelinesReturns(result1 error) {
-	fake.orderingPipelinesMutex.Lock()
+func (fake *FakeTeam) NotificationDeliveriesReturns(result1 []atc.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) Notifications() (atc.NotificationConfigs, error) {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct {
+	}{})
+	stub := fake.NotificationsStub
+	fakeReturns := fake.notificationsReturns
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationsCalls(stub func() (atc.NotificationConfigs, error)) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = stub
+}
+
+func (fake *FakeTeam) NotificationsReturns(result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) NotificationsReturnsOnCall(i int, result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 atc.NotificationConfigs
+			result2 error
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) OrderingPipelines(arg1 []string) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.orderingPipelinesMutex.Lock()
+	ret, specificReturn := fake.orderingPipelinesReturnsOnCall[len(fake.orderingPipelinesArgsForCall)]
+	fake.orderingPipelinesArgsForCall = append(fake.orderingPipelinesArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.OrderingPipelinesStub
+	fakeReturns := fake.orderingPipelinesReturns
+	fake.recordInvocation("OrderingPipelines", []interface{}{arg1Copy})
+	fake.orderingPipelinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) OrderingPipelinesCallCount() int {
+	fake.orderingPipelinesMutex.RLock()
+	defer fake.orderingPipelinesMutex.RUnlock()
+	return len(fake.orderingPipelinesArgsForCall)
+}
+
+func (fake *FakeTeam) OrderingPipelinesCalls(stub func([]string) error) {
+	fake.orderingPipelinesMutex.Lock()
+	defer fake.orderingPipelinesMutex.Unlock()
+	fake.OrderingPipelinesStub = stub
+}
+
+func (fake *FakeTeam) OrderingPipelinesArgsForCall(i int) []string {
+	fake.orderingPipelinesMutex.RLock()
+	defer fake.orderingPipelinesMutex.RUnlock()
+	argsForCall := fake.orderingPipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) OrderingPipelinesReturns(result1 error) {
+	fake.orderingPipelinesMutex.Lock()
 	defer fake.orderingPipelinesMutex.Unlock()
 	fake.OrderingPipelinesStub = nil
 	fake.orderingPipelinesReturns = struct {
@@ -3680,6 +4279,141 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineConfigVersion(arg1 atc.PipelineRef, arg2 int) (atc.PipelineConfigVersion, bool, error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionReturnsOnCall[len(fake.pipelineConfigVersionArgsForCall)]
+	fake.pipelineConfigVersionArgsForCall = append(fake.pipelineConfigVersionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.PipelineConfigVersionStub
+	fakeReturns := fake.pipelineConfigVersionReturns
+	fake.recordInvocation("PipelineConfigVersion", []interface{}{arg1, arg2})
+	fake.pipelineConfigVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionCallCount() int {
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	return len(fake.pipelineConfigVersionArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionCalls(stub func(atc.PipelineRef, int) (atc.PipelineConfigVersion, bool, error)) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineConfigVersionReturns(result1 atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = nil
+	fake.pipelineConfigVersionReturns = struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionReturnsOnCall(i int, result1 atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = nil
+	if fake.pipelineConfigVersionReturnsOnCall == nil {
+		fake.pipelineConfigVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionReturnsOnCall[i] = struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersions(arg1 atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionsReturnsOnCall[len(fake.pipelineConfigVersionsArgsForCall)]
+	fake.pipelineConfigVersionsArgsForCall = append(fake.pipelineConfigVersionsArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	stub := fake.PipelineConfigVersionsStub
+	fakeReturns := fake.pipelineConfigVersionsReturns
+	fake.recordInvocation("PipelineConfigVersions", []interface{}{arg1})
+	fake.pipelineConfigVersionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCallCount() int {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	return len(fake.pipelineConfigVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCalls(stub func(atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error)) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsArgsForCall(i int) atc.PipelineRef {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturns(result1 []atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	fake.pipelineConfigVersionsReturns = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturnsOnCall(i int, result1 []atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	if fake.pipelineConfigVersionsReturnsOnCall == nil {
+		fake.pipelineConfigVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionsReturnsOnCall[i] = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, []concourse.ConfigWarning, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -4023,6 +4757,70 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) RevokeToken(arg1 int) (bool, error) {
+	fake.revokeTokenMutex.Lock()
+	ret, specificReturn := fake.revokeTokenReturnsOnCall[len(fake.revokeTokenArgsForCall)]
+	fake.revokeTokenArgsForCall = append(fake.revokeTokenArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.RevokeTokenStub
+	fakeReturns := fake.revokeTokenReturns
+	fake.recordInvocation("RevokeToken", []interface{}{arg1})
+	fake.revokeTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeTokenCallCount() int {
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	return len(fake.revokeTokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeTokenCalls(stub func(int) (bool, error)) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeTokenArgsForCall(i int) int {
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	argsForCall := fake.revokeTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) RevokeTokenReturns(result1 bool, result2 error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = nil
+	fake.revokeTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = nil
+	if fake.revokeTokenReturnsOnCall == nil {
+		fake.revokeTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ScheduleJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.scheduleJobMutex.Lock()
 	ret, specificReturn := fake.scheduleJobReturnsOnCall[len(fake.scheduleJobArgsForCall)]
@@ -4088,6 +4886,128 @@ func (fake *FakeTeam) ScheduleJobReturnsOnCall(i int, result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetGitOps(arg1 atc.GitOpsConfig) error {
+	fake.setGitOpsMutex.Lock()
+	ret, specificReturn := fake.setGitOpsReturnsOnCall[len(fake.setGitOpsArgsForCall)]
+	fake.setGitOpsArgsForCall = append(fake.setGitOpsArgsForCall, struct {
+		arg1 atc.GitOpsConfig
+	}{arg1})
+	stub := fake.SetGitOpsStub
+	fakeReturns := fake.setGitOpsReturns
+	fake.recordInvocation("SetGitOps", []interface{}{arg1})
+	fake.setGitOpsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetGitOpsCallCount() int {
+	fake.setGitOpsMutex.RLock()
+	defer fake.setGitOpsMutex.RUnlock()
+	return len(fake.setGitOpsArgsForCall)
+}
+
+func (fake *FakeTeam) SetGitOpsCalls(stub func(atc.GitOpsConfig) error) {
+	fake.setGitOpsMutex.Lock()
+	defer fake.setGitOpsMutex.Unlock()
+	fake.SetGitOpsStub = stub
+}
+
+func (fake *FakeTeam) SetGitOpsArgsForCall(i int) atc.GitOpsConfig {
+	fake.setGitOpsMutex.RLock()
+	defer fake.setGitOpsMutex.RUnlock()
+	argsForCall := fake.setGitOpsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetGitOpsReturns(result1 error) {
+	fake.setGitOpsMutex.Lock()
+	defer fake.setGitOpsMutex.Unlock()
+	fake.SetGitOpsStub = nil
+	fake.setGitOpsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetGitOpsReturnsOnCall(i int, result1 error) {
+	fake.setGitOpsMutex.Lock()
+	defer fake.setGitOpsMutex.Unlock()
+	fake.SetGitOpsStub = nil
+	if fake.setGitOpsReturnsOnCall == nil {
+		fake.setGitOpsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setGitOpsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetNotifications(arg1 atc.NotificationConfigs) error {
+	fake.setNotificationsMutex.Lock()
+	ret, specificReturn := fake.setNotificationsReturnsOnCall[len(fake.setNotificationsArgsForCall)]
+	fake.setNotificationsArgsForCall = append(fake.setNotificationsArgsForCall, struct {
+		arg1 atc.NotificationConfigs
+	}{arg1})
+	stub := fake.SetNotificationsStub
+	fakeReturns := fake.setNotificationsReturns
+	fake.recordInvocation("SetNotifications", []interface{}{arg1})
+	fake.setNotificationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetNotificationsCallCount() int {
+	fake.setNotificationsMutex.RLock()
+	defer fake.setNotificationsMutex.RUnlock()
+	return len(fake.setNotificationsArgsForCall)
+}
+
+func (fake *FakeTeam) SetNotificationsCalls(stub func(atc.NotificationConfigs) error) {
+	fake.setNotificationsMutex.Lock()
+	defer fake.setNotificationsMutex.Unlock()
+	fake.SetNotificationsStub = stub
+}
+
+func (fake *FakeTeam) SetNotificationsArgsForCall(i int) atc.NotificationConfigs {
+	fake.setNotificationsMutex.RLock()
+	defer fake.setNotificationsMutex.RUnlock()
+	argsForCall := fake.setNotificationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetNotificationsReturns(result1 error) {
+	fake.setNotificationsMutex.Lock()
+	defer fake.setNotificationsMutex.Unlock()
+	fake.SetNotificationsStub = nil
+	fake.setNotificationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetNotificationsReturnsOnCall(i int, result1 error) {
+	fake.setNotificationsMutex.Lock()
+	defer fake.setNotificationsMutex.Unlock()
+	fake.SetNotificationsStub = nil
+	if fake.setNotificationsReturnsOnCall == nil {
+		fake.setNotificationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setNotificationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) SetPinComment(arg1 atc.PipelineRef, arg2 string, arg3 string) (bool, error) {
 	fake.setPinCommentMutex.Lock()
 	ret, specificReturn := fake.setPinCommentReturnsOnCall[len(fake.setPinCommentArgsForCall)]
@@ -4154,6 +5074,196 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetTeamResources(arg1 atc.TeamResources) ([]concourse.ConfigWarning, error) {
+	fake.setTeamResourcesMutex.Lock()
+	ret, specificReturn := fake.setTeamResourcesReturnsOnCall[len(fake.setTeamResourcesArgsForCall)]
+	fake.setTeamResourcesArgsForCall = append(fake.setTeamResourcesArgsForCall, struct {
+		arg1 atc.TeamResources
+	}{arg1})
+	stub := fake.SetTeamResourcesStub
+	fakeReturns := fake.setTeamResourcesReturns
+	fake.recordInvocation("SetTeamResources", []interface{}{arg1})
+	fake.setTeamResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SetTeamResourcesCallCount() int {
+	fake.setTeamResourcesMutex.RLock()
+	defer fake.setTeamResourcesMutex.RUnlock()
+	return len(fake.setTeamResourcesArgsForCall)
+}
+
+func (fake *FakeTeam) SetTeamResourcesCalls(stub func(atc.TeamResources) ([]concourse.ConfigWarning, error)) {
+	fake.setTeamResourcesMutex.Lock()
+	defer fake.setTeamResourcesMutex.Unlock()
+	fake.SetTeamResourcesStub = stub
+}
+
+func (fake *FakeTeam) SetTeamResourcesArgsForCall(i int) atc.TeamResources {
+	fake.setTeamResourcesMutex.RLock()
+	defer fake.setTeamResourcesMutex.RUnlock()
+	argsForCall := fake.setTeamResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetTeamResourcesReturns(result1 []concourse.ConfigWarning, result2 error) {
+	fake.setTeamResourcesMutex.Lock()
+	defer fake.setTeamResourcesMutex.Unlock()
+	fake.SetTeamResourcesStub = nil
+	fake.setTeamResourcesReturns = struct {
+		result1 []concourse.ConfigWarning
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SetTeamResourcesReturnsOnCall(i int, result1 []concourse.ConfigWarning, result2 error) {
+	fake.setTeamResourcesMutex.Lock()
+	defer fake.setTeamResourcesMutex.Unlock()
+	fake.SetTeamResourcesStub = nil
+	if fake.setTeamResourcesReturnsOnCall == nil {
+		fake.setTeamResourcesReturnsOnCall = make(map[int]struct {
+			result1 []concourse.ConfigWarning
+			result2 error
+		})
+	}
+	fake.setTeamResourcesReturnsOnCall[i] = struct {
+		result1 []concourse.ConfigWarning
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SharePipeline(arg1 atc.PipelineRef, arg2 []string) (bool, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.sharePipelineMutex.Lock()
+	ret, specificReturn := fake.sharePipelineReturnsOnCall[len(fake.sharePipelineArgsForCall)]
+	fake.sharePipelineArgsForCall = append(fake.sharePipelineArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.SharePipelineStub
+	fakeReturns := fake.sharePipelineReturns
+	fake.recordInvocation("SharePipeline", []interface{}{arg1, arg2Copy})
+	fake.sharePipelineMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SharePipelineCallCount() int {
+	fake.sharePipelineMutex.RLock()
+	defer fake.sharePipelineMutex.RUnlock()
+	return len(fake.sharePipelineArgsForCall)
+}
+
+func (fake *FakeTeam) SharePipelineCalls(stub func(atc.PipelineRef, []string) (bool, error)) {
+	fake.sharePipelineMutex.Lock()
+	defer fake.sharePipelineMutex.Unlock()
+	fake.SharePipelineStub = stub
+}
+
+func (fake *FakeTeam) SharePipelineArgsForCall(i int) (atc.PipelineRef, []string) {
+	fake.sharePipelineMutex.RLock()
+	defer fake.sharePipelineMutex.RUnlock()
+	argsForCall := fake.sharePipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) SharePipelineReturns(result1 bool, result2 error) {
+	fake.sharePipelineMutex.Lock()
+	defer fake.sharePipelineMutex.Unlock()
+	fake.SharePipelineStub = nil
+	fake.sharePipelineReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SharePipelineReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.sharePipelineMutex.Lock()
+	defer fake.sharePipelineMutex.Unlock()
+	fake.SharePipelineStub = nil
+	if fake.sharePipelineReturnsOnCall == nil {
+		fake.sharePipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.sharePipelineReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) TeamResources() (atc.TeamResources, error) {
+	fake.teamResourcesMutex.Lock()
+	ret, specificReturn := fake.teamResourcesReturnsOnCall[len(fake.teamResourcesArgsForCall)]
+	fake.teamResourcesArgsForCall = append(fake.teamResourcesArgsForCall, struct {
+	}{})
+	stub := fake.TeamResourcesStub
+	fakeReturns := fake.teamResourcesReturns
+	fake.recordInvocation("TeamResources", []interface{}{})
+	fake.teamResourcesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) TeamResourcesCallCount() int {
+	fake.teamResourcesMutex.RLock()
+	defer fake.teamResourcesMutex.RUnlock()
+	return len(fake.teamResourcesArgsForCall)
+}
+
+func (fake *FakeTeam) TeamResourcesCalls(stub func() (atc.TeamResources, error)) {
+	fake.teamResourcesMutex.Lock()
+	defer fake.teamResourcesMutex.Unlock()
+	fake.TeamResourcesStub = stub
+}
+
+func (fake *FakeTeam) TeamResourcesReturns(result1 atc.TeamResources, result2 error) {
+	fake.teamResourcesMutex.Lock()
+	defer fake.teamResourcesMutex.Unlock()
+	fake.TeamResourcesStub = nil
+	fake.teamResourcesReturns = struct {
+		result1 atc.TeamResources
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) TeamResourcesReturnsOnCall(i int, result1 atc.TeamResources, result2 error) {
+	fake.teamResourcesMutex.Lock()
+	defer fake.teamResourcesMutex.Unlock()
+	fake.TeamResourcesStub = nil
+	if fake.teamResourcesReturnsOnCall == nil {
+		fake.teamResourcesReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamResources
+			result2 error
+		})
+	}
+	fake.teamResourcesReturnsOnCall[i] = struct {
+		result1 atc.TeamResources
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -4454,10 +5564,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
+	fake.disableGitOpsMutex.RLock()
+	defer fake.disableGitOpsMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
@@ -4468,6 +5582,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.getArtifactMutex.RUnlock()
 	fake.getContainerMutex.RLock()
 	defer fake.getContainerMutex.RUnlock()
+	fake.gitOpsMutex.RLock()
+	defer fake.gitOpsMutex.RUnlock()
 	fake.hidePipelineMutex.RLock()
 	defer fake.hidePipelineMutex.RUnlock()
 	fake.iDMutex.RLock()
@@ -4482,14 +5598,22 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
 	defer fake.listJobsMutex.RUnlock()
+	fake.listMembersMutex.RLock()
+	defer fake.listMembersMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
 	fake.listResourcesMutex.RLock()
 	defer fake.listResourcesMutex.RUnlock()
+	fake.listTokensMutex.RLock()
+	defer fake.listTokensMutex.RUnlock()
 	fake.listVolumesMutex.RLock()
 	defer fake.listVolumesMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
 	fake.orderingPipelinesMutex.RLock()
 	defer fake.orderingPipelinesMutex.RUnlock()
 	fake.orderingPipelinesWithinGroupMutex.RLock()
@@ -4506,6 +5630,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -4516,10 +5644,22 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
+	fake.setGitOpsMutex.RLock()
+	defer fake.setGitOpsMutex.RUnlock()
+	fake.setNotificationsMutex.RLock()
+	defer fake.setNotificationsMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.setTeamResourcesMutex.RLock()
+	defer fake.setTeamResourcesMutex.RUnlock()
+	fake.sharePipelineMutex.RLock()
+	defer fake.sharePipelineMutex.RUnlock()
+	fake.teamResourcesMutex.RLock()
+	defer fake.teamResourcesMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
@@ -0,0 +1,108 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Config Versions", func() {
+	var pipelineRef atc.PipelineRef
+
+	BeforeEach(func() {
+		pipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "main"}}
+	})
+
+	Describe("PipelineConfigVersions", func() {
+		Context("when the pipeline exists", func() {
+			var expected []atc.PipelineConfigVersion
+
+			BeforeEach(func() {
+				expected = []atc.PipelineConfigVersion{
+					{Version: 5, CreatedBy: "some-user", CreatedAt: 100},
+					{Version: 2, BuildID: 42, CreatedAt: 50},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/config/versions", `vars.branch=%22main%22`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expected),
+					),
+				)
+			})
+
+			It("returns the versions", func() {
+				versions, found, err := team.PipelineConfigVersions(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(Equal(expected))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/config/versions"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				_, found, err := team.PipelineConfigVersions(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("PipelineConfigVersion", func() {
+		Context("when the version exists", func() {
+			var expected atc.PipelineConfigVersion
+
+			BeforeEach(func() {
+				expected = atc.PipelineConfigVersion{
+					Version:   5,
+					CreatedAt: 100,
+					Config:    &atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/config/versions/5"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expected),
+					),
+				)
+			})
+
+			It("returns the version with its config", func() {
+				version, found, err := team.PipelineConfigVersion(pipelineRef, 5)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(version).To(Equal(expected))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/config/versions/5"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				_, found, err := team.PipelineConfigVersion(pipelineRef, 5)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -34,3 +34,15 @@ type InvalidConfigError struct {
 func (c InvalidConfigError) Error() string {
 	return fmt.Sprintf("invalid pipeline config:\n%s", strings.Join(c.Errors, "\n"))
 }
+
+// InvalidTeamResourcesError is returned when saving a team's shared resources
+// returns errors, i.e. validation failures or a removed resource which a
+// pipeline still uses.
+type InvalidTeamResourcesError struct {
+	Errors []string `json:"errors"`
+}
+
+// Error lists the errors returned for the shared resources.
+func (c InvalidTeamResourcesError) Error() string {
+	return fmt.Sprintf("invalid shared resources:\n%s", strings.Join(c.Errors, "\n"))
+}
@@ -1,10 +1,14 @@
 package concourse
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
+	"github.com/vito/go-sse/sse"
 )
 
 type Events interface {
@@ -25,3 +29,49 @@ func (client *client) BuildEvents(buildID string) (Events, error) {
 
 	return eventstream.NewSSEEventStream(sseEvents), nil
 }
+
+type StatusEvents interface {
+	NextEvent() (atc.StatusEvent, error)
+	Close() error
+}
+
+// StatusEvents streams the status changes of builds and jobs in every team
+// the user can see. The stream reconnects on its own, resuming after the last
+// event it received.
+func (client *client) StatusEvents() (StatusEvents, error) {
+	sseEvents, err := client.connection.ConnectToEventStream(internal.Request{
+		RequestName: atc.StatusEvents,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &statusEventStream{sseReader: sseEvents}, nil
+}
+
+type statusEventStream struct {
+	sseReader *sse.EventSource
+}
+
+func (s *statusEventStream) NextEvent() (atc.StatusEvent, error) {
+	se, err := s.sseReader.Next()
+	if err != nil {
+		return atc.StatusEvent{}, err
+	}
+
+	if se.Name != "event" {
+		return atc.StatusEvent{}, fmt.Errorf("unknown event name: %s", se.Name)
+	}
+
+	var ev atc.StatusEvent
+	err = json.Unmarshal(se.Data, &ev)
+	if err != nil {
+		return atc.StatusEvent{}, err
+	}
+
+	return ev, nil
+}
+
+func (s *statusEventStream) Close() error {
+	return s.sseReader.Close()
+}
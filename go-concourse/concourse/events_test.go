@@ -128,4 +128,58 @@ var _ = Describe("ATC Handler Events", func() {
 			})
 		})
 	})
+
+	Describe("StatusEvents", func() {
+		Context("when the server returns events", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/status/events"),
+						func(w http.ResponseWriter, r *http.Request) {
+							w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+							w.WriteHeader(http.StatusOK)
+
+							err := sse.Event{
+								ID:   "42",
+								Name: "event",
+								Data: []byte(`{"id":42,"type":"job","team_name":"some-team","job_name":"some-job","paused":true}`),
+							}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+						},
+					),
+				)
+			})
+
+			It("returns the status events", func() {
+				stream, err := client.StatusEvents()
+				Expect(err).NotTo(HaveOccurred())
+
+				paused := true
+
+				next, err := stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(atc.StatusEvent{
+					ID:       42,
+					Type:     atc.StatusEventJob,
+					TeamName: "some-team",
+					JobName:  "some-job",
+					Paused:   &paused,
+				}))
+
+				err = stream.Close()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the server returns 401", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(ghttp.RespondWith(http.StatusUnauthorized, ""))
+			})
+
+			It("returns ErrUnauthorized", func() {
+				_, err := client.StatusEvents()
+				Expect(err).To(Equal(concourse.ErrUnauthorized))
+			})
+		})
+	})
 })
@@ -0,0 +1,51 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) GitOps() (atc.GitOps, bool, error) {
+	var gitOps atc.GitOps
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetTeamGitOps,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &gitOps,
+	})
+
+	switch err.(type) {
+	case nil:
+		return gitOps, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.GitOps{}, false, nil
+	default:
+		return atc.GitOps{}, false, err
+	}
+}
+
+func (team *team) SetGitOps(config atc.GitOpsConfig) error {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamGitOps,
+		Params:      rata.Params{"team_name": team.Name()},
+		Body:        bytes.NewBuffer(payload),
+		Header:      http.Header{"Content-Type": {"application/json"}},
+	}, nil)
+}
+
+func (team *team) DisableGitOps() error {
+	return team.connection.Send(internal.Request{
+		RequestName: atc.DeleteTeamGitOps,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, nil)
+}
@@ -0,0 +1,107 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler GitOps", func() {
+	var team concourse.Team
+
+	BeforeEach(func() {
+		team = client.Team("some-team")
+	})
+
+	Describe("GitOps", func() {
+		Context("when gitops is enabled", func() {
+			var expected atc.GitOps
+
+			BeforeEach(func() {
+				expected = atc.GitOps{
+					Config: &atc.GitOpsConfig{Type: "git", Path: "pipelines.yml"},
+					Status: &atc.GitOpsStatus{BuildID: 42, Result: atc.GitOpsSucceeded},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/gitops"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expected),
+					),
+				)
+			})
+
+			It("returns the config and status", func() {
+				gitOps, found, err := team.GitOps()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(gitOps).To(Equal(expected))
+			})
+		})
+
+		Context("when gitops is not enabled", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/gitops"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				_, found, err := team.GitOps()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("SetGitOps", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/gitops"),
+					ghttp.VerifyJSONRepresenting(atc.GitOpsConfig{
+						Type:   "git",
+						Source: atc.Source{"uri": "https://example.com/ci.git"},
+						Path:   "pipelines.yml",
+					}),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("sends the config", func() {
+			err := team.SetGitOps(atc.GitOpsConfig{
+				Type:   "git",
+				Source: atc.Source{"uri": "https://example.com/ci.git"},
+				Path:   "pipelines.yml",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("DisableGitOps", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/gitops"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("disables gitops", func() {
+			err := team.DisableGitOps()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) Notifications() (atc.NotificationConfigs, error) {
+	var configs atc.NotificationConfigs
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetTeamNotifications,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &configs,
+	})
+
+	return configs, err
+}
+
+func (team *team) SetNotifications(configs atc.NotificationConfigs) error {
+	if configs == nil {
+		configs = atc.NotificationConfigs{}
+	}
+
+	payload, err := json.Marshal(configs)
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamNotifications,
+		Params:      rata.Params{"team_name": team.Name()},
+		Body:        bytes.NewBuffer(payload),
+		Header:      http.Header{"Content-Type": {"application/json"}},
+	}, nil)
+}
+
+func (team *team) NotificationDeliveries(limit int) ([]atc.NotificationDelivery, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.NotificationDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamNotificationDeliveries,
+		Params:      rata.Params{"team_name": team.Name()},
+		Query:       query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+
+	return deliveries, err
+}
@@ -0,0 +1,109 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Notifications", func() {
+	var team concourse.Team
+
+	BeforeEach(func() {
+		team = client.Team("some-team")
+	})
+
+	Describe("Notifications", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/notifications"),
+					ghttp.RespondWith(http.StatusOK, `[{"name":"some-hook","url":"https://example.com"}]`),
+				),
+			)
+		})
+
+		It("returns the team's notifications", func() {
+			configs, err := team.Notifications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configs).To(Equal(atc.NotificationConfigs{
+				{Name: "some-hook", URL: "https://example.com"},
+			}))
+		})
+	})
+
+	Describe("SetNotifications", func() {
+		Context("when the notifications are accepted", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/notifications"),
+						ghttp.VerifyJSON(`[{"name":"some-hook","url":"https://example.com","secret":"shh"}]`),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("sets them", func() {
+				err := team.SetNotifications(atc.NotificationConfigs{
+					{Name: "some-hook", URL: "https://example.com", Secret: "shh"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when clearing the notifications", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/notifications"),
+						ghttp.VerifyJSON(`[]`),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("sends an empty list", func() {
+				Expect(team.SetNotifications(nil)).To(Succeed())
+			})
+		})
+
+		Context("when the notifications are invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusBadRequest, "url must be http or https"),
+				)
+			})
+
+			It("returns the error", func() {
+				err := team.SetNotifications(atc.NotificationConfigs{{Name: "some-hook", URL: "ftp://example.com"}})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("url must be http or https"))
+			})
+		})
+	})
+
+	Describe("NotificationDeliveries", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/notifications/deliveries", "limit=10"),
+					ghttp.RespondWith(http.StatusOK, `[{"id":3,"notification":"some-hook","state":"succeeded","attempts":1}]`),
+				),
+			)
+		})
+
+		It("returns the deliveries", func() {
+			deliveries, err := team.NotificationDeliveries(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(Equal([]atc.NotificationDelivery{
+				{ID: 3, Notification: "some-hook", State: atc.NotificationDeliverySucceeded, Attempts: 1},
+			}))
+		})
+	})
+})
@@ -222,6 +222,36 @@ func (team *team) managePipeline(pipelineRef atc.PipelineRef, endpoint string) (
 	}
 }
 
+// SharePipeline replaces the teams the pipeline is shared with read-only.
+func (team *team) SharePipeline(pipelineRef atc.PipelineRef, teamNames []string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	jsonBytes, err := json.Marshal(atc.PipelineShares{Teams: teamNames})
+	if err != nil {
+		return false, err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.SharePipeline,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (team *team) RenamePipeline(oldName string, newName string) (bool, []ConfigWarning, error) {
 	params := rata.Params{
 		"pipeline_name": oldName,
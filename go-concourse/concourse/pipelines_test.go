@@ -174,6 +174,64 @@ var _ = Describe("ATC Handler Pipelines", func() {
 		})
 	})
 
+	Describe("SharePipeline", func() {
+
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/shares"
+		queryParams := "vars.branch=%22master%22"
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, queryParams),
+						ghttp.VerifyJSONRepresenting(atc.PipelineShares{Teams: []string{"team-a", "team-b"}}),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("return true and no error", func() {
+				found, err := team.SharePipeline(pipelineRef, []string{"team-a", "team-b"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the pipeline doesn't exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, queryParams),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				found, err := team.SharePipeline(pipelineRef, []string{"team-a"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when a team doesn't exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, queryParams),
+						ghttp.RespondWith(http.StatusBadRequest, "teams not found: team-a\n"),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.SharePipeline(pipelineRef, []string{"team-a"})
+				Expect(err).To(MatchError(ContainSubstring("teams not found: team-a")))
+			})
+		})
+	})
+
 	Describe("HidePipeline", func() {
 
 		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/hide"
@@ -0,0 +1,71 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// SessionFilter narrows down sessions to those of a user. Connector is
+// optional, as usernames are only unique per connector.
+type SessionFilter struct {
+	Username  string
+	Connector string
+}
+
+func (filter SessionFilter) query() url.Values {
+	queryParams := url.Values{}
+
+	if filter.Username != "" {
+		queryParams.Add("username", filter.Username)
+	}
+
+	if filter.Connector != "" {
+		queryParams.Add("connector", filter.Connector)
+	}
+
+	return queryParams
+}
+
+func (client *client) ListSessions(filter SessionFilter) ([]atc.Session, error) {
+	var sessions []atc.Session
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListSessions,
+		Query:       filter.query(),
+	}, &internal.Response{
+		Result: &sessions,
+	})
+
+	return sessions, err
+}
+
+func (client *client) RevokeSession(id int) (bool, error) {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeSession,
+		Params:      rata.Params{"session_id": strconv.Itoa(id)},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (client *client) RevokeUserSessions(filter SessionFilter) (atc.RevokedSessions, error) {
+	var revoked atc.RevokedSessions
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeUserSessions,
+		Query:       filter.query(),
+	}, &internal.Response{
+		Result: &revoked,
+	})
+
+	return revoked, err
+}
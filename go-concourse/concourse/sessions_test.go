@@ -0,0 +1,85 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Sessions", func() {
+	Describe("ListSessions", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/sessions", "username=bob&connector=github"),
+					ghttp.RespondWith(http.StatusOK, `[{"id":2,"sub":"bob-sub","username":"bob","connector":"github","created_at":100,"expires_at":200}]`),
+				),
+			)
+		})
+
+		It("returns the sessions", func() {
+			sessions, err := client.ListSessions(concourse.SessionFilter{Username: "bob", Connector: "github"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(Equal([]atc.Session{
+				{ID: 2, Sub: "bob-sub", Username: "bob", Connector: "github", CreatedAt: 100, ExpiresAt: 200},
+			}))
+		})
+	})
+
+	Describe("RevokeSession", func() {
+		Context("when the session exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/sessions/2"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("revokes it", func() {
+				revoked, err := client.RevokeSession(2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeTrue())
+			})
+		})
+
+		Context("when the session does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/sessions/2"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				revoked, err := client.RevokeSession(2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RevokeUserSessions", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/sessions", "username=bob"),
+					ghttp.RespondWith(http.StatusOK, `{"revoked":3,"api_tokens":1}`),
+				),
+			)
+		})
+
+		It("returns the number of revoked sessions and API tokens", func() {
+			revoked, err := client.RevokeUserSessions(concourse.SessionFilter{Username: "bob"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(Equal(atc.RevokedSessions{Revoked: 3, APITokens: 1}))
+		})
+	})
+})
@@ -17,6 +17,22 @@ type Team interface {
 	CreateOrUpdate(team atc.Team) (atc.Team, bool, bool, []ConfigWarning, error)
 	RenameTeam(teamName, name string) (bool, []ConfigWarning, error)
 	DestroyTeam(teamName string) error
+	ListMembers() ([]atc.TeamMember, error)
+
+	GitOps() (atc.GitOps, bool, error)
+	SetGitOps(config atc.GitOpsConfig) error
+	DisableGitOps() error
+
+	Notifications() (atc.NotificationConfigs, error)
+	SetNotifications(configs atc.NotificationConfigs) error
+	NotificationDeliveries(limit int) ([]atc.NotificationDelivery, error)
+
+	TeamResources() (atc.TeamResources, error)
+	SetTeamResources(shared atc.TeamResources) ([]ConfigWarning, error)
+
+	ListTokens() ([]atc.APIToken, error)
+	CreateToken(request atc.APITokenRequest) (atc.APIToken, error)
+	RevokeToken(id int) (bool, error)
 
 	Pipeline(pipelineRef atc.PipelineRef) (atc.Pipeline, bool, error)
 	PipelineBuilds(pipelineRef atc.PipelineRef, page Page) ([]atc.Build, Pagination, bool, error)
@@ -26,10 +42,13 @@ type Team interface {
 	UnpausePipeline(pipelineRef atc.PipelineRef) (bool, error)
 	ExposePipeline(pipelineRef atc.PipelineRef) (bool, error)
 	HidePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	SharePipeline(pipelineRef atc.PipelineRef, teamNames []string) (bool, error)
 	RenamePipeline(oldName, newName string) (bool, []ConfigWarning, error)
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	PipelineConfigVersions(pipelineRef atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error)
+	PipelineConfigVersion(pipelineRef atc.PipelineRef, version int) (atc.PipelineConfigVersion, bool, error)
 
 	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
 
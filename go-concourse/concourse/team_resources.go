@@ -0,0 +1,74 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) TeamResources() (atc.TeamResources, error) {
+	var shared atc.TeamResources
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetTeamResources,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &shared,
+	})
+
+	return shared, err
+}
+
+func (team *team) SetTeamResources(shared atc.TeamResources) ([]ConfigWarning, error) {
+	payload, err := json.Marshal(shared)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := team.httpAgent.Send(internal.Request{
+		ReturnResponseBody: true,
+		RequestName:        atc.SetTeamResources,
+		Params:             rata.Params{"team_name": team.Name()},
+		Body:               bytes.NewBuffer(payload),
+		Header:             http.Header{"Content-Type": {"application/json"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+	body, _ := ioutil.ReadAll(response.Body)
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var saveResponse setConfigResponse
+		err = json.Unmarshal(body, &saveResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		return saveResponse.Warnings, nil
+	case http.StatusBadRequest, http.StatusConflict:
+		var saveResponse setConfigResponse
+		err = json.Unmarshal(body, &saveResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, InvalidTeamResourcesError{Errors: saveResponse.Errors}
+	case http.StatusForbidden:
+		return nil, internal.ForbiddenError{
+			Reason: string(body),
+		}
+	default:
+		return nil, internal.UnexpectedResponseError{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			Body:       string(body),
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Team Resources", func() {
+	var team concourse.Team
+
+	BeforeEach(func() {
+		team = client.Team("some-team")
+	})
+
+	Describe("TeamResources", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/shared-resources"),
+					ghttp.RespondWith(http.StatusOK, `{"resources":[{"name":"repo","type":"git","source":{"uri":"some-uri"}}]}`),
+				),
+			)
+		})
+
+		It("returns the team's shared resources", func() {
+			shared, err := team.TeamResources()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shared).To(Equal(atc.TeamResources{
+				Resources: atc.ResourceConfigs{
+					{Name: "repo", Type: "git", Source: atc.Source{"uri": "some-uri"}},
+				},
+			}))
+		})
+	})
+
+	Describe("SetTeamResources", func() {
+		var (
+			warnings []concourse.ConfigWarning
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = team.SetTeamResources(atc.TeamResources{
+				Resources: atc.ResourceConfigs{
+					{Name: "repo", Type: "git", Source: atc.Source{"uri": "some-uri"}},
+				},
+			})
+		})
+
+		Context("when the shared resources are accepted", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/shared-resources"),
+						ghttp.VerifyJSON(`{"resources":[{"name":"repo","type":"git","source":{"uri":"some-uri"}}]}`),
+						ghttp.RespondWith(http.StatusOK, `{"warnings":[{"type":"invalid_identifier","message":"some-warning"}]}`),
+					),
+				)
+			})
+
+			It("returns the warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(Equal([]concourse.ConfigWarning{
+					{Type: "invalid_identifier", Message: "some-warning"},
+				}))
+			})
+		})
+
+		Context("when a removed shared resource is still used", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/shared-resources"),
+						ghttp.RespondWith(http.StatusConflict, `{"errors":["shared resource 'chat' is still used by pipeline 'some-pipeline'"]}`),
+					),
+				)
+			})
+
+			It("returns the errors", func() {
+				Expect(err).To(Equal(concourse.InvalidTeamResourcesError{
+					Errors: []string{"shared resource 'chat' is still used by pipeline 'some-pipeline'"},
+				}))
+			})
+		})
+
+		Context("when the shared resources are invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/shared-resources"),
+						ghttp.RespondWith(http.StatusBadRequest, `{"errors":["invalid resources:\n\tresources.repo has no type\n"]}`),
+					),
+				)
+			})
+
+			It("returns the errors", func() {
+				Expect(err).To(BeAssignableToTypeOf(concourse.InvalidTeamResourcesError{}))
+				Expect(err.Error()).To(ContainSubstring("resources.repo has no type"))
+			})
+		})
+	})
+})
@@ -107,6 +107,20 @@ func (team *team) RenameTeam(teamName, name string) (bool, []ConfigWarning, erro
 	}
 }
 
+// ListMembers lists the users provisioned through SCIM who are granted roles
+// on the team.
+func (team *team) ListMembers() ([]atc.TeamMember, error) {
+	var members []atc.TeamMember
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamMembers,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &members,
+	})
+
+	return members, err
+}
+
 func (client *client) ListTeams() ([]atc.Team, error) {
 	var teams []atc.Team
 	err := client.connection.Send(internal.Request{
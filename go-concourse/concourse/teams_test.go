@@ -287,4 +287,28 @@ var _ = Describe("ATC Handler Teams", func() {
 			Expect(teams).To(Equal(expectedTeams))
 		})
 	})
+
+	Describe("ListMembers", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/members"),
+					ghttp.RespondWith(http.StatusOK, `[{"user_name":"bob","email":"bob@example.com","roles":["owner"],"groups":["admins"]}]`),
+				),
+			)
+		})
+
+		It("returns the team's members", func() {
+			members, err := client.Team("some-team").ListMembers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(Equal([]atc.TeamMember{
+				{
+					UserName: "bob",
+					Email:    "bob@example.com",
+					Roles:    []string{"owner"},
+					Groups:   []string{"admins"},
+				},
+			}))
+		})
+	})
 })
@@ -0,0 +1,93 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListTokens() ([]atc.APIToken, error) {
+	var tokens []atc.APIToken
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListUserTokens,
+	}, &internal.Response{
+		Result: &tokens,
+	})
+
+	return tokens, err
+}
+
+func (client *client) CreateToken(request atc.APITokenRequest) (atc.APIToken, error) {
+	return createToken(client.connection, atc.CreateUserToken, nil, request)
+}
+
+func (client *client) RevokeToken(id int) (bool, error) {
+	return revokeToken(client.connection, atc.RevokeUserToken, rata.Params{
+		"token_id": strconv.Itoa(id),
+	})
+}
+
+func (team *team) ListTokens() ([]atc.APIToken, error) {
+	var tokens []atc.APIToken
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamTokens,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &tokens,
+	})
+
+	return tokens, err
+}
+
+func (team *team) CreateToken(request atc.APITokenRequest) (atc.APIToken, error) {
+	return createToken(team.connection, atc.CreateTeamToken, rata.Params{
+		"team_name": team.Name(),
+	}, request)
+}
+
+func (team *team) RevokeToken(id int) (bool, error) {
+	return revokeToken(team.connection, atc.RevokeTeamToken, rata.Params{
+		"team_name": team.Name(),
+		"token_id":  strconv.Itoa(id),
+	})
+}
+
+func createToken(connection internal.Connection, requestName string, params rata.Params, request atc.APITokenRequest) (atc.APIToken, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	var token atc.APIToken
+	err = connection.Send(internal.Request{
+		RequestName: requestName,
+		Params:      params,
+		Body:        bytes.NewBuffer(payload),
+		Header:      http.Header{"Content-Type": {"application/json"}},
+	}, &internal.Response{
+		Result: &token,
+	})
+
+	return token, err
+}
+
+func revokeToken(connection internal.Connection, requestName string, params rata.Params) (bool, error) {
+	err := connection.Send(internal.Request{
+		RequestName: requestName,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,91 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Tokens", func() {
+	Describe("ListTokens", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/user/tokens"),
+					ghttp.RespondWith(http.StatusOK, `[{"id":1,"name":"ci","role":"viewer","created_at":100}]`),
+				),
+			)
+		})
+
+		It("returns the user's tokens", func() {
+			tokens, err := client.ListTokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal([]atc.APIToken{
+				{ID: 1, Name: "ci", Role: "viewer", CreatedAt: 100},
+			}))
+		})
+	})
+
+	Describe("CreateToken", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/tokens"),
+					ghttp.VerifyJSON(`{"name":"deploy","role":"member","service_account":"deployer"}`),
+					ghttp.RespondWith(http.StatusCreated, `{"id":1,"name":"deploy","role":"member","team_name":"some-team","service_account":"deployer","created_at":100,"token":"cpat_abc"}`),
+				),
+			)
+		})
+
+		It("returns the created token", func() {
+			token, err := client.Team("some-team").CreateToken(atc.APITokenRequest{
+				Name:           "deploy",
+				Role:           "member",
+				ServiceAccount: "deployer",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token.Token).To(Equal("cpat_abc"))
+			Expect(token.ServiceAccount).To(Equal("deployer"))
+		})
+	})
+
+	Describe("RevokeToken", func() {
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/user/tokens/42"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("revokes it", func() {
+				revoked, err := client.RevokeToken(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/tokens/42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				revoked, err := client.Team("some-team").RevokeToken(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeFalse())
+			})
+		})
+	})
+})
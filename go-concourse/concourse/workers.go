@@ -92,3 +92,39 @@ func (client *client) LandWorker(workerName string) error {
 
 	return err
 }
+
+func (client *client) DrainWorker(workerName string) error {
+	params := rata.Params{"worker_name": workerName}
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.DrainWorker,
+		Params:      params,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+
+	return err
+}
+
+func (client *client) ScaleDownWorker(workerName string) error {
+	params := rata.Params{"worker_name": workerName}
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ScaleDownWorker,
+		Params:      params,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+
+	return err
+}
+
+func (client *client) WorkerCapacity() (atc.WorkerCapacity, error) {
+	var capacity atc.WorkerCapacity
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetWorkerCapacity,
+	}, &internal.Response{
+		Result: &capacity,
+	})
+	return capacity, err
+}
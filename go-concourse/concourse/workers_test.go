@@ -160,4 +160,98 @@ var _ = Describe("ATC Handler Workers", func() {
 			})
 		})
 	})
+
+	Describe("DrainWorker", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/drain"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("drains the worker", func() {
+				err := client.DrainWorker("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("failing to drain worker", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/drain"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.DrainWorker("some-worker")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ScaleDownWorker", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/scale-down"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("marks the worker for scale down", func() {
+				err := client.ScaleDownWorker("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("failing to mark the worker", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/scale-down"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.ScaleDownWorker("some-worker")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("WorkerCapacity", func() {
+		expectedCapacity := atc.WorkerCapacity{
+			RunningWorkers: 2,
+			DesiredWorkers: 3,
+			WaitingSteps:   1,
+			Demand: []atc.WorkerDemand{
+				{Platform: "linux", WaitingSteps: 1, AdditionalWorkers: 1},
+			},
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/workers/capacity"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedCapacity),
+				),
+			)
+		})
+
+		It("returns the worker capacity", func() {
+			capacity, err := client.WorkerCapacity()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(capacity).To(Equal(expectedCapacity))
+		})
+	})
 })
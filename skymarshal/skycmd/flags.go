@@ -53,6 +53,7 @@ type AuthFlags struct {
 
 type AuthTeamFlags struct {
 	LocalUsers []string  `long:"local-user" description:"A whitelisted local concourse user. These are the users you've added at web startup with the --add-local-user flag." value-name:"USERNAME"`
+	SCIMGroups []string  `long:"scim-group" description:"A whitelisted group provisioned by an identity provider through SCIM. Its members are matched by their user ID at the connector configured with --scim-connector." value-name:"GROUP_NAME"`
 	Config     flag.File `short:"c" long:"config" description:"Configuration file for specifying team params"`
 }
 
@@ -134,6 +135,14 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 			}
 		}
 
+		if conf, ok := role[atc.SCIMConnector].(map[string]interface{}); ok {
+			for _, group := range conf["groups"].([]interface{}) {
+				if group != "" {
+					groups = append(groups, atc.SCIMConnector+":"+strings.ToLower(group.(string)))
+				}
+			}
+		}
+
 		if len(users) == 0 && len(groups) == 0 {
 			continue
 		}
@@ -142,6 +151,23 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 			"users":  users,
 			"groups": groups,
 		}
+
+		// Roles may be scoped to some pipelines and may define their own set of
+		// actions, making them custom roles.
+		for _, key := range []string{atc.TeamAuthPipelines, atc.TeamAuthActions} {
+			values, ok := role[key]
+			if !ok {
+				continue
+			}
+
+			var list []string
+			err = mapstructure.Decode(values, &list)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s for role '%s': %w", key, roleName, err)
+			}
+
+			auth[roleName][key] = list
+		}
 	}
 
 	if err := auth.Validate(); err != nil {
@@ -183,6 +209,12 @@ func (flag *AuthTeamFlags) formatFromFlags() (atc.TeamAuth, error) {
 		}
 	}
 
+	for _, group := range flag.SCIMGroups {
+		if group != "" {
+			groups = append(groups, atc.SCIMConnector+":"+strings.ToLower(group))
+		}
+	}
+
 	if len(users) == 0 && len(groups) == 0 {
 		return nil, atc.ErrAuthConfigInvalid
 	}
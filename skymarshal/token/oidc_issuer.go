@@ -0,0 +1,143 @@
+package token
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// OIDCIssuerPath is the path of the ATC's OIDC issuer, under which its
+// discovery document and keys are served.
+const OIDCIssuerPath = "/oidc"
+
+// OIDCIssuer issues OIDC tokens identifying the steps of builds, allowing
+// them to authenticate with services which trust the ATC as an identity
+// provider without any credentials being stored.
+//
+// Tokens are signed with the same key as the auth tokens issued on login.
+type OIDCIssuer struct {
+	issuer   string
+	key      jose.JSONWebKey
+	signer   jose.Signer
+	validFor time.Duration
+}
+
+func NewOIDCIssuer(externalURL string, signingKey *rsa.PrivateKey, validFor time.Duration) (*OIDCIssuer, error) {
+	public := jose.JSONWebKey{
+		Key:       &signingKey.PublicKey,
+		Algorithm: string(jose.RS256),
+		Use:       "sig",
+	}
+
+	thumbprint, err := public.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	public.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.RS256,
+			Key: jose.JSONWebKey{
+				Key:   signingKey,
+				KeyID: public.KeyID,
+			},
+		},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OIDCIssuer{
+		issuer:   strings.TrimRight(externalURL, "/") + OIDCIssuerPath,
+		key:      public,
+		signer:   signer,
+		validFor: validFor,
+	}, nil
+}
+
+// Issuer is the issuer URL, i.e. the 'iss' claim of the tokens.
+func (issuer *OIDCIssuer) Issuer() string {
+	return issuer.issuer
+}
+
+// IssueOIDCToken signs a token for the identity, valid for the given
+// audiences, or for the issuer itself if none are given.
+func (issuer *OIDCIssuer) IssueOIDCToken(identity atc.WorkloadIdentity, audience []string) (string, error) {
+	if len(audience) == 0 {
+		audience = []string{issuer.issuer}
+	}
+
+	now := time.Now()
+
+	return jwt.Signed(issuer.signer).
+		Claims(jwt.Claims{
+			Issuer:    issuer.issuer,
+			Subject:   identity.Subject(),
+			Audience:  jwt.Audience(audience),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(now.Add(issuer.validFor)),
+		}).
+		Claims(identity.Claims()).
+		CompactSerialize()
+}
+
+// ServeHTTP serves the discovery document and the keys tokens are signed
+// with, which is all that relying parties need to verify tokens.
+func (issuer *OIDCIssuer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch strings.TrimPrefix(r.URL.Path, OIDCIssuerPath) {
+	case "/.well-known/openid-configuration":
+		issuer.respond(w, issuer.discovery())
+	case "/jwks":
+		issuer.respond(w, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{issuer.key}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+type oidcDiscovery struct {
+	Issuer             string   `json:"issuer"`
+	JWKSURI            string   `json:"jwks_uri"`
+	ResponseTypes      []string `json:"response_types_supported"`
+	SubjectTypes       []string `json:"subject_types_supported"`
+	IDTokenSigningAlgs []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported    []string `json:"claims_supported"`
+	ScopesSupported    []string `json:"scopes_supported"`
+}
+
+func (issuer *OIDCIssuer) discovery() oidcDiscovery {
+	return oidcDiscovery{
+		Issuer:             issuer.issuer,
+		JWKSURI:            issuer.issuer + "/jwks",
+		ResponseTypes:      []string{"id_token"},
+		SubjectTypes:       []string{"public"},
+		IDTokenSigningAlgs: []string{string(jose.RS256)},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "nbf",
+			"team", "pipeline", "instance_vars", "job", "build_id", "build_name", "step",
+		},
+		ScopesSupported: []string{"openid"},
+	}
+}
+
+func (issuer *OIDCIssuer) respond(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,135 @@
+package token_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/skymarshal/token"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+var _ = Describe("OIDCIssuer", func() {
+	var (
+		signingKey *rsa.PrivateKey
+		issuer     *token.OIDCIssuer
+		identity   atc.WorkloadIdentity
+	)
+
+	BeforeEach(func() {
+		var err error
+		signingKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		issuer, err = token.NewOIDCIssuer("https://ci.example.com/", signingKey, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		identity = atc.WorkloadIdentity{
+			TeamName:     "main",
+			PipelineName: "deploy",
+			JobName:      "prod",
+			BuildID:      42,
+			BuildName:    "7",
+			StepName:     "terraform",
+		}
+	})
+
+	It("has an issuer URL under the external URL", func() {
+		Expect(issuer.Issuer()).To(Equal("https://ci.example.com/oidc"))
+	})
+
+	Describe("IssueOIDCToken", func() {
+		It("issues a token signed with the signing key describing the step", func() {
+			raw, err := issuer.IssueOIDCToken(identity, []string{"sts.amazonaws.com"})
+			Expect(err).NotTo(HaveOccurred())
+
+			var custom map[string]interface{}
+			err = parse(raw, signingKey, &custom)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := jwt.ParseSigned(raw)
+			Expect(err).NotTo(HaveOccurred())
+
+			var claims jwt.Claims
+			err = parsed.Claims(&signingKey.PublicKey, &claims)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = claims.Validate(jwt.Expected{
+				Issuer:   "https://ci.example.com/oidc",
+				Subject:  "team:main:pipeline:deploy:job:prod:step:terraform",
+				Audience: jwt.Audience{"sts.amazonaws.com"},
+				Time:     time.Now(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.Expiry.Time()).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+			Expect(custom).To(HaveKeyWithValue("team", "main"))
+			Expect(custom).To(HaveKeyWithValue("pipeline", "deploy"))
+			Expect(custom).To(HaveKeyWithValue("job", "prod"))
+			Expect(custom).To(HaveKeyWithValue("build_id", "42"))
+			Expect(custom).To(HaveKeyWithValue("build_name", "7"))
+			Expect(custom).To(HaveKeyWithValue("step", "terraform"))
+		})
+
+		It("defaults the audience to the issuer", func() {
+			raw, err := issuer.IssueOIDCToken(identity, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := jwt.ParseSigned(raw)
+			Expect(err).NotTo(HaveOccurred())
+
+			var claims jwt.Claims
+			err = parsed.Claims(&signingKey.PublicKey, &claims)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.Audience).To(Equal(jwt.Audience{"https://ci.example.com/oidc"}))
+		})
+	})
+
+	Describe("serving", func() {
+		get := func(path string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			issuer.ServeHTTP(recorder, httptest.NewRequest("GET", path, nil))
+			return recorder
+		}
+
+		It("serves the discovery document", func() {
+			response := get("/oidc/.well-known/openid-configuration")
+			Expect(response.Code).To(Equal(http.StatusOK))
+
+			var discovery map[string]interface{}
+			Expect(json.Unmarshal(response.Body.Bytes(), &discovery)).To(Succeed())
+			Expect(discovery).To(HaveKeyWithValue("issuer", "https://ci.example.com/oidc"))
+			Expect(discovery).To(HaveKeyWithValue("jwks_uri", "https://ci.example.com/oidc/jwks"))
+		})
+
+		It("serves the keys which verify the tokens", func() {
+			response := get("/oidc/jwks")
+			Expect(response.Code).To(Equal(http.StatusOK))
+
+			var keySet jose.JSONWebKeySet
+			Expect(json.Unmarshal(response.Body.Bytes(), &keySet)).To(Succeed())
+			Expect(keySet.Keys).To(HaveLen(1))
+
+			raw, err := issuer.IssueOIDCToken(identity, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := jwt.ParseSigned(raw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Headers[0].KeyID).To(Equal(keySet.Keys[0].KeyID))
+
+			var claims jwt.Claims
+			Expect(parsed.Claims(keySet.Keys[0].Key, &claims)).To(Succeed())
+		})
+
+		It("does not serve anything else", func() {
+			Expect(get("/oidc/token").Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -193,6 +193,23 @@ func (client *Client) Land(ctx context.Context) error {
 	return client.run(ctx, sshClient, "land-worker", os.Stdout)
 }
 
+// Drain invokes the 'land-worker' command with --drain, which will initiate
+// the landing process like Land does, but with steps that can safely be re-run
+// being moved to other workers rather than waited on.
+func (client *Client) Drain(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx)
+
+	sshClient, _, err := client.dial(ctx, 0)
+	if err != nil {
+		logger.Error("failed-to-dial", err)
+		return err
+	}
+
+	defer sshClient.Close()
+
+	return client.run(ctx, sshClient, "land-worker --drain", os.Stdout)
+}
+
 // Retire invokes the 'retire-worker' command, which will initiate the retiring
 // process for the worker. The worker will transition to 'retiring' and
 // disappear when it is fully drained, causing any existing registrations to
@@ -89,7 +89,7 @@ func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 			return nil
 
 		case <-heartbeater.clock.NewTimer(currentInterval).C():
-			status := heartbeater.heartbeat(logger.Session("heartbeat"))
+			status := heartbeater.heartbeat(ctx, logger.Session("heartbeat"))
 			switch status {
 			case HeartbeatStatusGoneAway:
 				return nil
@@ -170,7 +170,7 @@ const (
 	HeartbeatStatusHealthy
 )
 
-func (heartbeater *Heartbeater) heartbeat(logger lager.Logger) HeartbeatStatus {
+func (heartbeater *Heartbeater) heartbeat(ctx context.Context, logger lager.Logger) HeartbeatStatus {
 	heartbeatData := lager.Data{
 		"worker-platform": heartbeater.registration.Platform,
 		"worker-address":  heartbeater.registration.GardenAddr,
@@ -241,6 +241,22 @@ func (heartbeater *Heartbeater) heartbeat(logger lager.Logger) HeartbeatStatus {
 		return HeartbeatStatusLanded
 	}
 
+	// the autoscaler has picked this worker to go away; retire it so that it
+	// finishes its builds and is removed once it has drained
+	if workerInfo.ScaleDownCandidate && workerInfo.State == "running" {
+		logger.Info("retiring-scale-down-candidate")
+
+		retirer := &Retirer{
+			ATCEndpoint: heartbeater.atcEndpointPicker.Pick(),
+			HTTPClient:  heartbeater.httpClient,
+		}
+
+		err = retirer.Retire(lagerctx.NewContext(ctx, logger.Session("retire")), heartbeater.registration)
+		if err != nil {
+			logger.Error("failed-to-retire-scale-down-candidate", err)
+		}
+	}
+
 	return HeartbeatStatusHealthy
 }
 
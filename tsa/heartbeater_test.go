@@ -311,6 +311,44 @@ var _ = Describe("Heartbeater", func() {
 			})
 		})
 
+		Context("when heartbeat returns worker is a scale down candidate", func() {
+			var retired chan struct{}
+
+			BeforeEach(func() {
+				retired = make(chan struct{}, 1)
+
+				retireRoute, found := atc.Routes.FindRouteByName(atc.RetireWorker)
+				Expect(found).To(BeTrue())
+
+				fakeATC1.AppendHandlers(
+					verifyRegister,
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(retireRoute.Method, "/api/v1/workers/some-name/retire"),
+						func(w http.ResponseWriter, r *http.Request) {
+							Expect(r.Header.Get("Authorization")).To(Equal("Bearer yo"))
+							retired <- struct{}{}
+						},
+					),
+				)
+				fakeATC2.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-name/heartbeat"),
+					func(w http.ResponseWriter, r *http.Request) {
+						json.NewEncoder(w).Encode(atc.Worker{
+							State:              "running",
+							ScaleDownCandidate: true,
+						})
+					},
+				))
+			})
+
+			It("retires the worker", func() {
+				Eventually(registrations).Should(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(interval)
+				Eventually(retired).Should(Receive())
+			})
+		})
+
 		Context("when the ATC doesn't respond to the first heartbeat", func() {
 			BeforeEach(func() {
 				fakeATC1.AppendHandlers(
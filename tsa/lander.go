@@ -17,6 +17,10 @@ import (
 type Lander struct {
 	ATCEndpoint *rata.RequestGenerator
 	HTTPClient  *http.Client
+
+	// Drain lands the worker by moving steps which can safely be re-run off
+	// of it, rather than waiting for them to finish.
+	Drain bool
 }
 
 func (l *Lander) Land(ctx context.Context, worker atc.Worker) error {
@@ -25,7 +29,12 @@ func (l *Lander) Land(ctx context.Context, worker atc.Worker) error {
 	logger.Info("start")
 	defer logger.Info("end")
 
-	request, err := l.ATCEndpoint.CreateRequest(atc.LandWorker, rata.Params{
+	route := atc.LandWorker
+	if l.Drain {
+		route = atc.DrainWorker
+	}
+
+	request, err := l.ATCEndpoint.CreateRequest(route, rata.Params{
 		"worker_name": worker.Name,
 	}, nil)
 	if err != nil {
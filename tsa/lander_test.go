@@ -78,6 +78,25 @@ var _ = Describe("Lander", func() {
 		Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
 	})
 
+	Context("when draining", func() {
+		BeforeEach(func() {
+			lander.Drain = true
+		})
+
+		It("tells the ATC to drain the worker", func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/drain"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer yo"),
+				ghttp.RespondWith(200, nil, nil),
+			))
+
+			err := lander.Land(ctx, worker)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
 	Context("when the ATC responds with a 403", func() {
 		BeforeEach(func() {
 			fakeATC.AppendHandlers(ghttp.CombineHandlers(
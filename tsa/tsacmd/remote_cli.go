@@ -137,6 +137,8 @@ func (r forwardWorkerRequest) expectedForwards() int {
 
 type landWorkerRequest struct {
 	server *server
+
+	drain bool
 }
 
 func checkTeam(state ConnState, worker atc.Worker) error {
@@ -170,6 +172,7 @@ func (req landWorkerRequest) Handle(ctx context.Context, state ConnState, channe
 	return (&tsa.Lander{
 		ATCEndpoint: req.server.atcEndpointPicker.Pick(),
 		HTTPClient:  req.server.httpClient,
+		Drain:       req.drain,
 	}).Land(ctx, worker)
 }
 
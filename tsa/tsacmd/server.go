@@ -509,8 +509,19 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 			baggageclaimAddr: *baggageclaim,
 		}
 	case tsa.LandWorker:
+		var fs = flag.NewFlagSet(command, flag.ContinueOnError)
+
+		var drain = fs.Bool("drain", false, "move re-runnable steps off of the worker instead of waiting for them")
+
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, "", err
+		}
+
 		req = landWorkerRequest{
 			server: server,
+
+			drain: *drain,
 		}
 	case tsa.RetireWorker:
 		req = retireWorkerRequest{
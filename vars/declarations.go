@@ -0,0 +1,140 @@
+package vars
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/go-multierror"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	VarTypeString  = "string"
+	VarTypeNumber  = "number"
+	VarTypeBoolean = "boolean"
+	VarTypeList    = "list"
+	VarTypeMap     = "map"
+)
+
+// VarTypes are the types a declared var may have. A var without a type may
+// have any value.
+var VarTypes = []string{
+	VarTypeString,
+	VarTypeNumber,
+	VarTypeBoolean,
+	VarTypeList,
+	VarTypeMap,
+}
+
+// VarDeclaration declares a var of a template in its top-level `vars:` block.
+// A required var must be provided when the template is resolved; the default
+// is used for other vars which are not provided.
+type VarDeclaration struct {
+	Name     string      `json:"name"`
+	Type     string      `json:"type,omitempty"`
+	Default  interface{} `json:"default,omitempty"`
+	Required bool        `json:"required,omitempty"`
+}
+
+type VarDeclarations []VarDeclaration
+
+func (declarations VarDeclarations) Lookup(name string) (VarDeclaration, bool) {
+	for _, declaration := range declarations {
+		if declaration.Name == name {
+			return declaration, true
+		}
+	}
+
+	return VarDeclaration{}, false
+}
+
+// Defaults returns the default values of the declared vars.
+func (declarations VarDeclarations) Defaults() StaticVariables {
+	defaults := StaticVariables{}
+	for _, declaration := range declarations {
+		if declaration.Default != nil {
+			defaults[declaration.Name] = declaration.Default
+		}
+	}
+
+	return defaults
+}
+
+// Validate checks that the required vars are provided by the variables and
+// that the provided values are of the declared types.
+func (declarations VarDeclarations) Validate(variables Variables) error {
+	var errs error
+
+	for _, declaration := range declarations {
+		value, found, err := variables.Get(Reference{Path: declaration.Name})
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			if declaration.Required {
+				errs = multierror.Append(errs, fmt.Errorf("required var '%s' is not provided", declaration.Name))
+			}
+
+			continue
+		}
+
+		if !declaration.HasType(value) {
+			errs = multierror.Append(errs, InvalidVarTypeError{
+				Name:  declaration.Name,
+				Type:  declaration.Type,
+				Value: value,
+			})
+		}
+	}
+
+	return errs
+}
+
+// HasType returns whether the value is of the declared type.
+func (declaration VarDeclaration) HasType(value interface{}) bool {
+	if declaration.Type == "" {
+		return true
+	}
+
+	if value == nil {
+		return false
+	}
+
+	if _, ok := value.(json.Number); ok {
+		return declaration.Type == VarTypeNumber
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.String:
+		return declaration.Type == VarTypeString
+	case reflect.Bool:
+		return declaration.Type == VarTypeBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return declaration.Type == VarTypeNumber
+	case reflect.Slice, reflect.Array:
+		return declaration.Type == VarTypeList
+	case reflect.Map:
+		return declaration.Type == VarTypeMap
+	}
+
+	return false
+}
+
+// ParseVarDeclarations returns the vars declared in the top-level `vars:`
+// block of the template.
+func ParseVarDeclarations(template []byte) (VarDeclarations, error) {
+	var config struct {
+		Vars VarDeclarations `json:"vars,omitempty"`
+	}
+
+	err := yaml.Unmarshal(template, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config.Vars, nil
+}
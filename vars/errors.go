@@ -57,3 +57,13 @@ type InvalidInterpolationError struct {
 func (err InvalidInterpolationError) Error() string {
 	return fmt.Sprintf("cannot interpolate non-primitive value (%T) from var: %s", err.Value, err.Name)
 }
+
+type InvalidVarTypeError struct {
+	Name  string
+	Type  string
+	Value interface{}
+}
+
+func (err InvalidVarTypeError) Error() string {
+	return fmt.Sprintf("var '%s' must be of type %s, got %T", err.Name, err.Type, err.Value)
+}
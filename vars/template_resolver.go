@@ -35,6 +35,16 @@ func (resolver TemplateResolver) Resolve(expectAllKeys bool, allowEmptyInOldStyl
 		}
 	}
 
+	// a malformed vars block is left to fail config validation
+	declarations, err := ParseVarDeclarations(resolver.configPayload)
+	if err == nil && len(declarations) > 0 {
+		// defaults of the declared vars are used only when no param source
+		// provides them
+		params := make([]Variables, 0, len(resolver.params)+1)
+		params = append(params, resolver.params...)
+		resolver.params = append(params, declarations.Defaults())
+	}
+
 	resolver.configPayload, err = resolver.resolve(expectAllKeys)
 	if err != nil {
 		return nil, err
@@ -43,6 +53,23 @@ func (resolver TemplateResolver) Resolve(expectAllKeys bool, allowEmptyInOldStyl
 	return resolver.configPayload, nil
 }
 
+// ValidateVars checks the params against the vars declared by the config:
+// required vars must be provided, and provided vars must be of the declared
+// types.
+func (resolver TemplateResolver) ValidateVars() error {
+	payload := resolver.configPayload
+	if PresentDeprecated(payload) {
+		payload, _ = resolver.ResolveDeprecated(true)
+	}
+
+	declarations, err := ParseVarDeclarations(payload)
+	if err != nil {
+		return err
+	}
+
+	return declarations.Validate(NewMultiVars(resolver.params))
+}
+
 func (resolver TemplateResolver) resolve(expectAllKeys bool) ([]byte, error) {
 	tpl := NewTemplate(resolver.configPayload)
 	bytes, err := tpl.Evaluate(NewMultiVars(resolver.params), EvaluateOpts{ExpectAllKeys: expectAllKeys})
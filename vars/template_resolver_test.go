@@ -211,6 +211,100 @@ jobs:
 
 			})
 		})
+
+		Context("when the config declares vars with defaults", func() {
+			BeforeEach(func() {
+				configPayload = []byte(`
+vars:
+- name: env
+  default: some-default-env
+- name: branch
+  type: string
+  default: main
+
+resources:
+- name: env-state
+  source:
+    bucket: ((env))-ci
+    branch: ((branch))
+`)
+			})
+
+			It("uses the defaults of the vars which are not given", func() {
+				evaluatedContent, err := vars.NewTemplateResolver(configPayload, []vars.Variables{staticVars}).Resolve(true, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(evaluatedContent).To(MatchYAML([]byte(`
+vars:
+- name: env
+  default: some-default-env
+- name: branch
+  type: string
+  default: main
+
+resources:
+- name: env-state
+  source:
+    bucket: some-env-ci
+    branch: main
+`,
+				)))
+			})
+		})
+	})
+
+	Describe("ValidateVars", func() {
+		var validateErr error
+
+		BeforeEach(func() {
+			staticVars = nil
+
+			configPayload = []byte(`
+vars:
+- name: env
+  type: string
+  required: true
+- name: env-tags
+  type: list
+- name: replicas
+  type: number
+  default: 1
+
+jobs: []
+`)
+		})
+
+		JustBeforeEach(func() {
+			validateErr = vars.NewTemplateResolver(configPayload, []vars.Variables{staticVars}).ValidateVars()
+		})
+
+		It("succeeds when the given vars match the declarations", func() {
+			Expect(validateErr).NotTo(HaveOccurred())
+		})
+
+		Context("when a required var is not given", func() {
+			BeforeEach(func() {
+				paramPayload = []byte(`env-tags: ["speedy"]`)
+			})
+
+			It("returns an error", func() {
+				Expect(validateErr).To(HaveOccurred())
+				Expect(validateErr.Error()).To(ContainSubstring("required var 'env' is not provided"))
+			})
+		})
+
+		Context("when a given var is not of the declared type", func() {
+			BeforeEach(func() {
+				paramPayload = []byte(`
+env: some-env
+env-tags: speedy
+`)
+			})
+
+			It("returns an error", func() {
+				Expect(validateErr).To(HaveOccurred())
+				Expect(validateErr.Error()).To(ContainSubstring("var 'env-tags' must be of type list, got string"))
+			})
+		})
 	})
 
 	It("can template values into a byte slice", func() {
@@ -51,6 +51,8 @@ type StepTree
     | Put StepID
     | SetPipeline StepID
     | LoadVar StepID
+    | SyncPipelines StepID
+    | PipelineOutput StepID
     | ArtifactInput StepID
     | ArtifactOutput StepID
     | InParallel (Array StepTree)
@@ -277,6 +279,12 @@ activeStepIds model tree =
         LoadVar stepId ->
             [ stepId ]
 
+        SyncPipelines stepId ->
+            [ stepId ]
+
+        PipelineOutput stepId ->
+            [ stepId ]
+
         InParallel trees ->
             List.concatMap (activeStepIds model) (Array.toList trees)
 
@@ -101,6 +101,12 @@ init buildId hl resources plan =
         Concourse.BuildStepLoadVar _ ->
             step |> initBottom buildId hl resources plan LoadVar
 
+        Concourse.BuildStepSyncPipelines _ ->
+            step |> initBottom buildId hl resources plan SyncPipelines
+
+        Concourse.BuildStepPipelineOutput _ ->
+            step |> initBottom buildId hl resources plan PipelineOutput
+
         Concourse.BuildStepInParallel plans ->
             initMultiStep buildId hl resources plan.id InParallel plans Nothing
 
@@ -519,6 +525,12 @@ viewTree session model tree depth =
         LoadVar stepId ->
             viewStep model session depth stepId
 
+        SyncPipelines stepId ->
+            viewStep model session depth stepId
+
+        PipelineOutput stepId ->
+            viewStep model session depth stepId
+
         Try subTree ->
             viewTree session model subTree depth
 
@@ -1167,6 +1179,12 @@ viewStepHeader step =
         Concourse.BuildStepLoadVar name ->
             simpleHeader "load_var:" Nothing name
 
+        Concourse.BuildStepSyncPipelines name ->
+            simpleHeader "sync_pipelines:" Nothing name
+
+        Concourse.BuildStepPipelineOutput name ->
+            simpleHeader "pipeline_output:" Nothing name
+
         Concourse.BuildStepCheck name ->
             simpleHeader "check:" Nothing name
 
@@ -1231,6 +1249,12 @@ stepName header =
         Concourse.BuildStepLoadVar name ->
             Just name
 
+        Concourse.BuildStepSyncPipelines name ->
+            Just name
+
+        Concourse.BuildStepPipelineOutput name ->
+            Just name
+
         Concourse.BuildStepArtifactInput name ->
             Just name
 
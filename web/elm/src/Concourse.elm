@@ -375,6 +375,12 @@ mapBuildPlan fn plan =
                 BuildStepLoadVar _ ->
                     []
 
+                BuildStepSyncPipelines _ ->
+                    []
+
+                BuildStepPipelineOutput _ ->
+                    []
+
                 BuildStepArtifactInput _ ->
                     []
 
@@ -441,6 +447,8 @@ type BuildStep
     = BuildStepTask StepName
     | BuildStepSetPipeline StepName InstanceVars
     | BuildStepLoadVar StepName
+    | BuildStepSyncPipelines StepName
+    | BuildStepPipelineOutput StepName
     | BuildStepArtifactInput StepName
     | BuildStepCheck StepName
     | BuildStepGet StepName (Maybe ResourceName) (Maybe Version)
@@ -655,6 +663,10 @@ decodeBuildPlan =
                     lazy (\_ -> decodeBuildSetPipeline)
                 , Json.Decode.field "load_var" <|
                     lazy (\_ -> decodeBuildStepLoadVar)
+                , Json.Decode.field "sync_pipelines" <|
+                    lazy (\_ -> decodeBuildStepSyncPipelines)
+                , Json.Decode.field "pipeline_output" <|
+                    lazy (\_ -> decodeBuildStepPipelineOutput)
                 , Json.Decode.field "across" <|
                     lazy (\_ -> decodeBuildStepAcross)
                 ]
@@ -794,6 +806,18 @@ decodeBuildStepLoadVar =
         |> andMap (Json.Decode.field "name" Json.Decode.string)
 
 
+decodeBuildStepSyncPipelines : Json.Decode.Decoder BuildStep
+decodeBuildStepSyncPipelines =
+    Json.Decode.succeed BuildStepSyncPipelines
+        |> andMap (Json.Decode.field "name" Json.Decode.string)
+
+
+decodeBuildStepPipelineOutput : Json.Decode.Decoder BuildStep
+decodeBuildStepPipelineOutput =
+    Json.Decode.succeed BuildStepPipelineOutput
+        |> andMap (Json.Decode.field "name" Json.Decode.string)
+
+
 decodeBuildStepAcross : Json.Decode.Decoder BuildStep
 decodeBuildStepAcross =
     Json.Decode.map BuildStepAcross
@@ -29,6 +29,8 @@ all =
         [ initTask
         , initSetPipeline
         , initLoadVar
+        , initSyncPipelines
+        , initPipelineOutput
         , initCheck
         , initRun
         , initGet
@@ -160,6 +162,54 @@ initLoadVar =
         ]
 
 
+initSyncPipelines : Test
+initSyncPipelines =
+    let
+        step =
+            BuildStepSyncPipelines "some-name"
+
+        { tree, steps } =
+            StepTree.init Nothing
+                Routes.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = step
+                }
+    in
+    describe "init with SyncPipelines"
+        [ test "the tree" <|
+            \_ ->
+                Expect.equal (Models.SyncPipelines "some-id") tree
+        , test "the step" <|
+            \_ ->
+                assertSteps [ someStep "some-id" step Models.StepStatePending ] steps
+        ]
+
+
+initPipelineOutput : Test
+initPipelineOutput =
+    let
+        step =
+            BuildStepPipelineOutput "some-name"
+
+        { tree, steps } =
+            StepTree.init Nothing
+                Routes.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = step
+                }
+    in
+    describe "init with PipelineOutput"
+        [ test "the tree" <|
+            \_ ->
+                Expect.equal (Models.PipelineOutput "some-id") tree
+        , test "the step" <|
+            \_ ->
+                assertSteps [ someStep "some-id" step Models.StepStatePending ] steps
+        ]
+
+
 initCheck : Test
 initCheck =
     let
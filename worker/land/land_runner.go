@@ -14,6 +14,8 @@ type LandWorkerCommand struct {
 	TSA worker.TSAConfig `group:"TSA Configuration" namespace:"tsa" required:"true"`
 
 	WorkerName string `long:"name" required:"true" description:"The name of the worker you wish to land."`
+
+	Drain bool `long:"drain" description:"Move steps of interruptible jobs and retryable steps to other workers instead of waiting for them to finish."`
 }
 
 func (cmd *LandWorkerCommand) Execute(args []string) error {
@@ -24,5 +26,11 @@ func (cmd *LandWorkerCommand) Execute(args []string) error {
 		Name: cmd.WorkerName,
 	})
 
-	return client.Land(lagerctx.NewContext(context.Background(), logger))
+	ctx := lagerctx.NewContext(context.Background(), logger)
+
+	if cmd.Drain {
+		return client.Drain(ctx)
+	}
+
+	return client.Land(ctx)
 }
@@ -0,0 +1,445 @@
+// Package k8s provides the implementation of a Garden server backed by
+// Kubernetes, running each container as a pod.
+//
+// The pods mount their root filesystem and volumes from the persistent volume
+// claim that also backs the worker's baggageclaim volumes directory, so that
+// the worker itself does not need to be privileged.
+//
+// See https://kubernetes.io/, and https://github.com/cloudfoundry/garden.
+//
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+const (
+	WorkerLabel = "concourse.ci/worker"
+	HandleLabel = "concourse.ci/handle"
+
+	PropertiesAnnotation = "concourse.ci/properties"
+
+	GraceTimeKey = "garden.grace-time"
+
+	DefaultInitBinPath = "/usr/local/concourse/bin/init"
+)
+
+// PodPollingInterval is how often the backend checks whether a newly created
+// pod is running.
+//
+var PodPollingInterval = 500 * time.Millisecond
+
+var _ garden.Backend = (*Backend)(nil)
+
+// Backend implements a Garden backend backed by Kubernetes pods.
+//
+type Backend struct {
+	client     kubernetes.Interface
+	executor   Executor
+	workerName string
+
+	namespace    string
+	image        string
+	initBinPath  string
+	volumesDir   string
+	volumesClaim string
+
+	maxContainers  int
+	requestTimeout time.Duration
+
+	processes *processTable
+}
+
+// BackendOpt defines a functional option that when applied, modifies the
+// configuration of a Backend.
+//
+type BackendOpt func(b *Backend)
+
+// WithNamespace configures the namespace that pods are created in.
+//
+func WithNamespace(namespace string) BackendOpt {
+	return func(b *Backend) {
+		b.namespace = namespace
+	}
+}
+
+// WithImage configures the image used for the pods' only container. The image
+// must provide the init binary, `sh`, `chroot`, `kill` and `tar`; the actual
+// root filesystem of the container is mounted from the volumes claim and
+// processes are chrooted into it.
+//
+func WithImage(image string) BackendOpt {
+	return func(b *Backend) {
+		b.image = image
+	}
+}
+
+// WithInitBinPath configures the path to the init binary within the image,
+// which sits there doing nothing until Concourse runs processes in the pod.
+//
+func WithInitBinPath(initBinPath string) BackendOpt {
+	return func(b *Backend) {
+		b.initBinPath = initBinPath
+	}
+}
+
+// WithVolumes configures the directory baggageclaim keeps its volumes in, and
+// the name of the persistent volume claim mounted there. Root filesystems and
+// bind mounts below the directory are mounted into pods as sub paths of the
+// claim.
+//
+func WithVolumes(dir string, claim string) BackendOpt {
+	return func(b *Backend) {
+		b.volumesDir = dir
+		b.volumesClaim = claim
+	}
+}
+
+// WithMaxContainers configures the max number of containers that can be created
+//
+func WithMaxContainers(limit int) BackendOpt {
+	return func(b *Backend) {
+		b.maxContainers = limit
+	}
+}
+
+// WithRequestTimeout configures how long to wait for a pod to start running.
+// 0 means no timeout.
+//
+func WithRequestTimeout(requestTimeout time.Duration) BackendOpt {
+	return func(b *Backend) {
+		b.requestTimeout = requestTimeout
+	}
+}
+
+// NewBackend instantiates a Backend which creates pods labelled with the name
+// of the worker, running processes in them through the executor.
+//
+func NewBackend(client kubernetes.Interface, executor Executor, workerName string, opts ...BackendOpt) (*Backend, error) {
+	if client == nil {
+		return nil, ErrInvalidInput("nil client")
+	}
+
+	if executor == nil {
+		return nil, ErrInvalidInput("nil executor")
+	}
+
+	if workerName == "" {
+		return nil, ErrInvalidInput("empty worker name")
+	}
+
+	b := &Backend{
+		client:     client,
+		executor:   executor,
+		workerName: workerName,
+		processes:  newProcessTable(),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	if b.namespace == "" {
+		b.namespace = metav1.NamespaceDefault
+	}
+
+	if b.initBinPath == "" {
+		b.initBinPath = DefaultInitBinPath
+	}
+
+	if b.image == "" {
+		return nil, ErrInvalidInput("empty image")
+	}
+
+	return b, nil
+}
+
+// Start checks that pods can be listed in the namespace.
+//
+func (b *Backend) Start() error {
+	_, err := b.client.CoreV1().Pods(b.namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: b.workerSelector().String(),
+		Limit:         1,
+	})
+	if err != nil {
+		return fmt.Errorf("list pods: %w", err)
+	}
+
+	return nil
+}
+
+// Stop - Not Implemented
+//
+func (b *Backend) Stop() {}
+
+// Ping pings the Kubernetes API server in order to check connectivity.
+//
+func (b *Backend) Ping() error {
+	_, err := b.client.Discovery().ServerVersion()
+	if err != nil {
+		return fmt.Errorf("getting kubernetes version: %w", err)
+	}
+
+	return nil
+}
+
+// Capacity returns the max number of containers. Memory and disk are
+// accounted for by the Kubernetes scheduler instead.
+//
+func (b *Backend) Capacity() (garden.Capacity, error) {
+	return garden.Capacity{
+		MaxContainers: uint64(b.maxContainers),
+	}, nil
+}
+
+// Create creates a pod for the container and waits for it to be running.
+//
+func (b *Backend) Create(gdnSpec garden.ContainerSpec) (garden.Container, error) {
+	ctx := context.Background()
+
+	if gdnSpec.Handle == "" {
+		return nil, ErrInvalidInput("empty handle")
+	}
+
+	err := b.checkContainerCapacity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("checking container capacity: %w", err)
+	}
+
+	pod, err := b.podSpec(gdnSpec)
+	if err != nil {
+		return nil, fmt.Errorf("garden spec to pod spec: %w", err)
+	}
+
+	pods := b.client.CoreV1().Pods(b.namespace)
+
+	pod, err = pods.Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("create pod: %w", err)
+	}
+
+	pod, err = b.waitForPod(ctx, pod.Name)
+	if err != nil {
+		_ = pods.Delete(ctx, pod.Name, deleteNow())
+		return nil, fmt.Errorf("wait for pod: %w", err)
+	}
+
+	return b.newContainer(pod), nil
+}
+
+func (b *Backend) waitForPod(ctx context.Context, name string) (*corev1.Pod, error) {
+	var pod *corev1.Pod
+
+	condition := func() (bool, error) {
+		var err error
+		pod, err = b.client.CoreV1().Pods(b.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		switch pod.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, fmt.Errorf("pod %s: %s", strings.ToLower(string(pod.Status.Phase)), pod.Status.Message)
+		default:
+			return false, nil
+		}
+	}
+
+	var err error
+	if b.requestTimeout == 0 {
+		err = wait.PollImmediateInfinite(PodPollingInterval, condition)
+	} else {
+		err = wait.PollImmediate(PodPollingInterval, b.requestTimeout, condition)
+	}
+
+	if pod == nil {
+		pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	return pod, err
+}
+
+// Destroy deletes the container's pod right away, as it does not hold any
+// state that needs to be flushed.
+//
+func (b *Backend) Destroy(handle string) error {
+	if handle == "" {
+		return ErrInvalidInput("empty handle")
+	}
+
+	err := b.client.CoreV1().Pods(b.namespace).Delete(context.Background(), handle, deleteNow())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return garden.ContainerNotFoundError{Handle: handle}
+		}
+
+		return fmt.Errorf("delete pod: %w", err)
+	}
+
+	b.processes.forget(handle)
+
+	return nil
+}
+
+// Containers lists the containers of the worker that have all of the
+// properties.
+//
+func (b *Backend) Containers(properties garden.Properties) ([]garden.Container, error) {
+	list, err := b.client.CoreV1().Pods(b.namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: b.workerSelector().String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+
+	containers := []garden.Container{}
+	for i := range list.Items {
+		pod := &list.Items[i]
+
+		podProperties, err := podProperties(pod)
+		if err != nil {
+			return nil, fmt.Errorf("pod %s properties: %w", pod.Name, err)
+		}
+
+		if !matchesProperties(podProperties, properties) {
+			continue
+		}
+
+		containers = append(containers, b.newContainer(pod))
+	}
+
+	return containers, nil
+}
+
+// Lookup returns the container with the specified handle.
+//
+func (b *Backend) Lookup(handle string) (garden.Container, error) {
+	if handle == "" {
+		return nil, ErrInvalidInput("empty handle")
+	}
+
+	pod, err := b.client.CoreV1().Pods(b.namespace).Get(context.Background(), handle, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, garden.ContainerNotFoundError{Handle: handle}
+		}
+
+		return nil, fmt.Errorf("get pod: %w", err)
+	}
+
+	if pod.Labels[WorkerLabel] != b.workerName {
+		return nil, garden.ContainerNotFoundError{Handle: handle}
+	}
+
+	return b.newContainer(pod), nil
+}
+
+// BulkInfo - Not Implemented
+//
+func (b *Backend) BulkInfo(handles []string) (info map[string]garden.ContainerInfoEntry, err error) {
+	err = ErrNotImplemented
+	return
+}
+
+// BulkMetrics - Not Implemented
+//
+func (b *Backend) BulkMetrics(handles []string) (metrics map[string]garden.ContainerMetricsEntry, err error) {
+	err = ErrNotImplemented
+	return
+}
+
+// GraceTime returns the value of the "garden.grace-time" property
+//
+func (b *Backend) GraceTime(container garden.Container) (duration time.Duration) {
+	property, err := container.Property(GraceTimeKey)
+	if err != nil {
+		return 0
+	}
+
+	_, err = fmt.Sscanf(property, "%d", &duration)
+	if err != nil {
+		return 0
+	}
+
+	return duration
+}
+
+// checkContainerCapacity ensures that MaxContainers is respected
+//
+func (b *Backend) checkContainerCapacity(ctx context.Context) error {
+	if b.maxContainers == 0 {
+		return nil
+	}
+
+	list, err := b.client.CoreV1().Pods(b.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: b.workerSelector().String(),
+	})
+	if err != nil {
+		return fmt.Errorf("list pods: %w", err)
+	}
+
+	if len(list.Items) >= b.maxContainers {
+		return ErrMaxContainersReached
+	}
+
+	return nil
+}
+
+func (b *Backend) workerSelector() labels.Selector {
+	return labels.SelectorFromSet(labels.Set{WorkerLabel: b.workerName})
+}
+
+func (b *Backend) newContainer(pod *corev1.Pod) *Container {
+	return NewContainer(
+		pod,
+		b.client,
+		b.executor,
+		b.processes,
+	)
+}
+
+func podProperties(pod *corev1.Pod) (garden.Properties, error) {
+	properties := garden.Properties{}
+
+	encoded, found := pod.Annotations[PropertiesAnnotation]
+	if !found {
+		return properties, nil
+	}
+
+	err := json.Unmarshal([]byte(encoded), &properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return properties, nil
+}
+
+func matchesProperties(properties garden.Properties, filter garden.Properties) bool {
+	for k, v := range filter {
+		if properties[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func deleteNow() metav1.DeleteOptions {
+	gracePeriod := int64(0)
+	return metav1.DeleteOptions{GracePeriodSeconds: &gracePeriod}
+}
@@ -0,0 +1,287 @@
+package k8s_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/worker/runtime/k8s"
+	"github.com/concourse/concourse/worker/runtime/k8s/k8sfakes"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type BackendSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	backend  *k8s.Backend
+	client   *fake.Clientset
+	executor *k8sfakes.FakeExecutor
+}
+
+const namespace = "some-namespace"
+
+var minimumValidGdnSpec = garden.ContainerSpec{
+	Handle:     "handle",
+	RootFSPath: "raw:///worker/volumes/live/some-volume/volume/rootfs",
+}
+
+// newClient returns a fake clientset whose pods start running as soon as
+// they are created.
+func newClient(objects ...runtime.Object) *fake.Clientset {
+	client := fake.NewSimpleClientset(objects...)
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		pod.Status.Phase = corev1.PodRunning
+		return false, nil, nil
+	})
+
+	return client
+}
+
+func (s *BackendSuite) SetupTest() {
+	s.client = newClient()
+	s.executor = new(k8sfakes.FakeExecutor)
+
+	var err error
+	s.backend, err = k8s.NewBackend(s.client, s.executor, "some-worker",
+		k8s.WithNamespace(namespace),
+		k8s.WithImage("concourse/concourse"),
+		k8s.WithVolumes("/worker/volumes", "some-claim"),
+		k8s.WithRequestTimeout(time.Second),
+	)
+	s.NoError(err)
+
+	k8s.PodPollingInterval = time.Millisecond
+}
+
+func (s *BackendSuite) TestNew() {
+	_, err := k8s.NewBackend(nil, s.executor, "some-worker")
+	s.EqualError(err, "nil client")
+
+	_, err = k8s.NewBackend(s.client, nil, "some-worker")
+	s.EqualError(err, "nil executor")
+
+	_, err = k8s.NewBackend(s.client, s.executor, "some-worker")
+	s.EqualError(err, "empty image")
+}
+
+func (s *BackendSuite) TestStart() {
+	s.NoError(s.backend.Start())
+
+	s.client.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("forbidden")
+	})
+
+	s.EqualError(errors.Unwrap(s.backend.Start()), "forbidden")
+}
+
+func (s *BackendSuite) TestCreateWithInvalidSpec() {
+	_, err := s.backend.Create(garden.ContainerSpec{})
+	s.Error(err)
+
+	_, err = s.backend.Create(garden.ContainerSpec{
+		Handle:     "handle",
+		RootFSPath: "docker:///busybox",
+	})
+	s.Error(err)
+
+	pods, err := s.client.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{})
+	s.NoError(err)
+	s.Empty(pods.Items)
+}
+
+func (s *BackendSuite) TestCreate() {
+	cont, err := s.backend.Create(garden.ContainerSpec{
+		Handle:     "handle",
+		RootFSPath: "raw:///worker/volumes/live/some-volume/volume/rootfs",
+		BindMounts: []garden.BindMount{
+			{
+				SrcPath: "/worker/volumes/live/some-input/volume",
+				DstPath: "/tmp/build/some-input",
+				Mode:    garden.BindMountModeRW,
+			},
+			{
+				SrcPath: "/worker/volumes/live/some-cache/volume",
+				DstPath: "/tmp/build/some-cache",
+			},
+		},
+		Env:        []string{"FOO=bar=baz"},
+		Properties: garden.Properties{"some": "property"},
+		Limits: garden.Limits{
+			CPU:    garden.CPULimits{Weight: 512},
+			Memory: garden.MemoryLimits{LimitInBytes: 1024 * 1024},
+		},
+	})
+	s.NoError(err)
+	s.Equal("handle", cont.Handle())
+
+	pod, err := s.client.CoreV1().Pods(namespace).Get(context.Background(), "handle", metav1.GetOptions{})
+	s.NoError(err)
+
+	s.Equal(map[string]string{
+		k8s.WorkerLabel: "some-worker",
+		k8s.HandleLabel: "handle",
+	}, pod.Labels)
+	s.Equal(`{"some":"property"}`, pod.Annotations[k8s.PropertiesAnnotation])
+
+	s.Equal(corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	s.Len(pod.Spec.Volumes, 1)
+	s.Equal("some-claim", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+
+	s.Len(pod.Spec.Containers, 1)
+	main := pod.Spec.Containers[0]
+	s.Equal(k8s.MainContainerName, main.Name)
+	s.Equal("concourse/concourse", main.Image)
+	s.Contains(main.Command, k8s.DefaultInitBinPath)
+	s.Equal([]corev1.EnvVar{{Name: "FOO", Value: "bar=baz"}}, main.Env)
+	s.False(*main.SecurityContext.Privileged)
+
+	s.Equal([]corev1.VolumeMount{
+		{
+			Name:      "volumes",
+			MountPath: "/concourse/rootfs",
+			SubPath:   "live/some-volume/volume/rootfs",
+		},
+		{
+			Name:      "volumes",
+			MountPath: "/concourse/rootfs/tmp/build/some-input",
+			SubPath:   "live/some-input/volume",
+		},
+		{
+			Name:      "volumes",
+			MountPath: "/concourse/rootfs/tmp/build/some-cache",
+			SubPath:   "live/some-cache/volume",
+			ReadOnly:  true,
+		},
+	}, main.VolumeMounts)
+
+	s.Equal("500m", main.Resources.Requests.Cpu().String())
+	s.Equal("1Mi", main.Resources.Limits.Memory().String())
+}
+
+func (s *BackendSuite) TestCreateWithMountOutsideVolumes() {
+	spec := minimumValidGdnSpec
+	spec.BindMounts = []garden.BindMount{
+		{SrcPath: "/etc/ssl/certs", DstPath: "/etc/ssl/certs"},
+	}
+
+	_, err := s.backend.Create(spec)
+	s.True(errors.As(err, &k8s.UnsupportedMountError{}))
+}
+
+func (s *BackendSuite) TestCreateWhenPodFails() {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		pod.Status.Phase = corev1.PodFailed
+		pod.Status.Message = "image pull failed"
+		return false, nil, nil
+	})
+
+	backend, err := k8s.NewBackend(client, s.executor, "some-worker",
+		k8s.WithNamespace(namespace),
+		k8s.WithImage("concourse/concourse"),
+		k8s.WithVolumes("/worker/volumes", "some-claim"),
+	)
+	s.NoError(err)
+
+	_, err = backend.Create(minimumValidGdnSpec)
+	s.Error(err)
+	s.Contains(err.Error(), "pod failed: image pull failed")
+
+	_, err = client.CoreV1().Pods(namespace).Get(context.Background(), "handle", metav1.GetOptions{})
+	s.Error(err, "pod should have been deleted")
+}
+
+func (s *BackendSuite) TestCreateMaxContainersReached() {
+	backend, err := k8s.NewBackend(s.client, s.executor, "some-worker",
+		k8s.WithNamespace(namespace),
+		k8s.WithImage("concourse/concourse"),
+		k8s.WithVolumes("/worker/volumes", "some-claim"),
+		k8s.WithMaxContainers(1),
+	)
+	s.NoError(err)
+
+	_, err = backend.Create(minimumValidGdnSpec)
+	s.NoError(err)
+
+	spec := minimumValidGdnSpec
+	spec.Handle = "other-handle"
+
+	_, err = backend.Create(spec)
+	s.Error(err)
+	s.Contains(err.Error(), "max containers reached")
+}
+
+func (s *BackendSuite) TestDestroy() {
+	_, err := s.backend.Create(minimumValidGdnSpec)
+	s.NoError(err)
+
+	s.NoError(s.backend.Destroy("handle"))
+
+	_, err = s.backend.Lookup("handle")
+	s.Equal(garden.ContainerNotFoundError{Handle: "handle"}, err)
+
+	err = s.backend.Destroy("handle")
+	s.Equal(garden.ContainerNotFoundError{Handle: "handle"}, err)
+}
+
+func (s *BackendSuite) TestContainers() {
+	for handle, properties := range map[string]garden.Properties{
+		"first":  {"type": "task", "team": "main"},
+		"second": {"type": "check"},
+	} {
+		spec := minimumValidGdnSpec
+		spec.Handle = handle
+		spec.Properties = properties
+
+		_, err := s.backend.Create(spec)
+		s.NoError(err)
+	}
+
+	_, err := s.client.CoreV1().Pods(namespace).Create(context.Background(), &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "other-worker-pod",
+			Labels: map[string]string{k8s.WorkerLabel: "other-worker"},
+		},
+	}, metav1.CreateOptions{})
+	s.NoError(err)
+
+	containers, err := s.backend.Containers(nil)
+	s.NoError(err)
+	s.Len(containers, 2)
+
+	containers, err = s.backend.Containers(garden.Properties{"type": "task"})
+	s.NoError(err)
+	s.Len(containers, 1)
+	s.Equal("first", containers[0].Handle())
+}
+
+func (s *BackendSuite) TestLookupOtherWorkersPod() {
+	_, err := s.client.CoreV1().Pods(namespace).Create(context.Background(), &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "other-worker-pod",
+			Labels: map[string]string{k8s.WorkerLabel: "other-worker"},
+		},
+	}, metav1.CreateOptions{})
+	s.NoError(err)
+
+	_, err = s.backend.Lookup("other-worker-pod")
+	s.Equal(garden.ContainerNotFoundError{Handle: "other-worker-pod"}, err)
+}
+
+func (s *BackendSuite) TestGraceTime() {
+	cont, err := s.backend.Create(minimumValidGdnSpec)
+	s.NoError(err)
+
+	s.NoError(cont.SetGraceTime(5 * time.Second))
+	s.Equal(5*time.Second, s.backend.GraceTime(cont))
+}
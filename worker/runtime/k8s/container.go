@@ -0,0 +1,415 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	uuid "github.com/nu7hatch/gouuid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// Container is a garden container backed by a pod.
+//
+type Container struct {
+	pod       *corev1.Pod
+	client    kubernetes.Interface
+	executor  Executor
+	processes *processTable
+}
+
+func NewContainer(
+	pod *corev1.Pod,
+	client kubernetes.Interface,
+	executor Executor,
+	processes *processTable,
+) *Container {
+	return &Container{
+		pod:       pod,
+		client:    client,
+		executor:  executor,
+		processes: processes,
+	}
+}
+
+var _ garden.Container = (*Container)(nil)
+
+func (c *Container) Handle() string {
+	return c.pod.Name
+}
+
+// Stop stops all processes in the container. The pod itself keeps running
+// until the container is destroyed.
+//
+func (c *Container) Stop(kill bool) error {
+	err := c.signalAll(kill)
+	if err != nil {
+		return fmt.Errorf("kill: %w", err)
+	}
+
+	return nil
+}
+
+// Run a process inside the container.
+//
+// The process is chrooted into the container's root filesystem, changes into
+// its working directory and has its environment set through `env`.
+//
+func (c *Container) Run(
+	spec garden.ProcessSpec,
+	processIO garden.ProcessIO,
+) (garden.Process, error) {
+	if spec.Path == "" {
+		return nil, ErrInvalidInput("empty path")
+	}
+
+	proc := newProcess(procID(spec), c, processIO)
+	c.processes.add(c.Handle(), proc)
+
+	execSpec := c.execSpec(processCommand(spec))
+	execSpec.Stdin = processIO.Stdin
+	execSpec.Stdout = proc.stdout
+	execSpec.Stderr = proc.stderr
+	execSpec.TTY = spec.TTY
+
+	go func() {
+		proc.finish(c.executor.Exec(execSpec))
+	}()
+
+	return proc, nil
+}
+
+// Attach starts streaming the output back to the client from a specified
+// process.
+//
+func (c *Container) Attach(pid string, processIO garden.ProcessIO) (garden.Process, error) {
+	if pid == "" {
+		return nil, ErrInvalidInput("empty pid")
+	}
+
+	proc, found := c.processes.find(c.Handle(), pid)
+	if !found {
+		return nil, garden.ProcessNotFoundError{ProcessID: pid}
+	}
+
+	proc.attach(processIO)
+
+	return proc, nil
+}
+
+// Properties returns the current set of properties
+//
+func (c *Container) Properties() (garden.Properties, error) {
+	pod, err := c.client.CoreV1().Pods(c.pod.Namespace).Get(context.Background(), c.pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return garden.Properties{}, fmt.Errorf("get pod: %w", err)
+	}
+
+	return podProperties(pod)
+}
+
+// Property returns the value of the property with the specified name.
+//
+func (c *Container) Property(name string) (string, error) {
+	properties, err := c.Properties()
+	if err != nil {
+		return "", err
+	}
+
+	v, found := properties[name]
+	if !found {
+		return "", ErrNotFound(name)
+	}
+
+	return v, nil
+}
+
+// Set a named property on a container to a specified value.
+//
+func (c *Container) SetProperty(name string, value string) error {
+	return c.updateProperties(func(properties garden.Properties) {
+		properties[name] = value
+	})
+}
+
+// RemoveProperty removes a property from the container.
+//
+func (c *Container) RemoveProperty(name string) error {
+	return c.updateProperties(func(properties garden.Properties) {
+		delete(properties, name)
+	})
+}
+
+func (c *Container) updateProperties(update func(garden.Properties)) error {
+	ctx := context.Background()
+	pods := c.client.CoreV1().Pods(c.pod.Namespace)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pod, err := pods.Get(ctx, c.pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		properties, err := podProperties(pod)
+		if err != nil {
+			return err
+		}
+
+		update(properties)
+
+		encoded, err := json.Marshal(properties)
+		if err != nil {
+			return err
+		}
+
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+
+		pod.Annotations[PropertiesAnnotation] = string(encoded)
+
+		_, err = pods.Update(ctx, pod, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("update properties: %w", err)
+	}
+
+	return nil
+}
+
+// Info returns the state, addresses and properties of the container.
+//
+func (c *Container) Info() (garden.ContainerInfo, error) {
+	pod, err := c.client.CoreV1().Pods(c.pod.Namespace).Get(context.Background(), c.pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return garden.ContainerInfo{}, fmt.Errorf("get pod: %w", err)
+	}
+
+	properties, err := podProperties(pod)
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	state := "stopped"
+	if pod.Status.Phase == corev1.PodRunning {
+		state = "active"
+	}
+
+	return garden.ContainerInfo{
+		State:       state,
+		HostIP:      pod.Status.HostIP,
+		ContainerIP: pod.Status.PodIP,
+		Properties:  properties,
+	}, nil
+}
+
+// Metrics - Not Implemented
+//
+func (c *Container) Metrics() (metrics garden.Metrics, err error) {
+	err = ErrNotImplemented
+	return
+}
+
+// StreamIn extracts a tar stream into the given directory of the container.
+//
+func (c *Container) StreamIn(spec garden.StreamInSpec) error {
+	dst := path.Join(RootfsMountPath, spec.Path)
+
+	var stderr bytes.Buffer
+
+	execSpec := c.execSpec([]string{"/bin/sh", "-c", `mkdir -p "$0" && exec tar -x -C "$0"`, dst})
+	execSpec.Stdin = spec.TarStream
+	execSpec.Stderr = &stderr
+
+	status, err := c.executor.Exec(execSpec)
+	if err != nil {
+		return fmt.Errorf("exec tar: %w", err)
+	}
+
+	if status != 0 {
+		return fmt.Errorf("tar exited with status %d: %s", status, stderr.String())
+	}
+
+	return nil
+}
+
+// StreamOut streams a tar of the given path out of the container. Following
+// garden's semantics, a path with a trailing slash streams the contents of
+// the directory rather than the directory itself.
+//
+func (c *Container) StreamOut(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+	src := path.Join(RootfsMountPath, spec.Path)
+
+	dir, base := path.Dir(src), path.Base(src)
+	if strings.HasSuffix(spec.Path, "/") {
+		dir, base = src, "."
+	}
+
+	reader, writer := io.Pipe()
+
+	var stderr bytes.Buffer
+
+	execSpec := c.execSpec([]string{"tar", "-c", "-C", dir, base})
+	execSpec.Stdout = writer
+	execSpec.Stderr = &stderr
+
+	go func() {
+		status, err := c.executor.Exec(execSpec)
+		if err == nil && status != 0 {
+			err = fmt.Errorf("tar exited with status %d: %s", status, stderr.String())
+		}
+
+		writer.CloseWithError(err)
+	}()
+
+	return reader, nil
+}
+
+// SetGraceTime stores the grace time as a property with key "garden.grace-time"
+//
+func (c *Container) SetGraceTime(graceTime time.Duration) error {
+	err := c.SetProperty(GraceTimeKey, fmt.Sprintf("%d", graceTime))
+	if err != nil {
+		return fmt.Errorf("set grace time: %w", err)
+	}
+
+	return nil
+}
+
+// CurrentBandwidthLimits returns no limits (achieves parity with Guardian)
+func (c *Container) CurrentBandwidthLimits() (garden.BandwidthLimits, error) {
+	return garden.BandwidthLimits{}, nil
+}
+
+// CurrentCPULimits returns the CPU shares that correspond to the CPU requested
+// by the pod
+func (c *Container) CurrentCPULimits() (garden.CPULimits, error) {
+	cpu, found := c.mainContainer().Resources.Requests[corev1.ResourceCPU]
+	if !found {
+		return garden.CPULimits{}, nil
+	}
+
+	return garden.CPULimits{
+		Weight: uint64(cpu.MilliValue() * 1024 / 1000),
+	}, nil
+}
+
+// CurrentDiskLimits returns no limits (achieves parity with Guardian)
+func (c *Container) CurrentDiskLimits() (garden.DiskLimits, error) {
+	return garden.DiskLimits{}, nil
+}
+
+// CurrentMemoryLimits returns the memory limit in bytes of the pod
+func (c *Container) CurrentMemoryLimits() (garden.MemoryLimits, error) {
+	memory, found := c.mainContainer().Resources.Limits[corev1.ResourceMemory]
+	if !found {
+		return garden.MemoryLimits{}, nil
+	}
+
+	return garden.MemoryLimits{
+		LimitInBytes: uint64(memory.Value()),
+	}, nil
+}
+
+// NetIn - Not Implemented
+func (c *Container) NetIn(hostPort, containerPort uint32) (a, b uint32, err error) {
+	err = ErrNotImplemented
+	return
+}
+
+// NetOut - Not Implemented
+func (c *Container) NetOut(netOutRule garden.NetOutRule) (err error) {
+	err = ErrNotImplemented
+	return
+}
+
+// BulkNetOut - Not Implemented
+func (c *Container) BulkNetOut(netOutRules []garden.NetOutRule) (err error) {
+	err = ErrNotImplemented
+	return
+}
+
+// signalAll signals every process in the pod's main container apart from its
+// init process.
+//
+func (c *Container) signalAll(kill bool) error {
+	signal := "-TERM"
+	if kill {
+		signal = "-KILL"
+	}
+
+	// the exit status is ignored, as kill fails when there are no processes
+	// left to signal
+	_, err := c.executor.Exec(c.execSpec([]string{"kill", signal, "-1"}))
+	return err
+}
+
+func (c *Container) execSpec(command []string) ExecSpec {
+	return ExecSpec{
+		Namespace: c.pod.Namespace,
+		Pod:       c.pod.Name,
+		Container: MainContainerName,
+		Command:   command,
+	}
+}
+
+func (c *Container) mainContainer() corev1.Container {
+	for _, container := range c.pod.Spec.Containers {
+		if container.Name == MainContainerName {
+			return container
+		}
+	}
+
+	return corev1.Container{}
+}
+
+func procID(gdnProcSpec garden.ProcessSpec) string {
+	id := gdnProcSpec.ID
+	if id == "" {
+		uuid, err := uuid.NewV4()
+		if err != nil {
+			panic(fmt.Errorf("uuid gen: %w", err))
+		}
+
+		id = uuid.String()
+	}
+
+	return id
+}
+
+// processCommand builds the command which runs the process within the
+// container's root filesystem.
+//
+func processCommand(spec garden.ProcessSpec) []string {
+	dir := spec.Dir
+	if dir == "" {
+		dir = "/"
+	}
+
+	command := []string{"chroot"}
+	if spec.User != "" {
+		command = append(command, "--userspec="+spec.User)
+	}
+
+	command = append(command, RootfsMountPath, "/bin/sh", "-c", `cd "$0" && exec "$@"`, dir)
+
+	if len(spec.Env) > 0 {
+		command = append(command, "env")
+		command = append(command, spec.Env...)
+	}
+
+	command = append(command, spec.Path)
+	command = append(command, spec.Args...)
+
+	return command
+}
@@ -0,0 +1,188 @@
+package k8s_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/worker/runtime/k8s"
+	"github.com/concourse/concourse/worker/runtime/k8s/k8sfakes"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContainerSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	container garden.Container
+	executor  *k8sfakes.FakeExecutor
+}
+
+func (s *ContainerSuite) SetupTest() {
+	s.executor = new(k8sfakes.FakeExecutor)
+
+	backend, err := k8s.NewBackend(newClient(), s.executor, "some-worker",
+		k8s.WithNamespace(namespace),
+		k8s.WithImage("concourse/concourse"),
+		k8s.WithVolumes("/worker/volumes", "some-claim"),
+	)
+	s.NoError(err)
+
+	s.container, err = backend.Create(minimumValidGdnSpec)
+	s.NoError(err)
+}
+
+func (s *ContainerSuite) TestRun() {
+	s.executor.ExecStub = func(spec k8s.ExecSpec) (int, error) {
+		_, _ = spec.Stdout.Write([]byte("hello"))
+		return 42, nil
+	}
+
+	stdout := new(bytes.Buffer)
+	proc, err := s.container.Run(garden.ProcessSpec{
+		ID:   "some-id",
+		Path: "/bin/echo",
+		Args: []string{"hello"},
+		Dir:  "/tmp/build",
+		Env:  []string{"FOO=bar"},
+		User: "concourse",
+	}, garden.ProcessIO{Stdout: stdout})
+	s.NoError(err)
+	s.Equal("some-id", proc.ID())
+
+	status, err := proc.Wait()
+	s.NoError(err)
+	s.Equal(42, status)
+	s.Equal("hello", stdout.String())
+
+	spec := s.executor.ExecArgsForCall(0)
+	s.Equal(namespace, spec.Namespace)
+	s.Equal("handle", spec.Pod)
+	s.Equal(k8s.MainContainerName, spec.Container)
+	s.Equal([]string{
+		"chroot", "--userspec=concourse", "/concourse/rootfs",
+		"/bin/sh", "-c", `cd "$0" && exec "$@"`, "/tmp/build",
+		"env", "FOO=bar",
+		"/bin/echo", "hello",
+	}, spec.Command)
+}
+
+func (s *ContainerSuite) TestRunExecFailure() {
+	s.executor.ExecReturns(0, errors.New("upgrade failed"))
+
+	proc, err := s.container.Run(garden.ProcessSpec{Path: "/bin/true"}, garden.ProcessIO{})
+	s.NoError(err)
+	s.NotEmpty(proc.ID())
+
+	_, err = proc.Wait()
+	s.EqualError(err, "upgrade failed")
+}
+
+func (s *ContainerSuite) TestAttach() {
+	release := make(chan struct{})
+	s.executor.ExecStub = func(spec k8s.ExecSpec) (int, error) {
+		<-release
+		_, _ = spec.Stdout.Write([]byte("after attach"))
+		return 0, nil
+	}
+
+	_, err := s.container.Run(garden.ProcessSpec{ID: "some-id", Path: "/bin/true"}, garden.ProcessIO{
+		Stdout: ioutil.Discard,
+	})
+	s.NoError(err)
+
+	_, err = s.container.Attach("unknown-id", garden.ProcessIO{})
+	s.Equal(garden.ProcessNotFoundError{ProcessID: "unknown-id"}, err)
+
+	stdout := new(bytes.Buffer)
+	proc, err := s.container.Attach("some-id", garden.ProcessIO{Stdout: stdout})
+	s.NoError(err)
+
+	close(release)
+
+	status, err := proc.Wait()
+	s.NoError(err)
+	s.Equal(0, status)
+	s.Equal("after attach", stdout.String())
+}
+
+func (s *ContainerSuite) TestStop() {
+	s.NoError(s.container.Stop(false))
+	s.Equal([]string{"kill", "-TERM", "-1"}, s.executor.ExecArgsForCall(0).Command)
+
+	s.NoError(s.container.Stop(true))
+	s.Equal([]string{"kill", "-KILL", "-1"}, s.executor.ExecArgsForCall(1).Command)
+}
+
+func (s *ContainerSuite) TestProperties() {
+	properties, err := s.container.Properties()
+	s.NoError(err)
+	s.Empty(properties)
+
+	s.NoError(s.container.SetProperty("concourse:exit-status", "0"))
+
+	value, err := s.container.Property("concourse:exit-status")
+	s.NoError(err)
+	s.Equal("0", value)
+
+	s.NoError(s.container.RemoveProperty("concourse:exit-status"))
+
+	_, err = s.container.Property("concourse:exit-status")
+	s.Equal(k8s.ErrNotFound("concourse:exit-status"), err)
+}
+
+func (s *ContainerSuite) TestStreamIn() {
+	var streamed []byte
+	s.executor.ExecStub = func(spec k8s.ExecSpec) (int, error) {
+		var err error
+		streamed, err = ioutil.ReadAll(spec.Stdin)
+		return 0, err
+	}
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/tmp/build/some-input",
+		TarStream: strings.NewReader("some-tar"),
+	})
+	s.NoError(err)
+	s.Equal("some-tar", string(streamed))
+
+	command := s.executor.ExecArgsForCall(0).Command
+	s.Equal("/concourse/rootfs/tmp/build/some-input", command[len(command)-1])
+
+	s.executor.ExecReturns(2, nil)
+	s.executor.ExecStub = nil
+
+	err = s.container.StreamIn(garden.StreamInSpec{Path: "/tmp", TarStream: strings.NewReader("")})
+	s.Error(err)
+}
+
+func (s *ContainerSuite) TestStreamOut() {
+	s.executor.ExecStub = func(spec k8s.ExecSpec) (int, error) {
+		_, err := io.WriteString(spec.Stdout, "some-tar")
+		return 0, err
+	}
+
+	reader, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/tmp/build/some-output/"})
+	s.NoError(err)
+
+	streamed, err := ioutil.ReadAll(reader)
+	s.NoError(err)
+	s.Equal("some-tar", string(streamed))
+
+	s.Equal([]string{"tar", "-c", "-C", "/concourse/rootfs/tmp/build/some-output", "."}, s.executor.ExecArgsForCall(0).Command)
+
+	s.executor.ExecStub = nil
+	s.executor.ExecReturns(2, nil)
+
+	reader, err = s.container.StreamOut(garden.StreamOutSpec{Path: "/tmp/build/some-file"})
+	s.NoError(err)
+
+	_, err = ioutil.ReadAll(reader)
+	s.Error(err)
+
+	s.Equal([]string{"tar", "-c", "-C", "/concourse/rootfs/tmp/build", "some-file"}, s.executor.ExecArgsForCall(1).Command)
+}
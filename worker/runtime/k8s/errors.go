@@ -0,0 +1,44 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidInput indicates a bad input was supplied.
+//
+type ErrInvalidInput string
+
+func (e ErrInvalidInput) Error() string {
+	return string(e)
+}
+
+// ErrNotFound indicates that something wasn't found.
+//
+type ErrNotFound string
+
+func (e ErrNotFound) Error() string {
+	return "not found: " + string(e)
+}
+
+// UnsupportedMountError indicates that a path can't be mounted into a pod as
+// it does not live in the volumes claim.
+//
+type UnsupportedMountError struct {
+	Path string
+}
+
+func (e UnsupportedMountError) Error() string {
+	return fmt.Sprintf("cannot mount '%s': only paths within the volumes directory can be mounted", e.Path)
+}
+
+var (
+	// ErrNotImplemented indicates that a method is not implemented.
+	//
+	ErrNotImplemented = errors.New("not implemented")
+
+	// ErrMaxContainersReached indicates that the worker already runs as many
+	// pods as it is allowed to.
+	//
+	ErrMaxContainersReached = errors.New("max containers reached")
+)
@@ -0,0 +1,123 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/garden"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// ExecSpec describes a command to execute in a container of a pod.
+//
+type ExecSpec struct {
+	Namespace string
+	Pod       string
+	Container string
+	Command   []string
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	TTY    *garden.TTYSpec
+}
+
+// Executor executes commands in the containers of running pods.
+//
+//counterfeiter:generate . Executor
+type Executor interface {
+	// Exec runs the command to completion, returning its exit status.
+	//
+	Exec(spec ExecSpec) (int, error)
+}
+
+// NewExecutor returns an Executor which runs commands through the `exec`
+// subresource of pods, like `kubectl exec` does.
+//
+func NewExecutor(config *rest.Config, client kubernetes.Interface) Executor {
+	return &spdyExecutor{
+		config: config,
+		client: client,
+	}
+}
+
+type spdyExecutor struct {
+	config *rest.Config
+	client kubernetes.Interface
+}
+
+func (e *spdyExecutor) Exec(spec ExecSpec) (int, error) {
+	tty := spec.TTY != nil
+
+	req := e.client.CoreV1().RESTClient().
+		Post().
+		Namespace(spec.Namespace).
+		Resource("pods").
+		Name(spec.Pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: spec.Container,
+			Command:   spec.Command,
+			Stdin:     spec.Stdin != nil,
+			Stdout:    spec.Stdout != nil,
+			// stderr is merged into stdout when using a tty
+			Stderr: spec.Stderr != nil && !tty,
+			TTY:    tty,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(e.config, "POST", req.URL())
+	if err != nil {
+		return 0, fmt.Errorf("new executor: %w", err)
+	}
+
+	opts := remotecommand.StreamOptions{
+		Stdin:  spec.Stdin,
+		Stdout: spec.Stdout,
+		Tty:    tty,
+	}
+
+	if !tty {
+		opts.Stderr = spec.Stderr
+	} else if spec.TTY.WindowSize != nil {
+		opts.TerminalSizeQueue = &fixedSizeQueue{size: spec.TTY.WindowSize}
+	}
+
+	err = exec.Stream(opts)
+	if err != nil {
+		var exitErr utilexec.CodeExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.Code, nil
+		}
+
+		return 0, fmt.Errorf("stream: %w", err)
+	}
+
+	return 0, nil
+}
+
+// fixedSizeQueue reports the initial window size of a tty once. Resizing is
+// not supported as garden processes are not re-attached to.
+//
+type fixedSizeQueue struct {
+	size *garden.WindowSize
+	sent bool
+}
+
+func (q *fixedSizeQueue) Next() *remotecommand.TerminalSize {
+	if q.sent {
+		return nil
+	}
+
+	q.sent = true
+
+	return &remotecommand.TerminalSize{
+		Width:  uint16(q.size.Columns),
+		Height: uint16(q.size.Rows),
+	}
+}
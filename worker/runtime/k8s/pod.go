@@ -0,0 +1,224 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MainContainerName is the name of the container within each pod that
+	// processes are executed in.
+	//
+	MainContainerName = "main"
+
+	// RootfsMountPath is where the container's root filesystem is mounted
+	// within the pod's main container.
+	//
+	RootfsMountPath = "/concourse/rootfs"
+
+	volumesVolumeName = "volumes"
+)
+
+// podSpec converts a garden container spec into the pod that runs it.
+//
+// The pod runs the init binary from the backend's image, with the root
+// filesystem and bind mounts mounted below RootfsMountPath as sub paths of
+// the volumes claim.
+//
+func (b *Backend) podSpec(gdnSpec garden.ContainerSpec) (*corev1.Pod, error) {
+	rootfs, err := rootfsDir(gdnSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	rootfsSubPath, err := b.volumeSubPath(rootfs)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts := []corev1.VolumeMount{
+		{
+			Name:      volumesVolumeName,
+			MountPath: RootfsMountPath,
+			SubPath:   rootfsSubPath,
+		},
+	}
+
+	for _, bindMount := range gdnSpec.BindMounts {
+		subPath, err := b.volumeSubPath(bindMount.SrcPath)
+		if err != nil {
+			return nil, err
+		}
+
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      volumesVolumeName,
+			MountPath: path.Join(RootfsMountPath, bindMount.DstPath),
+			SubPath:   subPath,
+			ReadOnly:  bindMount.Mode != garden.BindMountModeRW,
+		})
+	}
+
+	properties := gdnSpec.Properties
+	if properties == nil {
+		properties = garden.Properties{}
+	}
+
+	encodedProperties, err := json.Marshal(properties)
+	if err != nil {
+		return nil, fmt.Errorf("marshal properties: %w", err)
+	}
+
+	env, err := podEnv(gdnSpec.Env)
+	if err != nil {
+		return nil, err
+	}
+
+	privileged := gdnSpec.Privileged
+	automountServiceAccountToken := false
+	enableServiceLinks := false
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gdnSpec.Handle,
+			Namespace: b.namespace,
+			Labels: map[string]string{
+				WorkerLabel: b.workerName,
+				HandleLabel: gdnSpec.Handle,
+			},
+			Annotations: map[string]string{
+				PropertiesAnnotation: string(encodedProperties),
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                corev1.RestartPolicyNever,
+			AutomountServiceAccountToken: &automountServiceAccountToken,
+			EnableServiceLinks:           &enableServiceLinks,
+			Containers: []corev1.Container{
+				{
+					Name:  MainContainerName,
+					Image: b.image,
+					Command: []string{
+						"/bin/sh", "-c",
+						// name resolution has to keep working once chrooted
+						`cp /etc/hosts /etc/resolv.conf "$1/etc/" 2>/dev/null; exec "$0"`,
+						b.initBinPath,
+						RootfsMountPath,
+					},
+					Env:          env,
+					VolumeMounts: mounts,
+					Resources:    podResources(gdnSpec.Limits),
+					SecurityContext: &corev1.SecurityContext{
+						Privileged: &privileged,
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: volumesVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: b.volumesClaim,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// volumeSubPath determines the sub path of the volumes claim that a path on
+// the worker corresponds to.
+//
+func (b *Backend) volumeSubPath(p string) (string, error) {
+	if b.volumesDir == "" || b.volumesClaim == "" {
+		return "", UnsupportedMountError{Path: p}
+	}
+
+	rel, err := filepath.Rel(b.volumesDir, p)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", UnsupportedMountError{Path: p}
+	}
+
+	if rel == "." {
+		return "", nil
+	}
+
+	return rel, nil
+}
+
+// rootfsDir takes a raw rootfs uri and extracts the directory that it points to,
+// if using a valid scheme (`raw://`)
+//
+func rootfsDir(gdnSpec garden.ContainerSpec) (string, error) {
+	uri := gdnSpec.Image.URI
+	if uri == "" {
+		uri = gdnSpec.RootFSPath
+	}
+
+	if uri == "" {
+		return "", ErrInvalidInput("empty rootfs")
+	}
+
+	parts := strings.SplitN(uri, "://", 2)
+	if len(parts) != 2 {
+		return "", ErrInvalidInput("malformatted rootfs: must be of form 'scheme://<abs_dir>'")
+	}
+
+	if parts[0] != "raw" {
+		return "", ErrInvalidInput("unsupported scheme: " + parts[0])
+	}
+
+	if !filepath.IsAbs(parts[1]) {
+		return "", ErrInvalidInput("directory must be an absolute path")
+	}
+
+	return parts[1], nil
+}
+
+func podEnv(env []string) ([]corev1.EnvVar, error) {
+	vars := make([]corev1.EnvVar, 0, len(env))
+	for _, e := range env {
+		parts := strings.SplitN(e, "=", 2)
+		if len(parts) != 2 {
+			return nil, ErrInvalidInput("malformed env var: " + e)
+		}
+
+		vars = append(vars, corev1.EnvVar{Name: parts[0], Value: parts[1]})
+	}
+
+	return vars, nil
+}
+
+// podResources maps garden limits onto the container's resources. CPU shares
+// are relative to 1024, which translates to one CPU being requested.
+//
+func podResources(limits garden.Limits) corev1.ResourceRequirements {
+	resources := corev1.ResourceRequirements{}
+
+	shares := limits.CPU.Weight
+	if shares == 0 {
+		shares = limits.CPU.LimitInShares
+	}
+
+	if shares > 0 {
+		resources.Requests = corev1.ResourceList{
+			corev1.ResourceCPU: *resource.NewMilliQuantity(int64(shares*1000/1024), resource.DecimalSI),
+		}
+	}
+
+	if limits.Memory.LimitInBytes > 0 {
+		resources.Limits = corev1.ResourceList{
+			corev1.ResourceMemory: *resource.NewQuantity(int64(limits.Memory.LimitInBytes), resource.BinarySI),
+		}
+	}
+
+	return resources
+}
@@ -0,0 +1,144 @@
+package k8s
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/garden"
+)
+
+// Process is a process executed in a pod. It keeps running and can be
+// attached to for as long as the worker is up, even when the client that ran
+// it has gone away.
+//
+type Process struct {
+	id        string
+	container *Container
+
+	stdout *swappableWriter
+	stderr *swappableWriter
+
+	done       chan struct{}
+	exitStatus int
+	err        error
+}
+
+var _ garden.Process = (*Process)(nil)
+
+func newProcess(id string, container *Container, processIO garden.ProcessIO) *Process {
+	return &Process{
+		id:        id,
+		container: container,
+		stdout:    &swappableWriter{writer: processIO.Stdout},
+		stderr:    &swappableWriter{writer: processIO.Stderr},
+		done:      make(chan struct{}),
+	}
+}
+
+// ID retrieves the ID associated with this process.
+//
+func (p *Process) ID() string {
+	return p.id
+}
+
+// Wait for the process to terminate (either naturally, or from a signal).
+//
+func (p *Process) Wait() (int, error) {
+	<-p.done
+	return p.exitStatus, p.err
+}
+
+// SetTTY - Not Implemented
+//
+func (p *Process) SetTTY(spec garden.TTYSpec) error {
+	return ErrNotImplemented
+}
+
+// Signal sends the signal to the processes in the container. Processes are
+// executed through the Kubernetes API, which doesn't expose their PIDs, so
+// all of them are signalled.
+//
+func (p *Process) Signal(signal garden.Signal) error {
+	return p.container.signalAll(signal == garden.SignalKill)
+}
+
+func (p *Process) attach(processIO garden.ProcessIO) {
+	p.stdout.swap(processIO.Stdout)
+	p.stderr.swap(processIO.Stderr)
+}
+
+func (p *Process) finish(exitStatus int, err error) {
+	p.exitStatus = exitStatus
+	p.err = err
+	close(p.done)
+}
+
+// swappableWriter forwards writes to a writer which can be replaced once a
+// client attaches to the process again.
+//
+type swappableWriter struct {
+	lock   sync.Mutex
+	writer io.Writer
+}
+
+func (w *swappableWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.writer == nil {
+		return len(p), nil
+	}
+
+	_, err := w.writer.Write(p)
+	if err != nil {
+		// the client went away; keep the process running until it attaches
+		// again
+		w.writer = nil
+	}
+
+	return len(p), nil
+}
+
+func (w *swappableWriter) swap(writer io.Writer) {
+	w.lock.Lock()
+	w.writer = writer
+	w.lock.Unlock()
+}
+
+// processTable keeps track of the processes of each container.
+//
+type processTable struct {
+	lock      sync.Mutex
+	processes map[string]map[string]*Process
+}
+
+func newProcessTable() *processTable {
+	return &processTable{
+		processes: map[string]map[string]*Process{},
+	}
+}
+
+func (t *processTable) add(handle string, process *Process) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.processes[handle] == nil {
+		t.processes[handle] = map[string]*Process{}
+	}
+
+	t.processes[handle][process.id] = process
+}
+
+func (t *processTable) find(handle string, id string) (*Process, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	process, found := t.processes[handle][id]
+	return process, found
+}
+
+func (t *processTable) forget(handle string) {
+	t.lock.Lock()
+	delete(t.processes, handle)
+	t.lock.Unlock()
+}
@@ -0,0 +1,64 @@
+// +build linux
+
+package workercmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden/server"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/worker/runtime/k8s"
+	"github.com/tedsuo/ifrit"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// kubernetesRunner returns a Garden server which runs containers as pods of
+// the cluster. The worker's baggageclaim volumes directory must be backed by
+// the configured volumes claim so that the pods can mount their volumes.
+func (cmd *WorkerCommand) kubernetesRunner(logger lager.Logger, workerName string) (ifrit.Runner, error) {
+	config, err := cmd.kubernetesConfig()
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes config: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes client: %w", err)
+	}
+
+	backend, err := k8s.NewBackend(
+		client,
+		k8s.NewExecutor(config, client),
+		workerName,
+		k8s.WithNamespace(cmd.Kubernetes.Namespace),
+		k8s.WithImage(cmd.Kubernetes.Image),
+		k8s.WithInitBinPath(cmd.Kubernetes.InitBin),
+		k8s.WithVolumes(filepath.Join(cmd.WorkDir.Path(), "volumes"), cmd.Kubernetes.VolumesClaim),
+		k8s.WithMaxContainers(cmd.Kubernetes.MaxContainers),
+		k8s.WithRequestTimeout(cmd.Kubernetes.RequestTimeout),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes backend: %w", err)
+	}
+
+	server := server.New(
+		"tcp",
+		cmd.bindAddr(),
+		0,
+		backend,
+		logger,
+	)
+
+	return gardenServerRunner{logger, server}, nil
+}
+
+func (cmd *WorkerCommand) kubernetesConfig() (*rest.Config, error) {
+	if cmd.Kubernetes.Kubeconfig.Path() != "" {
+		return clientcmd.BuildConfigFromFlags("", cmd.Kubernetes.Kubeconfig.Path())
+	}
+
+	return rest.InClusterConfig()
+}
@@ -56,6 +56,8 @@ type WorkerCommand struct {
 
 	Containerd ContainerdRuntime `group:"Containerd Configuration" namespace:"containerd"`
 
+	Kubernetes KubernetesRuntime `group:"Kubernetes Configuration" namespace:"kubernetes"`
+
 	ExternalGardenURL flag.URL `long:"external-garden-url" description:"API endpoint of an externally managed Garden server to use instead of running the embedded Garden server."`
 
 	Baggageclaim baggageclaimcmd.BaggageclaimCommand `group:"Baggageclaim Configuration" namespace:"baggageclaim"`
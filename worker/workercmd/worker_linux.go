@@ -25,7 +25,7 @@ type Certs struct {
 }
 
 type RuntimeConfiguration struct {
-	Runtime string `long:"runtime" default:"containerd" choice:"guardian" choice:"containerd" choice:"houdini" description:"Runtime to use with the worker. Please note that Houdini is insecure and doesn't run 'tasks' in containers."`
+	Runtime string `long:"runtime" default:"containerd" choice:"guardian" choice:"containerd" choice:"houdini" choice:"kubernetes" description:"Runtime to use with the worker. Please note that Houdini is insecure and doesn't run 'tasks' in containers."`
 }
 
 type GuardianRuntime struct {
@@ -58,6 +58,17 @@ type ContainerdRuntime struct {
 	MaxContainers int `long:"max-containers" default:"250" description:"Max container capacity. 0 means no limit."`
 }
 
+type KubernetesRuntime struct {
+	Kubeconfig     flag.File     `long:"kubeconfig"      description:"Path to a kubeconfig file. Defaults to the service account of the worker's pod."`
+	Namespace      string        `long:"namespace"       default:"default" description:"Namespace to create the pods running containers in."`
+	Image          string        `long:"image"           default:"concourse/concourse" description:"Image to run the pods with. It must provide the init executable, sh, chroot, kill and tar."`
+	InitBin        string        `long:"init-bin"        default:"/usr/local/concourse/bin/init" description:"Path to the init executable within the image."`
+	VolumesClaim   string        `long:"volumes-claim"   description:"Persistent volume claim mounted at the baggageclaim volumes directory, from which pods mount their root filesystems and volumes."`
+	RequestTimeout time.Duration `long:"request-timeout" default:"5m" description:"How long to wait for pods to start running. 0 means no timeout."`
+
+	MaxContainers int `long:"max-containers" default:"250" description:"Max container capacity. 0 means no limit."`
+}
+
 type DNSConfig struct {
 	Enable bool `long:"enable" description:"Enable proxy DNS server. Note: this will enable containers to access the host network."`
 }
@@ -65,6 +76,7 @@ type DNSConfig struct {
 const containerdRuntime = "containerd"
 const guardianRuntime = "guardian"
 const houdiniRuntime = "houdini"
+const kubernetesRuntime = "kubernetes"
 
 func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Command) {
 	// configured as work-dir/volumes
@@ -76,9 +88,14 @@ func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Comman
 // endpoints that allow the ATC to make container related requests to the worker.
 // The runner may also include additional processes such as the runtime's daemon or a DNS proxy server.
 func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, ifrit.Runner, error) {
-	err := cmd.checkRoot()
-	if err != nil {
-		return atc.Worker{}, nil, err
+	var err error
+
+	// the kubernetes runtime leaves running containers to the cluster
+	if cmd.Runtime != kubernetesRuntime {
+		err = cmd.checkRoot()
+		if err != nil {
+			return atc.Worker{}, nil, err
+		}
 	}
 
 	err = cmd.verifyRuntimeFlags()
@@ -114,6 +131,8 @@ func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, i
 		runner, err = cmd.containerdRunner(logger)
 	case cmd.Runtime == guardianRuntime:
 		runner, err = cmd.guardianRunner(logger)
+	case cmd.Runtime == kubernetesRuntime:
+		runner, err = cmd.kubernetesRunner(logger, worker.Name)
 	default:
 		err = fmt.Errorf("unsupported Runtime :%s", cmd.Runtime)
 	}
@@ -231,21 +250,26 @@ func (cmd *WorkerCommand) hasFlags(prefix string) bool {
 
 const guardianEnvPrefix = "CONCOURSE_GARDEN_"
 const containerdEnvPrefix = "CONCOURSE_CONTAINERD_"
+const kubernetesEnvPrefix = "CONCOURSE_KUBERNETES_"
 
 // Checks if runtime specific flags provided match the selected runtime type
 func (cmd *WorkerCommand) verifyRuntimeFlags() error {
 	switch {
 	case cmd.Runtime == houdiniRuntime:
-		if cmd.hasFlags(guardianEnvPrefix) || cmd.hasFlags(containerdEnvPrefix) {
-			return fmt.Errorf("cannot use %s or %s environment variables with Houdini", guardianEnvPrefix, containerdEnvPrefix)
+		if cmd.hasFlags(guardianEnvPrefix) || cmd.hasFlags(containerdEnvPrefix) || cmd.hasFlags(kubernetesEnvPrefix) {
+			return fmt.Errorf("cannot use %s, %s or %s environment variables with Houdini", guardianEnvPrefix, containerdEnvPrefix, kubernetesEnvPrefix)
 		}
 	case cmd.Runtime == containerdRuntime:
-		if cmd.hasFlags(guardianEnvPrefix) {
-			return fmt.Errorf("cannot use %s environment variables with Containerd", guardianEnvPrefix)
+		if cmd.hasFlags(guardianEnvPrefix) || cmd.hasFlags(kubernetesEnvPrefix) {
+			return fmt.Errorf("cannot use %s or %s environment variables with Containerd", guardianEnvPrefix, kubernetesEnvPrefix)
 		}
 	case cmd.Runtime == guardianRuntime:
-		if cmd.hasFlags(containerdEnvPrefix) {
-			return fmt.Errorf("cannot use %s environment variables with Guardian", containerdEnvPrefix)
+		if cmd.hasFlags(containerdEnvPrefix) || cmd.hasFlags(kubernetesEnvPrefix) {
+			return fmt.Errorf("cannot use %s or %s environment variables with Guardian", containerdEnvPrefix, kubernetesEnvPrefix)
+		}
+	case cmd.Runtime == kubernetesRuntime:
+		if cmd.hasFlags(guardianEnvPrefix) || cmd.hasFlags(containerdEnvPrefix) {
+			return fmt.Errorf("cannot use %s or %s environment variables with Kubernetes", guardianEnvPrefix, containerdEnvPrefix)
 		}
 	default:
 		return fmt.Errorf("unsupported Runtime :%s", cmd.Runtime)
@@ -22,6 +22,9 @@ type GuardianRuntime struct {
 type ContainerdRuntime struct {
 }
 
+type KubernetesRuntime struct {
+}
+
 type Certs struct{}
 
 func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Command) {